@@ -54,6 +54,17 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	restoreControlPlaneLoadBalancerStatus(&restored.Status.Network.SecondaryAPIServerELB, &dst.Status.Network.SecondaryAPIServerELB)
 
 	dst.Spec.S3Bucket = restored.Spec.S3Bucket
+	dst.Spec.ControlPlaneDNS = restored.Spec.ControlPlaneDNS
+	dst.Spec.IngressLoadBalancer = restored.Spec.IngressLoadBalancer
+	dst.Status.ControlPlaneDNS = restored.Status.ControlPlaneDNS
+	dst.Status.ControlPlaneLoadBalancerMigration = restored.Status.ControlPlaneLoadBalancerMigration
+	dst.Spec.Bastion.AllowedPrefixLists = restored.Spec.Bastion.AllowedPrefixLists
+	dst.Spec.Bastion.Mode = restored.Spec.Bastion.Mode
+	dst.Spec.Bastion.InstanceProfile = restored.Spec.Bastion.InstanceProfile
+	dst.Spec.Bastion.AMILookup = restored.Spec.Bastion.AMILookup
+	dst.Spec.Bastion.UserData = restored.Spec.Bastion.UserData
+	dst.Spec.Bastion.InstanceMetadataOptions = restored.Spec.Bastion.InstanceMetadataOptions
+	dst.Spec.Bastion.AutoScaling = restored.Spec.Bastion.AutoScaling
 	if restored.Status.Bastion != nil {
 		dst.Status.Bastion.InstanceMetadataOptions = restored.Status.Bastion.InstanceMetadataOptions
 		dst.Status.Bastion.PlacementGroupName = restored.Status.Bastion.PlacementGroupName
@@ -79,6 +90,15 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Status.Network.SecurityGroups[role] = sg
 	}
 	dst.Status.Network.NatGatewaysIPs = restored.Status.Network.NatGatewaysIPs
+	dst.Status.Network.TransitGatewayAttachment = restored.Status.Network.TransitGatewayAttachment
+	dst.Status.Network.ManagedPrefixLists = restored.Status.Network.ManagedPrefixLists
+	dst.Status.Network.IngressLoadBalancer = restored.Status.Network.IngressLoadBalancer
+	for role, sg := range restored.Status.Network.SecurityGroups {
+		if dstSG, ok := dst.Status.Network.SecurityGroups[role]; ok {
+			dstSG.EgressRules = sg.EgressRules
+			dst.Status.Network.SecurityGroups[role] = dstSG
+		}
+	}
 
 	if restored.Spec.NetworkSpec.VPC.IPAMPool != nil {
 		if dst.Spec.NetworkSpec.VPC.IPAMPool == nil {
@@ -99,6 +119,10 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.NetworkSpec.AdditionalControlPlaneIngressRules = restored.Spec.NetworkSpec.AdditionalControlPlaneIngressRules
 	dst.Spec.NetworkSpec.AdditionalNodeIngressRules = restored.Spec.NetworkSpec.AdditionalNodeIngressRules
 	dst.Spec.NetworkSpec.NodePortIngressRuleCidrBlocks = restored.Spec.NetworkSpec.NodePortIngressRuleCidrBlocks
+	dst.Spec.NetworkSpec.AdditionalControlPlaneEgressRules = restored.Spec.NetworkSpec.AdditionalControlPlaneEgressRules
+	dst.Spec.NetworkSpec.AdditionalNodeEgressRules = restored.Spec.NetworkSpec.AdditionalNodeEgressRules
+	dst.Spec.NetworkSpec.SecurityGroupEgressMode = restored.Spec.NetworkSpec.SecurityGroupEgressMode
+	dst.Spec.NetworkSpec.ManagedPrefixLists = restored.Spec.NetworkSpec.ManagedPrefixLists
 
 	if restored.Spec.NetworkSpec.VPC.IPAMPool != nil {
 		if dst.Spec.NetworkSpec.VPC.IPAMPool == nil {
@@ -121,6 +145,10 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.NetworkSpec.VPC.CarrierGatewayID = restored.Spec.NetworkSpec.VPC.CarrierGatewayID
 	dst.Spec.NetworkSpec.VPC.SubnetSchema = restored.Spec.NetworkSpec.VPC.SubnetSchema
 	dst.Spec.NetworkSpec.VPC.SecondaryCidrBlocks = restored.Spec.NetworkSpec.VPC.SecondaryCidrBlocks
+	dst.Spec.NetworkSpec.VPC.TransitGateway = restored.Spec.NetworkSpec.VPC.TransitGateway
+	dst.Spec.NetworkSpec.VPC.FlowLogs = restored.Spec.NetworkSpec.VPC.FlowLogs
+	dst.Spec.NetworkSpec.VPC.VPCEndpoints = restored.Spec.NetworkSpec.VPC.VPCEndpoints
+	dst.Spec.NetworkSpec.VPC.VPCEndpointsPreset = restored.Spec.NetworkSpec.VPC.VPCEndpointsPreset
 
 	if restored.Spec.NetworkSpec.VPC.ElasticIPPool != nil {
 		if dst.Spec.NetworkSpec.VPC.ElasticIPPool == nil {
@@ -165,6 +193,7 @@ func restoreControlPlaneLoadBalancerStatus(restored, dst *infrav1.LoadBalancer)
 	dst.ELBListeners = restored.ELBListeners
 	dst.Name = restored.Name
 	dst.DNSName = restored.DNSName
+	dst.CanonicalHostedZoneID = restored.CanonicalHostedZoneID
 	dst.Scheme = restored.Scheme
 	dst.SubnetIDs = restored.SubnetIDs
 	dst.SecurityGroupIDs = restored.SecurityGroupIDs
@@ -193,12 +222,17 @@ func restoreControlPlaneLoadBalancer(restored, dst *infrav1.AWSLoadBalancerSpec)
 	dst.DisableHostsRewrite = restored.DisableHostsRewrite
 	dst.PreserveClientIP = restored.PreserveClientIP
 	dst.IngressRules = restored.IngressRules
+	dst.EgressRules = restored.EgressRules
 	dst.AdditionalListeners = restored.AdditionalListeners
 	dst.AdditionalSecurityGroups = restored.AdditionalSecurityGroups
 	dst.Scheme = restored.Scheme
 	dst.CrossZoneLoadBalancing = restored.CrossZoneLoadBalancing
 	dst.Subnets = restored.Subnets
 	dst.TargetGroupIPType = restored.TargetGroupIPType
+	dst.AccessLogs = restored.AccessLogs
+	dst.DeletionProtection = restored.DeletionProtection
+	dst.TLSListener = restored.TLSListener
+	dst.TargetGroupAttributes = restored.TargetGroupAttributes
 }
 
 // ConvertFrom converts the v1beta1 AWSCluster receiver to a v1beta1 AWSCluster.
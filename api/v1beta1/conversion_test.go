@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	"encoding/json"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -33,6 +34,7 @@ func fuzzFuncs(_ runtimeserializer.CodecFactory) []interface{} {
 	return []interface{}{
 		AWSMachineFuzzer,
 		AWSMachineTemplateFuzzer,
+		LoadBalancerMigrationStatusFuzzer,
 	}
 }
 
@@ -68,6 +70,21 @@ func AWSMachineTemplateFuzzer(obj *AWSMachineTemplate, c randfill.Continue) {
 	obj.Spec.Template.Spec.FailureDomain = nil
 }
 
+func LoadBalancerMigrationStatusFuzzer(obj *v1beta2.LoadBalancerMigrationStatus, c randfill.Continue) {
+	c.FillNoCustom(obj)
+
+	// AWSCluster.Status.ControlPlaneLoadBalancerMigration only exists in v1beta2 and is restored from the conversion data annotation,
+	// which drops empty maps and slices as well as sub-second times, so normalizing it through JSON in order to avoid v1beta2 --> v1beta1 --> v1beta2 round trip errors.
+	data, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	*obj = v1beta2.LoadBalancerMigrationStatus{}
+	if err := json.Unmarshal(data, obj); err != nil {
+		panic(err)
+	}
+}
+
 func TestFuzzyConversion(t *testing.T) {
 	g := NewWithT(t)
 	scheme := runtime.NewScheme()
@@ -75,9 +92,10 @@ func TestFuzzyConversion(t *testing.T) {
 	g.Expect(v1beta2.AddToScheme(scheme)).To(Succeed())
 
 	t.Run("for AWSCluster", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
-		Scheme: scheme,
-		Hub:    &v1beta2.AWSCluster{},
-		Spoke:  &AWSCluster{},
+		Scheme:      scheme,
+		Hub:         &v1beta2.AWSCluster{},
+		Spoke:       &AWSCluster{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
 	}))
 
 	t.Run("for AWSMachine", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
@@ -179,11 +179,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.AWSClusterStatus)(nil), (*AWSClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_AWSClusterStatus_To_v1beta1_AWSClusterStatus(a.(*v1beta2.AWSClusterStatus), b.(*AWSClusterStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*AWSClusterTemplate)(nil), (*v1beta2.AWSClusterTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AWSClusterTemplate_To_v1beta2_AWSClusterTemplate(a.(*AWSClusterTemplate), b.(*v1beta2.AWSClusterTemplate), scope)
 	}); err != nil {
@@ -339,11 +334,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.Bastion)(nil), (*Bastion)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_Bastion_To_v1beta1_Bastion(a.(*v1beta2.Bastion), b.(*Bastion), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*BuildParams)(nil), (*v1beta2.BuildParams)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_BuildParams_To_v1beta2_BuildParams(a.(*BuildParams), b.(*v1beta2.BuildParams), scope)
 	}); err != nil {
@@ -474,11 +464,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.SecurityGroup)(nil), (*SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_SecurityGroup_To_v1beta1_SecurityGroup(a.(*v1beta2.SecurityGroup), b.(*SecurityGroup), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*SpotMarketOptions)(nil), (*v1beta2.SpotMarketOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SpotMarketOptions_To_v1beta2_SpotMarketOptions(a.(*SpotMarketOptions), b.(*v1beta2.SpotMarketOptions), scope)
 	}); err != nil {
@@ -529,6 +514,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.AWSClusterStatus)(nil), (*AWSClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_AWSClusterStatus_To_v1beta1_AWSClusterStatus(a.(*v1beta2.AWSClusterStatus), b.(*AWSClusterStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.AWSLoadBalancerSpec)(nil), (*AWSLoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_AWSLoadBalancerSpec_To_v1beta1_AWSLoadBalancerSpec(a.(*v1beta2.AWSLoadBalancerSpec), b.(*AWSLoadBalancerSpec), scope)
 	}); err != nil {
@@ -549,6 +539,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.Bastion)(nil), (*Bastion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_Bastion_To_v1beta1_Bastion(a.(*v1beta2.Bastion), b.(*Bastion), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.IPv6)(nil), (*IPv6)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_IPv6_To_v1beta1_IPv6(a.(*v1beta2.IPv6), b.(*IPv6), scope)
 	}); err != nil {
@@ -589,6 +584,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.SecurityGroup)(nil), (*SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_SecurityGroup_To_v1beta1_SecurityGroup(a.(*v1beta2.SecurityGroup), b.(*SecurityGroup), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.SubnetSpec)(nil), (*SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec(a.(*v1beta2.SubnetSpec), b.(*SubnetSpec), scope)
 	}); err != nil {
@@ -948,6 +948,8 @@ func autoConvert_v1beta2_AWSClusterSpec_To_v1beta1_AWSClusterSpec(in *v1beta2.AW
 	} else {
 		out.S3Bucket = nil
 	}
+	// WARNING: in.ControlPlaneDNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.IngressLoadBalancer requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1065,14 +1067,11 @@ func autoConvert_v1beta2_AWSClusterStatus_To_v1beta1_AWSClusterStatus(in *v1beta
 		out.Bastion = nil
 	}
 	out.Conditions = *(*corev1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	// WARNING: in.ControlPlaneDNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.ControlPlaneLoadBalancerMigration requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_AWSClusterStatus_To_v1beta1_AWSClusterStatus is an autogenerated conversion function.
-func Convert_v1beta2_AWSClusterStatus_To_v1beta1_AWSClusterStatus(in *v1beta2.AWSClusterStatus, out *AWSClusterStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_AWSClusterStatus_To_v1beta1_AWSClusterStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_AWSClusterTemplate_To_v1beta2_AWSClusterTemplate(in *AWSClusterTemplate, out *v1beta2.AWSClusterTemplate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_AWSClusterTemplateSpec_To_v1beta2_AWSClusterTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1238,10 +1237,15 @@ func autoConvert_v1beta2_AWSLoadBalancerSpec_To_v1beta1_AWSLoadBalancerSpec(in *
 	out.AdditionalSecurityGroups = *(*[]string)(unsafe.Pointer(&in.AdditionalSecurityGroups))
 	// WARNING: in.AdditionalListeners requires manual conversion: does not exist in peer-type
 	// WARNING: in.IngressRules requires manual conversion: does not exist in peer-type
+	// WARNING: in.EgressRules requires manual conversion: does not exist in peer-type
 	// WARNING: in.LoadBalancerType requires manual conversion: does not exist in peer-type
 	// WARNING: in.DisableHostsRewrite requires manual conversion: does not exist in peer-type
 	// WARNING: in.PreserveClientIP requires manual conversion: does not exist in peer-type
 	// WARNING: in.TargetGroupIPType requires manual conversion: does not exist in peer-type
+	// WARNING: in.AccessLogs requires manual conversion: does not exist in peer-type
+	// WARNING: in.DeletionProtection requires manual conversion: does not exist in peer-type
+	// WARNING: in.TLSListener requires manual conversion: does not exist in peer-type
+	// WARNING: in.TargetGroupAttributes requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1698,18 +1702,20 @@ func Convert_v1beta1_Bastion_To_v1beta2_Bastion(in *Bastion, out *v1beta2.Bastio
 
 func autoConvert_v1beta2_Bastion_To_v1beta1_Bastion(in *v1beta2.Bastion, out *Bastion, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	// WARNING: in.Mode requires manual conversion: does not exist in peer-type
+	// WARNING: in.InstanceProfile requires manual conversion: does not exist in peer-type
 	out.DisableIngressRules = in.DisableIngressRules
 	out.AllowedCIDRBlocks = *(*[]string)(unsafe.Pointer(&in.AllowedCIDRBlocks))
+	// WARNING: in.AllowedPrefixLists requires manual conversion: does not exist in peer-type
 	out.InstanceType = in.InstanceType
 	out.AMI = in.AMI
+	// WARNING: in.AMILookup requires manual conversion: does not exist in peer-type
+	// WARNING: in.UserData requires manual conversion: does not exist in peer-type
+	// WARNING: in.InstanceMetadataOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.AutoScaling requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_Bastion_To_v1beta1_Bastion is an autogenerated conversion function.
-func Convert_v1beta2_Bastion_To_v1beta1_Bastion(in *v1beta2.Bastion, out *Bastion, s conversion.Scope) error {
-	return autoConvert_v1beta2_Bastion_To_v1beta1_Bastion(in, out, s)
-}
-
 func autoConvert_v1beta1_BuildParams_To_v1beta2_BuildParams(in *BuildParams, out *v1beta2.BuildParams, s conversion.Scope) error {
 	out.Lifecycle = v1beta2.ResourceLifecycle(in.Lifecycle)
 	out.ClusterName = in.ClusterName
@@ -1974,6 +1980,7 @@ func autoConvert_v1beta2_IngressRule_To_v1beta1_IngressRule(in *v1beta2.IngressR
 	out.SourceSecurityGroupIDs = *(*[]string)(unsafe.Pointer(&in.SourceSecurityGroupIDs))
 	// WARNING: in.SourceSecurityGroupRoles requires manual conversion: does not exist in peer-type
 	// WARNING: in.NatGatewaysIPsSource requires manual conversion: does not exist in peer-type
+	// WARNING: in.SourcePrefixLists requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2094,6 +2101,10 @@ func autoConvert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(in *v1beta2.NetworkS
 	// WARNING: in.AdditionalControlPlaneIngressRules requires manual conversion: does not exist in peer-type
 	// WARNING: in.AdditionalNodeIngressRules requires manual conversion: does not exist in peer-type
 	// WARNING: in.NodePortIngressRuleCidrBlocks requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalControlPlaneEgressRules requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalNodeEgressRules requires manual conversion: does not exist in peer-type
+	// WARNING: in.SecurityGroupEgressMode requires manual conversion: does not exist in peer-type
+	// WARNING: in.ManagedPrefixLists requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2140,7 +2151,10 @@ func autoConvert_v1beta2_NetworkStatus_To_v1beta1_NetworkStatus(in *v1beta2.Netw
 		return err
 	}
 	// WARNING: in.SecondaryAPIServerELB requires manual conversion: does not exist in peer-type
+	// WARNING: in.IngressLoadBalancer requires manual conversion: does not exist in peer-type
 	// WARNING: in.NatGatewaysIPs requires manual conversion: does not exist in peer-type
+	// WARNING: in.TransitGatewayAttachment requires manual conversion: does not exist in peer-type
+	// WARNING: in.ManagedPrefixLists requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2222,15 +2236,11 @@ func autoConvert_v1beta2_SecurityGroup_To_v1beta1_SecurityGroup(in *v1beta2.Secu
 	} else {
 		out.IngressRules = nil
 	}
+	// WARNING: in.EgressRules requires manual conversion: does not exist in peer-type
 	out.Tags = *(*Tags)(unsafe.Pointer(&in.Tags))
 	return nil
 }
 
-// Convert_v1beta2_SecurityGroup_To_v1beta1_SecurityGroup is an autogenerated conversion function.
-func Convert_v1beta2_SecurityGroup_To_v1beta1_SecurityGroup(in *v1beta2.SecurityGroup, out *SecurityGroup, s conversion.Scope) error {
-	return autoConvert_v1beta2_SecurityGroup_To_v1beta1_SecurityGroup(in, out, s)
-}
-
 func autoConvert_v1beta1_SpotMarketOptions_To_v1beta2_SpotMarketOptions(in *SpotMarketOptions, out *v1beta2.SpotMarketOptions, s conversion.Scope) error {
 	out.MaxPrice = (*string)(unsafe.Pointer(in.MaxPrice))
 	return nil
@@ -2332,6 +2342,10 @@ func autoConvert_v1beta2_VPCSpec_To_v1beta1_VPCSpec(in *v1beta2.VPCSpec, out *VP
 	// WARNING: in.PrivateDNSHostnameTypeOnLaunch requires manual conversion: does not exist in peer-type
 	// WARNING: in.ElasticIPPool requires manual conversion: does not exist in peer-type
 	// WARNING: in.SubnetSchema requires manual conversion: does not exist in peer-type
+	// WARNING: in.TransitGateway requires manual conversion: does not exist in peer-type
+	// WARNING: in.FlowLogs requires manual conversion: does not exist in peer-type
+	// WARNING: in.VPCEndpoints requires manual conversion: does not exist in peer-type
+	// WARNING: in.VPCEndpointsPreset requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -111,6 +111,18 @@ type AWSClusterSpec struct {
 	// BootstrapFormatIgnition feature flag to be enabled).
 	// +optional
 	S3Bucket *S3Bucket `json:"s3Bucket,omitempty"`
+
+	// ControlPlaneDNS configures a Route53 record pointing to the API server load balancer.
+	// When set, the record name is used as the host of the control plane endpoint instead of
+	// the DNS name of the load balancer.
+	// +optional
+	ControlPlaneDNS *ControlPlaneDNS `json:"controlPlaneDNS,omitempty"`
+
+	// IngressLoadBalancer configures an Application Load Balancer in front of additional HTTPS
+	// endpoints served by the control plane instances, such as webhooks. Requests are routed to
+	// target groups by host and path, and can be protected by an AWS WAF web ACL.
+	// +optional
+	IngressLoadBalancer *IngressLoadBalancerSpec `json:"ingressLoadBalancer,omitempty"`
 }
 
 // AWSIdentityKind defines allowed AWS identity types.
@@ -145,8 +157,22 @@ type Bastion struct {
 	// +optional
 	Enabled bool `json:"enabled"`
 
+	// Mode defines how the bastion host is accessed.
+	// With ssh, the default, the bastion host is placed in a public subnet and is reached with SSH.
+	// With ssm, the bastion host is placed in a private subnet without any ingress rules and is reached
+	// through AWS Systems Manager Session Manager, e.g. with `clusterawsadm bastion connect`.
+	// +kubebuilder:validation:Enum=ssh;ssm
+	// +optional
+	Mode BastionMode `json:"mode,omitempty"`
+
+	// InstanceProfile is the name of the IAM instance profile of the bastion host.
+	// It is required when Mode is ssm and must grant the permissions of the AmazonSSMManagedInstanceCore
+	// managed policy so that the SSM agent of the bastion host can register with Session Manager.
+	// +optional
+	InstanceProfile string `json:"instanceProfile,omitempty"`
+
 	// DisableIngressRules will ensure there are no Ingress rules in the bastion host's security group.
-	// Requires AllowedCIDRBlocks to be empty.
+	// Requires AllowedCIDRBlocks and AllowedPrefixLists to be empty.
 	// +optional
 	DisableIngressRules bool `json:"disableIngressRules,omitempty"`
 
@@ -156,6 +182,11 @@ type Bastion struct {
 	// +optional
 	AllowedCIDRBlocks CidrBlocks `json:"allowedCIDRBlocks,omitempty"`
 
+	// AllowedPrefixLists is a list of managed prefix lists allowed to access the bastion host.
+	// When set, AllowedCIDRBlocks isn't defaulted.
+	// +optional
+	AllowedPrefixLists []PrefixListReference `json:"allowedPrefixLists,omitempty"`
+
 	// InstanceType will use the specified instance type for the bastion. If not specified,
 	// Cluster API Provider AWS will use t3.micro for all regions except us-east-1, where t2.micro
 	// will be the default.
@@ -165,8 +196,74 @@ type Bastion struct {
 	// the AMI will default to one picked out in public space.
 	// +optional
 	AMI string `json:"ami,omitempty"`
+
+	// AMILookup defines how the AMI of the bastion host is looked up when AMI isn't set.
+	// If not specified, the latest Ubuntu LTS image published by Canonical is used.
+	// +optional
+	AMILookup *BastionAMILookup `json:"amiLookup,omitempty"`
+
+	// UserData is the user data of the bastion host. If not specified, a default
+	// script hardening the SSH daemon is used.
+	// +optional
+	UserData string `json:"userData,omitempty"`
+
+	// InstanceMetadataOptions is the metadata options for the bastion host.
+	// +optional
+	InstanceMetadataOptions *InstanceMetadataOptions `json:"instanceMetadataOptions,omitempty"`
+
+	// AutoScaling runs the bastion host as a single-instance Auto Scaling Group spread over
+	// the subnets of the bastion host so that it's replaced when it becomes unhealthy or its
+	// availability zone fails. Outside of ssm mode, an Elastic IP is associated with every
+	// new instance so that the address of the bastion host doesn't change.
+	// +optional
+	AutoScaling *BastionAutoScaling `json:"autoScaling,omitempty"`
+}
+
+// BastionAMILookup defines how the AMI of the bastion host is looked up.
+type BastionAMILookup struct {
+	// Owners is the list of AWS account IDs or aliases owning the AMI.
+	// +kubebuilder:validation:MinItems=1
+	Owners []string `json:"owners"`
+
+	// Name is the name of the AMI, which may contain the * and ? wildcards.
+	// The most recently created matching AMI is used.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Architecture is the architecture of the AMI.
+	// +kubebuilder:validation:Enum=x86_64;arm64
+	// +kubebuilder:default=x86_64
+	// +optional
+	Architecture string `json:"architecture,omitempty"`
 }
 
+// BastionAutoScaling defines the Auto Scaling Group of the bastion host.
+type BastionAutoScaling struct {
+	// HealthCheckGracePeriod is the time, in seconds, that Amazon EC2 Auto Scaling waits
+	// before checking the health status of a new bastion instance. Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	HealthCheckGracePeriod *int32 `json:"healthCheckGracePeriod,omitempty"`
+
+	// DisableInstanceRefresh disables the instance refresh started when the launch
+	// template of the bastion host changes. The new launch template version is then
+	// only used once the current instance is replaced.
+	// +optional
+	DisableInstanceRefresh bool `json:"disableInstanceRefresh,omitempty"`
+}
+
+// BastionMode defines how the bastion host is accessed.
+type BastionMode string
+
+var (
+	// BastionModeSSH is the mode of a bastion host in a public subnet reached with SSH.
+	BastionModeSSH = BastionMode("ssh")
+
+	// BastionModeSSM is the mode of a bastion host in a private subnet reached through
+	// AWS Systems Manager Session Manager.
+	BastionModeSSM = BastionMode("ssm")
+)
+
 // LoadBalancerType defines the type of load balancer to use.
 type LoadBalancerType string
 
@@ -241,6 +338,11 @@ type AWSLoadBalancerSpec struct {
 	// +optional
 	IngressRules []IngressRule `json:"ingressRules,omitempty"`
 
+	// EgressRules sets the additional egress rules for the control plane load balancer security group.
+	// See spec.network.securityGroupEgressMode for how they combine with the default egress rules.
+	// +optional
+	EgressRules []EgressRule `json:"egressRules,omitempty"`
+
 	// LoadBalancerType sets the type for a load balancer. The default type is classic.
 	// +kubebuilder:default=classic
 	// +kubebuilder:validation:Enum:=classic;elb;alb;nlb;disabled
@@ -262,6 +364,63 @@ type AWSLoadBalancerSpec struct {
 	// +kubebuilder:validation:Enum=ipv4;ipv6
 	// +optional
 	TargetGroupIPType *TargetGroupIPType `json:"targetGroupIPType,omitempty"`
+
+	// AccessLogs configures the load balancer to deliver its access logs to an S3 bucket.
+	// This is only applicable to Network Load Balancer (NLB) and Application Load Balancer (ALB) types.
+	// +optional
+	AccessLogs *LoadBalancerAccessLogs `json:"accessLogs,omitempty"`
+
+	// DeletionProtection enables the deletion protection of the load balancer. CAPA disables it
+	// before deleting the load balancer together with the cluster.
+	// This field cannot be set if LoadBalancerType is classic or disabled.
+	// +optional
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+
+	// TLSListener adds a listener terminating TLS with an ACM certificate and forwarding the traffic
+	// to the API server. The listener on the API server port is kept, as clients authenticating with
+	// a client certificate need the TLS connection to be passed through to the API server.
+	// This is only applicable to Network Load Balancer (NLB) and Application Load Balancer (ALB) types.
+	// +optional
+	TLSListener *TLSListenerSpec `json:"tlsListener,omitempty"`
+
+	// TargetGroupAttributes sets the attributes of the API server target groups.
+	// This field cannot be set if LoadBalancerType is classic or disabled.
+	// +optional
+	TargetGroupAttributes *TargetGroupAttributes `json:"targetGroupAttributes,omitempty"`
+}
+
+// LoadBalancerAccessLogs defines the delivery of the access logs of a load balancer to S3.
+type LoadBalancerAccessLogs struct {
+	// Bucket is the name of the S3 bucket the access logs are delivered to. The bucket policy must
+	// allow the Elastic Load Balancing log delivery service to write to it.
+	// +kubebuilder:validation:MinLength=3
+	// +kubebuilder:validation:MaxLength=63
+	Bucket string `json:"bucket"`
+
+	// Prefix is the prefix of the access log objects in the bucket. When not set, the logs are
+	// written at the root of the bucket.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+}
+
+// TLSListenerSpec defines a load balancer listener terminating TLS in front of the API server.
+type TLSListenerSpec struct {
+	// Port sets the port of the listener. It must be different from the API server port
+	// and from the ports of the additional listeners.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=443
+	// +optional
+	Port int64 `json:"port,omitempty"`
+
+	// CertificateARN is the ARN of the ACM certificate presented by the listener.
+	// +kubebuilder:validation:MinLength=1
+	CertificateARN string `json:"certificateARN"`
+
+	// SSLPolicy is the name of the security policy defining the protocols and ciphers
+	// supported by the listener. When not set, the default policy of the load balancer type is used.
+	// +optional
+	SSLPolicy *string `json:"sslPolicy,omitempty"`
 }
 
 // AdditionalListenerSpec defines the desired state of an
@@ -289,6 +448,274 @@ type AdditionalListenerSpec struct {
 	// +kubebuilder:validation:Enum=ipv4;ipv6
 	// +optional
 	TargetGroupIPType *TargetGroupIPType `json:"targetGroupIPType,omitempty"`
+
+	// TargetGroupAttributes sets the attributes of the target group of the additional listener.
+	// +optional
+	TargetGroupAttributes *TargetGroupAttributes `json:"targetGroupAttributes,omitempty"`
+}
+
+// TargetGroupAttributes defines the attributes of a load balancer target group.
+// Attributes that are not set keep the value set when the target group was created.
+type TargetGroupAttributes struct {
+	// DeregistrationDelaySeconds is how long the load balancer keeps a deregistering target
+	// so in-flight requests can complete. AWS defaults to 300 seconds.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	DeregistrationDelaySeconds *int64 `json:"deregistrationDelaySeconds,omitempty"`
+
+	// ConnectionTermination closes the connections to a deregistering target at the end of
+	// the deregistration delay.
+	// This is only applicable to Network Load Balancer (NLB) types.
+	// +optional
+	ConnectionTermination *bool `json:"connectionTermination,omitempty"`
+
+	// UnhealthyConnectionTermination closes the connections to a target as soon as it becomes unhealthy.
+	// CAPA disables it when creating the target groups of Network Load Balancers.
+	// This is only applicable to Network Load Balancer (NLB) types.
+	// +optional
+	UnhealthyConnectionTermination *bool `json:"unhealthyConnectionTermination,omitempty"`
+
+	// UnhealthyDrainingIntervalSeconds is how long the connections to an unhealthy target are kept
+	// when UnhealthyConnectionTermination is disabled. CAPA sets it to 300 seconds when creating the
+	// target groups of Network Load Balancers.
+	// This is only applicable to Network Load Balancer (NLB) types.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=360000
+	// +optional
+	UnhealthyDrainingIntervalSeconds *int64 `json:"unhealthyDrainingIntervalSeconds,omitempty"`
+
+	// ProxyProtocolV2 sends the proxy protocol v2 header to the targets. The API server does not
+	// support it, so it can only be enabled for additional listeners.
+	// This is only applicable to Network Load Balancer (NLB) types.
+	// +optional
+	ProxyProtocolV2 *bool `json:"proxyProtocolV2,omitempty"`
+
+	// Stickiness routes the requests of a client to the same target.
+	// +optional
+	Stickiness *TargetGroupStickiness `json:"stickiness,omitempty"`
+}
+
+// TargetGroupStickinessType defines the type of stickiness of a target group.
+type TargetGroupStickinessType string
+
+var (
+	// TargetGroupStickinessTypeSourceIP routes the requests of a client IP address to the same target.
+	// It is used by Network Load Balancers.
+	TargetGroupStickinessTypeSourceIP = TargetGroupStickinessType("source_ip")
+
+	// TargetGroupStickinessTypeLBCookie routes the requests of a client to the same target with a
+	// cookie generated by the load balancer. It is used by Application Load Balancers.
+	TargetGroupStickinessTypeLBCookie = TargetGroupStickinessType("lb_cookie")
+)
+
+// TargetGroupStickiness defines the stickiness of a target group.
+type TargetGroupStickiness struct {
+	// Enabled enables the stickiness.
+	Enabled bool `json:"enabled"`
+
+	// Type is the type of stickiness. Network Load Balancers support source_ip,
+	// Application Load Balancers support lb_cookie.
+	// +kubebuilder:validation:Enum=source_ip;lb_cookie
+	Type TargetGroupStickinessType `json:"type"`
+
+	// CookieDurationSeconds is how long the cookie of lb_cookie stickiness is valid. AWS defaults to one day.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=604800
+	// +optional
+	CookieDurationSeconds *int64 `json:"cookieDurationSeconds,omitempty"`
+}
+
+// IngressLoadBalancerSpec defines an Application Load Balancer routing HTTPS requests to the control plane instances.
+type IngressLoadBalancerSpec struct {
+	// Name sets the name of the load balancer. It must be unique within the region.
+	// When not set, a name is generated from the name of the cluster.
+	// +kubebuilder:validation:MaxLength:=32
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9]([A-Za-z0-9]{0,31}|[-A-Za-z0-9]{0,30}[A-Za-z0-9])$`
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Scheme sets the scheme of the load balancer (defaults to internet-facing).
+	// +kubebuilder:default=internet-facing
+	// +kubebuilder:validation:Enum=internet-facing;internal
+	// +optional
+	Scheme *ELBScheme `json:"scheme,omitempty"`
+
+	// Subnets sets the subnets of the load balancer. When not set, a public subnet, or a private
+	// subnet for internal load balancers, is selected in every availability zone of the cluster.
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+
+	// AdditionalSecurityGroups sets the security groups attached to the load balancer in addition
+	// to the security group managed for it.
+	// +optional
+	AdditionalSecurityGroups []string `json:"additionalSecurityGroups,omitempty"`
+
+	// IngressRules sets the ingress rules of the security group of the load balancer.
+	// When not set, the ports of the listeners are allowed from anywhere.
+	// +optional
+	IngressRules []IngressRule `json:"ingressRules,omitempty"`
+
+	// Listeners sets the HTTPS listeners of the load balancer.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=port
+	Listeners []IngressListenerSpec `json:"listeners"`
+
+	// WebACLARN is the ARN of the AWS WAF (WAFv2) web ACL associated with the load balancer.
+	// Removing it disassociates the web ACL.
+	// +optional
+	WebACLARN *string `json:"webACLARN,omitempty"`
+}
+
+// IngressListenerSpec defines an HTTPS listener of the ingress load balancer.
+// Requests not matching any of the rules of the listener are answered with a 404 status code.
+type IngressListenerSpec struct {
+	// Port sets the port of the listener.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=443
+	Port int64 `json:"port"`
+
+	// CertificateARN is the ARN of the ACM certificate presented by the listener.
+	// +kubebuilder:validation:MinLength=1
+	CertificateARN string `json:"certificateARN"`
+
+	// SSLPolicy is the name of the security policy defining the protocols and ciphers
+	// supported by the listener. When not set, the default policy is used.
+	// +optional
+	SSLPolicy *string `json:"sslPolicy,omitempty"`
+
+	// Rules route the requests to target groups by host and path.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	// +listType=map
+	// +listMapKey=priority
+	Rules []IngressListenerRule `json:"rules"`
+}
+
+// IngressListenerRule forwards the requests matching its conditions to a target group.
+// A request matches when its host matches one of the host headers, if any, and its path
+// matches one of the path patterns, if any.
+type IngressListenerRule struct {
+	// Priority sets the priority of the rule. Rules are evaluated from the lowest priority to the highest.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=50000
+	Priority int32 `json:"priority"`
+
+	// HostHeaders are the host names matched by the rule. They can contain the * and ? wildcards.
+	// +kubebuilder:validation:MaxItems=5
+	// +optional
+	HostHeaders []string `json:"hostHeaders,omitempty"`
+
+	// PathPatterns are the paths matched by the rule. They can contain the * and ? wildcards.
+	// +kubebuilder:validation:MaxItems=5
+	// +optional
+	PathPatterns []string `json:"pathPatterns,omitempty"`
+
+	// TargetGroup sets the target group the matching requests are forwarded to.
+	TargetGroup IngressTargetGroupSpec `json:"targetGroup"`
+}
+
+// IngressTargetGroupSpec defines a target group of the ingress load balancer, whose targets
+// are the control plane instances.
+type IngressTargetGroupSpec struct {
+	// Port sets the port of the control plane instances the requests are forwarded to.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int64 `json:"port"`
+
+	// Protocol sets the protocol used to forward the requests to the control plane instances.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	// +kubebuilder:default=HTTPS
+	// +optional
+	Protocol ELBProtocol `json:"protocol,omitempty"`
+
+	// HealthCheck sets the health check of the target group. When not set, the port is health checked
+	// with the protocol of the target group.
+	// +optional
+	HealthCheck *TargetGroupHealthCheckAdditionalSpec `json:"healthCheck,omitempty"`
+
+	// Attributes sets the attributes of the target group.
+	// +optional
+	Attributes *TargetGroupAttributes `json:"attributes,omitempty"`
+}
+
+// ControlPlaneDNS defines a Route53 record for the control plane endpoint.
+// +kubebuilder:validation:XValidation:rule="has(self.hostedZoneID) != has(self.hostedZoneName)",message="exactly one of hostedZoneID or hostedZoneName must be set"
+type ControlPlaneDNS struct {
+	// HostedZoneID is the id of the Route53 hosted zone the record is created in.
+	// +optional
+	HostedZoneID *string `json:"hostedZoneID,omitempty"`
+
+	// HostedZoneName is the name of the Route53 hosted zone the record is created in.
+	// PrivateZone selects between the public and private hosted zone of that name.
+	// +optional
+	HostedZoneName *string `json:"hostedZoneName,omitempty"`
+
+	// PrivateZone must be true when the hosted zone is a private hosted zone.
+	// +optional
+	PrivateZone bool `json:"privateZone,omitempty"`
+
+	// RecordName is the fully qualified name of the record, for example api.my-cluster.example.com.
+	// It must be a subdomain of the hosted zone.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	RecordName string `json:"recordName"`
+
+	// TTL is the time to live of the record, in seconds. When not set, an alias record to the
+	// load balancer is created. When set, a CNAME record with this TTL is created instead, as
+	// Route53 doesn't allow setting the TTL of alias records.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2147483647
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
+}
+
+// ControlPlaneDNSStatus defines the observed state of the control plane DNS record.
+type ControlPlaneDNSStatus struct {
+	// HostedZoneID is the id of the hosted zone the record was created in.
+	HostedZoneID string `json:"hostedZoneID"`
+
+	// RecordName is the fully qualified name of the record.
+	RecordName string `json:"recordName"`
+}
+
+// LoadBalancerMigrationPhase is a phase of the migration of the control plane load balancer
+// to another load balancer type.
+type LoadBalancerMigrationPhase string
+
+var (
+	// LoadBalancerMigrationPhaseProvisioning is the phase in which the new load balancer is created
+	// and the control plane instances are registered with it.
+	LoadBalancerMigrationPhaseProvisioning = LoadBalancerMigrationPhase("Provisioning")
+
+	// LoadBalancerMigrationPhaseWaitingForCutover is the phase in which the new load balancer serves
+	// all the control plane instances and the migration waits for the cutover annotation.
+	LoadBalancerMigrationPhaseWaitingForCutover = LoadBalancerMigrationPhase("WaitingForCutover")
+
+	// LoadBalancerMigrationPhaseDraining is the phase in which the control plane endpoint points at the
+	// new load balancer and the source load balancer is drained before being deleted.
+	LoadBalancerMigrationPhaseDraining = LoadBalancerMigrationPhase("Draining")
+)
+
+// LoadBalancerMigrationStatus defines the observed state of the migration of the control plane
+// load balancer to another load balancer type.
+type LoadBalancerMigrationStatus struct {
+	// Phase is the current phase of the migration.
+	Phase LoadBalancerMigrationPhase `json:"phase"`
+
+	// Source is the load balancer the control plane is migrated from.
+	Source LoadBalancer `json:"source"`
+
+	// CutoverTime is the time the control plane endpoint was switched to the new load balancer.
+	// +optional
+	CutoverTime *metav1.Time `json:"cutoverTime,omitempty"`
+}
+
+// IsCutOver returns true if the control plane endpoint was switched to the new load balancer.
+func (m *LoadBalancerMigrationStatus) IsCutOver() bool {
+	return m != nil && m.Phase == LoadBalancerMigrationPhaseDraining
 }
 
 // AWSClusterStatus defines the observed state of AWSCluster.
@@ -299,6 +726,15 @@ type AWSClusterStatus struct {
 	FailureDomains clusterv1beta1.FailureDomains `json:"failureDomains,omitempty"`
 	Bastion        *Instance                     `json:"bastion,omitempty"`
 	Conditions     clusterv1beta1.Conditions     `json:"conditions,omitempty"`
+
+	// ControlPlaneDNS reports the Route53 record created for the control plane endpoint, if any.
+	// +optional
+	ControlPlaneDNS *ControlPlaneDNSStatus `json:"controlPlaneDNS,omitempty"`
+
+	// ControlPlaneLoadBalancerMigration reports the progress of the migration of the control plane
+	// load balancer to another load balancer type, if one is in progress.
+	// +optional
+	ControlPlaneLoadBalancerMigration *LoadBalancerMigrationStatus `json:"controlPlaneLoadBalancerMigration,omitempty"`
 }
 
 // S3Bucket defines a supporting S3 bucket for the cluster, currently can be optionally used for Ignition.
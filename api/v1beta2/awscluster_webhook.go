@@ -20,7 +20,9 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -76,6 +78,8 @@ func (*awsClusterWebhook) ValidateCreate(_ context.Context, obj runtime.Object)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
 	allErrs = append(allErrs, r.validateNetwork()...)
+	allErrs = append(allErrs, r.validateControlPlaneDNS()...)
+	allErrs = append(allErrs, r.validateIngressLoadBalancer()...)
 
 	warnings, errs := r.validateControlPlaneLBs()
 	if len(errs) > 0 {
@@ -104,6 +108,8 @@ func (*awsClusterWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runti
 	var allWarnings admission.Warnings
 
 	allErrs = append(allErrs, r.validateGCTasksAnnotation()...)
+	allErrs = append(allErrs, r.validateGCDryRunAnnotation()...)
+	allErrs = append(allErrs, r.validateLoadBalancerDrainPeriodAnnotation()...)
 
 	oldC, ok := oldObj.(*AWSCluster)
 	if !ok {
@@ -129,14 +135,22 @@ func (*awsClusterWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runti
 
 		allErrs = append(allErrs, r.validateControlPlaneLoadBalancerUpdate(oldLB, newLB)...)
 	}
+	allErrs = append(allErrs, r.validateControlPlaneLoadBalancerTypeUpdate(oldC)...)
 
 	if !cmp.Equal(oldC.Spec.ControlPlaneEndpoint, clusterv1beta1.APIEndpoint{}) &&
-		!cmp.Equal(r.Spec.ControlPlaneEndpoint, oldC.Spec.ControlPlaneEndpoint) {
+		!cmp.Equal(r.Spec.ControlPlaneEndpoint, oldC.Spec.ControlPlaneEndpoint) &&
+		!r.isControlPlaneLoadBalancerCutover(oldC) {
 		allErrs = append(allErrs,
 			field.Invalid(field.NewPath("spec", "controlPlaneEndpoint"), r.Spec.ControlPlaneEndpoint, "field is immutable"),
 		)
 	}
 
+	allErrs = append(allErrs, r.validateControlPlaneDNS()...)
+	allErrs = append(allErrs, r.validateIngressLoadBalancer()...)
+	allErrs = append(allErrs, r.validateIngressLoadBalancerUpdate(oldC.Spec.IngressLoadBalancer)...)
+	allErrs = append(allErrs, ValidateControlPlaneDNSUpdate(field.NewPath("spec", "controlPlaneDNS"), oldC.Spec.ControlPlaneDNS, r.Spec.ControlPlaneDNS,
+		!cmp.Equal(oldC.Spec.ControlPlaneEndpoint, clusterv1beta1.APIEndpoint{}))...)
+
 	// Modifying VPC id is not allowed because it will cause a new VPC creation if set to nil.
 	if !cmp.Equal(oldC.Spec.NetworkSpec, NetworkSpec{}) &&
 		!cmp.Equal(oldC.Spec.NetworkSpec.VPC, VPCSpec{}) &&
@@ -150,6 +164,21 @@ func (*awsClusterWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runti
 		}
 	}
 
+	transitGatewayField := field.NewPath("spec", "network", "vpc", "transitGateway")
+	allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.TransitGateway.Validate(transitGatewayField, r.Spec.NetworkSpec.Subnets)...)
+	allErrs = append(allErrs, ValidateTransitGatewayUpdate(transitGatewayField, oldC.Spec.NetworkSpec.VPC.TransitGateway, r.Spec.NetworkSpec.VPC.TransitGateway)...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.FlowLogs.Validate(field.NewPath("spec", "network", "vpc", "flowLogs"))...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.ValidateVPCEndpoints(field.NewPath("spec", "network", "vpc"), r.Spec.NetworkSpec.Subnets)...)
+	allErrs = append(allErrs, EgressRules(r.Spec.NetworkSpec.AdditionalControlPlaneEgressRules).Validate(field.NewPath("spec", "network", "additionalControlPlaneEgressRules"))...)
+	allErrs = append(allErrs, EgressRules(r.Spec.NetworkSpec.AdditionalNodeEgressRules).Validate(field.NewPath("spec", "network", "additionalNodeEgressRules"))...)
+	allErrs = append(allErrs, ValidateManagedPrefixLists(field.NewPath("spec", "network", "managedPrefixLists"), r.Spec.NetworkSpec.ManagedPrefixLists)...)
+	if r.Spec.ControlPlaneLoadBalancer != nil {
+		allErrs = append(allErrs, EgressRules(r.Spec.ControlPlaneLoadBalancer.EgressRules).Validate(field.NewPath("spec", "controlPlaneLoadBalancer", "egressRules"))...)
+	}
+	if r.Spec.SecondaryControlPlaneLoadBalancer != nil {
+		allErrs = append(allErrs, EgressRules(r.Spec.SecondaryControlPlaneLoadBalancer.EgressRules).Validate(field.NewPath("spec", "secondaryControlPlaneLoadBalancer", "egressRules"))...)
+	}
+
 	// If a identityRef is already set, do not allow removal of it.
 	if oldC.Spec.IdentityRef != nil && r.Spec.IdentityRef == nil {
 		allErrs = append(allErrs,
@@ -278,7 +307,7 @@ func (r *AWSCluster) validateGCTasksAnnotation() field.ErrorList {
 	if gcTasksAnnotationValue := annotations[ExternalResourceGCTasksAnnotation]; gcTasksAnnotationValue != "" {
 		gcTasks := strings.Split(gcTasksAnnotationValue, ",")
 
-		supportedGCTasks := []GCTask{GCTaskLoadBalancer, GCTaskTargetGroup, GCTaskSecurityGroup}
+		supportedGCTasks := SupportedGCTasks()
 
 		for _, gcTask := range gcTasks {
 			found := false
@@ -303,6 +332,91 @@ func (r *AWSCluster) validateGCTasksAnnotation() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSCluster) validateGCDryRunAnnotation() field.ErrorList {
+	value, found := r.GetAnnotations()[ExternalResourceGCDryRunAnnotation]
+	if !found {
+		return nil
+	}
+
+	if _, err := strconv.ParseBool(value); err != nil {
+		return field.ErrorList{
+			field.Invalid(field.NewPath("metadata", "annotations"),
+				r.Annotations,
+				fmt.Sprintf("annotation %s must be a boolean", ExternalResourceGCDryRunAnnotation)),
+		}
+	}
+
+	return nil
+}
+
+func (r *AWSCluster) validateLoadBalancerDrainPeriodAnnotation() field.ErrorList {
+	value, found := r.GetAnnotations()[ControlPlaneLoadBalancerDrainPeriodAnnotation]
+	if !found {
+		return nil
+	}
+
+	if drainPeriod, err := time.ParseDuration(value); err != nil || drainPeriod < 0 {
+		return field.ErrorList{
+			field.Invalid(field.NewPath("metadata", "annotations"),
+				r.Annotations,
+				fmt.Sprintf("annotation %s must be a non-negative duration", ControlPlaneLoadBalancerDrainPeriodAnnotation)),
+		}
+	}
+
+	return nil
+}
+
+// validateControlPlaneLoadBalancerTypeUpdate only allows changing the type of a control plane load balancer
+// serving the control plane endpoint from classic to a v2 type, which migrates the cluster to a new load balancer.
+// The new load balancer takes the place of the secondary control plane load balancer until the cutover, so a
+// secondary control plane load balancer can't be configured during a migration.
+// Changes from and to the disabled type are validated in validateControlPlaneLoadBalancerUpdate.
+func (r *AWSCluster) validateControlPlaneLoadBalancerTypeUpdate(oldC *AWSCluster) field.ErrorList {
+	if oldC.Status.ControlPlaneLoadBalancerMigration != nil && oldC.Spec.SecondaryControlPlaneLoadBalancer == nil && r.Spec.SecondaryControlPlaneLoadBalancer != nil {
+		return field.ErrorList{
+			field.Forbidden(field.NewPath("spec", "secondaryControlPlaneLoadBalancer"), "field cannot be set while the control plane load balancer is migrated"),
+		}
+	}
+
+	oldLB, newLB := oldC.Spec.ControlPlaneLoadBalancer, r.Spec.ControlPlaneLoadBalancer
+	if oldLB == nil || newLB == nil || oldLB.LoadBalancerType == newLB.LoadBalancerType ||
+		oldLB.LoadBalancerType == LoadBalancerTypeDisabled || newLB.LoadBalancerType == LoadBalancerTypeDisabled ||
+		cmp.Equal(oldC.Spec.ControlPlaneEndpoint, clusterv1beta1.APIEndpoint{}) {
+		return nil
+	}
+
+	typePath := field.NewPath("spec", "controlPlaneLoadBalancer", "loadBalancerType")
+	if oldC.Status.ControlPlaneLoadBalancerMigration != nil {
+		return field.ErrorList{
+			field.Forbidden(typePath, "field cannot be changed while the control plane load balancer is migrated"),
+		}
+	}
+	if oldLB.LoadBalancerType != LoadBalancerTypeClassic && oldLB.LoadBalancerType != "" {
+		return field.ErrorList{
+			field.Invalid(typePath, newLB.LoadBalancerType, "only classic control plane load balancers can be migrated to another type"),
+		}
+	}
+	if r.Spec.SecondaryControlPlaneLoadBalancer != nil {
+		return field.ErrorList{
+			field.Forbidden(typePath, "field cannot be changed while a secondary control plane load balancer is set"),
+		}
+	}
+
+	return nil
+}
+
+// isControlPlaneLoadBalancerCutover returns true if the update switches the control plane endpoint
+// to the new load balancer of a control plane load balancer migration. The new load balancer is reported
+// as the secondary load balancer until the status of the cutover is written.
+func (r *AWSCluster) isControlPlaneLoadBalancerCutover(oldC *AWSCluster) bool {
+	network := oldC.Status.Network
+	return oldC.Status.ControlPlaneLoadBalancerMigration != nil &&
+		r.GetAnnotations()[ControlPlaneLoadBalancerCutoverAnnotation] == "true" &&
+		r.Spec.ControlPlaneEndpoint.Host != "" &&
+		(r.Spec.ControlPlaneEndpoint.Host == network.SecondaryAPIServerELB.DNSName || r.Spec.ControlPlaneEndpoint.Host == network.APIServerELB.DNSName) &&
+		r.Spec.ControlPlaneEndpoint.Port == oldC.Spec.ControlPlaneEndpoint.Port
+}
+
 func (r *AWSCluster) validateSSHKeyName() field.ErrorList {
 	return validateSSHKeyName(r.Spec.SSHKeyName)
 }
@@ -350,8 +464,15 @@ func (r *AWSCluster) validateNetwork() field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("ipamPool"), r.Spec.NetworkSpec.VPC.IPAMPool, "ipamPool must have either id or name"))
 	}
 
+	allErrs = append(allErrs, vpcSpec.TransitGateway.Validate(vpcField.Child("transitGateway"), r.Spec.NetworkSpec.Subnets)...)
+	allErrs = append(allErrs, vpcSpec.FlowLogs.Validate(vpcField.Child("flowLogs"))...)
+	allErrs = append(allErrs, vpcSpec.ValidateVPCEndpoints(vpcField, r.Spec.NetworkSpec.Subnets)...)
+
 	allErrs = append(allErrs, r.validateIngressRules(field.NewPath("spec", "network", "additionalControlPlaneIngressRules"), r.Spec.NetworkSpec.AdditionalControlPlaneIngressRules)...)
 	allErrs = append(allErrs, r.validateIngressRules(field.NewPath("spec", "network", "additionalNodeIngressRules"), r.Spec.NetworkSpec.AdditionalNodeIngressRules)...)
+	allErrs = append(allErrs, EgressRules(r.Spec.NetworkSpec.AdditionalControlPlaneEgressRules).Validate(field.NewPath("spec", "network", "additionalControlPlaneEgressRules"))...)
+	allErrs = append(allErrs, EgressRules(r.Spec.NetworkSpec.AdditionalNodeEgressRules).Validate(field.NewPath("spec", "network", "additionalNodeEgressRules"))...)
+	allErrs = append(allErrs, ValidateManagedPrefixLists(field.NewPath("spec", "network", "managedPrefixLists"), r.Spec.NetworkSpec.ManagedPrefixLists)...)
 
 	for cidrBlockIndex, cidrBlock := range r.Spec.NetworkSpec.NodePortIngressRuleCidrBlocks {
 		if _, _, err := net.ParseCIDR(cidrBlock); err != nil {
@@ -419,6 +540,8 @@ func (r *AWSCluster) validateControlPlaneLBs() (admission.Warnings, field.ErrorL
 			}
 		}
 		allErrs = append(allErrs, r.validateIngressRules(basePath.Child("ingressRules"), r.Spec.ControlPlaneLoadBalancer.IngressRules)...)
+		allErrs = append(allErrs, EgressRules(r.Spec.ControlPlaneLoadBalancer.EgressRules).Validate(basePath.Child("egressRules"))...)
+		allErrs = append(allErrs, r.validateLoadBalancerOptions(basePath, r.Spec.ControlPlaneLoadBalancer)...)
 
 		if r.Spec.ControlPlaneLoadBalancer.LoadBalancerType == LoadBalancerTypeDisabled {
 			if r.Spec.ControlPlaneLoadBalancer.Name != nil {
@@ -497,22 +620,219 @@ func (r *AWSCluster) validateControlPlaneLBs() (admission.Warnings, field.ErrorL
 			}
 		}
 		allErrs = append(allErrs, r.validateIngressRules(basePath.Child("ingressRules"), r.Spec.SecondaryControlPlaneLoadBalancer.IngressRules)...)
+		allErrs = append(allErrs, EgressRules(r.Spec.SecondaryControlPlaneLoadBalancer.EgressRules).Validate(basePath.Child("egressRules"))...)
+		allErrs = append(allErrs, r.validateLoadBalancerOptions(basePath, r.Spec.SecondaryControlPlaneLoadBalancer)...)
 	}
 
 	return allWarnings, allErrs
 }
 
+// validateLoadBalancerOptions validates the access logs, deletion protection, target group attributes
+// and TLS listener settings, which are only supported by some load balancer types.
+func (r *AWSCluster) validateLoadBalancerOptions(path *field.Path, lbSpec *AWSLoadBalancerSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	supportsListenerOptions := lbSpec.LoadBalancerType == LoadBalancerTypeNLB || lbSpec.LoadBalancerType == LoadBalancerTypeALB
+
+	if lbSpec.AccessLogs != nil && !supportsListenerOptions {
+		allErrs = append(allErrs, field.Invalid(path.Child("accessLogs"), lbSpec.AccessLogs, "access logs can only be configured for nlb and alb load balancer types"))
+	}
+
+	if lbSpec.DeletionProtection && (lbSpec.LoadBalancerType == LoadBalancerTypeClassic || lbSpec.LoadBalancerType == LoadBalancerTypeDisabled) {
+		allErrs = append(allErrs, field.Invalid(path.Child("deletionProtection"), lbSpec.DeletionProtection, "deletion protection cannot be enabled for classic load balancers or if the LoadBalancer reconciliation is disabled"))
+	}
+
+	allErrs = append(allErrs, validateTargetGroupAttributes(path.Child("targetGroupAttributes"), lbSpec.LoadBalancerType, lbSpec.TargetGroupAttributes)...)
+	if lbSpec.TargetGroupAttributes != nil && lbSpec.TargetGroupAttributes.ProxyProtocolV2 != nil && *lbSpec.TargetGroupAttributes.ProxyProtocolV2 {
+		allErrs = append(allErrs, field.Forbidden(path.Child("targetGroupAttributes", "proxyProtocolV2"), "the API server does not support the proxy protocol"))
+	}
+	for i, ln := range lbSpec.AdditionalListeners {
+		allErrs = append(allErrs, validateTargetGroupAttributes(path.Child("additionalListeners").Index(i).Child("targetGroupAttributes"), lbSpec.LoadBalancerType, ln.TargetGroupAttributes)...)
+	}
+
+	if lbSpec.TLSListener == nil {
+		return allErrs
+	}
+
+	tlsPath := path.Child("tlsListener")
+	if !supportsListenerOptions {
+		allErrs = append(allErrs, field.Invalid(tlsPath, lbSpec.TLSListener, "a TLS listener can only be configured for nlb and alb load balancer types"))
+	}
+
+	if !strings.HasPrefix(lbSpec.TLSListener.CertificateARN, "arn:") {
+		allErrs = append(allErrs, field.Invalid(tlsPath.Child("certificateARN"), lbSpec.TLSListener.CertificateARN, "must be a valid certificate ARN"))
+	}
+
+	if lbSpec.TLSListener.SSLPolicy != nil && *lbSpec.TLSListener.SSLPolicy == "" {
+		allErrs = append(allErrs, field.Required(tlsPath.Child("sslPolicy"), "can't be empty"))
+	}
+
+	if lbSpec.TLSListener.Port == DefaultAPIServerPort {
+		allErrs = append(allErrs, field.Invalid(tlsPath.Child("port"), lbSpec.TLSListener.Port, "must be different from the API server port"))
+	}
+	for _, ln := range lbSpec.AdditionalListeners {
+		if ln.Port == lbSpec.TLSListener.Port {
+			allErrs = append(allErrs, field.Invalid(tlsPath.Child("port"), lbSpec.TLSListener.Port, "must be different from the ports of the additional listeners"))
+			break
+		}
+	}
+
+	return allErrs
+}
+
+// validateTargetGroupAttributes validates that the target group attributes are supported by the load balancer type.
+func validateTargetGroupAttributes(path *field.Path, lbType LoadBalancerType, attrs *TargetGroupAttributes) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if attrs == nil {
+		return allErrs
+	}
+
+	if lbType == LoadBalancerTypeClassic || lbType == LoadBalancerTypeDisabled {
+		return append(allErrs, field.Invalid(path, attrs, "target group attributes cannot be set for classic load balancers or if the LoadBalancer reconciliation is disabled"))
+	}
+
+	if lbType != LoadBalancerTypeNLB {
+		if attrs.ConnectionTermination != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("connectionTermination"), *attrs.ConnectionTermination, "can only be set for nlb load balancer types"))
+		}
+		if attrs.UnhealthyConnectionTermination != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("unhealthyConnectionTermination"), *attrs.UnhealthyConnectionTermination, "can only be set for nlb load balancer types"))
+		}
+		if attrs.UnhealthyDrainingIntervalSeconds != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("unhealthyDrainingIntervalSeconds"), *attrs.UnhealthyDrainingIntervalSeconds, "can only be set for nlb load balancer types"))
+		}
+		if attrs.ProxyProtocolV2 != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("proxyProtocolV2"), *attrs.ProxyProtocolV2, "can only be set for nlb load balancer types"))
+		}
+	}
+
+	if attrs.Stickiness != nil {
+		stickinessPath := path.Child("stickiness")
+		switch {
+		case lbType == LoadBalancerTypeNLB && attrs.Stickiness.Type != TargetGroupStickinessTypeSourceIP:
+			allErrs = append(allErrs, field.Invalid(stickinessPath.Child("type"), attrs.Stickiness.Type, "nlb load balancer types only support source_ip stickiness"))
+		case lbType == LoadBalancerTypeALB && attrs.Stickiness.Type != TargetGroupStickinessTypeLBCookie:
+			allErrs = append(allErrs, field.Invalid(stickinessPath.Child("type"), attrs.Stickiness.Type, "alb load balancer types only support lb_cookie stickiness"))
+		}
+		if attrs.Stickiness.CookieDurationSeconds != nil && attrs.Stickiness.Type != TargetGroupStickinessTypeLBCookie {
+			allErrs = append(allErrs, field.Invalid(stickinessPath.Child("cookieDurationSeconds"), *attrs.Stickiness.CookieDurationSeconds, "can only be set for lb_cookie stickiness"))
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSCluster) validateControlPlaneDNS() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.ControlPlaneDNS == nil {
+		return allErrs
+	}
+
+	path := field.NewPath("spec", "controlPlaneDNS")
+	allErrs = append(allErrs, r.Spec.ControlPlaneDNS.Validate(path)...)
+
+	if r.Spec.ControlPlaneLoadBalancer != nil && r.Spec.ControlPlaneLoadBalancer.LoadBalancerType == LoadBalancerTypeDisabled {
+		allErrs = append(allErrs, field.Forbidden(path, "cannot be set if the LoadBalancer reconciliation is disabled"))
+	}
+
+	if host := r.Spec.ControlPlaneEndpoint.Host; host != "" && host != r.Spec.ControlPlaneDNS.GetRecordName() {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneEndpoint", "host"), host, "must match spec.controlPlaneDNS.recordName"))
+	}
+
+	return allErrs
+}
+
+func (r *AWSCluster) validateIngressLoadBalancer() field.ErrorList {
+	var allErrs field.ErrorList
+
+	lbSpec := r.Spec.IngressLoadBalancer
+	if lbSpec == nil {
+		return allErrs
+	}
+
+	path := field.NewPath("spec", "ingressLoadBalancer")
+	if r.Spec.ControlPlaneLoadBalancer != nil && r.Spec.ControlPlaneLoadBalancer.LoadBalancerType == LoadBalancerTypeDisabled {
+		allErrs = append(allErrs, field.Forbidden(path, "cannot be set if the LoadBalancer reconciliation is disabled"))
+	}
+
+	if lbSpec.Name != nil {
+		for _, cpLB := range []*AWSLoadBalancerSpec{r.Spec.ControlPlaneLoadBalancer, r.Spec.SecondaryControlPlaneLoadBalancer} {
+			if cpLB != nil && cpLB.Name != nil && *cpLB.Name == *lbSpec.Name {
+				allErrs = append(allErrs, field.Invalid(path.Child("name"), *lbSpec.Name, "must be different from the names of the control plane load balancers"))
+			}
+		}
+	}
+
+	if lbSpec.WebACLARN != nil && !strings.HasPrefix(*lbSpec.WebACLARN, "arn:") {
+		allErrs = append(allErrs, field.Invalid(path.Child("webACLARN"), *lbSpec.WebACLARN, "must be a valid web ACL ARN"))
+	}
+
+	allErrs = append(allErrs, r.validateIngressRules(path.Child("ingressRules"), lbSpec.IngressRules)...)
+
+	// Rules forwarding to the same port and protocol share a target group.
+	targetGroups := map[string]IngressTargetGroupSpec{}
+	for i, ln := range lbSpec.Listeners {
+		lnPath := path.Child("listeners").Index(i)
+		if !strings.HasPrefix(ln.CertificateARN, "arn:") {
+			allErrs = append(allErrs, field.Invalid(lnPath.Child("certificateARN"), ln.CertificateARN, "must be a valid certificate ARN"))
+		}
+		if ln.SSLPolicy != nil && *ln.SSLPolicy == "" {
+			allErrs = append(allErrs, field.Required(lnPath.Child("sslPolicy"), "can't be empty"))
+		}
+
+		for j, rule := range ln.Rules {
+			rulePath := lnPath.Child("rules").Index(j)
+			if len(rule.HostHeaders) == 0 && len(rule.PathPatterns) == 0 {
+				allErrs = append(allErrs, field.Required(rulePath, "at least one of hostHeaders or pathPatterns must be set"))
+			}
+
+			tgPath := rulePath.Child("targetGroup")
+			allErrs = append(allErrs, validateTargetGroupAttributes(tgPath.Child("attributes"), LoadBalancerTypeALB, rule.TargetGroup.Attributes)...)
+
+			key := fmt.Sprintf("%s:%d", rule.TargetGroup.Protocol, rule.TargetGroup.Port)
+			if tg, ok := targetGroups[key]; ok && (!cmp.Equal(tg.HealthCheck, rule.TargetGroup.HealthCheck) || !cmp.Equal(tg.Attributes, rule.TargetGroup.Attributes)) {
+				allErrs = append(allErrs, field.Invalid(tgPath, rule.TargetGroup, "rules forwarding to the same port and protocol must use the same health check and attributes"))
+			} else if !ok {
+				targetGroups[key] = rule.TargetGroup
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSCluster) validateIngressLoadBalancerUpdate(oldLB *IngressLoadBalancerSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	newLB := r.Spec.IngressLoadBalancer
+	if oldLB == nil || newLB == nil {
+		return allErrs
+	}
+
+	path := field.NewPath("spec", "ingressLoadBalancer")
+	if !cmp.Equal(oldLB.Name, newLB.Name) {
+		allErrs = append(allErrs, field.Invalid(path.Child("name"), newLB.Name, "field is immutable"))
+	}
+	if !cmp.Equal(oldLB.Scheme, newLB.Scheme) {
+		allErrs = append(allErrs, field.Invalid(path.Child("scheme"), newLB.Scheme, "field is immutable"))
+	}
+
+	return allErrs
+}
+
 func (r *AWSCluster) validateIngressRules(path *field.Path, rules []IngressRule) field.ErrorList {
 	var allErrs field.ErrorList
 	for ruleIndex, rule := range rules {
 		rulePath := path.Index(ruleIndex)
 		if rule.NatGatewaysIPsSource {
-			if rule.CidrBlocks != nil || rule.IPv6CidrBlocks != nil || rule.SourceSecurityGroupIDs != nil || rule.SourceSecurityGroupRoles != nil {
-				allErrs = append(allErrs, field.Invalid(rulePath, rules, "natGatewaysIPsSource cannot be used together with CIDR blocks, security group IDs or security group roles"))
+			if rule.CidrBlocks != nil || rule.IPv6CidrBlocks != nil || rule.SourceSecurityGroupIDs != nil || rule.SourceSecurityGroupRoles != nil || rule.SourcePrefixLists != nil {
+				allErrs = append(allErrs, field.Invalid(rulePath, rules, "natGatewaysIPsSource cannot be used together with CIDR blocks, prefix lists, security group IDs or security group roles"))
 			}
 		} else {
-			if (rule.CidrBlocks != nil || rule.IPv6CidrBlocks != nil) && (rule.SourceSecurityGroupIDs != nil || rule.SourceSecurityGroupRoles != nil) {
-				allErrs = append(allErrs, field.Invalid(rulePath, rules, "CIDR blocks and security group IDs or security group roles cannot be used together"))
+			if (rule.CidrBlocks != nil || rule.IPv6CidrBlocks != nil || rule.SourcePrefixLists != nil) && (rule.SourceSecurityGroupIDs != nil || rule.SourceSecurityGroupRoles != nil) {
+				allErrs = append(allErrs, field.Invalid(rulePath, rules, "CIDR blocks or prefix lists and security group IDs or security group roles cannot be used together"))
 			}
 		}
 	}
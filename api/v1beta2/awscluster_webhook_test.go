@@ -995,6 +995,738 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "accepts a transit gateway with routes",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							TransitGateway: &TransitGatewaySpec{
+								ID:      "tgw-123",
+								OwnerID: ptr.To("111122223333"),
+								Subnets: []string{"private-a"},
+								Routes: []TransitGatewayRoute{
+									{DestinationCIDRBlock: ptr.To("10.100.0.0/16")},
+									{DestinationPrefixListID: ptr.To("pl-123"), RouteTables: TransitGatewayRouteTablesAll},
+								},
+							},
+						},
+						Subnets: Subnets{
+							{ID: "private-a", CidrBlock: "10.0.0.0/24", AvailabilityZone: "us-east-1a"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects a transit gateway attached to an unknown subnet",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							TransitGateway: &TransitGatewaySpec{
+								ID:      "tgw-123",
+								Subnets: []string{"private-b"},
+							},
+						},
+						Subnets: Subnets{
+							{ID: "private-a", CidrBlock: "10.0.0.0/24", AvailabilityZone: "us-east-1a"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a transit gateway route with both a CIDR block and a prefix list",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							TransitGateway: &TransitGatewaySpec{
+								ID: "tgw-123",
+								Routes: []TransitGatewayRoute{
+									{DestinationCIDRBlock: ptr.To("10.100.0.0/16"), DestinationPrefixListID: ptr.To("pl-123")},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a transit gateway route to the default route",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							TransitGateway: &TransitGatewaySpec{
+								ID: "tgw-123",
+								Routes: []TransitGatewayRoute{
+									{DestinationCIDRBlock: ptr.To("0.0.0.0/0")},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts flow logs published to CloudWatch Logs",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							FlowLogs: &VPCFlowLogs{
+								DestinationARN:           "arn:aws:logs:us-east-1:123456789012:log-group:flow-logs",
+								DeliverLogsPermissionARN: ptr.To("arn:aws:iam::123456789012:role/flow-logs"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects flow logs published to CloudWatch Logs without a delivery role",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							FlowLogs: &VPCFlowLogs{
+								DestinationARN: "arn:aws:logs:us-east-1:123456789012:log-group:flow-logs",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts the private cluster VPC endpoints preset with additional endpoints",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							VPCEndpointsPreset: VPCEndpointsPresetPrivateCluster,
+							VPCEndpoints: []VPCEndpointSpec{
+								{Service: "dynamodb"},
+								{Service: "com.amazonaws.us-east-1.kms", PrivateDNSEnabled: ptr.To(false)},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects a gateway VPC endpoint for a service without gateway endpoints",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							VPCEndpoints: []VPCEndpointSpec{
+								{Service: "ecr.api", Type: VPCEndpointTypeGateway},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a VPC endpoint ingress rule from a security group role",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							VPCEndpoints: []VPCEndpointSpec{{
+								Service: "sts",
+								IngressRules: []IngressRule{{
+									Protocol:                 SecurityGroupProtocolTCP,
+									FromPort:                 443,
+									ToPort:                   443,
+									SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupNode},
+								}},
+							}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts restricted egress with additional egress rules",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						SecurityGroupEgressMode: SecurityGroupEgressModeRestricted,
+						AdditionalNodeEgressRules: []EgressRule{
+							{
+								Description:   "S3",
+								Protocol:      SecurityGroupProtocolTCP,
+								FromPort:      443,
+								ToPort:        443,
+								PrefixListIDs: []string{"pl-63a5400a"},
+							},
+							{
+								Description:                   "Control plane",
+								Protocol:                      SecurityGroupProtocolAll,
+								DestinationSecurityGroupRoles: []SecurityGroupRole{SecurityGroupControlPlane},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects an egress rule without destination",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalControlPlaneEgressRules: []EgressRule{
+							{
+								Description: "HTTPS",
+								Protocol:    SecurityGroupProtocolTCP,
+								FromPort:    443,
+								ToPort:      443,
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a load balancer egress rule with an invalid CIDR block",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						EgressRules: []EgressRule{
+							{
+								Description: "HTTPS",
+								Protocol:    SecurityGroupProtocolTCP,
+								FromPort:    443,
+								ToPort:      443,
+								CidrBlocks:  []string{"10.0.0.0"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts ingress rules and managed prefix lists referenced by name",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						ManagedPrefixLists: []ManagedPrefixListSpec{
+							{
+								Name:       "vpn",
+								CidrBlocks: []string{"10.10.0.0/16", "10.20.0.0/16"},
+								MaxEntries: ptr.To[int32](10),
+							},
+						},
+						AdditionalControlPlaneIngressRules: []IngressRule{
+							{
+								Description:       "SSH from the VPN",
+								Protocol:          SecurityGroupProtocolTCP,
+								FromPort:          22,
+								ToPort:            22,
+								SourcePrefixLists: []PrefixListReference{{Name: ptr.To("vpn")}, {ID: ptr.To("pl-0123456789abcdef0")}},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects an ingress rule with prefix lists and security group roles",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalNodeIngressRules: []IngressRule{
+							{
+								Description:              "SSH",
+								Protocol:                 SecurityGroupProtocolTCP,
+								FromPort:                 22,
+								ToPort:                   22,
+								SourcePrefixLists:        []PrefixListReference{{ID: ptr.To("pl-0123456789abcdef0")}},
+								SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupControlPlane},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a prefix list reference with both id and name",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalNodeIngressRules: []IngressRule{
+							{
+								Description:       "SSH",
+								Protocol:          SecurityGroupProtocolTCP,
+								FromPort:          22,
+								ToPort:            22,
+								SourcePrefixLists: []PrefixListReference{{ID: ptr.To("pl-0123456789abcdef0"), Name: ptr.To("vpn")}},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a managed prefix list with entries of the wrong address family",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						ManagedPrefixLists: []ManagedPrefixListSpec{
+							{
+								Name:       "vpn",
+								CidrBlocks: []string{"10.10.0.0/16", "2001:db8::/32"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a managed prefix list with fewer max entries than CIDR blocks",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						ManagedPrefixLists: []ManagedPrefixListSpec{
+							{
+								Name:       "vpn",
+								CidrBlocks: []string{"10.10.0.0/16", "10.20.0.0/16"},
+								MaxEntries: ptr.To[int32](1),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts access logs, deletion protection and a TLS listener on an NLB",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType:   LoadBalancerTypeNLB,
+						DeletionProtection: true,
+						AccessLogs: &LoadBalancerAccessLogs{
+							Bucket: "access-logs",
+						},
+						TLSListener: &TLSListenerSpec{
+							Port:           443,
+							CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects access logs on a classic load balancer",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeClassic,
+						AccessLogs: &LoadBalancerAccessLogs{
+							Bucket: "access-logs",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects deletion protection on a classic load balancer",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType:   LoadBalancerTypeClassic,
+						DeletionProtection: true,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a TLS listener on a classic load balancer",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeClassic,
+						TLSListener: &TLSListenerSpec{
+							Port:           443,
+							CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a TLS listener on the API server port",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+						TLSListener: &TLSListenerSpec{
+							Port:           6443,
+							CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a TLS listener on the port of an additional listener",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+						AdditionalListeners: []AdditionalListenerSpec{
+							{
+								Port:     443,
+								Protocol: ELBProtocolTCP,
+							},
+						},
+						TLSListener: &TLSListenerSpec{
+							Port:           443,
+							CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a TLS listener with an invalid certificate ARN",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+						TLSListener: &TLSListenerSpec{
+							Port:           443,
+							CertificateARN: "my-certificate",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts target group attributes on an NLB",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+						TargetGroupAttributes: &TargetGroupAttributes{
+							DeregistrationDelaySeconds: ptr.To[int64](30),
+							ConnectionTermination:      ptr.To(true),
+						},
+						AdditionalListeners: []AdditionalListenerSpec{
+							{
+								Port:     8132,
+								Protocol: ELBProtocolTCP,
+								TargetGroupAttributes: &TargetGroupAttributes{
+									ProxyProtocolV2: ptr.To(true),
+									Stickiness: &TargetGroupStickiness{
+										Enabled: true,
+										Type:    TargetGroupStickinessTypeSourceIP,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects target group attributes on a classic load balancer",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeClassic,
+						TargetGroupAttributes: &TargetGroupAttributes{
+							DeregistrationDelaySeconds: ptr.To[int64](30),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects proxy protocol v2 on the API server target group",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+						TargetGroupAttributes: &TargetGroupAttributes{
+							ProxyProtocolV2: ptr.To(true),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects NLB only target group attributes on an ALB",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeALB,
+						TargetGroupAttributes: &TargetGroupAttributes{
+							ConnectionTermination: ptr.To(true),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects lb_cookie stickiness on an NLB",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+						TargetGroupAttributes: &TargetGroupAttributes{
+							Stickiness: &TargetGroupStickiness{
+								Enabled:               true,
+								Type:                  TargetGroupStickinessTypeLBCookie,
+								CookieDurationSeconds: ptr.To[int64](3600),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts a control plane DNS record in a hosted zone referenced by name",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneDNS: &ControlPlaneDNS{
+						HostedZoneName: ptr.To("example.com."),
+						RecordName:     "api.test.example.com",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects a control plane DNS record outside of its hosted zone",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneDNS: &ControlPlaneDNS{
+						HostedZoneName: ptr.To("example.com"),
+						RecordName:     "api.test.example.org",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a control plane DNS record with both a hosted zone id and name",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneDNS: &ControlPlaneDNS{
+						HostedZoneID:   ptr.To("Z0123456789ABCDEFGHIJ"),
+						HostedZoneName: ptr.To("example.com"),
+						RecordName:     "api.test.example.com",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a control plane DNS record when the load balancer is disabled",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeDisabled,
+					},
+					ControlPlaneDNS: &ControlPlaneDNS{
+						HostedZoneID: ptr.To("Z0123456789ABCDEFGHIJ"),
+						RecordName:   "api.test.example.com",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a control plane endpoint that doesn't match the control plane DNS record",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneEndpoint: clusterv1beta1.APIEndpoint{Host: "api.other.example.com", Port: 6443},
+					ControlPlaneDNS: &ControlPlaneDNS{
+						HostedZoneID: ptr.To("Z0123456789ABCDEFGHIJ"),
+						RecordName:   "api.test.example.com",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts an ingress load balancer",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					IngressLoadBalancer: &IngressLoadBalancerSpec{
+						WebACLARN: ptr.To("arn:aws:wafv2:us-east-1:123456789012:regional/webacl/ingress/a1b2c3"),
+						Listeners: []IngressListenerSpec{
+							{
+								Port:           443,
+								CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+								Rules: []IngressListenerRule{
+									{
+										Priority:     1,
+										HostHeaders:  []string{"webhooks.example.com"},
+										PathPatterns: []string{"/validate/*"},
+										TargetGroup:  IngressTargetGroupSpec{Port: 9443, Protocol: ELBProtocolHTTPS},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects an ingress load balancer rule without conditions",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					IngressLoadBalancer: &IngressLoadBalancerSpec{
+						Listeners: []IngressListenerSpec{
+							{
+								Port:           443,
+								CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+								Rules: []IngressListenerRule{
+									{
+										Priority:    1,
+										TargetGroup: IngressTargetGroupSpec{Port: 9443, Protocol: ELBProtocolHTTPS},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects an ingress load balancer with an invalid web ACL ARN",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					IngressLoadBalancer: &IngressLoadBalancerSpec{
+						WebACLARN: ptr.To("my-web-acl"),
+						Listeners: []IngressListenerSpec{
+							{
+								Port:           443,
+								CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+								Rules: []IngressListenerRule{
+									{
+										Priority:    1,
+										HostHeaders: []string{"webhooks.example.com"},
+										TargetGroup: IngressTargetGroupSpec{Port: 9443, Protocol: ELBProtocolHTTPS},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects ingress load balancer rules sharing a target group with different health checks",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					IngressLoadBalancer: &IngressLoadBalancerSpec{
+						Listeners: []IngressListenerSpec{
+							{
+								Port:           443,
+								CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+								Rules: []IngressListenerRule{
+									{
+										Priority:    1,
+										HostHeaders: []string{"a.example.com"},
+										TargetGroup: IngressTargetGroupSpec{Port: 9443, Protocol: ELBProtocolHTTPS},
+									},
+									{
+										Priority:    2,
+										HostHeaders: []string{"b.example.com"},
+										TargetGroup: IngressTargetGroupSpec{
+											Port:        9443,
+											Protocol:    ELBProtocolHTTPS,
+											HealthCheck: &TargetGroupHealthCheckAdditionalSpec{Path: ptr.To("/healthz")},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects an ingress load balancer when the load balancer is disabled",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeDisabled,
+					},
+					IngressLoadBalancer: &IngressLoadBalancerSpec{
+						Listeners: []IngressListenerSpec{
+							{
+								Port:           443,
+								CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+								Rules: []IngressListenerRule{
+									{
+										Priority:    1,
+										HostHeaders: []string{"webhooks.example.com"},
+										TargetGroup: IngressTargetGroupSpec{Port: 9443, Protocol: ELBProtocolHTTPS},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects flow logs published to S3 with a log group ARN",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							FlowLogs: &VPCFlowLogs{
+								DestinationType: FlowLogsDestinationTypeS3,
+								DestinationARN:  "arn:aws:logs:us-east-1:123456789012:log-group:flow-logs",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1076,6 +1808,49 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "controlPlaneDNS is immutable once the control plane endpoint is set",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneEndpoint: clusterv1beta1.APIEndpoint{Host: "api.test.example.com", Port: 6443},
+					ControlPlaneDNS: &ControlPlaneDNS{
+						HostedZoneName: ptr.To("example.com"),
+						RecordName:     "api.test.example.com",
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneEndpoint: clusterv1beta1.APIEndpoint{Host: "api.test.example.com", Port: 6443},
+					ControlPlaneDNS: &ControlPlaneDNS{
+						HostedZoneName: ptr.To("example.com"),
+						RecordName:     "api.test.example.com",
+						TTL:            ptr.To[int64](60),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "controlPlaneDNS can be changed while the control plane endpoint is not set",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneDNS: &ControlPlaneDNS{
+						HostedZoneName: ptr.To("example.com"),
+						RecordName:     "api.test.example.com",
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneDNS: &ControlPlaneDNS{
+						HostedZoneName: ptr.To("example.com"),
+						RecordName:     "kube.test.example.com",
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "region is immutable",
 			oldCluster: &AWSCluster{
@@ -1425,6 +2200,20 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "correct GC tasks annotation with workload resources",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{},
+			},
+			newCluster: &AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ExternalResourceGCTasksAnnotation: "load-balancer,volume,snapshot,network-interface,elastic-ip,efs-access-point",
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "empty GC tasks annotation",
 			oldCluster: &AWSCluster{
@@ -1439,6 +2228,20 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "incorrect GC dry-run annotation",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{},
+			},
+			newCluster: &AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ExternalResourceGCDryRunAnnotation: "maybe",
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "incorrect GC tasks annotation",
 			oldCluster: &AWSCluster{
@@ -1480,6 +2283,44 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "should fail if the transit gateway id is changed",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							TransitGateway: &TransitGatewaySpec{ID: "tgw-123"},
+						},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							TransitGateway: &TransitGatewaySpec{ID: "tgw-456"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should pass if the transit gateway configuration is removed",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							TransitGateway: &TransitGatewaySpec{ID: "tgw-123"},
+						},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{},
+			},
+			wantErr: false,
+		},
 		{
 			name: "should pass controlPlaneLoadBalancer targetGroupIPType is the same on update",
 			oldCluster: &AWSCluster{
@@ -1500,6 +2341,77 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "ingress load balancer name is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					IngressLoadBalancer: &IngressLoadBalancerSpec{
+						Name: ptr.To("ingress-old"),
+						Listeners: []IngressListenerSpec{
+							{
+								Port:           443,
+								CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+								Rules: []IngressListenerRule{
+									{
+										Priority:    1,
+										HostHeaders: []string{"webhooks.example.com"},
+										TargetGroup: IngressTargetGroupSpec{Port: 9443, Protocol: ELBProtocolHTTPS},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					IngressLoadBalancer: &IngressLoadBalancerSpec{
+						Name: ptr.To("ingress-new"),
+						Listeners: []IngressListenerSpec{
+							{
+								Port:           443,
+								CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+								Rules: []IngressListenerRule{
+									{
+										Priority:    1,
+										HostHeaders: []string{"webhooks.example.com"},
+										TargetGroup: IngressTargetGroupSpec{Port: 9443, Protocol: ELBProtocolHTTPS},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ingress load balancer can be removed",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					IngressLoadBalancer: &IngressLoadBalancerSpec{
+						Name: ptr.To("ingress-old"),
+						Listeners: []IngressListenerSpec{
+							{
+								Port:           443,
+								CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+								Rules: []IngressListenerRule{
+									{
+										Priority:    1,
+										HostHeaders: []string{"webhooks.example.com"},
+										TargetGroup: IngressTargetGroupSpec{Port: 9443, Protocol: ELBProtocolHTTPS},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1684,6 +2596,81 @@ func TestAWSClusterValidateAllowedCIDRBlocks(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "allow prefix lists without CIDR blocks",
+			awsc: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						AllowedPrefixLists: []PrefixListReference{{ID: ptr.To("pl-0123456789abcdef0")}},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "disableIngressRules not allowed with prefix lists",
+			awsc: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						AllowedPrefixLists:  []PrefixListReference{{ID: ptr.To("pl-0123456789abcdef0")}},
+						DisableIngressRules: true,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ssm mode allowed with instance profile",
+			awsc: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						Enabled:         true,
+						Mode:            BastionModeSSM,
+						InstanceProfile: "bastion.cluster-api-provider-aws.sigs.k8s.io",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ssm mode not allowed without instance profile",
+			awsc: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						Enabled: true,
+						Mode:    BastionModeSSM,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ssm mode not allowed with CIDR blocks",
+			awsc: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						Enabled:           true,
+						Mode:              BastionModeSSM,
+						InstanceProfile:   "bastion.cluster-api-provider-aws.sigs.k8s.io",
+						AllowedCIDRBlocks: []string{"192.168.0.0/16"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "amiLookup not allowed with ami",
+			awsc: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						Enabled:   true,
+						AMI:       "ami-0123456789abcdef0",
+						AMILookup: &BastionAMILookup{Owners: []string{"amazon"}, Name: "al2023-ami-*"},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid CIDR block with invalid network",
 			awsc: &AWSCluster{
@@ -1750,6 +2737,44 @@ func TestAWSClusterDefaultAllowedCIDRBlocks(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "AllowedCIDRBlocks isn't defaulted when AllowedPrefixLists is set",
+			beforeCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						AllowedPrefixLists: []PrefixListReference{{Name: ptr.To("vpn")}},
+					},
+				},
+			},
+			afterCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						AllowedPrefixLists: []PrefixListReference{{Name: ptr.To("vpn")}},
+					},
+				},
+			},
+		},
+		{
+			name: "AllowedCIDRBlocks isn't defaulted in ssm mode",
+			beforeCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						Enabled:         true,
+						Mode:            BastionModeSSM,
+						InstanceProfile: "bastion.cluster-api-provider-aws.sigs.k8s.io",
+					},
+				},
+			},
+			afterCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						Enabled:         true,
+						Mode:            BastionModeSSM,
+						InstanceProfile: "bastion.cluster-api-provider-aws.sigs.k8s.io",
+					},
+				},
+			},
+		},
 		{
 			name: "AllowedCIDRBlocks change not allowed if DisableIngressRules is true",
 			beforeCluster: &AWSCluster{
@@ -1790,6 +2815,149 @@ func TestAWSClusterDefaultAllowedCIDRBlocks(t *testing.T) {
 	}
 }
 
+func TestAWSClusterValidateControlPlaneLoadBalancerMigration(t *testing.T) {
+	endpoint := clusterv1beta1.APIEndpoint{Host: "classic.elb.amazonaws.com", Port: 6443}
+	clusterWithType := func(lbType LoadBalancerType) *AWSCluster {
+		return &AWSCluster{
+			Spec: AWSClusterSpec{
+				ControlPlaneEndpoint:     endpoint,
+				ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{LoadBalancerType: lbType},
+			},
+		}
+	}
+	migrating := func(c *AWSCluster) *AWSCluster {
+		c.Status.Network.APIServerELB = LoadBalancer{DNSName: endpoint.Host, LoadBalancerType: LoadBalancerTypeClassic}
+		c.Status.Network.SecondaryAPIServerELB = LoadBalancer{DNSName: "nlb.elb.amazonaws.com", LoadBalancerType: LoadBalancerTypeNLB}
+		c.Status.ControlPlaneLoadBalancerMigration = &LoadBalancerMigrationStatus{
+			Phase:  LoadBalancerMigrationPhaseWaitingForCutover,
+			Source: LoadBalancer{DNSName: endpoint.Host, LoadBalancerType: LoadBalancerTypeClassic},
+		}
+		return c
+	}
+	cutOver := func(c *AWSCluster, host string) *AWSCluster {
+		c.Annotations = map[string]string{ControlPlaneLoadBalancerCutoverAnnotation: "true"}
+		c.Spec.ControlPlaneEndpoint.Host = host
+		return c
+	}
+
+	tests := []struct {
+		name       string
+		oldCluster *AWSCluster
+		newCluster *AWSCluster
+		wantErr    bool
+	}{
+		{
+			name:       "allows migrating a classic load balancer to nlb",
+			oldCluster: clusterWithType(LoadBalancerTypeClassic),
+			newCluster: clusterWithType(LoadBalancerTypeNLB),
+		},
+		{
+			name:       "allows migrating a classic load balancer to alb",
+			oldCluster: clusterWithType(LoadBalancerTypeClassic),
+			newCluster: clusterWithType(LoadBalancerTypeALB),
+		},
+		{
+			name:       "rejects migrating a classic load balancer with a secondary load balancer",
+			oldCluster: clusterWithType(LoadBalancerTypeClassic),
+			newCluster: func() *AWSCluster {
+				c := clusterWithType(LoadBalancerTypeNLB)
+				c.Spec.SecondaryControlPlaneLoadBalancer = &AWSLoadBalancerSpec{
+					Name:             ptr.To("secondary"),
+					Scheme:           &ELBSchemeInternal,
+					LoadBalancerType: LoadBalancerTypeNLB,
+				}
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name:       "rejects adding a secondary load balancer during a migration",
+			oldCluster: migrating(clusterWithType(LoadBalancerTypeNLB)),
+			newCluster: func() *AWSCluster {
+				c := clusterWithType(LoadBalancerTypeNLB)
+				c.Spec.SecondaryControlPlaneLoadBalancer = &AWSLoadBalancerSpec{
+					Name:             ptr.To("secondary"),
+					Scheme:           &ELBSchemeInternal,
+					LoadBalancerType: LoadBalancerTypeNLB,
+				}
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name:       "rejects migrating an nlb to another type",
+			oldCluster: clusterWithType(LoadBalancerTypeNLB),
+			newCluster: clusterWithType(LoadBalancerTypeALB),
+			wantErr:    true,
+		},
+		{
+			name:       "rejects changing the type during a migration",
+			oldCluster: migrating(clusterWithType(LoadBalancerTypeNLB)),
+			newCluster: clusterWithType(LoadBalancerTypeClassic),
+			wantErr:    true,
+		},
+		{
+			name:       "allows switching the endpoint to the new load balancer on cutover",
+			oldCluster: migrating(clusterWithType(LoadBalancerTypeNLB)),
+			newCluster: cutOver(clusterWithType(LoadBalancerTypeNLB), "nlb.elb.amazonaws.com"),
+		},
+		{
+			name: "allows switching the endpoint to the new load balancer once the cutover is reported",
+			oldCluster: func() *AWSCluster {
+				c := migrating(clusterWithType(LoadBalancerTypeNLB))
+				c.Status.Network.APIServerELB = c.Status.Network.SecondaryAPIServerELB
+				c.Status.Network.SecondaryAPIServerELB = LoadBalancer{}
+				c.Status.ControlPlaneLoadBalancerMigration.Phase = LoadBalancerMigrationPhaseDraining
+				return c
+			}(),
+			newCluster: cutOver(clusterWithType(LoadBalancerTypeNLB), "nlb.elb.amazonaws.com"),
+		},
+		{
+			name:       "rejects switching the endpoint to another host on cutover",
+			oldCluster: migrating(clusterWithType(LoadBalancerTypeNLB)),
+			newCluster: cutOver(clusterWithType(LoadBalancerTypeNLB), "other.elb.amazonaws.com"),
+			wantErr:    true,
+		},
+		{
+			name:       "rejects switching the endpoint without the cutover annotation",
+			oldCluster: migrating(clusterWithType(LoadBalancerTypeNLB)),
+			newCluster: func() *AWSCluster {
+				c := clusterWithType(LoadBalancerTypeNLB)
+				c.Spec.ControlPlaneEndpoint.Host = "nlb.elb.amazonaws.com"
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name:       "rejects switching the endpoint without a migration",
+			oldCluster: clusterWithType(LoadBalancerTypeNLB),
+			newCluster: cutOver(clusterWithType(LoadBalancerTypeNLB), "nlb.elb.amazonaws.com"),
+			wantErr:    true,
+		},
+		{
+			name:       "rejects an invalid drain period",
+			oldCluster: clusterWithType(LoadBalancerTypeClassic),
+			newCluster: func() *AWSCluster {
+				c := clusterWithType(LoadBalancerTypeNLB)
+				c.Annotations = map[string]string{ControlPlaneLoadBalancerDrainPeriodAnnotation: "-5m"}
+				return c
+			}(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := (&awsClusterWebhook{}).ValidateUpdate(context.TODO(), tt.oldCluster, tt.newCluster)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
 // defaultValidateTest returns a new testing function to be used in tests to
 // make sure defaulting webhooks also pass validation tests on create,
 // update and delete.
@@ -39,6 +39,38 @@ func (b *Bastion) Validate() []*field.Error {
 		return errs
 	}
 
+	if b.DisableIngressRules && len(b.AllowedPrefixLists) > 0 {
+		errs = append(errs,
+			field.Forbidden(field.NewPath("spec", "bastion", "allowedPrefixLists"), "cannot be set if spec.bastion.disableIngressRules is true"),
+		)
+		return errs
+	}
+
+	if b.AMI != "" && b.AMILookup != nil {
+		errs = append(errs,
+			field.Forbidden(field.NewPath("spec", "bastion", "amiLookup"), "cannot be set if spec.bastion.ami is set"),
+		)
+	}
+
+	if b.IsSSM() {
+		if len(b.AllowedCIDRBlocks) > 0 {
+			errs = append(errs,
+				field.Forbidden(field.NewPath("spec", "bastion", "allowedCIDRBlocks"), "cannot be set if spec.bastion.mode is ssm"),
+			)
+		}
+		if len(b.AllowedPrefixLists) > 0 {
+			errs = append(errs,
+				field.Forbidden(field.NewPath("spec", "bastion", "allowedPrefixLists"), "cannot be set if spec.bastion.mode is ssm"),
+			)
+		}
+		if b.Enabled && b.InstanceProfile == "" {
+			errs = append(errs,
+				field.Required(field.NewPath("spec", "bastion", "instanceProfile"), "is required if spec.bastion.mode is ssm"),
+			)
+		}
+		return errs
+	}
+
 	for i, cidr := range b.AllowedCIDRBlocks {
 		if _, _, err := net.ParseCIDR(cidr); err != nil {
 			errs = append(errs,
@@ -49,6 +81,11 @@ func (b *Bastion) Validate() []*field.Error {
 	return errs
 }
 
+// IsSSM returns true if the bastion host is accessed through AWS Systems Manager Session Manager.
+func (b *Bastion) IsSSM() bool {
+	return b.Mode == BastionModeSSM
+}
+
 func validateSSHKeyName(sshKeyName *string) field.ErrorList {
 	var allErrs field.ErrorList
 	switch {
@@ -103,6 +103,30 @@ const (
 	VpcEndpointsReconciliationFailedReason = "VpcEndpointsReconciliationFailed"
 )
 
+const (
+	// VpcFlowLogsReadyCondition reports successful reconciliation of the vpc flow logs.
+	// Only applicable to managed clusters with flow logs configured.
+	VpcFlowLogsReadyCondition clusterv1beta1.ConditionType = "VpcFlowLogsReady"
+	// VpcFlowLogsReconciliationFailedReason used when any errors occur during reconciliation of the vpc flow logs.
+	VpcFlowLogsReconciliationFailedReason = "VpcFlowLogsReconciliationFailed"
+	// VpcFlowLogsDeliveryFailedReason used when AWS fails to publish the vpc flow logs to their destination.
+	VpcFlowLogsDeliveryFailedReason = "VpcFlowLogsDeliveryFailed"
+)
+
+const (
+	// TransitGatewayAttachmentReadyCondition reports on the successful reconciliation of the attachment of the VPC
+	// to a transit gateway and of the routes to the transit gateway.
+	// Only applicable to managed clusters.
+	TransitGatewayAttachmentReadyCondition clusterv1beta1.ConditionType = "TransitGatewayAttachmentReady"
+	// TransitGatewayAttachmentReconciliationFailedReason used when any errors occur during reconciliation of the transit gateway attachment.
+	TransitGatewayAttachmentReconciliationFailedReason = "TransitGatewayAttachmentReconciliationFailed"
+	// TransitGatewayAttachmentPendingReason used while the transit gateway attachment is being created or modified.
+	TransitGatewayAttachmentPendingReason = "TransitGatewayAttachmentPending"
+	// TransitGatewayAttachmentPendingAcceptanceReason used while the transit gateway attachment waits to be
+	// accepted by the account owning the transit gateway.
+	TransitGatewayAttachmentPendingAcceptanceReason = "TransitGatewayAttachmentPendingAcceptance"
+)
+
 const (
 	// SecondaryCidrsReadyCondition reports successful reconciliation of secondary CIDR blocks.
 	// Only applicable to managed clusters.
@@ -142,6 +166,28 @@ const (
 	LoadBalancerFailedReason = "LoadBalancerFailed"
 )
 
+const (
+	// ControlPlaneDNSReadyCondition reports on whether the Route53 record of the control plane endpoint was successfully reconciled.
+	ControlPlaneDNSReadyCondition clusterv1beta1.ConditionType = "ControlPlaneDNSReady"
+	// WaitForLoadBalancerHostedZoneReason used while waiting for the hosted zone of the API server load balancer to be populated.
+	WaitForLoadBalancerHostedZoneReason = "WaitForLoadBalancerHostedZone"
+	// ControlPlaneDNSFailedReason used when an error occurs during reconciliation of the control plane DNS record.
+	ControlPlaneDNSFailedReason = "ControlPlaneDNSFailed"
+)
+
+const (
+	// ControlPlaneLoadBalancerMigrationCondition reports on the progress of the migration of the control plane load balancer to another type.
+	ControlPlaneLoadBalancerMigrationCondition clusterv1beta1.ConditionType = "ControlPlaneLoadBalancerMigration"
+	// LoadBalancerMigrationProvisioningReason used while the new load balancer is created and the control plane instances are registered with it.
+	LoadBalancerMigrationProvisioningReason = "Provisioning"
+	// LoadBalancerMigrationWaitingForCutoverReason used while the new load balancer is ready and the cutover annotation is not set.
+	LoadBalancerMigrationWaitingForCutoverReason = "WaitingForCutover"
+	// LoadBalancerMigrationDrainingReason used while the source load balancer is drained after the cutover.
+	LoadBalancerMigrationDrainingReason = "Draining"
+	// LoadBalancerMigrationFailedReason used when an error occurs during the migration of the control plane load balancer.
+	LoadBalancerMigrationFailedReason = "LoadBalancerMigrationFailed"
+)
+
 const (
 	// InstanceReadyCondition reports on current status of the EC2 instance. Ready indicates the instance is in a Running state.
 	InstanceReadyCondition clusterv1beta1.ConditionType = "InstanceReady"
@@ -168,6 +214,20 @@ const (
 	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
 )
 
+const (
+	// InstanceInterruptedCondition reports that AWS notified an upcoming interruption of the EC2 instance,
+	// either a spot interruption, a rebalance recommendation or a scheduled maintenance event.
+	// This condition has negative polarity: True means the instance is about to be interrupted.
+	InstanceInterruptedCondition clusterv1beta1.ConditionType = "InstanceInterrupted"
+
+	// SpotInstanceInterruptionReason used when AWS sent a two-minute spot instance interruption warning.
+	SpotInstanceInterruptionReason = "SpotInstanceInterruption"
+	// InstanceRebalanceRecommendationReason used when AWS recommended to rebalance the spot instance.
+	InstanceRebalanceRecommendationReason = "InstanceRebalanceRecommendation"
+	// InstanceScheduledMaintenanceReason used when AWS scheduled a maintenance event for the instance.
+	InstanceScheduledMaintenanceReason = "InstanceScheduledMaintenance"
+)
+
 const (
 	// SecurityGroupsReadyCondition indicates the security groups are up to date on the AWSMachine.
 	SecurityGroupsReadyCondition clusterv1beta1.ConditionType = "SecurityGroupsReady"
@@ -189,6 +249,14 @@ const (
 	ELBDetachFailedReason = "ELBDetachFailed"
 )
 
+const (
+	// ExternalResourceGCDryRunCondition reports the external resources the garbage collection would delete
+	// when the cluster is deleted. Only set when the dry-run of the garbage collection is enabled.
+	ExternalResourceGCDryRunCondition clusterv1beta1.ConditionType = "ExternalResourceGCDryRun"
+	// ExternalResourceGCDryRunFailedReason used when the external resources to delete could not be determined.
+	ExternalResourceGCDryRunFailedReason = "ExternalResourceGCDryRunFailed"
+)
+
 const (
 	// S3BucketReadyCondition indicates an S3 bucket has been created successfully.
 	S3BucketReadyCondition clusterv1beta1.ConditionType = "S3BucketCreated"
@@ -0,0 +1,73 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// GetRecordName returns the fully qualified name of the record, without trailing dot.
+func (d *ControlPlaneDNS) GetRecordName() string {
+	return strings.TrimSuffix(d.RecordName, ".")
+}
+
+// Validate validates ControlPlaneDNS fields.
+func (d *ControlPlaneDNS) Validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if d == nil {
+		return errs
+	}
+
+	recordName := d.GetRecordName()
+	for _, msg := range validation.IsDNS1123Subdomain(recordName) {
+		errs = append(errs, field.Invalid(path.Child("recordName"), d.RecordName, msg))
+	}
+
+	if d.HostedZoneID != nil && *d.HostedZoneID == "" {
+		errs = append(errs, field.Required(path.Child("hostedZoneID"), "can't be empty"))
+	}
+
+	if d.HostedZoneName != nil {
+		zoneName := strings.TrimSuffix(*d.HostedZoneName, ".")
+		if zoneName == "" {
+			errs = append(errs, field.Required(path.Child("hostedZoneName"), "can't be empty"))
+		} else if recordName != zoneName && !strings.HasSuffix(recordName, "."+zoneName) {
+			errs = append(errs, field.Invalid(path.Child("recordName"), d.RecordName, "must be a subdomain of the hosted zone"))
+		}
+	}
+
+	return errs
+}
+
+// ValidateControlPlaneDNSUpdate validates the changes to the control plane DNS record. The record name
+// becomes the host of the control plane endpoint, which can't change once set.
+func ValidateControlPlaneDNSUpdate(path *field.Path, oldSpec, newSpec *ControlPlaneDNS, endpointSet bool) field.ErrorList {
+	var errs field.ErrorList
+
+	if !endpointSet || cmp.Equal(oldSpec, newSpec) {
+		return errs
+	}
+
+	errs = append(errs, field.Invalid(path, newSpec, "field is immutable once the control plane endpoint is set"))
+
+	return errs
+}
@@ -24,10 +24,14 @@ import (
 
 // SetDefaults_Bastion is used by defaulter-gen.
 func SetDefaults_Bastion(obj *Bastion) { //nolint:golint,stylecheck
-	// Default to allow open access to the bastion host if no CIDR Blocks have been set
-	if len(obj.AllowedCIDRBlocks) == 0 && !obj.DisableIngressRules {
+	// Default to allow open access to the bastion host if no CIDR Blocks or prefix lists have been set.
+	// Bastion hosts accessed through Session Manager have no ingress rules.
+	if len(obj.AllowedCIDRBlocks) == 0 && len(obj.AllowedPrefixLists) == 0 && !obj.DisableIngressRules && !obj.IsSSM() {
 		obj.AllowedCIDRBlocks = []string{"0.0.0.0/0", "::/0"}
 	}
+	if obj.InstanceMetadataOptions != nil {
+		obj.InstanceMetadataOptions.SetDefaults()
+	}
 }
 
 // SetDefaults_NetworkSpec is used by defaulter-gen.
@@ -0,0 +1,58 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"net"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate validates the egress rules.
+func (e EgressRules) Validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	for i, rule := range e {
+		rulePath := path.Index(i)
+
+		if len(rule.CidrBlocks) == 0 && len(rule.IPv6CidrBlocks) == 0 && len(rule.PrefixListIDs) == 0 &&
+			len(rule.DestinationSecurityGroupIDs) == 0 && len(rule.DestinationSecurityGroupRoles) == 0 {
+			errs = append(errs, field.Required(rulePath, "at least one of cidrBlocks, ipv6CidrBlocks, prefixListIds, destinationSecurityGroupIds or destinationSecurityGroupRoles must be set"))
+		}
+
+		for j, cidr := range rule.CidrBlocks {
+			if ip, _, err := net.ParseCIDR(cidr); err != nil || ip.To4() == nil {
+				errs = append(errs, field.Invalid(rulePath.Child("cidrBlocks").Index(j), cidr, "must be a valid IPv4 CIDR block"))
+			}
+		}
+
+		for j, cidr := range rule.IPv6CidrBlocks {
+			if ip, _, err := net.ParseCIDR(cidr); err != nil || ip.To4() != nil {
+				errs = append(errs, field.Invalid(rulePath.Child("ipv6CidrBlocks").Index(j), cidr, "must be a valid IPv6 CIDR block"))
+			}
+		}
+
+		for j, id := range rule.PrefixListIDs {
+			if !strings.HasPrefix(id, "pl-") {
+				errs = append(errs, field.Invalid(rulePath.Child("prefixListIds").Index(j), id, "must be a managed prefix list id"))
+			}
+		}
+	}
+
+	return errs
+}
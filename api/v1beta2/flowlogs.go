@@ -0,0 +1,59 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate validates VPCFlowLogs fields.
+func (f *VPCFlowLogs) Validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if f == nil {
+		return errs
+	}
+
+	destination, err := arn.Parse(f.DestinationARN)
+	if err != nil {
+		errs = append(errs, field.Invalid(path.Child("destinationArn"), f.DestinationARN, "must be a valid ARN"))
+	}
+
+	switch f.DestinationType {
+	case FlowLogsDestinationTypeS3:
+		if err == nil && destination.Service != "s3" {
+			errs = append(errs, field.Invalid(path.Child("destinationArn"), f.DestinationARN, "must be the ARN of an S3 bucket"))
+		}
+		if f.DeliverLogsPermissionARN != nil {
+			errs = append(errs, field.Forbidden(path.Child("deliverLogsPermissionArn"), "cannot be set for the s3 destination type"))
+		}
+	default:
+		if err == nil && (destination.Service != "logs" || !strings.HasPrefix(destination.Resource, "log-group:")) {
+			errs = append(errs, field.Invalid(path.Child("destinationArn"), f.DestinationARN, "must be the ARN of a CloudWatch Logs log group"))
+		}
+		if f.DeliverLogsPermissionARN == nil {
+			errs = append(errs, field.Required(path.Child("deliverLogsPermissionArn"), "is required for the cloud-watch-logs destination type"))
+		} else if _, err := arn.Parse(*f.DeliverLogsPermissionARN); err != nil {
+			errs = append(errs, field.Invalid(path.Child("deliverLogsPermissionArn"), *f.DeliverLogsPermissionARN, "must be a valid ARN"))
+		}
+	}
+
+	return errs
+}
@@ -18,7 +18,9 @@ package v1beta2
 
 import (
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -62,8 +64,20 @@ type NetworkStatus struct {
 	// SecondaryAPIServerELB is the secondary Kubernetes api server load balancer.
 	SecondaryAPIServerELB LoadBalancer `json:"secondaryAPIServerELB,omitempty"`
 
+	// IngressLoadBalancer is the ingress load balancer, if any.
+	// +optional
+	IngressLoadBalancer *LoadBalancer `json:"ingressLoadBalancer,omitempty"`
+
 	// NatGatewaysIPs contains the public IPs of the NAT Gateways
 	NatGatewaysIPs []string `json:"natGatewaysIPs,omitempty"`
+
+	// TransitGatewayAttachment reports on the attachment of the VPC to the transit gateway, if any.
+	// +optional
+	TransitGatewayAttachment *TransitGatewayAttachmentStatus `json:"transitGatewayAttachment,omitempty"`
+
+	// ManagedPrefixLists is a map from the name of the managed prefix lists of the network spec to their id.
+	// +optional
+	ManagedPrefixLists map[string]string `json:"managedPrefixLists,omitempty"`
 }
 
 // ELBScheme defines the scheme of a load balancer.
@@ -216,6 +230,27 @@ var (
 	// TargetGroupAttributeUnhealthyDrainingIntervalSeconds defines the attribute key for the
 	// unhealthy target connection draining interval.
 	TargetGroupAttributeUnhealthyDrainingIntervalSeconds = "target_health_state.unhealthy.draining_interval_seconds"
+
+	// TargetGroupAttributeDeregistrationDelayTimeoutSeconds defines the attribute key for the
+	// time to wait before a deregistering target is removed.
+	TargetGroupAttributeDeregistrationDelayTimeoutSeconds = "deregistration_delay.timeout_seconds"
+
+	// TargetGroupAttributeDeregistrationDelayConnectionTermination defines the attribute key for
+	// closing the connections to a deregistering target at the end of the deregistration delay.
+	TargetGroupAttributeDeregistrationDelayConnectionTermination = "deregistration_delay.connection_termination.enabled"
+
+	// TargetGroupAttributeEnableProxyProtocolV2 defines the attribute key for enabling proxy protocol v2.
+	TargetGroupAttributeEnableProxyProtocolV2 = "proxy_protocol_v2.enabled"
+
+	// TargetGroupAttributeStickinessEnabled defines the attribute key for enabling stickiness.
+	TargetGroupAttributeStickinessEnabled = "stickiness.enabled"
+
+	// TargetGroupAttributeStickinessType defines the attribute key for the type of stickiness.
+	TargetGroupAttributeStickinessType = "stickiness.type"
+
+	// TargetGroupAttributeStickinessLBCookieDurationSeconds defines the attribute key for the
+	// duration of the cookie of lb_cookie stickiness.
+	TargetGroupAttributeStickinessLBCookieDurationSeconds = "stickiness.lb_cookie.duration_seconds"
 )
 
 // TargetGroupIPType defines the IP address type for target groups.
@@ -275,6 +310,14 @@ var (
 	LoadBalancerAttributeIdleTimeTimeoutSeconds = "idle_timeout.timeout_seconds"
 	// LoadBalancerAttributeIdleTimeDefaultTimeoutSecondsInSeconds defines the default idle timeout in seconds.
 	LoadBalancerAttributeIdleTimeDefaultTimeoutSecondsInSeconds = "60"
+	// LoadBalancerAttributeAccessLogsS3Enabled defines the attribute key for enabling the delivery of access logs to S3.
+	LoadBalancerAttributeAccessLogsS3Enabled = "access_logs.s3.enabled"
+	// LoadBalancerAttributeAccessLogsS3Bucket defines the attribute key for the S3 bucket the access logs are delivered to.
+	LoadBalancerAttributeAccessLogsS3Bucket = "access_logs.s3.bucket"
+	// LoadBalancerAttributeAccessLogsS3Prefix defines the attribute key for the prefix of the access logs in the S3 bucket.
+	LoadBalancerAttributeAccessLogsS3Prefix = "access_logs.s3.prefix"
+	// LoadBalancerAttributeDeletionProtectionEnabled defines the attribute key for enabling deletion protection.
+	LoadBalancerAttributeDeletionProtectionEnabled = "deletion_protection.enabled"
 )
 
 // TargetGroupSpec specifies target group settings for a given listener.
@@ -285,13 +328,16 @@ type TargetGroupSpec struct {
 	Name string `json:"name"`
 	// Port is the exposed port
 	Port int64 `json:"port"`
-	// +kubebuilder:validation:Enum=tcp;tls;udp;TCP;TLS;UDP
+	// +kubebuilder:validation:Enum=tcp;tls;udp;https;TCP;TLS;UDP;HTTPS
 	Protocol ELBProtocol `json:"protocol"`
 	VpcID    string      `json:"vpcId"`
 	// HealthCheck is the elb health check associated with the load balancer.
 	HealthCheck *TargetGroupHealthCheck `json:"targetGroupHealthCheck,omitempty"`
 	// IPType is the IP address type for the target group.
 	IPType TargetGroupIPType `json:"ipType,omitempty"`
+	// Attributes are the target group attributes set from the spec, by attribute key.
+	// +optional
+	Attributes map[string]string `json:"attributes,omitempty"`
 }
 
 // Listener defines an AWS network load balancer listener.
@@ -299,6 +345,12 @@ type Listener struct {
 	Protocol    ELBProtocol     `json:"protocol"`
 	Port        int64           `json:"port"`
 	TargetGroup TargetGroupSpec `json:"targetGroup"`
+	// CertificateARN is the ARN of the certificate presented by TLS and HTTPS listeners.
+	// +optional
+	CertificateARN string `json:"certificateArn,omitempty"`
+	// SSLPolicy is the security policy of TLS and HTTPS listeners.
+	// +optional
+	SSLPolicy string `json:"sslPolicy,omitempty"`
 }
 
 // LoadBalancer defines an AWS load balancer.
@@ -314,6 +366,11 @@ type LoadBalancer struct {
 	// DNSName is the dns name of the load balancer.
 	DNSName string `json:"dnsName,omitempty"`
 
+	// CanonicalHostedZoneID is the id of the Route53 hosted zone of the load balancer, used
+	// to create alias records to it.
+	// +optional
+	CanonicalHostedZoneID string `json:"canonicalHostedZoneID,omitempty"`
+
 	// Scheme is the load balancer scheme, either internet-facing or private.
 	Scheme ELBScheme `json:"scheme,omitempty"`
 
@@ -422,8 +479,113 @@ type NetworkSpec struct {
 	// If none are specified here, all IPs are allowed to connect.
 	// +optional
 	NodePortIngressRuleCidrBlocks CidrBlocks `json:"nodePortIngressRuleCidrBlocks,omitempty"`
+
+	// AdditionalControlPlaneEgressRules is an optional set of egress rules to add to the control plane
+	// security group.
+	// +optional
+	AdditionalControlPlaneEgressRules []EgressRule `json:"additionalControlPlaneEgressRules,omitempty"`
+
+	// AdditionalNodeEgressRules is an optional set of egress rules to add to the node security group.
+	// +optional
+	AdditionalNodeEgressRules []EgressRule `json:"additionalNodeEgressRules,omitempty"`
+
+	// SecurityGroupEgressMode determines how the egress rules of the control plane, node and
+	// control plane load balancer security groups are managed.
+	// AllowAll keeps the rule allowing all outbound traffic that AWS adds to new security groups,
+	// and adds the additional egress rules next to it.
+	// Restricted revokes that rule, and only allows traffic within the VPC (to the control plane
+	// instances for the load balancer) along with the additional egress rules.
+	// Defaults to AllowAll.
+	// +kubebuilder:validation:Enum=AllowAll;Restricted
+	// +optional
+	SecurityGroupEgressMode SecurityGroupEgressMode `json:"securityGroupEgressMode,omitempty"`
+
+	// ManagedPrefixLists are EC2 managed prefix lists created and owned by the provider for the cluster.
+	// Ingress rules and the bastion reference them by name in their source prefix lists.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ManagedPrefixLists []ManagedPrefixListSpec `json:"managedPrefixLists,omitempty"`
+}
+
+// ManagedPrefixListSpec defines an EC2 managed prefix list owned by the cluster.
+type ManagedPrefixListSpec struct {
+	// Name is the name the prefix list is referenced with in the cluster spec.
+	// The prefix list is named "<cluster name>-<name>" in AWS.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	Name string `json:"name"`
+
+	// AddressFamily is the IP address family of the prefix list entries.
+	// Defaults to IPv4.
+	// +kubebuilder:validation:Enum=IPv4;IPv6
+	// +kubebuilder:default=IPv4
+	// +optional
+	AddressFamily PrefixListAddressFamily `json:"addressFamily,omitempty"`
+
+	// CidrBlocks are the entries of the prefix list.
+	// +kubebuilder:validation:MinItems=1
+	CidrBlocks []string `json:"cidrBlocks"`
+
+	// MaxEntries is the maximum number of entries of the prefix list. Each security group rule
+	// referencing the prefix list counts as this number of rules against the security group quota.
+	// Defaults to the number of CIDR blocks.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxEntries *int32 `json:"maxEntries,omitempty"`
 }
 
+// GetMaxEntries returns the maximum number of entries of the prefix list.
+func (p *ManagedPrefixListSpec) GetMaxEntries() int32 {
+	if p.MaxEntries != nil {
+		return *p.MaxEntries
+	}
+	return int32(len(p.CidrBlocks)) //nolint:gosec
+}
+
+// PrefixListAddressFamily defines the IP address family of a managed prefix list.
+type PrefixListAddressFamily string
+
+const (
+	// PrefixListAddressFamilyIPv4 is the IPv4 address family.
+	PrefixListAddressFamilyIPv4 = PrefixListAddressFamily("IPv4")
+
+	// PrefixListAddressFamilyIPv6 is the IPv6 address family.
+	PrefixListAddressFamilyIPv6 = PrefixListAddressFamily("IPv6")
+)
+
+// PrefixListReference references an EC2 managed prefix list by id or name.
+// The name is first looked up in the managed prefix lists of the cluster, then in the account.
+// +kubebuilder:validation:XValidation:rule="has(self.id) != has(self.name)",message="exactly one of id or name must be set"
+type PrefixListReference struct {
+	// ID is the id of the managed prefix list.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Name is the name of the managed prefix list.
+	// +optional
+	Name *string `json:"name,omitempty"`
+}
+
+// String returns a string representation of the prefix list reference.
+func (p PrefixListReference) String() string {
+	if p.ID != nil {
+		return *p.ID
+	}
+	return fmt.Sprintf("name=%s", ptr.Deref(p.Name, ""))
+}
+
+// SecurityGroupEgressMode defines how the egress rules of the managed security groups are managed.
+type SecurityGroupEgressMode string
+
+const (
+	// SecurityGroupEgressModeAllowAll keeps the default rule allowing all outbound traffic.
+	SecurityGroupEgressModeAllowAll = SecurityGroupEgressMode("AllowAll")
+
+	// SecurityGroupEgressModeRestricted revokes the default rule allowing all outbound traffic.
+	SecurityGroupEgressModeRestricted = SecurityGroupEgressMode("Restricted")
+)
+
 // CidrBlocks defines a set of CIDR blocks.
 type CidrBlocks []string
 
@@ -580,6 +742,354 @@ type VPCSpec struct {
 	// +kubebuilder:default=PreferPrivate
 	// +kubebuilder:validation:Enum=PreferPrivate;PreferPublic
 	SubnetSchema *SubnetSchemaType `json:"subnetSchema,omitempty"`
+
+	// TransitGateway configures the attachment of the VPC to an AWS Transit Gateway, and the routes
+	// to add to the route tables of the cluster's subnets to reach it.
+	//
+	// NOTE: This only applies when the VPC is managed by the Cluster API AWS controller.
+	//
+	// +optional
+	TransitGateway *TransitGatewaySpec `json:"transitGateway,omitempty"`
+
+	// FlowLogs configures the publication of the flow logs of the VPC to CloudWatch Logs or S3.
+	//
+	// NOTE: This only applies when the VPC is managed by the Cluster API AWS controller.
+	//
+	// +optional
+	FlowLogs *VPCFlowLogs `json:"flowLogs,omitempty"`
+
+	// VPCEndpoints are the VPC endpoints to create in the VPC, so that AWS services can be reached
+	// from the cluster without going through a NAT gateway or the internet.
+	//
+	// NOTE: This only applies when the VPC is managed by the Cluster API AWS controller.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=service
+	VPCEndpoints []VPCEndpointSpec `json:"vpcEndpoints,omitempty"`
+
+	// VPCEndpointsPreset adds the VPC endpoints of a preset to the VPC endpoints of the VPC.
+	// PrivateCluster - the endpoints needed to provision the nodes of a cluster without NAT gateway or
+	// internet access: the s3 gateway endpoint, and the ec2, ecr.api, ecr.dkr, sts, ssm, secretsmanager,
+	// elasticloadbalancing, autoscaling and logs interface endpoints.
+	// The endpoints listed in VPCEndpoints take precedence over the endpoints of the preset for the same service.
+	//
+	// NOTE: This only applies when the VPC is managed by the Cluster API AWS controller.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=PrivateCluster
+	VPCEndpointsPreset VPCEndpointsPreset `json:"vpcEndpointsPreset,omitempty"`
+}
+
+// VPCEndpointSpec defines a VPC endpoint.
+type VPCEndpointSpec struct {
+	// Service is the name of the AWS service the endpoint connects to, either its short name,
+	// for example ecr.api, or its full name, for example com.amazonaws.us-east-1.ecr.api.
+	// +kubebuilder:validation:MinLength=1
+	Service string `json:"service"`
+
+	// Type is the type of the endpoint.
+	// Gateway - the endpoint is a target of the route tables of the subnets, only available for s3 and dynamodb.
+	// Interface - the endpoint is reached through network interfaces in the subnets.
+	// Defaults to Gateway for s3 and dynamodb, and to Interface for the other services.
+	// +optional
+	// +kubebuilder:validation:Enum=Gateway;Interface
+	Type VPCEndpointType `json:"type,omitempty"`
+
+	// Subnets are the ids of the subnets, from the network subnets, the network interfaces of an interface
+	// endpoint are created in. There can be at most one subnet per availability zone.
+	// Defaults to one private subnet in each availability zone used by the cluster.
+	// Gateway endpoints are added to the route tables of all the subnets of the cluster.
+	// +optional
+	// +listType=set
+	Subnets []string `json:"subnets,omitempty"`
+
+	// PrivateDNSEnabled associates a private hosted zone with the VPC, so that the default DNS name
+	// of the service resolves to the network interfaces of an interface endpoint.
+	// Defaults to true for interface endpoints.
+	// +optional
+	PrivateDNSEnabled *bool `json:"privateDnsEnabled,omitempty"`
+
+	// IngressRules are the rules of the security group of the network interfaces of an interface endpoint.
+	// Only the cidrBlocks, ipv6CidrBlocks and sourceSecurityGroupIds sources are supported.
+	// Defaults to HTTPS from the CIDR blocks of the VPC, in a security group shared by the interface
+	// endpoints using the default rules.
+	// +optional
+	IngressRules []IngressRule `json:"ingressRules,omitempty"`
+}
+
+// VPCEndpointType is the type of a VPC endpoint.
+type VPCEndpointType string
+
+var (
+	// VPCEndpointTypeGateway is the type of an endpoint targeted by the route tables of the subnets.
+	VPCEndpointTypeGateway = VPCEndpointType("Gateway")
+
+	// VPCEndpointTypeInterface is the type of an endpoint reached through network interfaces in the subnets.
+	VPCEndpointTypeInterface = VPCEndpointType("Interface")
+)
+
+// VPCEndpointsPreset is a predefined set of VPC endpoints.
+type VPCEndpointsPreset string
+
+var (
+	// VPCEndpointsPresetPrivateCluster is the set of endpoints needed to provision the nodes of a cluster
+	// without NAT gateway or internet access.
+	VPCEndpointsPresetPrivateCluster = VPCEndpointsPreset("PrivateCluster")
+)
+
+// privateClusterInterfaceEndpointServices are the services of the interface endpoints of the PrivateCluster preset.
+var privateClusterInterfaceEndpointServices = []string{
+	"ec2",
+	"ecr.api",
+	"ecr.dkr",
+	"sts",
+	"ssm",
+	"secretsmanager",
+	"elasticloadbalancing",
+	"autoscaling",
+	"logs",
+}
+
+// vpcEndpointGatewayServices are the services supporting gateway endpoints.
+var vpcEndpointGatewayServices = []string{"s3", "dynamodb"}
+
+// ServiceName returns the full name of the service of the endpoint in a region.
+func (e *VPCEndpointSpec) ServiceName(region string) string {
+	if strings.HasPrefix(e.Service, "com.") || strings.HasPrefix(e.Service, "aws.") || strings.HasPrefix(e.Service, "cn.com.") {
+		return e.Service
+	}
+	return fmt.Sprintf("com.amazonaws.%s.%s", region, e.Service)
+}
+
+// ShortServiceName returns the short name of the service of the endpoint, for example ecr.api.
+func (e *VPCEndpointSpec) ShortServiceName() string {
+	parts := strings.Split(e.Service, ".")
+	if !strings.HasPrefix(e.Service, "com.amazonaws.") || len(parts) < 4 {
+		return e.Service
+	}
+	return strings.Join(parts[3:], ".")
+}
+
+// GetVPCEndpoints returns the VPC endpoints of the VPC, including the endpoints of its preset.
+func (v *VPCSpec) GetVPCEndpoints() []VPCEndpointSpec {
+	endpoints := append([]VPCEndpointSpec{}, v.VPCEndpoints...)
+	if v.VPCEndpointsPreset != VPCEndpointsPresetPrivateCluster {
+		return endpoints
+	}
+
+	listed := make(map[string]bool, len(endpoints))
+	for i := range endpoints {
+		listed[endpoints[i].ShortServiceName()] = true
+	}
+	preset := []VPCEndpointSpec{{Service: "s3", Type: VPCEndpointTypeGateway}}
+	for _, service := range privateClusterInterfaceEndpointServices {
+		preset = append(preset, VPCEndpointSpec{Service: service, Type: VPCEndpointTypeInterface})
+	}
+	for _, endpoint := range preset {
+		if !listed[endpoint.Service] {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
+// EndpointType returns the type of the endpoint, defaulting to Gateway for the services supporting it.
+func (e *VPCEndpointSpec) EndpointType() VPCEndpointType {
+	if e.Type != "" {
+		return e.Type
+	}
+	if slices.Contains(vpcEndpointGatewayServices, e.ShortServiceName()) {
+		return VPCEndpointTypeGateway
+	}
+	return VPCEndpointTypeInterface
+}
+
+// VPCFlowLogs configures the flow logs of a VPC.
+// Flow logs cannot be modified in AWS, changing any of these fields replaces the flow log.
+type VPCFlowLogs struct {
+	// DestinationType is the type of destination the flow logs are published to.
+	// Defaults to cloud-watch-logs.
+	// +optional
+	// +kubebuilder:default=cloud-watch-logs
+	// +kubebuilder:validation:Enum=cloud-watch-logs;s3
+	DestinationType FlowLogsDestinationType `json:"destinationType,omitempty"`
+
+	// DestinationARN is the ARN of the destination the flow logs are published to:
+	// the ARN of a CloudWatch Logs log group, or the ARN of an S3 bucket, optionally
+	// followed by the folder the logs are published in (arn:aws:s3:::bucket/folder).
+	// +kubebuilder:validation:MinLength=1
+	DestinationARN string `json:"destinationArn"`
+
+	// DeliverLogsPermissionARN is the ARN of the IAM role the flow logs service assumes
+	// to publish the flow logs to CloudWatch Logs.
+	// Required for the cloud-watch-logs destination type, and cannot be set for s3.
+	// +optional
+	DeliverLogsPermissionARN *string `json:"deliverLogsPermissionArn,omitempty"`
+
+	// TrafficType is the type of traffic to log.
+	// Defaults to ALL.
+	// +optional
+	// +kubebuilder:default=ALL
+	// +kubebuilder:validation:Enum=ACCEPT;REJECT;ALL
+	TrafficType FlowLogsTrafficType `json:"trafficType,omitempty"`
+
+	// LogFormat is the fields to include in the flow log records, in the order they appear,
+	// for example "${version} ${srcaddr} ${dstaddr}".
+	// Defaults to the AWS default format.
+	// +optional
+	LogFormat *string `json:"logFormat,omitempty"`
+
+	// MaxAggregationInterval is the maximum interval of time, in seconds, during which a flow
+	// of packets is captured and aggregated into a flow log record.
+	// Defaults to 600.
+	// +optional
+	// +kubebuilder:default=600
+	// +kubebuilder:validation:Enum=60;600
+	MaxAggregationInterval int32 `json:"maxAggregationInterval,omitempty"`
+}
+
+// FlowLogsDestinationType is the type of destination flow logs are published to.
+type FlowLogsDestinationType string
+
+var (
+	// FlowLogsDestinationTypeCloudWatchLogs publishes the flow logs to a CloudWatch Logs log group.
+	FlowLogsDestinationTypeCloudWatchLogs = FlowLogsDestinationType("cloud-watch-logs")
+
+	// FlowLogsDestinationTypeS3 publishes the flow logs to an S3 bucket.
+	FlowLogsDestinationTypeS3 = FlowLogsDestinationType("s3")
+)
+
+// FlowLogsTrafficType is the type of traffic captured by flow logs.
+type FlowLogsTrafficType string
+
+var (
+	// FlowLogsTrafficTypeAccept captures the accepted traffic only.
+	FlowLogsTrafficTypeAccept = FlowLogsTrafficType("ACCEPT")
+
+	// FlowLogsTrafficTypeReject captures the rejected traffic only.
+	FlowLogsTrafficTypeReject = FlowLogsTrafficType("REJECT")
+
+	// FlowLogsTrafficTypeAll captures all the traffic.
+	FlowLogsTrafficTypeAll = FlowLogsTrafficType("ALL")
+)
+
+// TransitGatewaySpec configures the attachment of the VPC to an AWS Transit Gateway.
+type TransitGatewaySpec struct {
+	// ID is the id of the transit gateway to attach the VPC to.
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('tgw-')",message="Transit Gateway ID must start with 'tgw-'"
+	ID string `json:"id"`
+
+	// OwnerID is the ID of the AWS account owning the transit gateway, when it is shared with the
+	// cluster's account through AWS Resource Access Manager (RAM). The resource share must have been
+	// accepted, or be accepted automatically within the AWS Organization, for the transit gateway to be found.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]{12}$`
+	OwnerID *string `json:"ownerId,omitempty"`
+
+	// Subnets are the ids of the subnets, from the network subnets, the attachment is created in.
+	// There can be at most one subnet per availability zone and the transit gateway can only route
+	// traffic to the availability zones of these subnets.
+	// Defaults to one private subnet in each availability zone used by the cluster.
+	// +optional
+	// +listType=set
+	Subnets []string `json:"subnets,omitempty"`
+
+	// ApplianceModeSupport enables appliance mode on the attachment, so that the traffic of a flow
+	// goes through the same availability zone in both directions. This is required when the VPC
+	// hosts stateful network appliances inspecting the traffic routed by the transit gateway.
+	// +optional
+	ApplianceModeSupport bool `json:"applianceModeSupport,omitempty"`
+
+	// Routes are the routes to the transit gateway to add to the route tables of the cluster's subnets.
+	// Routes are added once the attachment is available.
+	// +optional
+	Routes []TransitGatewayRoute `json:"routes,omitempty"`
+}
+
+// TransitGatewayRoute defines a route to a transit gateway.
+// +kubebuilder:validation:XValidation:rule="has(self.destinationCidrBlock) != has(self.destinationPrefixListId)",message="exactly one of destinationCidrBlock or destinationPrefixListId must be set"
+type TransitGatewayRoute struct {
+	// DestinationCIDRBlock is the IPv4 CIDR block of the destination of the route.
+	// Mutually exclusive with DestinationPrefixListID.
+	// +optional
+	DestinationCIDRBlock *string `json:"destinationCidrBlock,omitempty"`
+
+	// DestinationPrefixListID is the id of the managed prefix list of the destination of the route.
+	// Mutually exclusive with DestinationCIDRBlock.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('pl-')",message="Prefix List ID must start with 'pl-'"
+	DestinationPrefixListID *string `json:"destinationPrefixListId,omitempty"`
+
+	// RouteTables selects the route tables the route is added to.
+	// Private - the route tables of the private subnets.
+	// Public - the route tables of the public subnets.
+	// All - the route tables of all the subnets.
+	// Defaults to Private.
+	// +optional
+	// +kubebuilder:default=Private
+	// +kubebuilder:validation:Enum=Private;Public;All
+	RouteTables TransitGatewayRouteTables `json:"routeTables,omitempty"`
+}
+
+// TransitGatewayRouteTables selects the route tables a transit gateway route is added to.
+type TransitGatewayRouteTables string
+
+var (
+	// TransitGatewayRouteTablesPrivate selects the route tables of the private subnets.
+	TransitGatewayRouteTablesPrivate = TransitGatewayRouteTables("Private")
+
+	// TransitGatewayRouteTablesPublic selects the route tables of the public subnets.
+	TransitGatewayRouteTablesPublic = TransitGatewayRouteTables("Public")
+
+	// TransitGatewayRouteTablesAll selects the route tables of all the subnets.
+	TransitGatewayRouteTablesAll = TransitGatewayRouteTables("All")
+)
+
+// AppliesTo returns true if the route must be added to the route table of a subnet.
+func (r *TransitGatewayRoute) AppliesTo(isPublic bool) bool {
+	switch r.RouteTables {
+	case TransitGatewayRouteTablesAll:
+		return true
+	case TransitGatewayRouteTablesPublic:
+		return isPublic
+	default:
+		return !isPublic
+	}
+}
+
+// TransitGatewayAttachmentState describes the state of a transit gateway VPC attachment.
+type TransitGatewayAttachmentState string
+
+var (
+	// TransitGatewayAttachmentStateAvailable is the state of an attachment that is ready to route traffic.
+	TransitGatewayAttachmentStateAvailable = TransitGatewayAttachmentState("available")
+
+	// TransitGatewayAttachmentStatePendingAcceptance is the state of an attachment to a transit gateway
+	// owned by another account, until it is accepted by the owner of the transit gateway.
+	TransitGatewayAttachmentStatePendingAcceptance = TransitGatewayAttachmentState("pendingAcceptance")
+)
+
+// TransitGatewayAttachmentStatus reports on the attachment of the VPC to a transit gateway.
+type TransitGatewayAttachmentStatus struct {
+	// ID is the id of the transit gateway VPC attachment.
+	ID string `json:"id"`
+
+	// TransitGatewayID is the id of the transit gateway the VPC is attached to.
+	TransitGatewayID string `json:"transitGatewayId"`
+
+	// State is the state of the attachment, as reported by AWS.
+	// +optional
+	State TransitGatewayAttachmentState `json:"state,omitempty"`
+
+	// SubnetIDs are the ids of the subnets the attachment is created in.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+}
+
+// IsAvailable returns true if the attachment can route traffic.
+func (s *TransitGatewayAttachmentStatus) IsAvailable() bool {
+	return s != nil && s.State == TransitGatewayAttachmentStateAvailable
 }
 
 // String returns a string representation of the VPC.
@@ -823,6 +1333,21 @@ func (s Subnets) FindByID(id string) *SubnetSpec {
 	return nil
 }
 
+// FindByIDOrResourceID returns a single subnet matching the given id or nil.
+// Unlike FindByID, the id is matched against both the ID and ResourceID of the subnets,
+// so that subnets created by the provider can be referenced by the ID they were given in the spec.
+//
+// The returned pointer can be used to write back into the original slice.
+func (s Subnets) FindByIDOrResourceID(id string) *SubnetSpec {
+	for i := range s {
+		x := &(s[i]) // pointer to original structure
+		if x.ID == id || (x.ResourceID != "" && x.ResourceID == id) {
+			return x
+		}
+	}
+	return nil
+}
+
 // FindEqual returns a subnet spec that is equal to the one passed in.
 // Two subnets are defined equal to each other if their id is equal
 // or if they are in the same vpc and the cidr block is the same.
@@ -949,7 +1474,7 @@ type RouteTable struct {
 }
 
 // SecurityGroupRole defines the unique role of a security group.
-// +kubebuilder:validation:Enum=bastion;node;controlplane;apiserver-lb;lb;node-eks-additional
+// +kubebuilder:validation:Enum=bastion;node;controlplane;apiserver-lb;lb;node-eks-additional;ingress-lb
 type SecurityGroupRole string
 
 var (
@@ -970,6 +1495,9 @@ var (
 
 	// SecurityGroupLB defines a container for the cloud provider to inject its load balancer ingress rules.
 	SecurityGroupLB = SecurityGroupRole("lb")
+
+	// SecurityGroupIngressLB defines the role of the ingress load balancer.
+	SecurityGroupIngressLB = SecurityGroupRole("ingress-lb")
 )
 
 // SecurityGroup defines an AWS security group.
@@ -984,6 +1512,10 @@ type SecurityGroup struct {
 	// +optional
 	IngressRules IngressRules `json:"ingressRule,omitempty"`
 
+	// EgressRules is the outbound rules associated with the security group.
+	// +optional
+	EgressRules EgressRules `json:"egressRule,omitempty"`
+
 	// Tags is a map of tags associated with the security group.
 	Tags Tags `json:"tags,omitempty"`
 }
@@ -1051,6 +1583,11 @@ type IngressRule struct {
 	// NatGatewaysIPsSource use the NAT gateways IPs as the source for the ingress rule.
 	// +optional
 	NatGatewaysIPsSource bool `json:"natGatewaysIPsSource,omitempty"`
+
+	// SourcePrefixLists are the managed prefix lists to allow access from. Cannot be specified with
+	// SourceSecurityGroupIDs or SourceSecurityGroupRoles.
+	// +optional
+	SourcePrefixLists []PrefixListReference `json:"sourcePrefixLists,omitempty"`
 }
 
 // String returns a string representation of the ingress rule.
@@ -1111,6 +1648,10 @@ func (i *IngressRule) Equals(o *IngressRule) bool {
 		}
 	}
 
+	if !equalStringSets(prefixListIDs(i.SourcePrefixLists), prefixListIDs(o.SourcePrefixLists)) {
+		return false
+	}
+
 	if len(i.SourceSecurityGroupIDs) != len(o.SourceSecurityGroupIDs) {
 		return false
 	}
@@ -1144,6 +1685,118 @@ func (i *IngressRule) Equals(o *IngressRule) bool {
 	return true
 }
 
+// EgressRule defines an AWS egress rule for security groups.
+type EgressRule struct {
+	// Description provides extended information about the egress rule.
+	Description string `json:"description"`
+	// Protocol is the protocol for the egress rule. Accepted values are "-1" (all), "4" (IP in IP),"tcp", "udp", "icmp", and "58" (ICMPv6), "50" (ESP).
+	// +kubebuilder:validation:Enum="-1";"4";tcp;udp;icmp;"58";"50"
+	Protocol SecurityGroupProtocol `json:"protocol"`
+	// FromPort is the start of port range.
+	FromPort int64 `json:"fromPort"`
+	// ToPort is the end of port range.
+	ToPort int64 `json:"toPort"`
+
+	// List of CIDR blocks to allow access to.
+	// +optional
+	CidrBlocks []string `json:"cidrBlocks,omitempty"`
+
+	// List of IPv6 CIDR blocks to allow access to.
+	// +optional
+	IPv6CidrBlocks []string `json:"ipv6CidrBlocks,omitempty"`
+
+	// List of managed prefix list ids to allow access to.
+	// +optional
+	PrefixListIDs []string `json:"prefixListIds,omitempty"`
+
+	// The security group ids to allow access to.
+	// +optional
+	DestinationSecurityGroupIDs []string `json:"destinationSecurityGroupIds,omitempty"`
+
+	// The security group roles to allow access to.
+	// The field will be combined with destination security group IDs if specified.
+	// +optional
+	DestinationSecurityGroupRoles []SecurityGroupRole `json:"destinationSecurityGroupRoles,omitempty"`
+}
+
+// String returns a string representation of the egress rule.
+func (e EgressRule) String() string {
+	return fmt.Sprintf("protocol=%s/range=[%d-%d]/description=%s", e.Protocol, e.FromPort, e.ToPort, e.Description)
+}
+
+// EgressRules is a slice of AWS egress rules for security groups.
+type EgressRules []EgressRule
+
+// Difference returns the difference between this slice and the other slice.
+func (e EgressRules) Difference(o EgressRules) (out EgressRules) {
+	for index := range e {
+		x := e[index]
+		found := false
+		for oIndex := range o {
+			y := o[oIndex]
+			if x.Equals(&y) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			out = append(out, x)
+		}
+	}
+
+	return
+}
+
+// Equals returns true if two EgressRule are equal.
+func (e *EgressRule) Equals(o *EgressRule) bool {
+	if !equalStringSets(e.CidrBlocks, o.CidrBlocks) ||
+		!equalStringSets(e.IPv6CidrBlocks, o.IPv6CidrBlocks) ||
+		!equalStringSets(e.PrefixListIDs, o.PrefixListIDs) ||
+		!equalStringSets(e.DestinationSecurityGroupIDs, o.DestinationSecurityGroupIDs) {
+		return false
+	}
+
+	if e.Description != o.Description || e.Protocol != o.Protocol {
+		return false
+	}
+
+	// See IngressRule.Equals: the port range is only meaningful for some protocols.
+	switch e.Protocol {
+	case SecurityGroupProtocolTCP,
+		SecurityGroupProtocolUDP,
+		SecurityGroupProtocolICMP,
+		SecurityGroupProtocolICMPv6:
+		return e.FromPort == o.FromPort && e.ToPort == o.ToPort
+	case SecurityGroupProtocolAll, SecurityGroupProtocolIPinIP, SecurityGroupProtocolESP:
+		// FromPort / ToPort are not applicable
+	}
+
+	return true
+}
+
+// prefixListIDs returns the ids of the prefix list references.
+// References are resolved to ids before being compared, the names of unresolved ones are returned as is.
+func prefixListIDs(refs []PrefixListReference) []string {
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		ids = append(ids, ref.String())
+	}
+	return ids
+}
+
+// equalStringSets returns true if both slices hold the same strings, regardless of their order.
+func equalStringSets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = slices.Sorted(slices.Values(a))
+	b = slices.Sorted(slices.Values(b))
+
+	return slices.Equal(a, b)
+}
+
 // ZoneType defines listener AWS Availability Zone type.
 type ZoneType string
 
@@ -0,0 +1,50 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"net"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateManagedPrefixLists validates the managed prefix lists of the cluster.
+func ValidateManagedPrefixLists(path *field.Path, lists []ManagedPrefixListSpec) field.ErrorList {
+	var errs field.ErrorList
+
+	for i := range lists {
+		list := lists[i]
+		listPath := path.Index(i)
+
+		for j, cidr := range list.CidrBlocks {
+			ip, _, err := net.ParseCIDR(cidr)
+			if err != nil {
+				errs = append(errs, field.Invalid(listPath.Child("cidrBlocks").Index(j), cidr, "must be a valid CIDR block"))
+				continue
+			}
+			if isIPv4 := ip.To4() != nil; isIPv4 != (list.AddressFamily != PrefixListAddressFamilyIPv6) {
+				errs = append(errs, field.Invalid(listPath.Child("cidrBlocks").Index(j), cidr, "must match the address family of the prefix list"))
+			}
+		}
+
+		if list.MaxEntries != nil && int(*list.MaxEntries) < len(list.CidrBlocks) {
+			errs = append(errs, field.Invalid(listPath.Child("maxEntries"), *list.MaxEntries, "must be greater than or equal to the number of CIDR blocks"))
+		}
+	}
+
+	return errs
+}
@@ -176,6 +176,9 @@ const (
 	// APIServerRoleTagValue describes the value for the apiserver role.
 	APIServerRoleTagValue = "apiserver"
 
+	// IngressRoleTagValue describes the value for the ingress load balancer role.
+	IngressRoleTagValue = "ingress"
+
 	// BastionRoleTagValue describes the value for the bastion role.
 	BastionRoleTagValue = "bastion"
 
@@ -188,6 +191,9 @@ const (
 	// PrivateRoleTagValue describes the value for the private role.
 	PrivateRoleTagValue = "private"
 
+	// VPCEndpointRoleTagValue describes the value for the vpc endpoint role.
+	VPCEndpointRoleTagValue = "vpc-endpoint"
+
 	// MachineNameTagKey is the key for machine name.
 	MachineNameTagKey = "MachineName"
 
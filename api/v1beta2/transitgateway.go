@@ -0,0 +1,115 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"net"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const anyIPv4CidrBlock = "0.0.0.0/0"
+
+// Validate validates TransitGatewaySpec fields against the subnets of the network.
+func (t *TransitGatewaySpec) Validate(path *field.Path, subnets Subnets) field.ErrorList {
+	var errs field.ErrorList
+
+	if t == nil {
+		return errs
+	}
+
+	if !strings.HasPrefix(t.ID, "tgw-") {
+		errs = append(errs, field.Invalid(path.Child("id"), t.ID, "must start with 'tgw-'"))
+	}
+
+	errs = append(errs, validateZonalSubnets(path.Child("subnets"), t.Subnets, subnets, "attached to a transit gateway")...)
+
+	for i, route := range t.Routes {
+		routePath := path.Child("routes").Index(i)
+		switch {
+		case route.DestinationCIDRBlock != nil && route.DestinationPrefixListID != nil:
+			errs = append(errs, field.Invalid(routePath, route, "destinationCidrBlock and destinationPrefixListId cannot be used together"))
+		case route.DestinationCIDRBlock != nil:
+			if _, _, err := net.ParseCIDR(*route.DestinationCIDRBlock); err != nil {
+				errs = append(errs, field.Invalid(routePath.Child("destinationCidrBlock"), *route.DestinationCIDRBlock, "CIDR block is invalid"))
+			}
+			if *route.DestinationCIDRBlock == anyIPv4CidrBlock {
+				errs = append(errs, field.Invalid(routePath.Child("destinationCidrBlock"), *route.DestinationCIDRBlock, "the default route of the subnets is managed by the provider"))
+			}
+		case route.DestinationPrefixListID != nil:
+			if !strings.HasPrefix(*route.DestinationPrefixListID, "pl-") {
+				errs = append(errs, field.Invalid(routePath.Child("destinationPrefixListId"), *route.DestinationPrefixListID, "must start with 'pl-'"))
+			}
+		default:
+			errs = append(errs, field.Required(routePath, "one of destinationCidrBlock or destinationPrefixListId must be set"))
+		}
+	}
+
+	return errs
+}
+
+// ValidateTransitGatewayUpdate validates the changes to the transit gateway configuration of a VPC.
+func ValidateTransitGatewayUpdate(path *field.Path, oldSpec, newSpec *TransitGatewaySpec) field.ErrorList {
+	var errs field.ErrorList
+
+	if oldSpec == nil || newSpec == nil {
+		return errs
+	}
+
+	// Attaching the VPC to another transit gateway requires removing the current attachment first.
+	if oldSpec.ID != newSpec.ID {
+		errs = append(errs, field.Invalid(path.Child("id"), newSpec.ID, "field is immutable, remove the transit gateway configuration first to attach the VPC to another transit gateway"))
+	}
+
+	return errs
+}
+
+// validateZonalSubnets validates the subnets of a resource spanning availability zones, which accept
+// at most one subnet per zone.
+func validateZonalSubnets(path *field.Path, ids []string, subnets Subnets, usage string) field.ErrorList {
+	var errs field.ErrorList
+
+	// The subnets are only known upfront when they're listed in the network spec,
+	// otherwise they're validated when reconciling the resource.
+	if len(subnets) == 0 {
+		return errs
+	}
+
+	zones := make(map[string]string)
+	for i, id := range ids {
+		sn := subnets.FindByIDOrResourceID(id)
+		if sn == nil {
+			errs = append(errs, field.NotFound(path.Index(i), id))
+			continue
+		}
+		if sn.IsEdge() {
+			errs = append(errs, field.Invalid(path.Index(i), id, "subnets in Local Zones or Wavelength Zones cannot be "+usage))
+			continue
+		}
+		if sn.AvailabilityZone == "" {
+			continue
+		}
+		if other, ok := zones[sn.AvailabilityZone]; ok {
+			errs = append(errs, field.Invalid(path.Index(i), id, "subnet is in the same availability zone as subnet "+other))
+			continue
+		}
+		zones[sn.AvailabilityZone] = id
+	}
+
+	return errs
+}
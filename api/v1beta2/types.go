@@ -86,6 +86,20 @@ const (
 	// ExternalResourceGCTasksAnnotation is the name of an annotation that indicates what
 	// external resources tasks should be executed by garbage collector for the cluster.
 	ExternalResourceGCTasksAnnotation = "aws.cluster.x-k8s.io/external-resource-tasks-gc"
+
+	// ExternalResourceGCDryRunAnnotation is the name of an annotation that indicates if the garbage
+	// collection of external resources should only report the resources it would delete, without
+	// deleting them.
+	ExternalResourceGCDryRunAnnotation = "aws.cluster.x-k8s.io/external-resource-gc-dry-run"
+
+	// ControlPlaneLoadBalancerCutoverAnnotation is the name of an annotation that indicates the control
+	// plane endpoint can be switched to the new load balancer during a load balancer type migration.
+	ControlPlaneLoadBalancerCutoverAnnotation = "aws.cluster.x-k8s.io/control-plane-load-balancer-cutover"
+
+	// ControlPlaneLoadBalancerDrainPeriodAnnotation is the name of an annotation that overrides how long
+	// the source load balancer of a load balancer type migration is kept after the cutover, as a duration
+	// such as "30m".
+	ControlPlaneLoadBalancerDrainPeriodAnnotation = "aws.cluster.x-k8s.io/control-plane-load-balancer-drain-period"
 )
 
 // GCTask defines a task to be executed by the garbage collector.
@@ -100,8 +114,37 @@ var (
 
 	// GCTaskSecurityGroup defines a task to cleaning up resources for AWS security groups.
 	GCTaskSecurityGroup = GCTask("security-group")
+
+	// GCTaskVolume defines a task to cleaning up EBS volumes, e.g. created by the EBS CSI driver.
+	GCTaskVolume = GCTask("volume")
+
+	// GCTaskSnapshot defines a task to cleaning up EBS snapshots, e.g. created by the EBS CSI driver.
+	GCTaskSnapshot = GCTask("snapshot")
+
+	// GCTaskNetworkInterface defines a task to cleaning up available network interfaces.
+	GCTaskNetworkInterface = GCTask("network-interface")
+
+	// GCTaskElasticIP defines a task to cleaning up Elastic IP addresses.
+	GCTaskElasticIP = GCTask("elastic-ip")
+
+	// GCTaskEFSAccessPoint defines a task to cleaning up EFS access points, e.g. created by the EFS CSI driver.
+	GCTaskEFSAccessPoint = GCTask("efs-access-point")
 )
 
+// SupportedGCTasks returns the tasks that can be executed by the garbage collector.
+func SupportedGCTasks() []GCTask {
+	return []GCTask{
+		GCTaskLoadBalancer,
+		GCTaskTargetGroup,
+		GCTaskSecurityGroup,
+		GCTaskVolume,
+		GCTaskSnapshot,
+		GCTaskNetworkInterface,
+		GCTaskElasticIP,
+		GCTaskEFSAccessPoint,
+	}
+}
+
 // AZSelectionScheme defines the scheme of selecting AZs.
 type AZSelectionScheme string
 
@@ -0,0 +1,80 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"net"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateVPCEndpoints validates the VPC endpoints of a VPC against the subnets of the network.
+func (v *VPCSpec) ValidateVPCEndpoints(path *field.Path, subnets Subnets) field.ErrorList {
+	var errs field.ErrorList
+
+	for i := range v.VPCEndpoints {
+		endpoint := &v.VPCEndpoints[i]
+		endpointPath := path.Child("vpcEndpoints").Index(i)
+
+		if endpoint.EndpointType() == VPCEndpointTypeGateway {
+			if !slices.Contains(vpcEndpointGatewayServices, endpoint.ShortServiceName()) {
+				errs = append(errs, field.Invalid(endpointPath.Child("type"), endpoint.Type, "gateway endpoints are only available for the s3 and dynamodb services"))
+			}
+			if len(endpoint.Subnets) > 0 {
+				errs = append(errs, field.Forbidden(endpointPath.Child("subnets"), "cannot be set for gateway endpoints"))
+			}
+			if endpoint.PrivateDNSEnabled != nil {
+				errs = append(errs, field.Forbidden(endpointPath.Child("privateDnsEnabled"), "cannot be set for gateway endpoints"))
+			}
+			if len(endpoint.IngressRules) > 0 {
+				errs = append(errs, field.Forbidden(endpointPath.Child("ingressRules"), "cannot be set for gateway endpoints"))
+			}
+			continue
+		}
+
+		errs = append(errs, validateZonalSubnets(endpointPath.Child("subnets"), endpoint.Subnets, subnets, "used by interface endpoints")...)
+
+		for j, rule := range endpoint.IngressRules {
+			rulePath := endpointPath.Child("ingressRules").Index(j)
+			if len(rule.SourceSecurityGroupRoles) > 0 {
+				errs = append(errs, field.Forbidden(rulePath.Child("sourceSecurityGroupRoles"), "is not supported for VPC endpoints"))
+			}
+			if rule.NatGatewaysIPsSource {
+				errs = append(errs, field.Forbidden(rulePath.Child("natGatewaysIPsSource"), "is not supported for VPC endpoints"))
+			}
+			if len(rule.SourcePrefixLists) > 0 {
+				errs = append(errs, field.Forbidden(rulePath.Child("sourcePrefixLists"), "is not supported for VPC endpoints"))
+			}
+			if len(rule.CidrBlocks) == 0 && len(rule.IPv6CidrBlocks) == 0 && len(rule.SourceSecurityGroupIDs) == 0 {
+				errs = append(errs, field.Required(rulePath, "one of cidrBlocks, ipv6CidrBlocks or sourceSecurityGroupIds must be set"))
+			}
+			for k, cidr := range rule.CidrBlocks {
+				if _, _, err := net.ParseCIDR(cidr); err != nil {
+					errs = append(errs, field.Invalid(rulePath.Child("cidrBlocks").Index(k), cidr, "CIDR block is invalid"))
+				}
+			}
+			for k, cidr := range rule.IPv6CidrBlocks {
+				if _, _, err := net.ParseCIDR(cidr); err != nil {
+					errs = append(errs, field.Invalid(rulePath.Child("ipv6CidrBlocks").Index(k), cidr, "CIDR block is invalid"))
+				}
+			}
+		}
+	}
+
+	return errs
+}
@@ -323,6 +323,16 @@ func (in *AWSClusterSpec) DeepCopyInto(out *AWSClusterSpec) {
 		*out = new(S3Bucket)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ControlPlaneDNS != nil {
+		in, out := &in.ControlPlaneDNS, &out.ControlPlaneDNS
+		*out = new(ControlPlaneDNS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IngressLoadBalancer != nil {
+		in, out := &in.IngressLoadBalancer, &out.IngressLoadBalancer
+		*out = new(IngressLoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterSpec.
@@ -432,6 +442,16 @@ func (in *AWSClusterStatus) DeepCopyInto(out *AWSClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ControlPlaneDNS != nil {
+		in, out := &in.ControlPlaneDNS, &out.ControlPlaneDNS
+		*out = new(ControlPlaneDNSStatus)
+		**out = **in
+	}
+	if in.ControlPlaneLoadBalancerMigration != nil {
+		in, out := &in.ControlPlaneLoadBalancerMigration, &out.ControlPlaneLoadBalancerMigration
+		*out = new(LoadBalancerMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterStatus.
@@ -597,11 +617,33 @@ func (in *AWSLoadBalancerSpec) DeepCopyInto(out *AWSLoadBalancerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EgressRules != nil {
+		in, out := &in.EgressRules, &out.EgressRules
+		*out = make([]EgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.TargetGroupIPType != nil {
 		in, out := &in.TargetGroupIPType, &out.TargetGroupIPType
 		*out = new(TargetGroupIPType)
 		**out = **in
 	}
+	if in.AccessLogs != nil {
+		in, out := &in.AccessLogs, &out.AccessLogs
+		*out = new(LoadBalancerAccessLogs)
+		**out = **in
+	}
+	if in.TLSListener != nil {
+		in, out := &in.TLSListener, &out.TLSListener
+		*out = new(TLSListenerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetGroupAttributes != nil {
+		in, out := &in.TargetGroupAttributes, &out.TargetGroupAttributes
+		*out = new(TargetGroupAttributes)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLoadBalancerSpec.
@@ -1236,6 +1278,11 @@ func (in *AdditionalListenerSpec) DeepCopyInto(out *AdditionalListenerSpec) {
 		*out = new(TargetGroupIPType)
 		**out = **in
 	}
+	if in.TargetGroupAttributes != nil {
+		in, out := &in.TargetGroupAttributes, &out.TargetGroupAttributes
+		*out = new(TargetGroupAttributes)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalListenerSpec.
@@ -1277,6 +1324,28 @@ func (in *Bastion) DeepCopyInto(out *Bastion) {
 		*out = make(CidrBlocks, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowedPrefixLists != nil {
+		in, out := &in.AllowedPrefixLists, &out.AllowedPrefixLists
+		*out = make([]PrefixListReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AMILookup != nil {
+		in, out := &in.AMILookup, &out.AMILookup
+		*out = new(BastionAMILookup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceMetadataOptions != nil {
+		in, out := &in.InstanceMetadataOptions, &out.InstanceMetadataOptions
+		*out = new(InstanceMetadataOptions)
+		**out = **in
+	}
+	if in.AutoScaling != nil {
+		in, out := &in.AutoScaling, &out.AutoScaling
+		*out = new(BastionAutoScaling)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bastion.
@@ -1289,6 +1358,46 @@ func (in *Bastion) DeepCopy() *Bastion {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionAMILookup) DeepCopyInto(out *BastionAMILookup) {
+	*out = *in
+	if in.Owners != nil {
+		in, out := &in.Owners, &out.Owners
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionAMILookup.
+func (in *BastionAMILookup) DeepCopy() *BastionAMILookup {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionAMILookup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionAutoScaling) DeepCopyInto(out *BastionAutoScaling) {
+	*out = *in
+	if in.HealthCheckGracePeriod != nil {
+		in, out := &in.HealthCheckGracePeriod, &out.HealthCheckGracePeriod
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionAutoScaling.
+func (in *BastionAutoScaling) DeepCopy() *BastionAutoScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionAutoScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildParams) DeepCopyInto(out *BuildParams) {
 	*out = *in
@@ -1469,6 +1578,51 @@ func (in *CloudInit) DeepCopy() *CloudInit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneDNS) DeepCopyInto(out *ControlPlaneDNS) {
+	*out = *in
+	if in.HostedZoneID != nil {
+		in, out := &in.HostedZoneID, &out.HostedZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostedZoneName != nil {
+		in, out := &in.HostedZoneName, &out.HostedZoneName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneDNS.
+func (in *ControlPlaneDNS) DeepCopy() *ControlPlaneDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneDNSStatus) DeepCopyInto(out *ControlPlaneDNSStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneDNSStatus.
+func (in *ControlPlaneDNSStatus) DeepCopy() *ControlPlaneDNSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneDNSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DedicatedHostInfo) DeepCopyInto(out *DedicatedHostInfo) {
 	*out = *in
@@ -1533,6 +1687,67 @@ func (in *DynamicHostAllocationSpec) DeepCopy() *DynamicHostAllocationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressRule) DeepCopyInto(out *EgressRule) {
+	*out = *in
+	if in.CidrBlocks != nil {
+		in, out := &in.CidrBlocks, &out.CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv6CidrBlocks != nil {
+		in, out := &in.IPv6CidrBlocks, &out.IPv6CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrefixListIDs != nil {
+		in, out := &in.PrefixListIDs, &out.PrefixListIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationSecurityGroupIDs != nil {
+		in, out := &in.DestinationSecurityGroupIDs, &out.DestinationSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationSecurityGroupRoles != nil {
+		in, out := &in.DestinationSecurityGroupRoles, &out.DestinationSecurityGroupRoles
+		*out = make([]SecurityGroupRole, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressRule.
+func (in *EgressRule) DeepCopy() *EgressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in EgressRules) DeepCopyInto(out *EgressRules) {
+	{
+		in := &in
+		*out = make(EgressRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressRules.
+func (in EgressRules) DeepCopy() EgressRules {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressRules)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticIPPool) DeepCopyInto(out *ElasticIPPool) {
 	*out = *in
@@ -1693,6 +1908,113 @@ func (in *IgnitionTLS) DeepCopy() *IgnitionTLS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressListenerRule) DeepCopyInto(out *IngressListenerRule) {
+	*out = *in
+	if in.HostHeaders != nil {
+		in, out := &in.HostHeaders, &out.HostHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PathPatterns != nil {
+		in, out := &in.PathPatterns, &out.PathPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.TargetGroup.DeepCopyInto(&out.TargetGroup)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressListenerRule.
+func (in *IngressListenerRule) DeepCopy() *IngressListenerRule {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressListenerRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressListenerSpec) DeepCopyInto(out *IngressListenerSpec) {
+	*out = *in
+	if in.SSLPolicy != nil {
+		in, out := &in.SSLPolicy, &out.SSLPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]IngressListenerRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressListenerSpec.
+func (in *IngressListenerSpec) DeepCopy() *IngressListenerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressListenerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressLoadBalancerSpec) DeepCopyInto(out *IngressLoadBalancerSpec) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(ELBScheme)
+		**out = **in
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalSecurityGroups != nil {
+		in, out := &in.AdditionalSecurityGroups, &out.AdditionalSecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IngressRules != nil {
+		in, out := &in.IngressRules, &out.IngressRules
+		*out = make([]IngressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]IngressListenerSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WebACLARN != nil {
+		in, out := &in.WebACLARN, &out.WebACLARN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressLoadBalancerSpec.
+func (in *IngressLoadBalancerSpec) DeepCopy() *IngressLoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressLoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressRule) DeepCopyInto(out *IngressRule) {
 	*out = *in
@@ -1716,6 +2038,13 @@ func (in *IngressRule) DeepCopyInto(out *IngressRule) {
 		*out = make([]SecurityGroupRole, len(*in))
 		copy(*out, *in)
 	}
+	if in.SourcePrefixLists != nil {
+		in, out := &in.SourcePrefixLists, &out.SourcePrefixLists
+		*out = make([]PrefixListReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressRule.
@@ -1749,6 +2078,31 @@ func (in IngressRules) DeepCopy() IngressRules {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressTargetGroupSpec) DeepCopyInto(out *IngressTargetGroupSpec) {
+	*out = *in
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(TargetGroupHealthCheckAdditionalSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = new(TargetGroupAttributes)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressTargetGroupSpec.
+func (in *IngressTargetGroupSpec) DeepCopy() *IngressTargetGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressTargetGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Instance) DeepCopyInto(out *Instance) {
 	*out = *in
@@ -1986,6 +2340,66 @@ func (in *LoadBalancer) DeepCopy() *LoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerAccessLogs) DeepCopyInto(out *LoadBalancerAccessLogs) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerAccessLogs.
+func (in *LoadBalancerAccessLogs) DeepCopy() *LoadBalancerAccessLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerAccessLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerMigrationStatus) DeepCopyInto(out *LoadBalancerMigrationStatus) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	if in.CutoverTime != nil {
+		in, out := &in.CutoverTime, &out.CutoverTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerMigrationStatus.
+func (in *LoadBalancerMigrationStatus) DeepCopy() *LoadBalancerMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedPrefixListSpec) DeepCopyInto(out *ManagedPrefixListSpec) {
+	*out = *in
+	if in.CidrBlocks != nil {
+		in, out := &in.CidrBlocks, &out.CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxEntries != nil {
+		in, out := &in.MaxEntries, &out.MaxEntries
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPrefixListSpec.
+func (in *ManagedPrefixListSpec) DeepCopy() *ManagedPrefixListSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedPrefixListSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
@@ -2028,6 +2442,27 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 		*out = make(CidrBlocks, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalControlPlaneEgressRules != nil {
+		in, out := &in.AdditionalControlPlaneEgressRules, &out.AdditionalControlPlaneEgressRules
+		*out = make([]EgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalNodeEgressRules != nil {
+		in, out := &in.AdditionalNodeEgressRules, &out.AdditionalNodeEgressRules
+		*out = make([]EgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManagedPrefixLists != nil {
+		in, out := &in.ManagedPrefixLists, &out.ManagedPrefixLists
+		*out = make([]ManagedPrefixListSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
@@ -2052,11 +2487,28 @@ func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
 	}
 	in.APIServerELB.DeepCopyInto(&out.APIServerELB)
 	in.SecondaryAPIServerELB.DeepCopyInto(&out.SecondaryAPIServerELB)
+	if in.IngressLoadBalancer != nil {
+		in, out := &in.IngressLoadBalancer, &out.IngressLoadBalancer
+		*out = new(LoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NatGatewaysIPs != nil {
 		in, out := &in.NatGatewaysIPs, &out.NatGatewaysIPs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.TransitGatewayAttachment != nil {
+		in, out := &in.TransitGatewayAttachment, &out.TransitGatewayAttachment
+		*out = new(TransitGatewayAttachmentStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagedPrefixLists != nil {
+		in, out := &in.ManagedPrefixLists, &out.ManagedPrefixLists
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
@@ -2084,6 +2536,31 @@ func (in *NodeInfo) DeepCopy() *NodeInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrefixListReference) DeepCopyInto(out *PrefixListReference) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixListReference.
+func (in *PrefixListReference) DeepCopy() *PrefixListReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PrefixListReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateDNSName) DeepCopyInto(out *PrivateDNSName) {
 	*out = *in
@@ -2169,6 +2646,13 @@ func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EgressRules != nil {
+		in, out := &in.EgressRules, &out.EgressRules
+		*out = make(EgressRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(Tags, len(*in))
@@ -2271,6 +2755,26 @@ func (in Subnets) DeepCopy() Subnets {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSListenerSpec) DeepCopyInto(out *TLSListenerSpec) {
+	*out = *in
+	if in.SSLPolicy != nil {
+		in, out := &in.SSLPolicy, &out.SSLPolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSListenerSpec.
+func (in *TLSListenerSpec) DeepCopy() *TLSListenerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSListenerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Tags) DeepCopyInto(out *Tags) {
 	{
@@ -2292,6 +2796,51 @@ func (in Tags) DeepCopy() Tags {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupAttributes) DeepCopyInto(out *TargetGroupAttributes) {
+	*out = *in
+	if in.DeregistrationDelaySeconds != nil {
+		in, out := &in.DeregistrationDelaySeconds, &out.DeregistrationDelaySeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ConnectionTermination != nil {
+		in, out := &in.ConnectionTermination, &out.ConnectionTermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UnhealthyConnectionTermination != nil {
+		in, out := &in.UnhealthyConnectionTermination, &out.UnhealthyConnectionTermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UnhealthyDrainingIntervalSeconds != nil {
+		in, out := &in.UnhealthyDrainingIntervalSeconds, &out.UnhealthyDrainingIntervalSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ProxyProtocolV2 != nil {
+		in, out := &in.ProxyProtocolV2, &out.ProxyProtocolV2
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Stickiness != nil {
+		in, out := &in.Stickiness, &out.Stickiness
+		*out = new(TargetGroupStickiness)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupAttributes.
+func (in *TargetGroupAttributes) DeepCopy() *TargetGroupAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetGroupHealthCheck) DeepCopyInto(out *TargetGroupHealthCheck) {
 	*out = *in
@@ -2435,6 +2984,13 @@ func (in *TargetGroupSpec) DeepCopyInto(out *TargetGroupSpec) {
 		*out = new(TargetGroupHealthCheck)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupSpec.
@@ -2447,6 +3003,160 @@ func (in *TargetGroupSpec) DeepCopy() *TargetGroupSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupStickiness) DeepCopyInto(out *TargetGroupStickiness) {
+	*out = *in
+	if in.CookieDurationSeconds != nil {
+		in, out := &in.CookieDurationSeconds, &out.CookieDurationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupStickiness.
+func (in *TargetGroupStickiness) DeepCopy() *TargetGroupStickiness {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupStickiness)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayAttachmentStatus) DeepCopyInto(out *TransitGatewayAttachmentStatus) {
+	*out = *in
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayAttachmentStatus.
+func (in *TransitGatewayAttachmentStatus) DeepCopy() *TransitGatewayAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRoute) DeepCopyInto(out *TransitGatewayRoute) {
+	*out = *in
+	if in.DestinationCIDRBlock != nil {
+		in, out := &in.DestinationCIDRBlock, &out.DestinationCIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationPrefixListID != nil {
+		in, out := &in.DestinationPrefixListID, &out.DestinationPrefixListID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRoute.
+func (in *TransitGatewayRoute) DeepCopy() *TransitGatewayRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewaySpec) DeepCopyInto(out *TransitGatewaySpec) {
+	*out = *in
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]TransitGatewayRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewaySpec.
+func (in *TransitGatewaySpec) DeepCopy() *TransitGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointSpec) DeepCopyInto(out *VPCEndpointSpec) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateDNSEnabled != nil {
+		in, out := &in.PrivateDNSEnabled, &out.PrivateDNSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IngressRules != nil {
+		in, out := &in.IngressRules, &out.IngressRules
+		*out = make([]IngressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointSpec.
+func (in *VPCEndpointSpec) DeepCopy() *VPCEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCFlowLogs) DeepCopyInto(out *VPCFlowLogs) {
+	*out = *in
+	if in.DeliverLogsPermissionARN != nil {
+		in, out := &in.DeliverLogsPermissionARN, &out.DeliverLogsPermissionARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogFormat != nil {
+		in, out := &in.LogFormat, &out.LogFormat
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCFlowLogs.
+func (in *VPCFlowLogs) DeepCopy() *VPCFlowLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCFlowLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 	*out = *in
@@ -2507,6 +3217,23 @@ func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 		*out = new(SubnetSchemaType)
 		**out = **in
 	}
+	if in.TransitGateway != nil {
+		in, out := &in.TransitGateway, &out.TransitGateway
+		*out = new(TransitGatewaySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = new(VPCFlowLogs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCEndpoints != nil {
+		in, out := &in.VPCEndpoints, &out.VPCEndpoints
+		*out = make([]VPCEndpointSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCSpec.
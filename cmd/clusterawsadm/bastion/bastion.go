@@ -0,0 +1,344 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bastion provides a way to reach the bastion host of a cluster through AWS Systems Manager Session Manager.
+package bastion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	_ "k8s.io/client-go/plugin/pkg/client/auth/exec" // import all auth plugins
+	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc" // import all oidc plugins
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/external"
+)
+
+const (
+	// sessionManagerPlugin is the executable of the Session Manager plugin, which streams the sessions
+	// started by the commands.
+	sessionManagerPlugin = "session-manager-plugin"
+
+	// portForwardingDocument is the SSM document of the sessions forwarding a local port to a remote host.
+	portForwardingDocument = "AWS-StartPortForwardingSessionToRemoteHost"
+)
+
+var (
+	scheme = runtime.NewScheme()
+)
+
+func init() {
+	_ = clusterv1.AddToScheme(scheme)
+	_ = infrav1.AddToScheme(scheme)
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+}
+
+// CmdProcessor handles the bastion commands.
+type CmdProcessor struct {
+	client client.Client
+
+	clusterName string
+	namespace   string
+
+	newSessionStarter func(clusterScope cloud.ClusterScoper) sessionStarter
+	runPlugin         func(ctx context.Context, args []string) error
+}
+
+// sessionStarter starts Session Manager sessions.
+type sessionStarter interface {
+	StartSession(ctx context.Context, params *ssm.StartSessionInput, optFns ...func(*ssm.Options)) (*ssm.StartSessionOutput, error)
+}
+
+// BastionInput holds the configuration for the command processor.
+type BastionInput struct {
+	ClusterName    string
+	Namespace      string
+	KubeconfigPath string
+}
+
+// PortForwardInput holds the configuration of a port forwarding session.
+type PortForwardInput struct {
+	// LocalPort is the local port forwarded to the remote host.
+	LocalPort int
+	// RemoteHost is the host the port is forwarded to. Defaults to the host of the API server endpoint.
+	RemoteHost string
+	// RemotePort is the port of the remote host. Defaults to the port of the API server endpoint.
+	RemotePort int
+}
+
+// CmdProcessorOption is a function type to supply options when creating the command processor.
+type CmdProcessorOption func(proc *CmdProcessor) error
+
+// WithClient is an option that enable you to explicitly supply a client.
+func WithClient(client client.Client) CmdProcessorOption {
+	return func(proc *CmdProcessor) error {
+		proc.client = client
+
+		return nil
+	}
+}
+
+// New creates a new instance of the command processor.
+func New(input BastionInput, opts ...CmdProcessorOption) (*CmdProcessor, error) {
+	cmd := &CmdProcessor{
+		clusterName: input.ClusterName,
+		namespace:   input.Namespace,
+		newSessionStarter: func(clusterScope cloud.ClusterScoper) sessionStarter {
+			return scope.NewSSMClient(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster())
+		},
+		runPlugin: runSessionManagerPlugin,
+	}
+
+	for _, opt := range opts {
+		if err := opt(cmd); err != nil {
+			return nil, fmt.Errorf("applying option: %w", err)
+		}
+	}
+
+	if cmd.client == nil {
+		config, err := clientcmd.BuildConfigFromFlags("", input.KubeconfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("building client config: %w", err)
+		}
+
+		cl, err := client.New(config, client.Options{Scheme: scheme})
+		if err != nil {
+			return nil, fmt.Errorf("creating new client: %w", err)
+		}
+
+		cmd.client = cl
+	}
+
+	return cmd, nil
+}
+
+// Connect starts an interactive shell session on the bastion host of the cluster.
+func (c *CmdProcessor) Connect(ctx context.Context) error {
+	target, err := c.getTarget(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.startSession(ctx, target, &ssm.StartSessionInput{
+		Target: aws.String(target.instanceID),
+	})
+}
+
+// PortForward forwards a local port to the API server of the cluster, or to the given remote host,
+// through the bastion host of the cluster.
+func (c *CmdProcessor) PortForward(ctx context.Context, input PortForwardInput) error {
+	target, err := c.getTarget(ctx)
+	if err != nil {
+		return err
+	}
+
+	remoteHost := input.RemoteHost
+	if remoteHost == "" {
+		remoteHost = target.endpoint.Host
+	}
+	if remoteHost == "" {
+		return fmt.Errorf("cluster %s/%s has no API server endpoint yet, a remote host is required", c.namespace, c.clusterName)
+	}
+
+	remotePort := input.RemotePort
+	if remotePort == 0 {
+		remotePort = int(target.endpoint.Port)
+	}
+	if remotePort == 0 {
+		remotePort = int(infrav1.DefaultAPIServerPort)
+	}
+
+	localPort := input.LocalPort
+	if localPort == 0 {
+		localPort = remotePort
+	}
+
+	return c.startSession(ctx, target, &ssm.StartSessionInput{
+		Target:       aws.String(target.instanceID),
+		DocumentName: aws.String(portForwardingDocument),
+		Parameters: map[string][]string{
+			"host":            {remoteHost},
+			"portNumber":      {strconv.Itoa(remotePort)},
+			"localPortNumber": {strconv.Itoa(localPort)},
+		},
+	})
+}
+
+// target is the bastion host a session is started on.
+type target struct {
+	clusterScope cloud.ClusterScoper
+	instanceID   string
+	endpoint     clusterv1beta1.APIEndpoint
+}
+
+// startSession starts a session on the bastion host and hands it over to the Session Manager plugin,
+// as the AWS CLI does.
+func (c *CmdProcessor) startSession(ctx context.Context, target *target, input *ssm.StartSessionInput) error {
+	region := target.clusterScope.Region()
+	endpoint, err := ssm.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, ssm.EndpointParameters{Region: aws.String(region)})
+	if err != nil {
+		return fmt.Errorf("resolving ssm endpoint: %w", err)
+	}
+
+	out, err := c.newSessionStarter(target.clusterScope).StartSession(ctx, input)
+	if err != nil {
+		return fmt.Errorf("starting session on bastion host %s: %w", target.instanceID, err)
+	}
+
+	session, err := json.Marshal(map[string]string{
+		"SessionId":  aws.ToString(out.SessionId),
+		"TokenValue": aws.ToString(out.TokenValue),
+		"StreamUrl":  aws.ToString(out.StreamUrl),
+	})
+	if err != nil {
+		return fmt.Errorf("encoding session: %w", err)
+	}
+
+	request, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("encoding session request: %w", err)
+	}
+
+	return c.runPlugin(ctx, []string{string(session), region, "StartSession", "", string(request), endpoint.URI.String()})
+}
+
+// getTarget returns the bastion host of the cluster, which must be reached through Session Manager.
+func (c *CmdProcessor) getTarget(ctx context.Context) (*target, error) {
+	cluster := &clusterv1.Cluster{}
+	key := client.ObjectKey{
+		Name:      c.clusterName,
+		Namespace: c.namespace,
+	}
+	if err := c.client.Get(ctx, key, cluster); err != nil {
+		return nil, fmt.Errorf("getting capi cluster %s/%s: %w", c.namespace, c.clusterName, err)
+	}
+
+	ref := cluster.Spec.InfrastructureRef
+	infraObj, err := external.GetObjectFromContractVersionedRef(ctx, c.client, ref, c.namespace)
+	if err != nil {
+		return nil, fmt.Errorf("getting infra cluster %s/%s: %w", c.namespace, ref.Name, err)
+	}
+
+	// Newer EKS clusters use an AWSManagedCluster as infra cluster, the bastion host is
+	// configured on their AWSManagedControlPlane.
+	if infraObj.GetKind() == "AWSManagedCluster" {
+		infraObj, err = external.GetObjectFromContractVersionedRef(ctx, c.client, cluster.Spec.ControlPlaneRef, c.namespace)
+		if err != nil {
+			return nil, fmt.Errorf("getting control plane %s/%s: %w", c.namespace, cluster.Spec.ControlPlaneRef.Name, err)
+		}
+	}
+
+	return c.newTarget(cluster, infraObj)
+}
+
+func (c *CmdProcessor) newTarget(cluster *clusterv1.Cluster, infraObj *unstructured.Unstructured) (*target, error) {
+	var (
+		res      = &target{}
+		bastion  infrav1.Bastion
+		instance *infrav1.Instance
+	)
+
+	switch infraObj.GetKind() {
+	case "AWSCluster":
+		awsCluster := &infrav1.AWSCluster{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(infraObj.Object, awsCluster); err != nil {
+			return nil, fmt.Errorf("converting infra cluster %s/%s: %w", c.namespace, infraObj.GetName(), err)
+		}
+
+		clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+			Client:         c.client,
+			Cluster:        cluster,
+			AWSCluster:     awsCluster,
+			ControllerName: "clusterawsadm",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating cluster scope: %w", err)
+		}
+
+		res.clusterScope = clusterScope
+		res.endpoint = awsCluster.Spec.ControlPlaneEndpoint
+		bastion, instance = awsCluster.Spec.Bastion, awsCluster.Status.Bastion
+	case "AWSManagedControlPlane":
+		controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(infraObj.Object, controlPlane); err != nil {
+			return nil, fmt.Errorf("converting control plane %s/%s: %w", c.namespace, infraObj.GetName(), err)
+		}
+
+		managedScope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+			Client:         c.client,
+			Cluster:        cluster,
+			ControlPlane:   controlPlane,
+			ControllerName: "clusterawsadm",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating managed control plane scope: %w", err)
+		}
+
+		res.clusterScope = managedScope
+		res.endpoint = controlPlane.Spec.ControlPlaneEndpoint
+		bastion, instance = controlPlane.Spec.Bastion, controlPlane.Status.Bastion
+	default:
+		return nil, fmt.Errorf("unsupported infra cluster kind %s", infraObj.GetKind())
+	}
+
+	if !bastion.Enabled || !bastion.IsSSM() {
+		return nil, fmt.Errorf("cluster %s/%s has no bastion host in %s mode", c.namespace, c.clusterName, infrav1.BastionModeSSM)
+	}
+	if instance == nil || instance.ID == "" {
+		return nil, fmt.Errorf("bastion host of cluster %s/%s is not ready yet", c.namespace, c.clusterName)
+	}
+	res.instanceID = instance.ID
+
+	return res, nil
+}
+
+// runSessionManagerPlugin runs the Session Manager plugin attached to the terminal until the session ends.
+func runSessionManagerPlugin(ctx context.Context, args []string) error {
+	path, err := exec.LookPath(sessionManagerPlugin)
+	if err != nil {
+		return fmt.Errorf("the Session Manager plugin is required, see https://docs.aws.amazon.com/systems-manager/latest/userguide/session-manager-working-with-install-plugin.html: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, path, args...) //#nosec G204
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("running %s: %w", sessionManagerPlugin, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bastion
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const (
+	testClusterName = "test-cluster"
+)
+
+func TestConnect(t *testing.T) {
+	testCases := []struct {
+		name         string
+		existingObjs []client.Object
+		expectError  bool
+	}{
+		{
+			name:         "no infra cluster",
+			existingObjs: newCluster(testClusterName, nil),
+			expectError:  true,
+		},
+		{
+			name: "bastion in ssh mode",
+			existingObjs: newCluster(testClusterName, &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					Bastion: infrav1.Bastion{Enabled: true},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Bastion: &infrav1.Instance{ID: "i-bastion"},
+				},
+			}),
+			expectError: true,
+		},
+		{
+			name: "bastion not ready",
+			existingObjs: newCluster(testClusterName, &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					Bastion: infrav1.Bastion{Enabled: true, Mode: infrav1.BastionModeSSM},
+				},
+			}),
+			expectError: true,
+		},
+		{
+			name:         "bastion in ssm mode",
+			existingObjs: newCluster(testClusterName, newSSMAWSCluster()),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			starter := &fakeSessionStarter{}
+			proc, args := newTestProcessor(g, starter, tc.existingObjs...)
+
+			err := proc.Connect(context.TODO())
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(starter.input).To(BeNil())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(starter.input.Target).To(Equal(aws.String("i-bastion")))
+			g.Expect(starter.input.DocumentName).To(BeNil())
+
+			g.Expect(*args).To(HaveLen(6))
+			g.Expect((*args)[0]).To(MatchJSON(`{"SessionId":"s-1","TokenValue":"token","StreamUrl":"wss://stream"}`))
+			g.Expect((*args)[1]).To(Equal("eu-west-1"))
+			g.Expect((*args)[2]).To(Equal("StartSession"))
+			g.Expect((*args)[5]).To(Equal("https://ssm.eu-west-1.amazonaws.com"))
+		})
+	}
+}
+
+func TestPortForward(t *testing.T) {
+	testCases := []struct {
+		name               string
+		input              PortForwardInput
+		expectedParameters map[string][]string
+	}{
+		{
+			name:  "defaults to the api server endpoint",
+			input: PortForwardInput{},
+			expectedParameters: map[string][]string{
+				"host":            {"api.example.com"},
+				"portNumber":      {"6443"},
+				"localPortNumber": {"6443"},
+			},
+		},
+		{
+			name:  "with local port and remote host",
+			input: PortForwardInput{LocalPort: 8443, RemoteHost: "10.0.0.10", RemotePort: 443},
+			expectedParameters: map[string][]string{
+				"host":            {"10.0.0.10"},
+				"portNumber":      {"443"},
+				"localPortNumber": {"8443"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			starter := &fakeSessionStarter{}
+			proc, args := newTestProcessor(g, starter, newCluster(testClusterName, newSSMAWSCluster())...)
+
+			g.Expect(proc.PortForward(context.TODO(), tc.input)).To(Succeed())
+			g.Expect(starter.input.Target).To(Equal(aws.String("i-bastion")))
+			g.Expect(starter.input.DocumentName).To(Equal(aws.String(portForwardingDocument)))
+			g.Expect(starter.input.Parameters).To(Equal(tc.expectedParameters))
+
+			request := &ssm.StartSessionInput{}
+			g.Expect(json.Unmarshal([]byte((*args)[4]), request)).To(Succeed())
+			g.Expect(request.Parameters).To(Equal(tc.expectedParameters))
+		})
+	}
+}
+
+type fakeSessionStarter struct {
+	input *ssm.StartSessionInput
+}
+
+func (f *fakeSessionStarter) StartSession(_ context.Context, input *ssm.StartSessionInput, _ ...func(*ssm.Options)) (*ssm.StartSessionOutput, error) {
+	f.input = input
+	return &ssm.StartSessionOutput{
+		SessionId:  aws.String("s-1"),
+		TokenValue: aws.String("token"),
+		StreamUrl:  aws.String("wss://stream"),
+	}, nil
+}
+
+func newTestProcessor(g *WithT, starter sessionStarter, objs ...client.Object) (*CmdProcessor, *[]string) {
+	proc, err := New(BastionInput{
+		ClusterName: testClusterName,
+		Namespace:   "default",
+	}, WithClient(newFakeClient(objs...)))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var args []string
+	proc.newSessionStarter = func(_ cloud.ClusterScoper) sessionStarter {
+		return starter
+	}
+	proc.runPlugin = func(_ context.Context, pluginArgs []string) error {
+		args = pluginArgs
+		return nil
+	}
+	return proc, &args
+}
+
+func newSSMAWSCluster() *infrav1.AWSCluster {
+	return &infrav1.AWSCluster{
+		Spec: infrav1.AWSClusterSpec{
+			Region: "eu-west-1",
+			ControlPlaneEndpoint: clusterv1beta1.APIEndpoint{
+				Host: "api.example.com",
+				Port: 6443,
+			},
+			Bastion: infrav1.Bastion{
+				Enabled:         true,
+				Mode:            infrav1.BastionModeSSM,
+				InstanceProfile: "bastion",
+			},
+		},
+		Status: infrav1.AWSClusterStatus{
+			Bastion: &infrav1.Instance{ID: "i-bastion"},
+		},
+	}
+}
+
+func newCluster(name string, awsCluster *infrav1.AWSCluster) []client.Object {
+	objs := []client.Object{
+		&clusterv1.Cluster{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Cluster",
+				APIVersion: clusterv1.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: clusterv1.ClusterSpec{
+				InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+					Name:     name,
+					Kind:     "AWSCluster",
+					APIGroup: infrav1.GroupVersion.Group,
+				},
+			},
+		},
+	}
+
+	if awsCluster != nil {
+		awsCluster.TypeMeta = metav1.TypeMeta{
+			Kind:       "AWSCluster",
+			APIVersion: infrav1.GroupVersion.String(),
+		}
+		awsCluster.ObjectMeta = metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		}
+		objs = append(objs, awsCluster)
+	}
+
+	return objs
+}
+
+func newFakeClient(objs ...client.Object) client.Client {
+	// Add the CRD to the fake client so external.GetObjectFromContractVersionedRef can find it
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awsclusters.infrastructure.cluster.x-k8s.io",
+			Labels: map[string]string{
+				"cluster.x-k8s.io/v1beta1": "v1beta2",
+			},
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: infrav1.GroupVersion.Group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Kind:   "AWSCluster",
+				Plural: "awsclusters",
+			},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:    "v1beta2",
+					Served:  true,
+					Storage: true,
+					Schema: &apiextensionsv1.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+							Type: "object",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objs, crd)...).Build()
+}
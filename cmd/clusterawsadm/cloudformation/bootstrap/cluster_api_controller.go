@@ -92,10 +92,13 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:AssociateRouteTable",
 				"ec2:AssociateVpcCidrBlock",
 				"ec2:AttachInternetGateway",
+				"ec2:AuthorizeSecurityGroupEgress",
 				"ec2:AuthorizeSecurityGroupIngress",
 				"ec2:CreateCarrierGateway",
+				"ec2:CreateFlowLogs",
 				"ec2:CreateInternetGateway",
 				"ec2:CreateEgressOnlyInternetGateway",
+				"ec2:CreateManagedPrefixList",
 				"ec2:CreateNatGateway",
 				"ec2:CreateNetworkInterface",
 				"ec2:CreateRoute",
@@ -103,38 +106,51 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:CreateSecurityGroup",
 				"ec2:CreateSubnet",
 				"ec2:CreateTags",
+				"ec2:CreateTransitGatewayVpcAttachment",
 				"ec2:CreateVpc",
 				"ec2:CreateVpcEndpoint",
 				"ec2:DisassociateVpcCidrBlock",
 				"ec2:ModifyVpcAttribute",
 				"ec2:ModifyVpcEndpoint",
 				"ec2:DeleteCarrierGateway",
+				"ec2:DeleteFlowLogs",
 				"ec2:DeleteInternetGateway",
 				"ec2:DeleteEgressOnlyInternetGateway",
+				"ec2:DeleteManagedPrefixList",
 				"ec2:DeleteNatGateway",
+				"ec2:DeleteNetworkInterface",
 				"ec2:DeleteRouteTable",
+				"ec2:DeleteRoute",
 				"ec2:ReplaceRoute",
 				"ec2:DeleteSecurityGroup",
+				"ec2:DeleteSnapshot",
 				"ec2:DeleteSubnet",
 				"ec2:DeleteTags",
+				"ec2:DeleteTransitGatewayVpcAttachment",
+				"ec2:DeleteVolume",
 				"ec2:DeleteVpc",
 				"ec2:DeleteVpcEndpoints",
 				"ec2:DescribeAccountAttributes",
 				"ec2:DescribeAddresses",
 				"ec2:DescribeAvailabilityZones",
 				"ec2:DescribeCarrierGateways",
+				"ec2:DescribeFlowLogs",
 				"ec2:DescribeInstances",
 				"ec2:DescribeInstanceTypes",
 				"ec2:DescribeInternetGateways",
 				"ec2:DescribeEgressOnlyInternetGateways",
 				"ec2:DescribeInstanceTypes",
 				"ec2:DescribeImages",
+				"ec2:DescribeManagedPrefixLists",
 				"ec2:DescribeNatGateways",
 				"ec2:DescribeNetworkInterfaces",
 				"ec2:DescribeNetworkInterfaceAttribute",
 				"ec2:DescribeRouteTables",
 				"ec2:DescribeSecurityGroups",
+				"ec2:DescribeSnapshots",
 				"ec2:DescribeSubnets",
+				"ec2:DescribeTransitGateways",
+				"ec2:DescribeTransitGatewayVpcAttachments",
 				"ec2:DescribeVpcs",
 				"ec2:DescribeDhcpOptions",
 				"ec2:DescribeVpcAttribute",
@@ -144,9 +160,13 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DetachInternetGateway",
 				"ec2:DisassociateRouteTable",
 				"ec2:DisassociateAddress",
+				"ec2:AssociateAddress",
 				"ec2:ModifyInstanceAttribute",
+				"ec2:GetManagedPrefixListEntries",
+				"ec2:ModifyManagedPrefixList",
 				"ec2:ModifyNetworkInterfaceAttribute",
 				"ec2:ModifySubnetAttribute",
+				"ec2:ModifyTransitGatewayVpcAttachment",
 				"ec2:ReleaseAddress",
 				"ec2:RevokeSecurityGroupEgress",
 				"ec2:RevokeSecurityGroupIngress",
@@ -154,6 +174,8 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:TerminateInstances",
 				"ec2:GetSecurityGroupsForVpc",
 				"tag:GetResources",
+				"elasticfilesystem:DeleteAccessPoint",
+				"elasticfilesystem:DescribeAccessPoints",
 				"elasticloadbalancing:AddTags",
 				"elasticloadbalancing:CreateLoadBalancer",
 				"elasticloadbalancing:ConfigureHealthCheck",
@@ -171,6 +193,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"elasticloadbalancing:RemoveTags",
 				"elasticloadbalancing:SetSubnets",
 				"elasticloadbalancing:ModifyTargetGroupAttributes",
+				"elasticloadbalancing:DescribeTargetGroupAttributes",
 				"elasticloadbalancing:CreateTargetGroup",
 				"elasticloadbalancing:DescribeListeners",
 				"elasticloadbalancing:CreateListener",
@@ -178,11 +201,20 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"elasticloadbalancing:RegisterTargets",
 				"elasticloadbalancing:DeregisterTargets",
 				"elasticloadbalancing:DeleteListener",
+				"elasticloadbalancing:ModifyListener",
+				"elasticloadbalancing:CreateRule",
+				"elasticloadbalancing:DeleteRule",
+				"elasticloadbalancing:DescribeRules",
+				"elasticloadbalancing:ModifyRule",
+				"elasticloadbalancing:SetWebAcl",
 				"autoscaling:DescribeAutoScalingGroups",
 				"autoscaling:DescribeInstanceRefreshes",
 				"autoscaling:DeleteLifecycleHook",
 				"autoscaling:DescribeLifecycleHooks",
 				"autoscaling:PutLifecycleHook",
+				"autoscaling:DescribeWarmPool",
+				"autoscaling:DescribePolicies",
+				"autoscaling:DescribeScheduledActions",
 				"ec2:CreateLaunchTemplate",
 				"ec2:CreateLaunchTemplateVersion",
 				"ec2:DescribeLaunchTemplates",
@@ -191,6 +223,11 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DeleteLaunchTemplateVersions",
 				"ec2:DescribeKeyPairs",
 				"ec2:ModifyInstanceMetadataOptions",
+				"ec2:CreateFleet",
+				"ec2:DescribeFleets",
+				"ec2:DescribeFleetInstances",
+				"ec2:ModifyFleet",
+				"ec2:DeleteFleets",
 				"eks:CreateAccessEntry",
 				"eks:DeleteAccessEntry",
 				"eks:DescribeAccessEntry",
@@ -199,6 +236,11 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"eks:AssociateAccessPolicy",
 				"eks:DisassociateAccessPolicy",
 				"eks:ListAssociatedAccessPolicies",
+				"eks:CreatePodIdentityAssociation",
+				"eks:DeletePodIdentityAssociation",
+				"eks:DescribePodIdentityAssociation",
+				"eks:UpdatePodIdentityAssociation",
+				"eks:ListPodIdentityAssociations",
 			},
 		},
 		{
@@ -214,6 +256,12 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"autoscaling:StartInstanceRefresh",
 				"autoscaling:DeleteAutoScalingGroup",
 				"autoscaling:DeleteTags",
+				"autoscaling:PutWarmPool",
+				"autoscaling:DeleteWarmPool",
+				"autoscaling:PutScalingPolicy",
+				"autoscaling:DeletePolicy",
+				"autoscaling:PutScheduledUpdateGroupAction",
+				"autoscaling:DeleteScheduledAction",
 			},
 		},
 		{
@@ -259,6 +307,44 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"iam:PassRole",
 			},
 		},
+		{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources{iamv1.Any},
+			Action: iamv1.Actions{
+				"iam:PassRole",
+			},
+			Condition: iamv1.Conditions{
+				iamv1.StringEquals: map[string]string{"iam:PassedToService": "vpc-flow-logs.amazonaws.com"},
+			},
+		},
+		{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources{iamv1.Any},
+			Action: iamv1.Actions{
+				"logs:CreateLogDelivery",
+				"logs:DeleteLogDelivery",
+			},
+		},
+		{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources{iamv1.Any},
+			Action: iamv1.Actions{
+				"route53:AssociateVPCWithHostedZone",
+				"route53:ChangeResourceRecordSets",
+				"route53:GetHostedZone",
+				"route53:ListHostedZonesByName",
+				"route53:ListResourceRecordSets",
+			},
+		},
+		{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources{iamv1.Any},
+			Action: iamv1.Actions{
+				"wafv2:AssociateWebACL",
+				"wafv2:DisassociateWebACL",
+				"wafv2:GetWebACLForResource",
+			},
+		},
 	}
 	for _, secureSecretBackend := range t.Spec.SecureSecretsBackends {
 		switch secureSecretBackend {
@@ -327,6 +413,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"events:PutRule",
 				"events:PutTargets",
 				"events:RemoveTargets",
+				"sqs:ChangeMessageVisibility",
 				"sqs:CreateQueue",
 				"sqs:DeleteMessage",
 				"sqs:DeleteQueue",
@@ -477,6 +564,7 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 				"eks:ListAddons",
 				"eks:CreateAddon",
 				"eks:DescribeAddonVersions",
+				"eks:DescribeAddonConfiguration",
 				"eks:DescribeAddon",
 				"eks:DeleteAddon",
 				"eks:UpdateAddon",
@@ -484,6 +572,9 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 				"eks:DescribeFargateProfile",
 				"eks:CreateFargateProfile",
 				"eks:DeleteFargateProfile",
+				"eks:DescribeClusterVersions",
+				"eks:ListInsights",
+				"eks:DescribeInsight",
 			},
 			Resource: iamv1.Resources{
 				"*",
@@ -504,6 +595,20 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 			},
 			Effect: iamv1.EffectAllow,
 		},
+		{
+			Action: iamv1.Actions{
+				"iam:PassRole",
+			},
+			Resource: iamv1.Resources{
+				"*",
+			},
+			Condition: iamv1.Conditions{
+				"StringEquals": map[string]string{
+					"iam:PassedToService": "pods.eks.amazonaws.com",
+				},
+			},
+			Effect: iamv1.EffectAllow,
+		},
 		{
 			Action: iamv1.Actions{
 				"kms:CreateGrant",
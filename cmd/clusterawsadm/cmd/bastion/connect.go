@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bastion
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/util/homedir"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	bastionproc "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/bastion"
+)
+
+func newConnectCmd() *cobra.Command {
+	var (
+		clusterName       string
+		namespace         string
+		kubeConfig        string
+		kubeConfigDefault string
+	)
+
+	if home := homedir.HomeDir(); home != "" {
+		kubeConfigDefault = filepath.Join(home, ".kube", "config")
+	}
+
+	newCmd := &cobra.Command{
+		Use:   "connect",
+		Short: "Start a shell session on the bastion host of a cluster",
+		Long: templates.LongDesc(`
+			This command will start an interactive shell session on the bastion
+			host of the given cluster through AWS Systems Manager Session Manager.
+			The bastion host must be in ssm mode and the Session Manager plugin
+			must be installed. The AWS credentials are resolved from the identity
+			of the infra cluster, as the controllers do.
+		`),
+		Example: templates.Examples(`
+			# Start a shell session on the bastion host of a cluster using existing k8s context
+			clusterawsadm bastion connect --cluster-name=test-cluster
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proc, err := bastionproc.New(bastionproc.BastionInput{
+				ClusterName:    clusterName,
+				Namespace:      namespace,
+				KubeconfigPath: kubeConfig,
+			})
+			if err != nil {
+				return fmt.Errorf("creating command processor: %w", err)
+			}
+
+			if err := proc.Connect(cmd.Context()); err != nil {
+				return fmt.Errorf("connecting to bastion host: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	newCmd.Flags().StringVar(&clusterName, "cluster-name", "", "The name of the CAPA cluster")
+	newCmd.Flags().StringVarP(&namespace, "namespace", "n", "default", "The namespace for the cluster definition")
+	newCmd.Flags().StringVar(&kubeConfig, "kubeconfig", kubeConfigDefault, "Path to the kubeconfig file to use")
+
+	newCmd.MarkFlagRequired("cluster-name") //nolint: errcheck
+
+	return newCmd
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bastion
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/util/homedir"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	bastionproc "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/bastion"
+)
+
+func newPortForwardCmd() *cobra.Command {
+	var (
+		clusterName       string
+		namespace         string
+		kubeConfig        string
+		kubeConfigDefault string
+		input             bastionproc.PortForwardInput
+	)
+
+	if home := homedir.HomeDir(); home != "" {
+		kubeConfigDefault = filepath.Join(home, ".kube", "config")
+	}
+
+	newCmd := &cobra.Command{
+		Use:   "port-forward",
+		Short: "Forward a local port to the API server of a cluster through its bastion host",
+		Long: templates.LongDesc(`
+			This command will forward a local port to the API server of the given
+			cluster, or to another remote host, through the bastion host of the
+			cluster using AWS Systems Manager Session Manager. The bastion host
+			must be in ssm mode and the Session Manager plugin must be installed.
+			The AWS credentials are resolved from the identity of the infra
+			cluster, as the controllers do.
+		`),
+		Example: templates.Examples(`
+			# Forward the local port 6443 to the API server of a cluster
+			clusterawsadm bastion port-forward --cluster-name=test-cluster
+
+			# Forward the local port 8443 to port 443 of a host in the VPC
+			clusterawsadm bastion port-forward --cluster-name=test-cluster --local-port=8443 --remote-host=10.0.0.10 --remote-port=443
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proc, err := bastionproc.New(bastionproc.BastionInput{
+				ClusterName:    clusterName,
+				Namespace:      namespace,
+				KubeconfigPath: kubeConfig,
+			})
+			if err != nil {
+				return fmt.Errorf("creating command processor: %w", err)
+			}
+
+			if err := proc.PortForward(cmd.Context(), input); err != nil {
+				return fmt.Errorf("forwarding port through bastion host: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	newCmd.Flags().StringVar(&clusterName, "cluster-name", "", "The name of the CAPA cluster")
+	newCmd.Flags().StringVarP(&namespace, "namespace", "n", "default", "The namespace for the cluster definition")
+	newCmd.Flags().StringVar(&kubeConfig, "kubeconfig", kubeConfigDefault, "Path to the kubeconfig file to use")
+	newCmd.Flags().IntVar(&input.LocalPort, "local-port", 0, "The local port to forward. Defaults to the remote port")
+	newCmd.Flags().StringVar(&input.RemoteHost, "remote-host", "", "The host to forward the port to. Defaults to the host of the API server endpoint")
+	newCmd.Flags().IntVar(&input.RemotePort, "remote-port", 0, "The port of the remote host. Defaults to the port of the API server endpoint")
+
+	newCmd.MarkFlagRequired("cluster-name") //nolint: errcheck
+
+	return newCmd
+}
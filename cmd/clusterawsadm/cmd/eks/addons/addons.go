@@ -30,6 +30,7 @@ func RootCmd() *cobra.Command {
 	}
 	newCmd.AddCommand(listAvailableCmd())
 	newCmd.AddCommand(listInstalledCmd())
+	newCmd.AddCommand(describeConfigCmd())
 
 	return newCmd
 }
@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/ptr"
+)
+
+func describeConfigCmd() *cobra.Command {
+	addonName := ""
+	addonVersion := ""
+	region := ""
+
+	newCmd := &cobra.Command{
+		Use:   "describe-config",
+		Short: "Describe the configuration schema of an EKS addon",
+		Long:  "Prints the JSON schema that the configuration of an EKS addon version is validated against",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return describeAddonConfig(&region, &addonName, &addonVersion)
+		},
+	}
+
+	newCmd.Flags().StringVarP(&region, "region", "r", "", "The AWS region to get the addon configuration schema from")
+	newCmd.Flags().StringVar(&addonName, "addon-name", "", "The name of the addon to get the configuration schema for")
+	newCmd.Flags().StringVar(&addonVersion, "addon-version", "", "The version of the addon to get the configuration schema for")
+	newCmd.MarkFlagRequired("addon-name")    //nolint: errcheck
+	newCmd.MarkFlagRequired("addon-version") //nolint: errcheck
+
+	return newCmd
+}
+
+func describeAddonConfig(region, addonName, addonVersion *string) error {
+	ctx := context.TODO()
+
+	optFns := []func(*config.LoadOptions) error{
+		config.WithRegion(ptr.Deref(region, "")),
+	}
+
+	cfg, err := config.LoadDefaultConfig(context.Background(), optFns...)
+
+	if err != nil {
+		return err
+	}
+
+	eksClient := eks.NewFromConfig(cfg)
+
+	input := &eks.DescribeAddonConfigurationInput{
+		AddonName:    addonName,
+		AddonVersion: addonVersion,
+	}
+	output, err := eksClient.DescribeAddonConfiguration(ctx, input)
+	if err != nil {
+		return fmt.Errorf("describing addon configuration %s: %w", *addonName, err)
+	}
+
+	schema := aws.ToString(output.ConfigurationSchema)
+	if schema == "" {
+		fmt.Printf("EKS addon %s version %s has no configuration schema\n", *addonName, *addonVersion)
+		return nil
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, []byte(schema), "", "  "); err != nil {
+		return fmt.Errorf("formatting addon configuration schema: %w", err)
+	}
+	fmt.Println(indented.String())
+
+	return nil
+}
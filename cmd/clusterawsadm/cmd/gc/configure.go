@@ -46,12 +46,16 @@ func newConfigureCmd() *cobra.Command {
 		Long: templates.LongDesc(`
 			This command will set what cleanup tasks to execute on the given cluster
 			during garbage collection (i.e. deleting) when the cluster is
-			requested to be deleted. Supported values: load-balancer, security-group, target-group.
+			requested to be deleted. Supported values: load-balancer, security-group, target-group,
+			volume, snapshot, network-interface, elastic-ip, efs-access-point.
 		`),
 		Example: templates.Examples(`
 			# Configure GC for a cluster to delete only load balancers and security groups using existing k8s context
 			clusterawsadm gc configure --cluster-name=test-cluster --gc-task load-balancer --gc-task security-group
 
+			# Configure GC for a cluster to also delete the EBS volumes and snapshots left by the EBS CSI driver
+			clusterawsadm gc configure --cluster-name=test-cluster --gc-task load-balancer --gc-task target-group --gc-task security-group --gc-task volume --gc-task snapshot
+
 			# Reset GC configuration for a cluster using kubeconfig
 			clusterawsadm gc configure --cluster-name=test-cluster --kubeconfig=test.kubeconfig
 		`),
@@ -0,0 +1,103 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/util/homedir"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	gcproc "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/gc"
+	cmdout "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/printers"
+)
+
+func newPreviewCmd() *cobra.Command {
+	var (
+		clusterName           string
+		namespace             string
+		kubeConfig            string
+		kubeConfigDefault     string
+		outputPrinterType     string
+		alternativeGCStrategy bool
+	)
+
+	if home := homedir.HomeDir(); home != "" {
+		kubeConfigDefault = filepath.Join(home, ".kube", "config")
+	}
+
+	newCmd := &cobra.Command{
+		Use:   "preview",
+		Short: "Show the external resources that would be garbage collected for a cluster",
+		Long: templates.LongDesc(`
+			This command will list the external AWS resources that would be
+			deleted by the garbage collection when the given cluster is deleted,
+			taking into account the cleanup tasks configured for the cluster.
+			Nothing is deleted. The AWS credentials are resolved from the
+			identity of the infra cluster, as the controllers do.
+		`),
+		Example: templates.Examples(`
+			# Preview GC for a cluster using existing k8s context
+			clusterawsadm gc preview --cluster-name=test-cluster
+
+			# Preview GC for a cluster using the alternative GC strategy and print the result as JSON
+			clusterawsadm gc preview --cluster-name=test-cluster --alternative-gc-strategy -o json
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputPrinter, err := cmdout.New(outputPrinterType, os.Stdout)
+			if err != nil {
+				return fmt.Errorf("creating output printer: %w", err)
+			}
+
+			proc, err := gcproc.New(gcproc.GCInput{
+				ClusterName:    clusterName,
+				Namespace:      namespace,
+				KubeconfigPath: kubeConfig,
+			})
+			if err != nil {
+				return fmt.Errorf("creating command processor: %w", err)
+			}
+
+			report, err := proc.Preview(cmd.Context(), alternativeGCStrategy)
+			if err != nil {
+				return fmt.Errorf("previewing garbage collection: %w", err)
+			}
+			if !report.Enabled {
+				fmt.Fprintf(os.Stderr, "Garbage collection is disabled for cluster %s/%s, the following resources would not be deleted\n", namespace, clusterName)
+			}
+
+			if outputPrinterType == string(cmdout.PrinterTypeTable) {
+				return outputPrinter.Print(report.ToTable())
+			}
+			return outputPrinter.Print(report)
+		},
+	}
+
+	newCmd.Flags().StringVar(&clusterName, "cluster-name", "", "The name of the CAPA cluster")
+	newCmd.Flags().StringVarP(&namespace, "namespace", "n", "default", "The namespace for the cluster definition")
+	newCmd.Flags().StringVar(&kubeConfig, "kubeconfig", kubeConfigDefault, "Path to the kubeconfig file to use")
+	newCmd.Flags().StringVarP(&outputPrinterType, "output", "o", "table", "The output format of the results. Possible values: table, json, yaml")
+	newCmd.Flags().BoolVar(&alternativeGCStrategy, "alternative-gc-strategy", false, "Collect the resources with the alternative GC strategy, as the controllers do when the AlternativeGCStrategy feature gate is enabled")
+
+	newCmd.MarkFlagRequired("cluster-name") //nolint: errcheck
+
+	return newCmd
+}
@@ -30,6 +30,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/cmd/ami"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/cmd/bastion"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/cmd/bootstrap"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/cmd/controller"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/cmd/eks"
@@ -75,6 +76,7 @@ func RootCmd() *cobra.Command {
 	newCmd.AddCommand(controller.RootCmd())
 	newCmd.AddCommand(resource.RootCmd())
 	newCmd.AddCommand(gc.RootCmd())
+	newCmd.AddCommand(bastion.RootCmd())
 
 	return newCmd
 }
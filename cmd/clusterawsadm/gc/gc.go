@@ -20,8 +20,10 @@ package gc
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -33,6 +35,9 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	gcservice "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/gc"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/controllers/external"
 	"sigs.k8s.io/cluster-api/util/patch"
@@ -47,6 +52,7 @@ func init() {
 	_ = infrav1.AddToScheme(scheme)
 	_ = ekscontrolplanev1.AddToScheme(scheme)
 	_ = apiextensionsv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
 }
 
 // CmdProcessor handles the garbage collection commands.
@@ -55,6 +61,13 @@ type CmdProcessor struct {
 
 	clusterName string
 	namespace   string
+
+	newPreviewer func(clusterScope cloud.ClusterScoper, alternativeGCStrategy bool) previewer
+}
+
+// previewer reports the resources that the garbage collection would delete.
+type previewer interface {
+	Preview(ctx context.Context) (*gcservice.Report, error)
 }
 
 // GCInput holds the configuration for the command processor.
@@ -81,6 +94,9 @@ func New(input GCInput, opts ...CmdProcessorOption) (*CmdProcessor, error) {
 	cmd := &CmdProcessor{
 		clusterName: input.ClusterName,
 		namespace:   input.Namespace,
+		newPreviewer: func(clusterScope cloud.ClusterScoper, alternativeGCStrategy bool) previewer {
+			return gcservice.NewService(clusterScope, gcservice.WithGCStrategy(alternativeGCStrategy))
+		},
 	}
 
 	for _, opt := range opts {
@@ -126,7 +142,7 @@ func (c *CmdProcessor) Disable(ctx context.Context) error {
 
 // Configure is used to configure external resource garbage collection for a cluster.
 func (c *CmdProcessor) Configure(ctx context.Context, gcTasks []string) error {
-	supportedGCTasks := []infrav1.GCTask{infrav1.GCTaskLoadBalancer, infrav1.GCTaskTargetGroup, infrav1.GCTaskSecurityGroup}
+	supportedGCTasks := infrav1.SupportedGCTasks()
 
 	for _, gcTask := range gcTasks {
 		found := false
@@ -152,6 +168,92 @@ func (c *CmdProcessor) Configure(ctx context.Context, gcTasks []string) error {
 	return nil
 }
 
+// Preview is used to report the external resources that would be garbage collected when the cluster
+// is deleted, without deleting them. The AWS credentials are resolved the same way the controllers do,
+// using the identity of the infra cluster.
+func (c *CmdProcessor) Preview(ctx context.Context, alternativeGCStrategy bool) (*PreviewReport, error) {
+	cluster, err := c.getCluster(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infraObj, err := c.getInfraCluster(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Newer EKS clusters use an AWSManagedCluster as infra cluster, the garbage collection is
+	// configured on their AWSManagedControlPlane.
+	if infraObj.GetKind() == "AWSManagedCluster" {
+		infraObj, err = external.GetObjectFromContractVersionedRef(ctx, c.client, cluster.Spec.ControlPlaneRef, c.namespace)
+		if err != nil {
+			return nil, fmt.Errorf("getting control plane %s/%s: %w", c.namespace, cluster.Spec.ControlPlaneRef.Name, err)
+		}
+	}
+
+	clusterScope, err := c.newClusterScope(cluster, infraObj)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := c.newPreviewer(clusterScope, alternativeGCStrategy).Preview(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("previewing garbage collection: %w", err)
+	}
+
+	enabled := true
+	if val, found := annotations.Get(infraObj, infrav1.ExternalResourceGCAnnotation); found {
+		enabled, _ = strconv.ParseBool(val)
+	}
+
+	return &PreviewReport{
+		ClusterName: c.clusterName,
+		Enabled:     enabled,
+		Resources:   report.Resources,
+	}, nil
+}
+
+func (c *CmdProcessor) newClusterScope(cluster *clusterv1.Cluster, infraObj *unstructured.Unstructured) (cloud.ClusterScoper, error) {
+	switch infraObj.GetKind() {
+	case "AWSCluster":
+		awsCluster := &infrav1.AWSCluster{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(infraObj.Object, awsCluster); err != nil {
+			return nil, fmt.Errorf("converting infra cluster %s/%s: %w", c.namespace, infraObj.GetName(), err)
+		}
+
+		clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+			Client:         c.client,
+			Cluster:        cluster,
+			AWSCluster:     awsCluster,
+			ControllerName: "clusterawsadm",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating cluster scope: %w", err)
+		}
+
+		return clusterScope, nil
+	case "AWSManagedControlPlane":
+		controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(infraObj.Object, controlPlane); err != nil {
+			return nil, fmt.Errorf("converting control plane %s/%s: %w", c.namespace, infraObj.GetName(), err)
+		}
+
+		managedScope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+			Client:         c.client,
+			Cluster:        cluster,
+			ControlPlane:   controlPlane,
+			ControllerName: "clusterawsadm",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating managed control plane scope: %w", err)
+		}
+
+		return managedScope, nil
+	default:
+		return nil, fmt.Errorf("unsupported infra cluster kind %s", infraObj.GetKind())
+	}
+}
+
 func (c *CmdProcessor) setAnnotationAndPatch(ctx context.Context, annotationName, annotationValue string) error {
 	infraObj, err := c.getInfraCluster(ctx)
 	if err != nil {
@@ -176,7 +278,7 @@ func (c *CmdProcessor) setAnnotationAndPatch(ctx context.Context, annotationName
 	return nil
 }
 
-func (c *CmdProcessor) getInfraCluster(ctx context.Context) (*unstructured.Unstructured, error) {
+func (c *CmdProcessor) getCluster(ctx context.Context) (*clusterv1.Cluster, error) {
 	cluster := &clusterv1.Cluster{}
 
 	key := client.ObjectKey{
@@ -188,6 +290,15 @@ func (c *CmdProcessor) getInfraCluster(ctx context.Context) (*unstructured.Unstr
 		return nil, fmt.Errorf("getting capi cluster %s/%s: %w", c.namespace, c.clusterName, err)
 	}
 
+	return cluster, nil
+}
+
+func (c *CmdProcessor) getInfraCluster(ctx context.Context) (*unstructured.Unstructured, error) {
+	cluster, err := c.getCluster(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	ref := cluster.Spec.InfrastructureRef
 	obj, err := external.GetObjectFromContractVersionedRef(ctx, c.client, ref, c.namespace)
 	if err != nil {
@@ -18,6 +18,7 @@ package gc
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -31,6 +32,8 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	gcservice "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/gc"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/controllers/external"
 )
@@ -237,6 +240,13 @@ func TestConfigureGC(t *testing.T) {
 			gcTasks:      []string{"load-balancer", "target-group"},
 			expectError:  false,
 		},
+		{
+			name:         "with awscluster and workload resource gc tasks",
+			clusterName:  testClusterName,
+			existingObjs: newUnManagedCluster(testClusterName, false),
+			gcTasks:      []string{"volume", "snapshot", "network-interface", "elastic-ip", "efs-access-point"},
+			expectError:  false,
+		},
 		{
 			name:         "with awscluster and invalid gc tasks",
 			clusterName:  testClusterName,
@@ -288,6 +298,79 @@ func TestConfigureGC(t *testing.T) {
 	}
 }
 
+func TestPreviewGC(t *testing.T) {
+	RegisterTestingT(t)
+
+	testCases := []struct {
+		name           string
+		existingObjs   []client.Object
+		expectError    bool
+		expectEnabled  bool
+		expectedScoper string
+	}{
+		{
+			name:         "no infra cluster",
+			existingObjs: newUnManagedCluster(testClusterName, true),
+			expectError:  true,
+		},
+		{
+			name:           "with awscluster",
+			existingObjs:   newUnManagedCluster(testClusterName, false),
+			expectEnabled:  true,
+			expectedScoper: "*scope.ClusterScope",
+		},
+		{
+			name:           "with managed control plane and gc disabled",
+			existingObjs:   newManagedClusterWithAnnotations(testClusterName, map[string]string{infrav1.ExternalResourceGCAnnotation: "false"}),
+			expectEnabled:  false,
+			expectedScoper: "*scope.ManagedControlPlaneScope",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			input := GCInput{
+				ClusterName: testClusterName,
+				Namespace:   "default",
+			}
+
+			fake := newFakeClient(scheme, tc.existingObjs...)
+			ctx := context.TODO()
+
+			proc, err := New(input, WithClient(fake))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			resources := []gcservice.ReportedResource{{Type: "volume", ARN: "arn:aws:ec2:eu-west-2:1234567890:volume/vol-1234"}}
+			proc.newPreviewer = func(clusterScope cloud.ClusterScoper, alternativeGCStrategy bool) previewer {
+				g.Expect(fmt.Sprintf("%T", clusterScope)).To(Equal(tc.expectedScoper))
+				g.Expect(alternativeGCStrategy).To(BeTrue())
+				return fakePreviewer{report: &gcservice.Report{Resources: resources}}
+			}
+
+			report, err := proc.Preview(ctx, true)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(report.ClusterName).To(Equal(testClusterName))
+			g.Expect(report.Enabled).To(Equal(tc.expectEnabled))
+			g.Expect(report.Resources).To(Equal(resources))
+			g.Expect(report.ToTable().Rows).To(HaveLen(1))
+		})
+	}
+}
+
+type fakePreviewer struct {
+	report *gcservice.Report
+}
+
+func (p fakePreviewer) Preview(_ context.Context) (*gcservice.Report, error) {
+	return p.report, nil
+}
+
 func newFakeClient(scheme *runtime.Scheme, objs ...client.Object) client.Client {
 	// Add CRDs to the fake client so external.GetObjectFromContractVersionedRef can find them
 	crds := []client.Object{
@@ -0,0 +1,60 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gcservice "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/gc"
+)
+
+// PreviewReport lists the external resources that would be garbage collected when a cluster is deleted.
+type PreviewReport struct {
+	ClusterName string `json:"cluster_name"`
+	// Enabled is false if the cluster opted-out of garbage collection, in which case nothing
+	// would be deleted.
+	Enabled   bool                         `json:"enabled"`
+	Resources []gcservice.ReportedResource `json:"resources"`
+}
+
+// ToTable converts PreviewReport to Table.
+func (r *PreviewReport) ToTable() *metav1.Table {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.SchemeGroupVersion.String(),
+			Kind:       "Table",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{
+				Name: "Type",
+				Type: "string",
+			},
+			{
+				Name: "ARN",
+				Type: "string",
+			},
+		},
+	}
+
+	for _, resource := range r.Resources {
+		row := metav1.TableRow{
+			Cells: []interface{}{resource.Type, resource.ARN},
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
@@ -45,6 +45,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/gc"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/network"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/route53"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/s3"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/securitygroup"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
@@ -60,6 +61,10 @@ import (
 
 const (
 	deleteRequeueAfter = 20 * time.Second
+
+	loadBalancerMigrationRequeueAfter = time.Minute
+
+	bastionAutoScalingRequeueAfter = time.Minute
 )
 
 var defaultAWSSecurityGroupRoles = []infrav1.SecurityGroupRole{
@@ -117,6 +122,9 @@ func securityGroupRolesForCluster(scope scope.ClusterScope) []infrav1.SecurityGr
 	if scope.Bastion().Enabled {
 		roles = append(roles, infrav1.SecurityGroupBastion)
 	}
+	if scope.IngressLoadBalancer() != nil {
+		roles = append(roles, infrav1.SecurityGroupIngressLB)
+	}
 	return roles
 }
 
@@ -249,6 +257,11 @@ func (r *AWSClusterReconciler) reconcileDelete(ctx context.Context, clusterScope
 		allErrs = append(allErrs, errors.Wrapf(err, "error deleting S3 Bucket"))
 	}
 
+	// The record is deleted before the load balancer it points to.
+	if err := route53.NewService(clusterScope).DeleteControlPlaneDNS(ctx); err != nil {
+		allErrs = append(allErrs, errors.Wrapf(err, "error deleting control plane DNS record"))
+	}
+
 	if err := elbsvc.DeleteLoadbalancers(ctx); err != nil {
 		allErrs = append(allErrs, errors.Wrapf(err, "error deleting load balancers"))
 	}
@@ -305,14 +318,49 @@ func (r *AWSClusterReconciler) reconcileLoadBalancer(ctx context.Context, cluste
 
 	v1beta1conditions.MarkTrue(awsCluster, infrav1.LoadBalancerReadyCondition)
 
+	host := awsCluster.Status.Network.APIServerELB.DNSName
+	if clusterScope.ControlPlaneDNS() != nil || clusterScope.ControlPlaneDNSStatus() != nil {
+		if requeueAfter, err := r.reconcileControlPlaneDNS(ctx, clusterScope, awsCluster); err != nil || requeueAfter != nil {
+			return requeueAfter, err
+		}
+		if dnsStatus := clusterScope.ControlPlaneDNSStatus(); dnsStatus != nil {
+			host = dnsStatus.RecordName
+		}
+	}
+
 	awsCluster.Spec.ControlPlaneEndpoint = clusterv1beta1.APIEndpoint{
-		Host: awsCluster.Status.Network.APIServerELB.DNSName,
+		Host: host,
 		Port: clusterScope.APIServerPort(),
 	}
 
 	return nil, nil
 }
 
+func (r *AWSClusterReconciler) reconcileControlPlaneDNS(ctx context.Context, clusterScope *scope.ClusterScope, awsCluster *infrav1.AWSCluster) (*time.Duration, error) {
+	retryAfterDuration := 15 * time.Second
+
+	// Alias records need the hosted zone of the load balancer, which is only reported once it is described.
+	if dns := clusterScope.ControlPlaneDNS(); dns != nil && dns.TTL == nil && awsCluster.Status.Network.APIServerELB.CanonicalHostedZoneID == "" {
+		v1beta1conditions.MarkFalse(awsCluster, infrav1.ControlPlaneDNSReadyCondition, infrav1.WaitForLoadBalancerHostedZoneReason, clusterv1beta1.ConditionSeverityInfo, "")
+		clusterScope.Info("Waiting on API server load balancer hosted zone")
+		return &retryAfterDuration, nil
+	}
+
+	if err := route53.NewService(clusterScope).ReconcileControlPlaneDNS(ctx); err != nil {
+		clusterScope.Error(err, "failed to reconcile control plane DNS record")
+		v1beta1conditions.MarkFalse(awsCluster, infrav1.ControlPlaneDNSReadyCondition, infrav1.ControlPlaneDNSFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), "%s", err.Error())
+		return nil, err
+	}
+
+	if clusterScope.ControlPlaneDNS() == nil {
+		v1beta1conditions.Delete(awsCluster, infrav1.ControlPlaneDNSReadyCondition)
+		return nil, nil
+	}
+
+	v1beta1conditions.MarkTrue(awsCluster, infrav1.ControlPlaneDNSReadyCondition)
+	return nil, nil
+}
+
 func (r *AWSClusterReconciler) reconcileNormal(ctx context.Context, clusterScope *scope.ClusterScope) (reconcile.Result, error) {
 	clusterScope.Info("Reconciling AWSCluster")
 
@@ -382,7 +430,25 @@ func (r *AWSClusterReconciler) reconcileNormal(ctx context.Context, clusterScope
 		})
 	}
 
+	if r.ExternalResourceGC {
+		gcSvc := gc.NewService(clusterScope, gc.WithGCStrategy(r.AlternativeGCStrategy))
+		if err := gcSvc.ReconcileDryRun(ctx); err != nil {
+			// non fatal error, so we continue
+			clusterScope.Error(err, "non-fatal: failed to preview garbage collection")
+		}
+	}
+
 	awsCluster.Status.Ready = true
+	if clusterScope.ControlPlaneLoadBalancerMigration() != nil {
+		// The migration of the control plane load balancer progresses on target health and the drain period,
+		// neither of which triggers a reconcile.
+		return reconcile.Result{RequeueAfter: loadBalancerMigrationRequeueAfter}, nil
+	}
+	if awsCluster.Spec.Bastion.Enabled && awsCluster.Spec.Bastion.AutoScaling != nil {
+		// Replacements of the bastion instance by its Auto Scaling Group don't trigger a reconcile, while
+		// the bastion status and Elastic IP must follow them.
+		return reconcile.Result{RequeueAfter: bastionAutoScalingRequeueAfter}, nil
+	}
 	return reconcile.Result{}, nil
 }
 
@@ -1491,6 +1491,30 @@ func mockedCallsForMissingEverything(m *mocks.MockEC2APIMockRecorder, e *mocks.M
 			UnhealthyThreshold: aws.Int32(3),
 		},
 	})).Return(&elb.ConfigureHealthCheckOutput{}, nil)
+	m.DescribeVpcEndpoints(context.TODO(), gomock.Eq(&ec2.DescribeVpcEndpointsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{"vpc-new"},
+			},
+		},
+	}), gomock.Any()).Return(&ec2.DescribeVpcEndpointsOutput{}, nil).AnyTimes()
+	m.DescribeSecurityGroups(context.TODO(), gomock.Eq(&ec2.DescribeSecurityGroupsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{"vpc-new"},
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: []string{"owned"},
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/role"),
+				Values: []string{"vpc-endpoint"},
+			},
+		},
+	}), gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{}, nil).AnyTimes()
 }
 
 func mockedCreateMaximumVPCCalls(m *mocks.MockEC2APIMockRecorder) {
@@ -1579,6 +1603,10 @@ func mockedDeleteVPCCalls(m *mocks.MockEC2APIMockRecorder) {
 			},
 		},
 	}), gomock.Any()).Return(&ec2.DescribeVpcEndpointsOutput{}, nil).AnyTimes()
+	m.DescribeTransitGatewayVpcAttachments(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeTransitGatewayVpcAttachmentsInput{})).
+		Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{}, nil).AnyTimes()
+	m.DescribeFlowLogs(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeFlowLogsInput{}), gomock.Any()).
+		Return(&ec2.DescribeFlowLogsOutput{}, nil).AnyTimes()
 	m.DescribeSubnets(context.TODO(), gomock.Eq(&ec2.DescribeSubnetsInput{
 		Filters: []ec2types.Filter{
 			{
@@ -1727,6 +1755,22 @@ func mockedDeleteVPCCalls(m *mocks.MockEC2APIMockRecorder) {
 	m.DeleteVpc(context.TODO(), gomock.Eq(&ec2.DeleteVpcInput{
 		VpcId: aws.String("vpc-exists"),
 	}))
+	m.DescribeSecurityGroups(context.TODO(), gomock.Eq(&ec2.DescribeSecurityGroupsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{"vpc-exists"},
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: []string{"owned"},
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/role"),
+				Values: []string{"vpc-endpoint"},
+			},
+		},
+	}), gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{}, nil).AnyTimes()
 }
 
 func mockedCreateSGCalls(recordLBV2 bool, vpcID string, m *mocks.MockEC2APIMockRecorder) {
@@ -1006,8 +1006,10 @@ func (r *AWSMachineReconciler) reconcileLBAttachment(ctx context.Context, machin
 		// from the ELB as soon as the machine or infra machine gets deleted or when the machine is in a not running state.
 		if machineScope.AWSMachineIsDeleted() || machineScope.MachineIsDeleted() || !machineScope.InstanceIsRunning() {
 			if lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeClassic {
+				// A classic load balancer is followed by the new load balancer while it is migrated to another type.
 				machineScope.Debug("deregistering from classic load balancer")
-				return r.deregisterInstanceFromClassicLB(ctx, machineScope, elbsvc, i)
+				errs = append(errs, r.deregisterInstanceFromClassicLB(ctx, machineScope, elbsvc, i))
+				continue
 			}
 			machineScope.Debug("deregistering from v2 load balancer")
 			errs = append(errs, r.deregisterInstanceFromV2LB(ctx, machineScope, elbsvc, i, lbSpec))
@@ -1019,6 +1021,22 @@ func (r *AWSMachineReconciler) reconcileLBAttachment(ctx context.Context, machin
 		}
 	}
 
+	// The ingress load balancer forwards to the control plane instances, like the control plane load balancers.
+	if elbScope.IngressLoadBalancer() != nil {
+		if machineScope.AWSMachineIsDeleted() || machineScope.MachineIsDeleted() || !machineScope.InstanceIsRunning() {
+			machineScope.Debug("deregistering from ingress load balancer")
+			if err := elbsvc.DeregisterInstanceFromIngressLB(ctx, i); err != nil {
+				r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedDetachIngressLB",
+					"Failed to deregister control plane instance %q from ingress load balancer: %v", i.ID, err)
+				errs = append(errs, errors.Wrapf(err, "could not deregister control plane instance %q from ingress load balancer", i.ID))
+			}
+		} else if err := elbsvc.RegisterInstanceWithIngressLB(ctx, i); err != nil {
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedAttachIngressLB",
+				"Failed to register control plane instance %q with ingress load balancer: %v", i.ID, err)
+			errs = append(errs, errors.Wrapf(err, "could not register control plane instance %q with ingress load balancer", i.ID))
+		}
+	}
+
 	return kerrors.NewAggregate(errs)
 }
 
@@ -117,11 +117,17 @@ func (r *AWSManagedControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.RestrictPrivateSubnets = restored.Spec.RestrictPrivateSubnets
 	dst.Spec.AccessConfig = restored.Spec.AccessConfig
 	dst.Spec.AccessEntries = restored.Spec.AccessEntries
+	dst.Spec.PodIdentityAssociations = restored.Spec.PodIdentityAssociations
+	restoreAddons(dst.Spec.Addons, restored.Spec.Addons)
+	restoreAddonStates(dst.Status.Addons, restored.Status.Addons)
 	dst.Spec.RolePath = restored.Spec.RolePath
 	dst.Spec.RolePermissionsBoundary = restored.Spec.RolePermissionsBoundary
 	dst.Status.Version = restored.Status.Version
 	dst.Spec.BootstrapSelfManagedAddons = restored.Spec.BootstrapSelfManagedAddons
 	dst.Spec.UpgradePolicy = restored.Spec.UpgradePolicy
+	dst.Spec.SkipUpgradePreflightChecks = restored.Spec.SkipUpgradePreflightChecks
+	dst.Status.Upgrade = restored.Status.Upgrade
+	dst.Status.Insights = restored.Status.Insights
 	return nil
 }
 
@@ -242,6 +248,68 @@ func Convert_v1beta2_AWSManagedControlPlaneSpec_To_v1beta1_AWSManagedControlPlan
 	return autoConvert_v1beta2_AWSManagedControlPlaneSpec_To_v1beta1_AWSManagedControlPlaneSpec(in, out, scope)
 }
 
+// Convert_v1beta2_Addon_To_v1beta1_Addon is a conversion function.
+func Convert_v1beta2_Addon_To_v1beta1_Addon(in *ekscontrolplanev1.Addon, out *Addon, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_Addon_To_v1beta1_Addon(in, out, s)
+}
+
+// Convert_Slice_v1beta1_Addon_To_Slice_v1beta2_Addon is a conversion function.
+func Convert_Slice_v1beta1_Addon_To_Slice_v1beta2_Addon(in *[]Addon, out *[]ekscontrolplanev1.Addon, s apiconversion.Scope) error {
+	*out = make([]ekscontrolplanev1.Addon, len(*in))
+	for i := range *in {
+		if err := Convert_v1beta1_Addon_To_v1beta2_Addon(&(*in)[i], &(*out)[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Convert_Slice_v1beta2_Addon_To_Slice_v1beta1_Addon is a conversion function.
+func Convert_Slice_v1beta2_Addon_To_Slice_v1beta1_Addon(in *[]ekscontrolplanev1.Addon, out *[]Addon, s apiconversion.Scope) error {
+	*out = make([]Addon, len(*in))
+	for i := range *in {
+		if err := Convert_v1beta2_Addon_To_v1beta1_Addon(&(*in)[i], &(*out)[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreAddons restores the fields of the addons that don't exist in v1beta1, matching
+// the addons by name.
+func restoreAddons(dst, restored *[]ekscontrolplanev1.Addon) {
+	if dst == nil || restored == nil {
+		return
+	}
+	for i := range *dst {
+		for _, addon := range *restored {
+			if addon.Name == (*dst)[i].Name {
+				(*dst)[i].PodIdentityAssociations = addon.PodIdentityAssociations
+				(*dst)[i].UpdatePolicy = addon.UpdatePolicy
+				break
+			}
+		}
+	}
+}
+
+// restoreAddonStates restores the fields of the addon states that don't exist in v1beta1,
+// matching the addons by name.
+func restoreAddonStates(dst, restored []ekscontrolplanev1.AddonState) {
+	for i := range dst {
+		for _, addon := range restored {
+			if addon.Name == dst[i].Name {
+				dst[i].ResolvedVersion = addon.ResolvedVersion
+				break
+			}
+		}
+	}
+}
+
+// Convert_v1beta2_AddonState_To_v1beta1_AddonState is a conversion function.
+func Convert_v1beta2_AddonState_To_v1beta1_AddonState(in *ekscontrolplanev1.AddonState, out *AddonState, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_AddonState_To_v1beta1_AddonState(in, out, s)
+}
+
 // Convert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedControlPlaneStatus is an autogenerated conversion function.
 func Convert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedControlPlaneStatus(in *ekscontrolplanev1.AWSManagedControlPlaneStatus, out *AWSManagedControlPlaneStatus, s apiconversion.Scope) error {
 	return autoConvert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedControlPlaneStatus(in, out, s)
@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	"encoding/json"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -32,6 +33,7 @@ import (
 func fuzzFuncs(_ runtimeserializer.CodecFactory) []interface{} {
 	return []interface{}{
 		AWSManagedControlPlaneFuzzer,
+		InsightsStatusFuzzer,
 	}
 }
 
@@ -40,6 +42,21 @@ func AWSManagedControlPlaneFuzzer(obj *AWSManagedControlPlane, c randfill.Contin
 	obj.Spec.DisableVPCCNI = false
 }
 
+func InsightsStatusFuzzer(obj *v1beta2.InsightsStatus, c randfill.Continue) {
+	c.FillNoCustom(obj)
+
+	// AWSManagedControlPlane.Status.Insights only exists in v1beta2 and is restored from the conversion data annotation,
+	// which drops empty slices as well as sub-second times, so normalizing it through JSON in order to avoid v1beta2 --> v1beta1 --> v1beta2 round trip errors.
+	data, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	*obj = v1beta2.InsightsStatus{}
+	if err := json.Unmarshal(data, obj); err != nil {
+		panic(err)
+	}
+}
+
 func TestFuzzyConversion(t *testing.T) {
 	g := NewWithT(t)
 	scheme := runtime.NewScheme()
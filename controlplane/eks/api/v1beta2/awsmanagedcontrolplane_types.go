@@ -201,6 +201,12 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	AccessEntries []AccessEntry `json:"accessEntries,omitempty"`
 
+	// PodIdentityAssociations specifies the EKS Pod Identity associations for the cluster.
+	// Each association grants the pods using a Kubernetes service account the credentials
+	// of an IAM role. The eks-pod-identity-agent addon must be installed in the cluster.
+	// +optional
+	PodIdentityAssociations []PodIdentityAssociation `json:"podIdentityAssociations,omitempty"`
+
 	// VpcCni is used to set configuration options for the VPC CNI plugin
 	// +optional
 	VpcCni VpcCni `json:"vpcCni,omitempty"`
@@ -226,6 +232,13 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +kubebuilder:validation:Enum=extended;standard
 	// +optional
 	UpgradePolicy UpgradePolicy `json:"upgradePolicy,omitempty"`
+
+	// SkipUpgradePreflightChecks disables the pre-flight checks that are run before
+	// each step of a control plane version upgrade. By default an upgrade step is only
+	// started if the target version is supported by EKS and there are no upgrade
+	// readiness insights in an error state for it.
+	// +optional
+	SkipUpgradePreflightChecks bool `json:"skipUpgradePreflightChecks,omitempty"`
 }
 
 // KubeProxy specifies how the kube-proxy daemonset is managed.
@@ -334,6 +347,43 @@ type AccessScope struct {
 	Namespaces []string `json:"namespaces,omitempty"`
 }
 
+// PodIdentityAssociation represents an EKS Pod Identity association between a Kubernetes
+// service account and an IAM role.
+type PodIdentityAssociation struct {
+	// ServiceAccountNamespace is the namespace of the Kubernetes service account.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccountNamespace string `json:"serviceAccountNamespace"`
+
+	// ServiceAccountName is the name of the Kubernetes service account.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccountName string `json:"serviceAccountName"`
+
+	// RoleARN is the ARN of an existing IAM role to associate with the service account.
+	// Exactly one of RoleARN or Role must be specified.
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+
+	// Role specifies an IAM role that is created and managed by CAPA for the association.
+	// Exactly one of RoleARN or Role must be specified.
+	// +optional
+	Role *PodIdentityRole `json:"role,omitempty"`
+}
+
+// PodIdentityRole defines an IAM role created by CAPA for an EKS Pod Identity association.
+type PodIdentityRole struct {
+	// PolicyARNs are the ARNs of the IAM policies to attach to the role.
+	// +optional
+	PolicyARNs []string `json:"policyARNs,omitempty"`
+
+	// TrustPolicy is the JSON trust policy document of the role. Defaults to a policy
+	// allowing the EKS Pod Identity service principal (pods.eks.amazonaws.com) to
+	// assume the role and tag the session.
+	// +optional
+	TrustPolicy string `json:"trustPolicy,omitempty"`
+}
+
 // EncryptionConfig specifies the encryption configuration for the EKS clsuter.
 type EncryptionConfig struct {
 	// Provider specifies the ARN or alias of the CMK (in AWS KMS)
@@ -402,6 +452,15 @@ type AWSManagedControlPlaneStatus struct {
 	// in the cluster.
 	// +optional
 	Version *string `json:"version,omitempty"`
+	// Upgrade reports on the progress of the most recent control plane version
+	// upgrade. Controllers of machine pools that belong to the cluster use it to
+	// hold back their own rollouts until the control plane and addons are upgraded.
+	// +optional
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
+	// Insights holds the EKS cluster insights that are not passing, which are
+	// refreshed periodically.
+	// +optional
+	Insights *InsightsStatus `json:"insights,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -18,10 +18,12 @@ package v1beta2
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 
 	"github.com/apparentlymart/go-cidr/cidr"
+	"github.com/blang/semver"
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -109,6 +111,7 @@ func (*awsManagedControlPlaneWebhook) ValidateCreate(_ context.Context, obj runt
 	allErrs = append(allErrs, r.validatePrivateDNSHostnameTypeOnLaunch()...)
 	allErrs = append(allErrs, r.validateAccessConfigCreate()...)
 	allErrs = append(allErrs, r.validateAccessEntries()...)
+	allErrs = append(allErrs, r.validatePodIdentityAssociations()...)
 
 	if len(allErrs) == 0 {
 		return nil, nil
@@ -152,6 +155,7 @@ func (*awsManagedControlPlaneWebhook) ValidateUpdate(ctx context.Context, oldObj
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validatePrivateDNSHostnameTypeOnLaunch()...)
 	allErrs = append(allErrs, r.validateAccessEntries()...)
+	allErrs = append(allErrs, r.validatePodIdentityAssociations()...)
 
 	if r.Spec.Region != oldAWSManagedControlplane.Spec.Region {
 		allErrs = append(allErrs,
@@ -185,6 +189,12 @@ func (*awsManagedControlPlaneWebhook) ValidateUpdate(ctx context.Context, oldObj
 		)
 	}
 
+	transitGatewayField := field.NewPath("spec", "network", "vpc", "transitGateway")
+	allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.TransitGateway.Validate(transitGatewayField, r.Spec.NetworkSpec.Subnets)...)
+	allErrs = append(allErrs, infrav1.ValidateTransitGatewayUpdate(transitGatewayField, oldAWSManagedControlplane.Spec.NetworkSpec.VPC.TransitGateway, r.Spec.NetworkSpec.VPC.TransitGateway)...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.FlowLogs.Validate(field.NewPath("spec", "network", "vpc", "flowLogs"))...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.ValidateVPCEndpoints(field.NewPath("spec", "network", "vpc"), r.Spec.NetworkSpec.Subnets)...)
+
 	if oldAWSManagedControlplane.Spec.NetworkSpec.VPC.IsIPv6Enabled() != r.Spec.NetworkSpec.VPC.IsIPv6Enabled() {
 		allErrs = append(allErrs,
 			field.Invalid(field.NewPath("spec", "network", "vpc", "enableIPv6"), r.Spec.NetworkSpec.VPC.IsIPv6Enabled(), "changing IP family is not allowed after it has been set"))
@@ -274,6 +284,17 @@ func validateEKSAddons(eksVersion *string, networkSpec infrav1.NetworkSpec, addo
 		return allErrs
 	}
 
+	if addons != nil {
+		for i, addon := range *addons {
+			if addon.Version == AddonVersionDefault || addon.Version == AddonVersionAuto || addon.Version == AddonVersionLatest || !IsAddonVersionSelector(addon.Version) {
+				continue
+			}
+			if _, err := semver.ParseRange(addon.Version); err != nil {
+				allErrs = append(allErrs, field.Invalid(path.Child("addons").Index(i).Child("version"), addon.Version, fmt.Sprintf("invalid version range: %v", err)))
+			}
+		}
+	}
+
 	// Version is required for addon validation
 	if eksVersion == nil {
 		return allErrs
@@ -304,7 +325,9 @@ func validateEKSAddons(eksVersion *string, networkSpec infrav1.NetworkSpec, addo
 		}
 
 		for _, addon := range *addons {
-			if addon.Name == vpcCniAddon {
+			// Versions selected for the Kubernetes versions that support IPv6 already
+			// meet the minimum version.
+			if addon.Name == vpcCniAddon && !IsAddonVersionSelector(addon.Version) {
 				v, err := version.ParseGeneric(addon.Version)
 				if err != nil {
 					allErrs = append(allErrs, field.Invalid(addonsPath, addon.Version, err.Error()))
@@ -322,6 +345,47 @@ func validateEKSAddons(eksVersion *string, networkSpec infrav1.NetworkSpec, addo
 	return allErrs
 }
 
+func (r *AWSManagedControlPlane) validatePodIdentityAssociations() field.ErrorList {
+	var allErrs field.ErrorList
+
+	associationsPath := field.NewPath("spec", "podIdentityAssociations")
+	serviceAccounts := map[string]bool{}
+	for i, association := range r.Spec.PodIdentityAssociations {
+		associationPath := associationsPath.Index(i)
+
+		key := association.ServiceAccountNamespace + "/" + association.ServiceAccountName
+		if serviceAccounts[key] {
+			allErrs = append(allErrs, field.Duplicate(associationPath, key))
+		}
+		serviceAccounts[key] = true
+
+		if (association.RoleARN == "") == (association.Role == nil) {
+			allErrs = append(allErrs, field.Invalid(associationPath, association, "exactly one of roleARN or role must be specified"))
+			continue
+		}
+
+		if association.Role != nil && association.Role.TrustPolicy != "" && !json.Valid([]byte(association.Role.TrustPolicy)) {
+			allErrs = append(allErrs, field.Invalid(associationPath.Child("role", "trustPolicy"), association.Role.TrustPolicy, "trustPolicy must be a valid JSON document"))
+		}
+	}
+
+	if r.Spec.Addons == nil {
+		return allErrs
+	}
+
+	for i, addon := range *r.Spec.Addons {
+		addonServiceAccounts := map[string]bool{}
+		for j, association := range addon.PodIdentityAssociations {
+			if addonServiceAccounts[association.ServiceAccount] {
+				allErrs = append(allErrs, field.Duplicate(field.NewPath("spec", "addons").Index(i).Child("podIdentityAssociations").Index(j).Child("serviceAccount"), association.ServiceAccount))
+			}
+			addonServiceAccounts[association.ServiceAccount] = true
+		}
+	}
+
+	return allErrs
+}
+
 func (r *AWSManagedControlPlane) validateAccessConfigUpdate(old *AWSManagedControlPlane) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -645,6 +709,22 @@ func validateNetwork(resourceName string, networkSpec infrav1.NetworkSpec, secon
 		}
 	}
 
+	allErrs = append(allErrs, networkSpec.VPC.TransitGateway.Validate(path.Child("network", "vpc", "transitGateway"), networkSpec.Subnets)...)
+	allErrs = append(allErrs, networkSpec.VPC.FlowLogs.Validate(path.Child("network", "vpc", "flowLogs"))...)
+	allErrs = append(allErrs, networkSpec.VPC.ValidateVPCEndpoints(path.Child("network", "vpc"), networkSpec.Subnets)...)
+	allErrs = append(allErrs, infrav1.ValidateManagedPrefixLists(path.Child("network", "managedPrefixLists"), networkSpec.ManagedPrefixLists)...)
+
+	// The egress rules are only managed on the security groups of self-managed clusters.
+	if len(networkSpec.AdditionalControlPlaneEgressRules) > 0 {
+		allErrs = append(allErrs, field.Forbidden(path.Child("network", "additionalControlPlaneEgressRules"), "egress rules are not supported for EKS clusters"))
+	}
+	if len(networkSpec.AdditionalNodeEgressRules) > 0 {
+		allErrs = append(allErrs, field.Forbidden(path.Child("network", "additionalNodeEgressRules"), "egress rules are not supported for EKS clusters"))
+	}
+	if networkSpec.SecurityGroupEgressMode == infrav1.SecurityGroupEgressModeRestricted {
+		allErrs = append(allErrs, field.Forbidden(path.Child("network", "securityGroupEgressMode"), "restricted egress is not supported for EKS clusters"))
+	}
+
 	return allErrs
 }
 
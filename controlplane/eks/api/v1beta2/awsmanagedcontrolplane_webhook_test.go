@@ -503,6 +503,47 @@ func TestWebhookCreateIPv6Details(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:        "ipv6 with addons and auto cni version",
+			kubeVersion: "v1.22",
+			addons: &[]Addon{
+				{
+					Name:    vpcCniAddon,
+					Version: AddonVersionAuto,
+				},
+			},
+			networkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					IPv6: &infrav1.IPv6{},
+				},
+			},
+		},
+		{
+			name:        "ipv6 with addons and latest cni version",
+			kubeVersion: "v1.22",
+			addons: &[]Addon{
+				{
+					Name:    vpcCniAddon,
+					Version: AddonVersionLatest,
+				},
+			},
+			networkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					IPv6: &infrav1.IPv6{},
+				},
+			},
+		},
+		{
+			name:        "addons with invalid version range",
+			kubeVersion: "v1.22",
+			addons: &[]Addon{
+				{
+					Name:    vpcCniAddon,
+					Version: ">=1.a",
+				},
+			},
+			err: "invalid version range",
+		},
 		{
 			name:        "ipv6 cidr block is set but pool is left empty",
 			kubeVersion: "v1.18",
@@ -1219,3 +1260,137 @@ func TestWebhookValidateAccessEntries(t *testing.T) {
 		})
 	}
 }
+
+func TestWebhookValidatePodIdentityAssociations(t *testing.T) {
+	tests := []struct {
+		name         string
+		associations []PodIdentityAssociation
+		addons       *[]Addon
+		expectError  bool
+		errorSubstr  string
+	}{
+		{
+			name: "valid association with role arn",
+			associations: []PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "default",
+					ServiceAccountName:      "app",
+					RoleARN:                 "arn:aws:iam::123456789012:role/app",
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "valid association with managed role",
+			associations: []PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "default",
+					ServiceAccountName:      "app",
+					Role: &PodIdentityRole{
+						PolicyARNs:  []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+						TrustPolicy: `{"Version":"2012-10-17","Statement":[]}`,
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid association with both role arn and role",
+			associations: []PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "default",
+					ServiceAccountName:      "app",
+					RoleARN:                 "arn:aws:iam::123456789012:role/app",
+					Role:                    &PodIdentityRole{},
+				},
+			},
+			expectError: true,
+			errorSubstr: "exactly one of roleARN or role must be specified",
+		},
+		{
+			name: "invalid association without role",
+			associations: []PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "default",
+					ServiceAccountName:      "app",
+				},
+			},
+			expectError: true,
+			errorSubstr: "exactly one of roleARN or role must be specified",
+		},
+		{
+			name: "invalid association with malformed trust policy",
+			associations: []PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "default",
+					ServiceAccountName:      "app",
+					Role: &PodIdentityRole{
+						TrustPolicy: `{"Version":`,
+					},
+				},
+			},
+			expectError: true,
+			errorSubstr: "trustPolicy must be a valid JSON document",
+		},
+		{
+			name: "invalid duplicate service accounts",
+			associations: []PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "default",
+					ServiceAccountName:      "app",
+					RoleARN:                 "arn:aws:iam::123456789012:role/app",
+				},
+				{
+					ServiceAccountNamespace: "default",
+					ServiceAccountName:      "app",
+					RoleARN:                 "arn:aws:iam::123456789012:role/other",
+				},
+			},
+			expectError: true,
+			errorSubstr: "Duplicate value",
+		},
+		{
+			name: "invalid duplicate addon service accounts",
+			addons: &[]Addon{
+				{
+					Name:    "aws-ebs-csi-driver",
+					Version: "v1.30.0-eksbuild.1",
+					PodIdentityAssociations: []AddonPodIdentityAssociation{
+						{ServiceAccount: "ebs-csi-controller-sa", RoleARN: "arn:aws:iam::123456789012:role/ebs"},
+						{ServiceAccount: "ebs-csi-controller-sa", RoleARN: "arn:aws:iam::123456789012:role/other"},
+					},
+				},
+			},
+			expectError: true,
+			errorSubstr: "Duplicate value",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName:          "default_cluster1",
+					Version:                 ptr.To("v1.30.0"),
+					Addons:                  tc.addons,
+					PodIdentityAssociations: tc.associations,
+				},
+			}
+
+			warn, err := (&awsManagedControlPlaneWebhook{}).ValidateCreate(context.Background(), mcp)
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				if tc.errorSubstr != "" {
+					g.Expect(err.Error()).To(ContainSubstring(tc.errorSubstr))
+				}
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			// Nothing emits warnings yet
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
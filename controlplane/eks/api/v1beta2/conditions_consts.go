@@ -31,6 +31,25 @@ const (
 	EKSControlPlaneReconciliationFailedReason = "EKSControlPlaneReconciliationFailed"
 )
 
+const (
+	// EKSUpgradePreflightChecksPassedCondition condition reports on whether the pre-flight
+	// checks for the next step of a control plane version upgrade have passed.
+	EKSUpgradePreflightChecksPassedCondition clusterv1beta1.ConditionType = "EKSUpgradePreflightChecksPassed"
+	// EKSUpgradePreflightChecksFailedReason used to report a control plane version upgrade
+	// being blocked by failed pre-flight checks.
+	EKSUpgradePreflightChecksFailedReason = "EKSUpgradePreflightChecksFailed"
+)
+
+const (
+	// EKSUpgradeInsightsPassingCondition condition reports on whether the upgrade readiness
+	// insights of the EKS cluster are passing.
+	EKSUpgradeInsightsPassingCondition clusterv1beta1.ConditionType = "EKSUpgradeInsightsPassing"
+	// EKSUpgradeInsightsFailingReason used to report upgrade readiness insights in an ERROR state.
+	EKSUpgradeInsightsFailingReason = "EKSUpgradeInsightsFailing"
+	// EKSUpgradeInsightsRefreshFailedReason used to report failures while fetching the EKS cluster insights.
+	EKSUpgradeInsightsRefreshFailedReason = "EKSUpgradeInsightsRefreshFailed"
+)
+
 const (
 	// IAMControlPlaneRolesReadyCondition condition reports on the successful reconciliation of eks control plane iam roles.
 	IAMControlPlaneRolesReadyCondition clusterv1beta1.ConditionType = "IAMControlPlaneRolesReady"
@@ -52,6 +71,15 @@ const (
 	EKSAddonsConfiguredFailedReason = "EKSAddonsConfiguredFailed"
 )
 
+const (
+	// EKSAddonsConfigurationValidCondition condition reports on whether the configuration of the EKS
+	// addons is valid for the configuration schema of the addon versions.
+	EKSAddonsConfigurationValidCondition clusterv1beta1.ConditionType = "EKSAddonsConfigurationValid"
+	// EKSAddonsConfigurationInvalidReason used to report an addon configuration that doesn't match the
+	// configuration schema of the addon version.
+	EKSAddonsConfigurationInvalidReason = "EKSAddonsConfigurationInvalid"
+)
+
 const (
 	// EKSIdentityProviderConfiguredCondition condition reports on the successful association of identity provider config.
 	EKSIdentityProviderConfiguredCondition clusterv1beta1.ConditionType = "EKSIdentityProviderConfigured"
@@ -183,8 +183,21 @@ type Addon struct {
 	// +kubebuilder:validation:MinLength:=2
 	// +kubebuilder:validation:Required
 	Name string `json:"name"`
-	// Version is the version of the addon to use
+	// Version is the version of the addon to use. It is either an exact version, such
+	// as v1.18.5-eksbuild.1, or a selector that is resolved against the versions of the
+	// addon that are compatible with the Kubernetes version of the cluster: "default"
+	// (or its alias "auto") for the default version, "latest" for the newest version, or
+	// a semver range such as ">=1.18.0 <1.19.0" or "1.18.x" for the newest version in
+	// the range.
 	Version string `json:"version"`
+	// UpdatePolicy defines when an addon whose version is a selector is updated to a
+	// newer version that the selector resolves to. With OnClusterUpgrade, which is the
+	// default, the addon is only updated after a control plane version upgrade or when
+	// the installed version no longer matches the selector. With Always the addon is
+	// updated as soon as a newer version is available.
+	// +kubebuilder:validation:Enum=OnClusterUpgrade;Always
+	// +optional
+	UpdatePolicy AddonUpdatePolicy `json:"updatePolicy,omitempty"`
 	// Configuration of the EKS addon
 	// +optional
 	Configuration string `json:"configuration,omitempty"`
@@ -196,12 +209,30 @@ type Addon struct {
 	// ServiceAccountRoleArn is the ARN of an IAM role to bind to the addons service account
 	// +optional
 	ServiceAccountRoleArn *string `json:"serviceAccountRoleARN,omitempty"`
+	// PodIdentityAssociations are the EKS Pod Identity associations to create for the
+	// addons service accounts, as an alternative to ServiceAccountRoleArn.
+	// +optional
+	PodIdentityAssociations []AddonPodIdentityAssociation `json:"podIdentityAssociations,omitempty"`
 	// PreserveOnDelete indicates that the addon resources should be
 	// preserved in the cluster on delete.
 	// +optional
 	PreserveOnDelete bool `json:"preserveOnDelete,omitempty"`
 }
 
+// AddonPodIdentityAssociation associates an addons service account with an IAM role
+// using EKS Pod Identity.
+type AddonPodIdentityAssociation struct {
+	// ServiceAccount is the name of the addons Kubernetes service account.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccount string `json:"serviceAccount"`
+
+	// RoleARN is the ARN of the IAM role to associate with the service account.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	RoleARN string `json:"roleARN"`
+}
+
 // AddonResolution defines the method for resolving parameter conflicts.
 type AddonResolution string
 
@@ -219,6 +250,43 @@ var (
 	AddonResolutionPreserve = AddonResolution("preserve")
 )
 
+// AddonUpdatePolicy defines when an addon whose version is a selector is updated.
+type AddonUpdatePolicy string
+
+var (
+	// AddonUpdatePolicyOnClusterUpgrade indicates that the addon is updated to a newer
+	// version only after a control plane version upgrade.
+	AddonUpdatePolicyOnClusterUpgrade = AddonUpdatePolicy("OnClusterUpgrade")
+
+	// AddonUpdatePolicyAlways indicates that the addon is updated as soon as a newer
+	// version is available.
+	AddonUpdatePolicyAlways = AddonUpdatePolicy("Always")
+)
+
+const (
+	// AddonVersionDefault is the addon version selector that resolves to the default
+	// version of the addon for the Kubernetes version of the cluster.
+	AddonVersionDefault = "default"
+
+	// AddonVersionLatest is the addon version selector that resolves to the newest
+	// version of the addon for the Kubernetes version of the cluster.
+	AddonVersionLatest = "latest"
+
+	// AddonVersionAuto is an alias of the "default" addon version selector.
+	AddonVersionAuto = "auto"
+)
+
+// IsAddonVersionSelector returns true if an addon version is a selector rather than an
+// exact version, that is "default", "auto", "latest" or a semver range using comparison
+// operators or x wildcards.
+func IsAddonVersionSelector(version string) bool {
+	if version == AddonVersionDefault || version == AddonVersionAuto || version == AddonVersionLatest {
+		return true
+	}
+
+	return strings.ContainsAny(version, "<>=!") || strings.Contains(version, ".x")
+}
+
 // AddonStatus defines the status for an addon.
 type AddonStatus string
 
@@ -251,6 +319,10 @@ type AddonState struct {
 	Name string `json:"name"`
 	// Version is the version of the addon to use
 	Version string `json:"version"`
+	// ResolvedVersion is the version that the version selector of the addon resolved
+	// to, if the addon version in the spec is a selector
+	// +optional
+	ResolvedVersion string `json:"resolvedVersion,omitempty"`
 	// ARN is the AWS ARN of the addon
 	ARN string `json:"arn"`
 	// ServiceAccountRoleArn is the ARN of the IAM role used for the service account
@@ -275,6 +347,38 @@ type AddonIssue struct {
 	ResourceIDs []string `json:"resourceIds,omitempty"`
 }
 
+// InsightsStatus holds the EKS cluster insights that are not passing.
+type InsightsStatus struct {
+	// LastRefreshTime is the last time the insights were fetched from EKS.
+	// +optional
+	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty"`
+	// Failing is the list of insights with an ERROR or WARNING status.
+	// +optional
+	Failing []InsightState `json:"failing,omitempty"`
+}
+
+// InsightState represents the state of an EKS cluster insight.
+type InsightState struct {
+	// ID is the ID of the insight
+	ID string `json:"id"`
+	// Name is the name of the insight
+	Name string `json:"name"`
+	// Category is the category of the insight, such as UPGRADE_READINESS
+	// +optional
+	Category string `json:"category,omitempty"`
+	// KubernetesVersion is the Kubernetes minor version the insight applies to
+	// +optional
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	// Status is the status of the insight, such as ERROR or WARNING
+	Status string `json:"status"`
+	// Reason is the explanation of the status of the insight
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Recommendation is a summary of how to remediate the insight
+	// +optional
+	Recommendation string `json:"recommendation,omitempty"`
+}
+
 // UpgradePolicy defines the support policy to use for the cluster.
 type UpgradePolicy string
 
@@ -293,6 +397,48 @@ func (e UpgradePolicy) String() string {
 	return string(e)
 }
 
+// SkipUpgradeInsightsCheckAnnotation is the name of an annotation that allows a control plane
+// version upgrade to start while upgrade readiness insights of the cluster are in an ERROR state.
+const SkipUpgradeInsightsCheckAnnotation = "controlplane.cluster.x-k8s.io/skip-upgrade-insights-check"
+
+// UpgradePhase is the phase of a control plane version upgrade.
+type UpgradePhase string
+
+var (
+	// UpgradePhasePreflight indicates that the pre-flight checks for the next
+	// upgrade step are being run.
+	UpgradePhasePreflight = UpgradePhase("Preflight")
+
+	// UpgradePhaseControlPlaneUpgrading indicates that the EKS control plane is
+	// being upgraded to the next minor version.
+	UpgradePhaseControlPlaneUpgrading = UpgradePhase("ControlPlaneUpgrading")
+
+	// UpgradePhaseAddonsUpgrading indicates that the EKS control plane has been
+	// upgraded and the addons are being upgraded to compatible versions.
+	UpgradePhaseAddonsUpgrading = UpgradePhase("AddonsUpgrading")
+
+	// UpgradePhaseCompleted indicates that the control plane and addons have been
+	// upgraded to the desired version.
+	UpgradePhaseCompleted = UpgradePhase("Completed")
+)
+
+// UpgradeStatus reports on the progress of a control plane version upgrade.
+type UpgradeStatus struct {
+	// Phase is the current phase of the upgrade.
+	// +kubebuilder:validation:Enum=Preflight;ControlPlaneUpgrading;AddonsUpgrading;Completed
+	Phase UpgradePhase `json:"phase"`
+
+	// Version is the Kubernetes version of the current upgrade step. Upgrades of more
+	// than one minor version are done one minor version at a time.
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// InProgress returns true if the upgrade has not completed yet.
+func (u *UpgradeStatus) InProgress() bool {
+	return u != nil && u.Phase != UpgradePhaseCompleted
+}
+
 const (
 	// SecurityGroupCluster is the security group for communication between EKS
 	// control plane and managed node groups.
@@ -182,6 +182,13 @@ func (in *AWSManagedControlPlaneSpec) DeepCopyInto(out *AWSManagedControlPlaneSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PodIdentityAssociations != nil {
+		in, out := &in.PodIdentityAssociations, &out.PodIdentityAssociations
+		*out = make([]PodIdentityAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.VpcCni.DeepCopyInto(&out.VpcCni)
 	out.KubeProxy = in.KubeProxy
 }
@@ -243,6 +250,16 @@ func (in *AWSManagedControlPlaneStatus) DeepCopyInto(out *AWSManagedControlPlane
 		*out = new(string)
 		**out = **in
 	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(UpgradeStatus)
+		**out = **in
+	}
+	if in.Insights != nil {
+		in, out := &in.Insights, &out.Insights
+		*out = new(InsightsStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSManagedControlPlaneStatus.
@@ -441,6 +458,11 @@ func (in *Addon) DeepCopyInto(out *Addon) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PodIdentityAssociations != nil {
+		in, out := &in.PodIdentityAssociations, &out.PodIdentityAssociations
+		*out = make([]AddonPodIdentityAssociation, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Addon.
@@ -483,6 +505,21 @@ func (in *AddonIssue) DeepCopy() *AddonIssue {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonPodIdentityAssociation) DeepCopyInto(out *AddonPodIdentityAssociation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonPodIdentityAssociation.
+func (in *AddonPodIdentityAssociation) DeepCopy() *AddonPodIdentityAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonPodIdentityAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddonState) DeepCopyInto(out *AddonState) {
 	*out = *in
@@ -643,6 +680,45 @@ func (in *IdentityProviderStatus) DeepCopy() *IdentityProviderStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InsightState) DeepCopyInto(out *InsightState) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsightState.
+func (in *InsightState) DeepCopy() *InsightState {
+	if in == nil {
+		return nil
+	}
+	out := new(InsightState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InsightsStatus) DeepCopyInto(out *InsightsStatus) {
+	*out = *in
+	if in.LastRefreshTime != nil {
+		in, out := &in.LastRefreshTime, &out.LastRefreshTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Failing != nil {
+		in, out := &in.Failing, &out.Failing
+		*out = make([]InsightState, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsightsStatus.
+func (in *InsightsStatus) DeepCopy() *InsightsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InsightsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeProxy) DeepCopyInto(out *KubeProxy) {
 	*out = *in
@@ -742,6 +818,46 @@ func (in *OIDCProviderStatus) DeepCopy() *OIDCProviderStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityAssociation) DeepCopyInto(out *PodIdentityAssociation) {
+	*out = *in
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(PodIdentityRole)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityAssociation.
+func (in *PodIdentityAssociation) DeepCopy() *PodIdentityAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityRole) DeepCopyInto(out *PodIdentityRole) {
+	*out = *in
+	if in.PolicyARNs != nil {
+		in, out := &in.PolicyARNs, &out.PolicyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityRole.
+func (in *PodIdentityRole) DeepCopy() *PodIdentityRole {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleMapping) DeepCopyInto(out *RoleMapping) {
 	*out = *in
@@ -758,6 +874,21 @@ func (in *RoleMapping) DeepCopy() *RoleMapping {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStatus) DeepCopyInto(out *UpgradeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStatus.
+func (in *UpgradeStatus) DeepCopy() *UpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserMapping) DeepCopyInto(out *UserMapping) {
 	*out = *in
@@ -65,6 +65,10 @@ const (
 	// has dependencies during deletion.
 	deleteRequeueAfter = 20 * time.Second
 
+	// bastionAutoScalingRequeueAfter is how often the bastion host is reconciled when it runs as an
+	// Auto Scaling Group, whose instance replacements don't trigger a reconcile.
+	bastionAutoScalingRequeueAfter = time.Minute
+
 	awsManagedControlPlaneKind = "AWSManagedControlPlane"
 )
 
@@ -382,6 +386,17 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 		})
 	}
 
+	if r.ExternalResourceGC {
+		gcSvc := gc.NewService(managedScope, gc.WithGCStrategy(r.AlternativeGCStrategy))
+		if err := gcSvc.ReconcileDryRun(ctx); err != nil {
+			// non fatal error, so we continue
+			managedScope.Error(err, "non-fatal: failed to preview garbage collection")
+		}
+	}
+
+	if awsManagedControlPlane.Spec.Bastion.Enabled && awsManagedControlPlane.Spec.Bastion.AutoScaling != nil {
+		return reconcile.Result{RequeueAfter: bastionAutoScalingRequeueAfter}, nil
+	}
 	return reconcile.Result{}, nil
 }
 
@@ -694,6 +694,30 @@ func mockedCallsForMissingEverything(ec2Rec *mocks.MockEC2APIMockRecorder, subne
 			SubnetId:     aws.String(subnetID),
 		})).Return(&ec2.AssociateRouteTableOutput{}, nil)
 	}
+	ec2Rec.DescribeVpcEndpoints(context.TODO(), gomock.Eq(&ec2.DescribeVpcEndpointsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{"vpc-new"},
+			},
+		},
+	}), gomock.Any()).Return(&ec2.DescribeVpcEndpointsOutput{}, nil).AnyTimes()
+	ec2Rec.DescribeSecurityGroups(context.TODO(), gomock.Eq(&ec2.DescribeSecurityGroupsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{"vpc-new"},
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: []string{"owned"},
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/role"),
+				Values: []string{"vpc-endpoint"},
+			},
+		},
+	}), gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{}, nil).AnyTimes()
 }
 
 func mockedCreateSGCalls(ec2Rec *mocks.MockEC2APIMockRecorder) {
@@ -974,6 +998,10 @@ func mockedEKSCluster(ctx context.Context, g *WithT, eksRec *mock_eksiface.MockE
 		},
 	}).Return(&eks.TagResourceOutput{}, nil)
 
+	eksRec.ListPodIdentityAssociations(ctx, &eks.ListPodIdentityAssociationsInput{
+		ClusterName: aws.String("test-cluster"),
+	}).Return(&eks.ListPodIdentityAssociationsOutput{}, nil)
+
 	eksRec.ListAddons(ctx, &eks.ListAddonsInput{
 		ClusterName: aws.String("test-cluster"),
 	}).Return(&eks.ListAddonsOutput{}, nil)
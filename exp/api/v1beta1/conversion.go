@@ -76,6 +76,16 @@ func (src *AWSMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.DefaultInstanceWarmup = restored.Spec.DefaultInstanceWarmup
 	dst.Spec.AWSLaunchTemplate.NonRootVolumes = restored.Spec.AWSLaunchTemplate.NonRootVolumes
+
+	if restored.Spec.WarmPool != nil {
+		dst.Spec.WarmPool = restored.Spec.WarmPool
+	}
+	dst.Status.WarmPool = restored.Status.WarmPool
+	restoreMixedInstancesPolicy(dst.Spec.MixedInstancesPolicy, restored.Spec.MixedInstancesPolicy)
+	dst.Spec.ScalingPolicies = restored.Spec.ScalingPolicies
+	dst.Spec.ScheduledActions = restored.Spec.ScheduledActions
+	dst.Status.ScalingPolicies = restored.Status.ScalingPolicies
+	dst.Status.ScheduledActions = restored.Status.ScheduledActions
 	return nil
 }
 
@@ -255,3 +265,31 @@ func Convert_v1beta2_RefreshPreferences_To_v1beta1_RefreshPreferences(in *expinf
 func Convert_v1beta2_FargateProfileSpec_To_v1beta1_FargateProfileSpec(in *expinfrav1.FargateProfileSpec, out *FargateProfileSpec, s apiconversion.Scope) error {
 	return autoConvert_v1beta2_FargateProfileSpec_To_v1beta1_FargateProfileSpec(in, out, s)
 }
+
+// Convert_v1beta2_MixedInstancesPolicy_To_v1beta1_MixedInstancesPolicy converts the v1beta2 MixedInstancesPolicy receiver to a v1beta1 MixedInstancesPolicy.
+func Convert_v1beta2_MixedInstancesPolicy_To_v1beta1_MixedInstancesPolicy(in *expinfrav1.MixedInstancesPolicy, out *MixedInstancesPolicy, s apiconversion.Scope) error {
+	// spec.mixedInstancesPolicy.instanceRequirements has been added to v1beta2.
+	return autoConvert_v1beta2_MixedInstancesPolicy_To_v1beta1_MixedInstancesPolicy(in, out, s)
+}
+
+// Convert_v1beta2_Overrides_To_v1beta1_Overrides converts the v1beta2 Overrides receiver to a v1beta1 Overrides.
+func Convert_v1beta2_Overrides_To_v1beta1_Overrides(in *expinfrav1.Overrides, out *Overrides, s apiconversion.Scope) error {
+	// spec.mixedInstancesPolicy.overrides.instanceRequirements has been added to v1beta2.
+	return autoConvert_v1beta2_Overrides_To_v1beta1_Overrides(in, out, s)
+}
+
+// restoreMixedInstancesPolicy restores the instance requirements of a v1beta2 MixedInstancesPolicy,
+// which don't exist in v1beta1.
+func restoreMixedInstancesPolicy(dst, restored *expinfrav1.MixedInstancesPolicy) {
+	if dst == nil || restored == nil {
+		return
+	}
+
+	dst.InstanceRequirements = restored.InstanceRequirements
+	if len(dst.Overrides) != len(restored.Overrides) {
+		return
+	}
+	for i := range dst.Overrides {
+		dst.Overrides[i].InstanceRequirements = restored.Overrides[i].InstanceRequirements
+	}
+}
@@ -20,12 +20,78 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
 	"k8s.io/apimachinery/pkg/runtime"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/randfill"
 
 	"sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 )
 
+func fuzzFuncs(_ runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		AWSMachinePoolHubFuzzer,
+	}
+}
+
+func AWSMachinePoolHubFuzzer(obj *v1beta2.AWSMachinePool, c randfill.Continue) {
+	c.FillNoCustom(obj)
+
+	// Fields that only exist in v1beta2 are restored from the conversion annotation, which
+	// doesn't preserve empty lists and zero times, so normalize them to avoid v1beta2 --> v1beta1 --> v1beta2 round trip errors.
+	if len(obj.Spec.ScalingPolicies) == 0 {
+		obj.Spec.ScalingPolicies = nil
+	}
+	if len(obj.Spec.ScheduledActions) == 0 {
+		obj.Spec.ScheduledActions = nil
+	}
+	for i := range obj.Spec.ScheduledActions {
+		if obj.Spec.ScheduledActions[i].StartTime != nil && obj.Spec.ScheduledActions[i].StartTime.IsZero() {
+			obj.Spec.ScheduledActions[i].StartTime = nil
+		}
+		if obj.Spec.ScheduledActions[i].EndTime != nil && obj.Spec.ScheduledActions[i].EndTime.IsZero() {
+			obj.Spec.ScheduledActions[i].EndTime = nil
+		}
+	}
+	if len(obj.Status.ScalingPolicies) == 0 {
+		obj.Status.ScalingPolicies = nil
+	}
+	if len(obj.Status.ScheduledActions) == 0 {
+		obj.Status.ScheduledActions = nil
+	}
+	if obj.Status.WarmPool != nil && len(obj.Status.WarmPool.Instances) == 0 {
+		obj.Status.WarmPool.Instances = nil
+	}
+	if policy := obj.Spec.MixedInstancesPolicy; policy != nil {
+		normalizeInstanceRequirements(policy.InstanceRequirements)
+		for i := range policy.Overrides {
+			normalizeInstanceRequirements(policy.Overrides[i].InstanceRequirements)
+		}
+	}
+}
+
+func normalizeInstanceRequirements(requirements *v1beta2.InstanceRequirements) {
+	if requirements == nil {
+		return
+	}
+	if len(requirements.CPUManufacturers) == 0 {
+		requirements.CPUManufacturers = nil
+	}
+	if len(requirements.InstanceGenerations) == 0 {
+		requirements.InstanceGenerations = nil
+	}
+	if len(requirements.AcceleratorTypes) == 0 {
+		requirements.AcceleratorTypes = nil
+	}
+	if len(requirements.AllowedInstanceTypes) == 0 {
+		requirements.AllowedInstanceTypes = nil
+	}
+	if len(requirements.ExcludedInstanceTypes) == 0 {
+		requirements.ExcludedInstanceTypes = nil
+	}
+}
+
 func TestFuzzyConversion(t *testing.T) {
 	g := NewWithT(t)
 	scheme := runtime.NewScheme()
@@ -33,9 +99,10 @@ func TestFuzzyConversion(t *testing.T) {
 	g.Expect(v1beta2.AddToScheme(scheme)).To(Succeed())
 
 	t.Run("for AWSMachinePool", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
-		Scheme: scheme,
-		Hub:    &v1beta2.AWSMachinePool{},
-		Spoke:  &AWSMachinePool{},
+		Scheme:      scheme,
+		Hub:         &v1beta2.AWSMachinePool{},
+		Spoke:       &AWSMachinePool{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
 	}))
 
 	t.Run("for AWSManagedMachinePool", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
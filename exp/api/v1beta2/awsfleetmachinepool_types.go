@@ -0,0 +1,247 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+)
+
+// AWSFleetMachinePoolSpec defines the desired state of AWSFleetMachinePool.
+type AWSFleetMachinePoolSpec struct {
+	// ProviderID is the ID of the associated EC2 Fleet.
+	// +optional
+	ProviderID string `json:"providerID,omitempty"`
+
+	// AvailabilityZones is an array of availability zones instances can run in
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// AvailabilityZoneSubnetType specifies which type of subnets to use when an availability zone is specified.
+	// +kubebuilder:validation:Enum:=public;private;all
+	// +optional
+	AvailabilityZoneSubnetType *AZSubnetType `json:"availabilityZoneSubnetType,omitempty"`
+
+	// Subnets is an array of subnet configurations
+	// +optional
+	Subnets []infrav1.AWSResourceReference `json:"subnets,omitempty"`
+
+	// AdditionalTags is an optional set of tags to add to an instance, in addition to the ones added by default by the
+	// AWS provider.
+	// +optional
+	AdditionalTags infrav1.Tags `json:"additionalTags,omitempty"`
+
+	// AWSLaunchTemplate specifies the launch template to use when an instance is launched.
+	// +kubebuilder:validation:Required
+	AWSLaunchTemplate AWSLaunchTemplate `json:"awsLaunchTemplate"`
+
+	// Overrides describes the instance types, or the instance attributes, the fleet can launch.
+	// When empty, the instance type of the launch template is used.
+	// +optional
+	Overrides []Overrides `json:"overrides,omitempty"`
+
+	// CapacityDistribution describes how the target capacity of the fleet is split between
+	// On-Demand and Spot instances.
+	// +optional
+	CapacityDistribution *FleetCapacityDistribution `json:"capacityDistribution,omitempty"`
+
+	// ReplaceUnhealthyInstances indicates whether the fleet replaces instances that fail EC2 health checks.
+	// +optional
+	ReplaceUnhealthyInstances bool `json:"replaceUnhealthyInstances,omitempty"`
+
+	// ProviderIDList are the identification IDs of machine instances provided by the provider.
+	// This field must match the provider IDs as seen on the node objects corresponding to a machine pool's machine instances.
+	// +optional
+	ProviderIDList []string `json:"providerIDList,omitempty"`
+
+	// Ignition defined options related to the bootstrapping systems where Ignition is used.
+	// +optional
+	Ignition *infrav1.Ignition `json:"ignition,omitempty"`
+}
+
+// FleetCapacityType is the purchasing option of the capacity of an EC2 Fleet.
+type FleetCapacityType string
+
+const (
+	// FleetCapacityTypeOnDemand launches On-Demand instances.
+	FleetCapacityTypeOnDemand = FleetCapacityType("on-demand")
+
+	// FleetCapacityTypeSpot launches Spot instances.
+	FleetCapacityTypeSpot = FleetCapacityType("spot")
+)
+
+// FleetOnDemandAllocationStrategy is the strategy used to fulfill the On-Demand capacity of an EC2 Fleet.
+type FleetOnDemandAllocationStrategy string
+
+const (
+	// FleetOnDemandAllocationStrategyLowestPrice launches the lowest priced instance types first.
+	FleetOnDemandAllocationStrategyLowestPrice = FleetOnDemandAllocationStrategy("lowest-price")
+
+	// FleetOnDemandAllocationStrategyPrioritized launches instance types in the order of the overrides.
+	FleetOnDemandAllocationStrategyPrioritized = FleetOnDemandAllocationStrategy("prioritized")
+)
+
+// FleetSpotAllocationStrategy is the strategy used to fulfill the Spot capacity of an EC2 Fleet.
+type FleetSpotAllocationStrategy string
+
+const (
+	// FleetSpotAllocationStrategyPriceCapacityOptimized launches instances from the pools with the highest
+	// capacity availability, and then the lowest price among those.
+	FleetSpotAllocationStrategyPriceCapacityOptimized = FleetSpotAllocationStrategy("price-capacity-optimized")
+
+	// FleetSpotAllocationStrategyCapacityOptimized launches instances from the pools with the highest capacity availability.
+	FleetSpotAllocationStrategyCapacityOptimized = FleetSpotAllocationStrategy("capacity-optimized")
+
+	// FleetSpotAllocationStrategyCapacityOptimizedPrioritized launches instances from the pools with the highest
+	// capacity availability, honoring the order of the overrides on a best-effort basis.
+	FleetSpotAllocationStrategyCapacityOptimizedPrioritized = FleetSpotAllocationStrategy("capacity-optimized-prioritized")
+
+	// FleetSpotAllocationStrategyLowestPrice launches instances from the lowest priced pools.
+	FleetSpotAllocationStrategyLowestPrice = FleetSpotAllocationStrategy("lowest-price")
+
+	// FleetSpotAllocationStrategyDiversified distributes instances across all pools.
+	FleetSpotAllocationStrategyDiversified = FleetSpotAllocationStrategy("diversified")
+)
+
+// FleetCapacityDistribution describes how the target capacity of an EC2 Fleet is split between
+// On-Demand and Spot instances. The target capacity is the number of replicas of the MachinePool.
+type FleetCapacityDistribution struct {
+	// OnDemandBaseCapacity is the minimum amount of the target capacity that is fulfilled by On-Demand instances.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	OnDemandBaseCapacity *int32 `json:"onDemandBaseCapacity,omitempty"`
+
+	// DefaultTargetCapacityType is the purchasing option of the capacity above OnDemandBaseCapacity.
+	// +kubebuilder:validation:Enum=on-demand;spot
+	// +kubebuilder:default=spot
+	// +optional
+	DefaultTargetCapacityType FleetCapacityType `json:"defaultTargetCapacityType,omitempty"`
+
+	// OnDemandAllocationStrategy is the strategy used to fulfill the On-Demand capacity.
+	// +kubebuilder:validation:Enum=lowest-price;prioritized
+	// +kubebuilder:default=lowest-price
+	// +optional
+	OnDemandAllocationStrategy FleetOnDemandAllocationStrategy `json:"onDemandAllocationStrategy,omitempty"`
+
+	// SpotAllocationStrategy is the strategy used to fulfill the Spot capacity.
+	// +kubebuilder:validation:Enum=price-capacity-optimized;capacity-optimized;capacity-optimized-prioritized;lowest-price;diversified
+	// +kubebuilder:default=price-capacity-optimized
+	// +optional
+	SpotAllocationStrategy FleetSpotAllocationStrategy `json:"spotAllocationStrategy,omitempty"`
+}
+
+// AWSFleetMachinePoolStatus defines the observed state of AWSFleetMachinePool.
+type AWSFleetMachinePoolStatus struct {
+	// Ready is true when the provider resource is ready.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// Replicas is the most recently observed number of replicas
+	// +optional
+	Replicas int32 `json:"replicas"`
+
+	// Conditions defines current service state of the AWSFleetMachinePool.
+	// +optional
+	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
+
+	// Instances contains the status for each instance in the pool
+	// +optional
+	Instances []AWSMachinePoolInstanceStatus `json:"instances,omitempty"`
+
+	// FleetID is the ID of the EC2 Fleet.
+	// +optional
+	FleetID string `json:"fleetID,omitempty"`
+
+	// FleetState is the state of the EC2 Fleet as reported by the EC2 API.
+	// +optional
+	FleetState string `json:"fleetState,omitempty"`
+
+	// The ID of the launch template
+	// +optional
+	LaunchTemplateID string `json:"launchTemplateID,omitempty"`
+
+	// The version of the launch template
+	// +optional
+	LaunchTemplateVersion *string `json:"launchTemplateVersion,omitempty"`
+
+	// FailureReason will be set in the event that there is a terminal problem
+	// reconciling the Machine and will contain a succinct value suitable
+	// for machine interpretation.
+	// +optional
+	FailureReason *string `json:"failureReason,omitempty"`
+
+	// FailureMessage will be set in the event that there is a terminal problem
+	// reconciling the Machine and will contain a more verbose string suitable
+	// for logging and human consumption.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:path=awsfleetmachinepools,scope=Namespaced,categories=cluster-api,shortName=awsfmp
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Machine ready status"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",description="Number of instances in the fleet"
+// +kubebuilder:printcolumn:name="Fleet ID",type="string",JSONPath=".status.fleetID",description="EC2 Fleet ID"
+// +kubebuilder:printcolumn:name="LaunchTemplate ID",type="string",JSONPath=".status.launchTemplateID",description="Launch Template ID"
+
+// AWSFleetMachinePool is the Schema for the awsfleetmachinepools API.
+// It provisions the instances of a MachinePool with an EC2 Fleet of type "maintain".
+type AWSFleetMachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSFleetMachinePoolSpec   `json:"spec,omitempty"`
+	Status AWSFleetMachinePoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AWSFleetMachinePoolList contains a list of AWSFleetMachinePool.
+type AWSFleetMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSFleetMachinePool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AWSFleetMachinePool{}, &AWSFleetMachinePoolList{})
+}
+
+// GetConditions returns the observations of the operational state of the AWSFleetMachinePool resource.
+func (r *AWSFleetMachinePool) GetConditions() clusterv1beta1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the AWSFleetMachinePool to the predescribed clusterv1beta1.Conditions.
+func (r *AWSFleetMachinePool) SetConditions(conditions clusterv1beta1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// GetObjectKind will return the ObjectKind of an AWSFleetMachinePool.
+func (r *AWSFleetMachinePool) GetObjectKind() schema.ObjectKind {
+	return &r.TypeMeta
+}
+
+// GetObjectKind will return the ObjectKind of an AWSFleetMachinePoolList.
+func (r *AWSFleetMachinePoolList) GetObjectKind() schema.ObjectKind {
+	return &r.TypeMeta
+}
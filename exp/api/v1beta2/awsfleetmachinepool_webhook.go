@@ -0,0 +1,260 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
+)
+
+// SetupWebhookWithManager will setup the webhooks for the AWSFleetMachinePool.
+func (r *AWSFleetMachinePool) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	w := new(awsFleetMachinePoolWebhook)
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithValidator(w).
+		WithDefaulter(w).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-awsfleetmachinepool,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=awsfleetmachinepools,versions=v1beta2,name=validation.awsfleetmachinepool.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta2-awsfleetmachinepool,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=awsfleetmachinepools,versions=v1beta2,name=default.awsfleetmachinepool.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+type awsFleetMachinePoolWebhook struct{}
+
+var _ webhook.CustomDefaulter = &awsFleetMachinePoolWebhook{}
+var _ webhook.CustomValidator = &awsFleetMachinePoolWebhook{}
+
+func (r *AWSFleetMachinePool) validateSubnets() field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, subnet := range r.Spec.Subnets {
+		if subnet.ID != nil && subnet.Filters != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.subnets.filters"), "providing either subnet ID or filter is supported, should not provide both"))
+			break
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSFleetMachinePool) validateLaunchTemplate() field.ErrorList {
+	var allErrs field.ErrorList
+
+	launchTemplatePath := field.NewPath("spec", "awsLaunchTemplate")
+
+	// The purchasing option of the instances is owned by the fleet.
+	if r.Spec.AWSLaunchTemplate.SpotMarketOptions != nil {
+		allErrs = append(allErrs, field.Forbidden(launchTemplatePath.Child("spotMarketOptions"), "can't be used with an EC2 Fleet, use spec.capacityDistribution instead"))
+	}
+	if r.Spec.AWSLaunchTemplate.MarketType != "" {
+		allErrs = append(allErrs, field.Forbidden(launchTemplatePath.Child("marketType"), "can't be used with an EC2 Fleet, use spec.capacityDistribution instead"))
+	}
+
+	for _, sg := range r.Spec.AWSLaunchTemplate.AdditionalSecurityGroups {
+		if sg.ID != nil && sg.Filters != nil {
+			allErrs = append(allErrs, field.Forbidden(launchTemplatePath.Child("additionalSecurityGroups"), "either ID or filters should be used"))
+		}
+	}
+
+	if rootVolume := r.Spec.AWSLaunchTemplate.RootVolume; rootVolume != nil {
+		if infrav1.VolumeTypesProvisioned.Has(string(rootVolume.Type)) && rootVolume.IOPS == 0 {
+			allErrs = append(allErrs, field.Required(launchTemplatePath.Child("rootVolume", "iops"), "iops required if type is 'io1' or 'io2'"))
+		}
+		if rootVolume.Throughput != nil && rootVolume.Type != infrav1.VolumeTypeGP3 {
+			allErrs = append(allErrs, field.Required(launchTemplatePath.Child("rootVolume", "throughput"), "throughput is valid only for type 'gp3'"))
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSFleetMachinePool) validateOverrides() field.ErrorList {
+	var allErrs field.ErrorList
+
+	overridesPath := field.NewPath("spec", "overrides")
+	usesInstanceTypes, usesInstanceRequirements := false, false
+
+	for i, override := range r.Spec.Overrides {
+		overridePath := overridesPath.Index(i)
+		if (override.InstanceType == "") == (override.InstanceRequirements == nil) {
+			allErrs = append(allErrs, field.Invalid(overridePath, override.InstanceType, "exactly one of instanceType and instanceRequirements must be set"))
+			continue
+		}
+
+		if override.InstanceRequirements != nil {
+			usesInstanceRequirements = true
+			allErrs = append(allErrs, validateInstanceRequirements(overridePath.Child("instanceRequirements"), override.InstanceRequirements)...)
+		} else {
+			usesInstanceTypes = true
+		}
+	}
+
+	if usesInstanceTypes && usesInstanceRequirements {
+		allErrs = append(allErrs, field.Forbidden(overridesPath, "overrides with instanceType and overrides with instanceRequirements can't be mixed"))
+	}
+
+	if usesInstanceRequirements && r.Spec.CapacityDistribution != nil {
+		distributionPath := field.NewPath("spec", "capacityDistribution")
+		if r.Spec.CapacityDistribution.OnDemandAllocationStrategy == FleetOnDemandAllocationStrategyPrioritized {
+			allErrs = append(allErrs, field.Forbidden(distributionPath.Child("onDemandAllocationStrategy"), "prioritized is not supported with instance requirements, use lowest-price"))
+		}
+		if r.Spec.CapacityDistribution.SpotAllocationStrategy == FleetSpotAllocationStrategyCapacityOptimizedPrioritized {
+			allErrs = append(allErrs, field.Forbidden(distributionPath.Child("spotAllocationStrategy"), "capacity-optimized-prioritized is not supported with instance requirements"))
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSFleetMachinePool) validateIgnition() field.ErrorList {
+	var allErrs field.ErrorList
+
+	// Feature gate is not enabled but ignition is enabled then send a forbidden error.
+	if !feature.Gates.Enabled(feature.BootstrapFormatIgnition) && r.Spec.Ignition != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "ignition"),
+			"can be set only if the BootstrapFormatIgnition feature gate is enabled"))
+	}
+
+	return allErrs
+}
+
+func (r *AWSFleetMachinePool) validate() error {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, r.validateSubnets()...)
+	allErrs = append(allErrs, r.validateLaunchTemplate()...)
+	allErrs = append(allErrs, r.validateOverrides()...)
+	allErrs = append(allErrs, r.validateIgnition()...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(
+		r.GroupVersionKind().GroupKind(),
+		r.Name,
+		allErrs,
+	)
+}
+
+// ValidateCreate will do any extra validation when creating an AWSFleetMachinePool.
+func (*awsFleetMachinePoolWebhook) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	r, ok := obj.(*AWSFleetMachinePool)
+	if !ok {
+		return nil, fmt.Errorf("expected an AWSFleetMachinePool object but got %T", r)
+	}
+
+	return nil, r.validate()
+}
+
+// ValidateUpdate will do any extra validation when updating an AWSFleetMachinePool.
+func (*awsFleetMachinePoolWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	r, ok := newObj.(*AWSFleetMachinePool)
+	if !ok {
+		return nil, fmt.Errorf("expected an AWSFleetMachinePool object but got %T", r)
+	}
+	old, ok := oldObj.(*AWSFleetMachinePool)
+	if !ok {
+		return nil, fmt.Errorf("expected an AWSFleetMachinePool object but got %T", old)
+	}
+
+	if allErrs := r.validateImmutable(old); len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
+	}
+
+	return nil, r.validate()
+}
+
+// validateImmutable checks the fields that can't be changed once the EC2 Fleet has been created.
+func (r *AWSFleetMachinePool) validateImmutable(old *AWSFleetMachinePool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.ReplaceUnhealthyInstances != old.Spec.ReplaceUnhealthyInstances {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "replaceUnhealthyInstances"), "field is immutable"))
+	}
+
+	oldDistribution := capacityDistributionWithDefaults(old.Spec.CapacityDistribution)
+	newDistribution := capacityDistributionWithDefaults(r.Spec.CapacityDistribution)
+
+	distributionPath := field.NewPath("spec", "capacityDistribution")
+	if newDistribution.DefaultTargetCapacityType != oldDistribution.DefaultTargetCapacityType {
+		allErrs = append(allErrs, field.Forbidden(distributionPath.Child("defaultTargetCapacityType"), "field is immutable"))
+	}
+	if newDistribution.OnDemandAllocationStrategy != oldDistribution.OnDemandAllocationStrategy {
+		allErrs = append(allErrs, field.Forbidden(distributionPath.Child("onDemandAllocationStrategy"), "field is immutable"))
+	}
+	if newDistribution.SpotAllocationStrategy != oldDistribution.SpotAllocationStrategy {
+		allErrs = append(allErrs, field.Forbidden(distributionPath.Child("spotAllocationStrategy"), "field is immutable"))
+	}
+
+	return allErrs
+}
+
+// capacityDistributionWithDefaults returns the capacity distribution with the API defaults applied,
+// so that omitting a field and setting it to its default value compare equal.
+func capacityDistributionWithDefaults(d *FleetCapacityDistribution) FleetCapacityDistribution {
+	distribution := FleetCapacityDistribution{}
+	if d != nil {
+		distribution = *d
+	}
+	if distribution.DefaultTargetCapacityType == "" {
+		distribution.DefaultTargetCapacityType = FleetCapacityTypeSpot
+	}
+	if distribution.OnDemandAllocationStrategy == "" {
+		distribution.OnDemandAllocationStrategy = FleetOnDemandAllocationStrategyLowestPrice
+	}
+	if distribution.SpotAllocationStrategy == "" {
+		distribution.SpotAllocationStrategy = FleetSpotAllocationStrategyPriceCapacityOptimized
+	}
+	return distribution
+}
+
+// ValidateDelete allows you to add any extra validation when deleting.
+func (*awsFleetMachinePoolWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// Default will set default values for the AWSFleetMachinePool.
+func (*awsFleetMachinePoolWebhook) Default(_ context.Context, obj runtime.Object) error {
+	r, ok := obj.(*AWSFleetMachinePool)
+	if !ok {
+		return fmt.Errorf("expected an AWSFleetMachinePool object but got %T", r)
+	}
+
+	if r.Spec.Ignition != nil && r.Spec.Ignition.StorageType == "" {
+		r.Spec.Ignition.StorageType = infrav1.DefaultMachinePoolIgnitionStorageType
+	}
+	// Defaults the version field if StorageType is not set to `UnencryptedUserData`.
+	// When using `UnencryptedUserData` the version field is ignored because the userdata defines its version itself.
+	if r.Spec.Ignition != nil && r.Spec.Ignition.Version == "" && r.Spec.Ignition.StorageType != infrav1.IgnitionStorageTypeOptionUnencryptedUserData {
+		r.Spec.Ignition.Version = infrav1.DefaultIgnitionVersion
+	}
+
+	return nil
+}
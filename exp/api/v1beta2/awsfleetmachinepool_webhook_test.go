@@ -0,0 +1,196 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+func TestAWSFleetMachinePoolValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name             string
+		pool             *AWSFleetMachinePool
+		wantErrToContain *string
+	}{
+		{
+			name: "fleet with instance type overrides and a capacity distribution is accepted",
+			pool: &AWSFleetMachinePool{
+				Spec: AWSFleetMachinePoolSpec{
+					Overrides: []Overrides{{InstanceType: "m6i.large"}, {InstanceType: "m5.large"}},
+					CapacityDistribution: &FleetCapacityDistribution{
+						OnDemandBaseCapacity:       ptr.To[int32](1),
+						DefaultTargetCapacityType:  FleetCapacityTypeSpot,
+						OnDemandAllocationStrategy: FleetOnDemandAllocationStrategyPrioritized,
+						SpotAllocationStrategy:     FleetSpotAllocationStrategyCapacityOptimized,
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "fleet with instance requirements overrides is accepted",
+			pool: &AWSFleetMachinePool{
+				Spec: AWSFleetMachinePoolSpec{
+					Overrides: []Overrides{{InstanceRequirements: &InstanceRequirements{VCPUCount: IntegerRange{Min: 2}, MemoryMiB: IntegerRange{Min: 4096}}}},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if subnets are provided with both ID and Filters",
+			pool: &AWSFleetMachinePool{
+				Spec: AWSFleetMachinePoolSpec{
+					Subnets: []infrav1.AWSResourceReference{
+						{
+							ID:      aws.String("subnet-1"),
+							Filters: []infrav1.Filter{{Name: "tag:Name", Values: []string{"private"}}},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.subnets.filters"),
+		},
+		{
+			name: "Should fail if the launch template sets spot market options",
+			pool: &AWSFleetMachinePool{
+				Spec: AWSFleetMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						SpotMarketOptions: &infrav1.SpotMarketOptions{},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.awsLaunchTemplate.spotMarketOptions"),
+		},
+		{
+			name: "Should fail if an override sets neither instance type nor instance requirements",
+			pool: &AWSFleetMachinePool{
+				Spec: AWSFleetMachinePoolSpec{
+					Overrides: []Overrides{{}},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.overrides[0]"),
+		},
+		{
+			name: "Should fail if overrides mix instance types and instance requirements",
+			pool: &AWSFleetMachinePool{
+				Spec: AWSFleetMachinePoolSpec{
+					Overrides: []Overrides{
+						{InstanceType: "m6i.large"},
+						{InstanceRequirements: &InstanceRequirements{VCPUCount: IntegerRange{Min: 2}, MemoryMiB: IntegerRange{Min: 4096}}},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("can't be mixed"),
+		},
+		{
+			name: "Should fail if instance requirements are used with the capacity-optimized-prioritized allocation strategy",
+			pool: &AWSFleetMachinePool{
+				Spec: AWSFleetMachinePoolSpec{
+					Overrides: []Overrides{{InstanceRequirements: &InstanceRequirements{VCPUCount: IntegerRange{Min: 2}, MemoryMiB: IntegerRange{Min: 4096}}}},
+					CapacityDistribution: &FleetCapacityDistribution{
+						SpotAllocationStrategy: FleetSpotAllocationStrategyCapacityOptimizedPrioritized,
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.capacityDistribution.spotAllocationStrategy"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warn, err := (&awsFleetMachinePoolWebhook{}).ValidateCreate(context.Background(), tt.pool)
+			if tt.wantErrToContain != nil {
+				g.Expect(err).ToNot(BeNil())
+				if err != nil {
+					g.Expect(err.Error()).To(ContainSubstring(*tt.wantErrToContain))
+				}
+			} else {
+				g.Expect(err).To(Succeed())
+			}
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
+
+func TestAWSFleetMachinePoolValidateUpdate(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name    string
+		new     *AWSFleetMachinePool
+		old     *AWSFleetMachinePool
+		wantErr bool
+	}{
+		{
+			name: "changing the on-demand base capacity is accepted",
+			old: &AWSFleetMachinePool{
+				Spec: AWSFleetMachinePoolSpec{
+					CapacityDistribution: &FleetCapacityDistribution{OnDemandBaseCapacity: ptr.To[int32](1)},
+				},
+			},
+			new: &AWSFleetMachinePool{
+				Spec: AWSFleetMachinePoolSpec{
+					CapacityDistribution: &FleetCapacityDistribution{OnDemandBaseCapacity: ptr.To[int32](2)},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "changing the spot allocation strategy is rejected",
+			old: &AWSFleetMachinePool{
+				Spec: AWSFleetMachinePoolSpec{
+					CapacityDistribution: &FleetCapacityDistribution{SpotAllocationStrategy: FleetSpotAllocationStrategyPriceCapacityOptimized},
+				},
+			},
+			new: &AWSFleetMachinePool{
+				Spec: AWSFleetMachinePoolSpec{
+					CapacityDistribution: &FleetCapacityDistribution{SpotAllocationStrategy: FleetSpotAllocationStrategyLowestPrice},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "changing replaceUnhealthyInstances is rejected",
+			old:  &AWSFleetMachinePool{},
+			new: &AWSFleetMachinePool{
+				Spec: AWSFleetMachinePoolSpec{
+					ReplaceUnhealthyInstances: true,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warn, err := (&awsFleetMachinePoolWebhook{}).ValidateUpdate(context.Background(), tt.old, tt.new)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).To(Succeed())
+			}
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
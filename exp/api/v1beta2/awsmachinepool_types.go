@@ -108,6 +108,28 @@ type AWSMachinePoolSpec struct {
 	// AWSLifecycleHooks specifies lifecycle hooks for the autoscaling group.
 	// +optional
 	AWSLifecycleHooks []AWSLifecycleHook `json:"lifecycleHooks,omitempty"`
+
+	// WarmPool configures a warm pool of pre-initialized instances for the autoscaling group.
+	// Instances in the warm pool have already booted, which reduces scale-out latency.
+	// If the warm pool is removed from the spec, it is deleted from the autoscaling group.
+	// +optional
+	WarmPool *WarmPool `json:"warmPool,omitempty"`
+
+	// ScalingPolicies specifies the target tracking and step scaling policies of the autoscaling group.
+	// When scaling policies are set, the desired capacity of the autoscaling group is owned by the
+	// policies and the MachinePool replicas are updated to follow it.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ScalingPolicies []ScalingPolicy `json:"scalingPolicies,omitempty"`
+
+	// ScheduledActions specifies the scheduled scaling actions of the autoscaling group.
+	// When a scheduled action sets the desired capacity, the desired capacity of the autoscaling group
+	// is owned by the scheduled actions and the MachinePool replicas are updated to follow it.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ScheduledActions []ScheduledAction `json:"scheduledActions,omitempty"`
 }
 
 // SuspendProcessesTypes contains user friendly auto-completable values for suspended process names.
@@ -260,6 +282,62 @@ type AWSMachinePoolStatus struct {
 	FailureMessage *string `json:"failureMessage,omitempty"`
 
 	ASGStatus *ASGStatus `json:"asgStatus,omitempty"`
+
+	// WarmPool contains the observed state of the warm pool of the autoscaling group.
+	// +optional
+	WarmPool *WarmPoolStatus `json:"warmPool,omitempty"`
+
+	// ScalingPolicies contains the scaling policies created for the autoscaling group.
+	// Scaling policies removed from the spec are only deleted if they are listed here.
+	// +optional
+	ScalingPolicies []ScalingPolicyStatus `json:"scalingPolicies,omitempty"`
+
+	// ScheduledActions contains the names of the scheduled actions created for the autoscaling group.
+	// Scheduled actions removed from the spec are only deleted if they are listed here.
+	// +optional
+	ScheduledActions []string `json:"scheduledActions,omitempty"`
+}
+
+// ScalingPolicyStatus defines the observed state of a scaling policy of the autoscaling group.
+type ScalingPolicyStatus struct {
+	// Name is the name of the scaling policy.
+	Name string `json:"name"`
+
+	// ARN is the Amazon Resource Name of the scaling policy. For step scaling policies,
+	// it is the alarm action that CloudWatch alarms must use to trigger the policy.
+	// +optional
+	ARN string `json:"arn,omitempty"`
+}
+
+// WarmPoolStatus defines the observed state of the warm pool of an autoscaling group.
+type WarmPoolStatus struct {
+	// Status is the status of the warm pool as reported by the autoscaling API.
+	// It is empty while the warm pool is active, and "PendingDelete" while it is being deleted.
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// Size is the number of instances currently in the warm pool.
+	// +optional
+	Size int32 `json:"size"`
+
+	// Instances contains the status for each instance in the warm pool.
+	// +optional
+	Instances []WarmPoolInstanceStatus `json:"instances,omitempty"`
+}
+
+// WarmPoolInstanceStatus defines the status of an instance in the warm pool.
+type WarmPoolInstanceStatus struct {
+	// InstanceID is the identification of the instance within the warm pool.
+	// +optional
+	InstanceID string `json:"instanceID,omitempty"`
+
+	// LifecycleState is the lifecycle state of the instance, for example "Warmed:Stopped".
+	// +optional
+	LifecycleState string `json:"lifecycleState,omitempty"`
+
+	// AvailabilityZone is the availability zone the instance runs in.
+	// +optional
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
 }
 
 // AWSMachinePoolInstanceStatus defines the status of the AWSMachinePoolInstance.
@@ -19,6 +19,7 @@ package v1beta2
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -152,6 +153,84 @@ func (r *AWSMachinePool) validateSpotInstances() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSMachinePool) validateMixedInstancesPolicy() field.ErrorList {
+	var allErrs field.ErrorList
+
+	policy := r.Spec.MixedInstancesPolicy
+	if policy == nil {
+		return allErrs
+	}
+
+	policyPath := field.NewPath("spec", "mixedInstancesPolicy")
+	usesInstanceTypes, usesInstanceRequirements := false, policy.InstanceRequirements != nil
+
+	if policy.InstanceRequirements != nil {
+		if len(policy.Overrides) > 0 {
+			allErrs = append(allErrs, field.Forbidden(policyPath.Child("instanceRequirements"), "can't be used together with spec.mixedInstancesPolicy.overrides, set the instance requirements on the overrides instead"))
+		}
+		allErrs = append(allErrs, validateInstanceRequirements(policyPath.Child("instanceRequirements"), policy.InstanceRequirements)...)
+	}
+
+	for i, override := range policy.Overrides {
+		overridePath := policyPath.Child("overrides").Index(i)
+		if (override.InstanceType == "") == (override.InstanceRequirements == nil) {
+			allErrs = append(allErrs, field.Invalid(overridePath, override.InstanceType, "exactly one of instanceType and instanceRequirements must be set"))
+			continue
+		}
+
+		if override.InstanceRequirements != nil {
+			usesInstanceRequirements = true
+			allErrs = append(allErrs, validateInstanceRequirements(overridePath.Child("instanceRequirements"), override.InstanceRequirements)...)
+		} else {
+			usesInstanceTypes = true
+		}
+	}
+
+	if usesInstanceTypes && usesInstanceRequirements {
+		allErrs = append(allErrs, field.Forbidden(policyPath.Child("overrides"), "overrides with instanceType and overrides with instanceRequirements can't be mixed"))
+	}
+
+	if usesInstanceRequirements && policy.InstancesDistribution != nil {
+		distributionPath := policyPath.Child("instancesDistribution")
+		if policy.InstancesDistribution.OnDemandAllocationStrategy == OnDemandAllocationStrategyPrioritized {
+			allErrs = append(allErrs, field.Forbidden(distributionPath.Child("onDemandAllocationStrategy"), "prioritized is not supported with instance requirements, use lowest-price"))
+		}
+		if policy.InstancesDistribution.SpotAllocationStrategy == SpotAllocationStrategyCapacityOptimizedPrioritized {
+			allErrs = append(allErrs, field.Forbidden(distributionPath.Child("spotAllocationStrategy"), "capacity-optimized-prioritized is not supported with instance requirements"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateInstanceRequirements(fldPath *field.Path, requirements *InstanceRequirements) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateIntegerRange(fldPath.Child("vCPUCount"), requirements.VCPUCount)...)
+	allErrs = append(allErrs, validateIntegerRange(fldPath.Child("memoryMiB"), requirements.MemoryMiB)...)
+	if requirements.AcceleratorCount != nil {
+		allErrs = append(allErrs, validateIntegerRange(fldPath.Child("acceleratorCount"), *requirements.AcceleratorCount)...)
+	}
+
+	if requirements.VCPUCount.Min < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("vCPUCount", "min"), requirements.VCPUCount.Min, "must be at least 1"))
+	}
+
+	if len(requirements.AllowedInstanceTypes) > 0 && len(requirements.ExcludedInstanceTypes) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("excludedInstanceTypes"), "can't be used together with allowedInstanceTypes"))
+	}
+
+	return allErrs
+}
+
+func validateIntegerRange(fldPath *field.Path, r IntegerRange) field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Max != nil && *r.Max < r.Min {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("max"), *r.Max, "must be greater than or equal to min"))
+	}
+	return allErrs
+}
+
 func (r *AWSMachinePool) validateRefreshPreferences() field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -172,6 +251,166 @@ func (r *AWSMachinePool) validateRefreshPreferences() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSMachinePool) validateWarmPool() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.WarmPool == nil {
+		return allErrs
+	}
+
+	warmPoolPath := field.NewPath("spec", "warmPool")
+
+	if r.Spec.MixedInstancesPolicy != nil {
+		allErrs = append(allErrs, field.Forbidden(warmPoolPath, "warm pools are not supported for autoscaling groups with spec.mixedInstancesPolicy"))
+	}
+
+	if r.Spec.AWSLaunchTemplate.SpotMarketOptions != nil || r.Spec.AWSLaunchTemplate.MarketType == infrav1.MarketTypeSpot {
+		allErrs = append(allErrs, field.Forbidden(warmPoolPath, "warm pools are not supported for autoscaling groups that launch Spot instances"))
+	}
+
+	if r.Spec.WarmPool.MinSize != nil && r.Spec.WarmPool.MaxGroupPreparedCapacity != nil && *r.Spec.WarmPool.MaxGroupPreparedCapacity < *r.Spec.WarmPool.MinSize {
+		allErrs = append(allErrs, field.Invalid(warmPoolPath.Child("maxGroupPreparedCapacity"), *r.Spec.WarmPool.MaxGroupPreparedCapacity, "must be greater than or equal to spec.warmPool.minSize"))
+	}
+
+	return allErrs
+}
+
+func (r *AWSMachinePool) validateScalingPolicies() field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := make(map[string]bool, len(r.Spec.ScalingPolicies))
+	for i, policy := range r.Spec.ScalingPolicies {
+		policyPath := field.NewPath("spec", "scalingPolicies").Index(i)
+
+		if names[policy.Name] {
+			allErrs = append(allErrs, field.Duplicate(policyPath.Child("name"), policy.Name))
+		}
+		names[policy.Name] = true
+
+		switch policy.PolicyType {
+		case ScalingPolicyTypeTargetTracking, "":
+			allErrs = append(allErrs, validateTargetTracking(policyPath, policy)...)
+		case ScalingPolicyTypeStepScaling:
+			allErrs = append(allErrs, validateStepScaling(policyPath, policy)...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateTargetTracking(policyPath *field.Path, policy ScalingPolicy) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if policy.StepScaling != nil {
+		allErrs = append(allErrs, field.Forbidden(policyPath.Child("stepScaling"), "can only be set if policyType is StepScaling"))
+	}
+
+	tt := policy.TargetTracking
+	if tt == nil {
+		return append(allErrs, field.Required(policyPath.Child("targetTracking"), "is required if policyType is TargetTrackingScaling"))
+	}
+
+	ttPath := policyPath.Child("targetTracking")
+	if (tt.PredefinedMetric == nil) == (tt.CustomMetric == nil) {
+		allErrs = append(allErrs, field.Invalid(ttPath, "", "exactly one of predefinedMetric and customMetric must be set"))
+	}
+
+	if tt.PredefinedMetric != nil {
+		if tt.PredefinedMetric.MetricType == PredefinedMetricTypeALBRequestCountPerTarget && tt.PredefinedMetric.ResourceLabel == "" {
+			allErrs = append(allErrs, field.Required(ttPath.Child("predefinedMetric", "resourceLabel"), "is required if metricType is ALBRequestCountPerTarget"))
+		}
+		if tt.PredefinedMetric.MetricType != PredefinedMetricTypeALBRequestCountPerTarget && tt.PredefinedMetric.ResourceLabel != "" {
+			allErrs = append(allErrs, field.Forbidden(ttPath.Child("predefinedMetric", "resourceLabel"), "can only be set if metricType is ALBRequestCountPerTarget"))
+		}
+	}
+
+	if tt.TargetValue.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(ttPath.Child("targetValue"), tt.TargetValue.String(), "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validateStepScaling(policyPath *field.Path, policy ScalingPolicy) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if policy.TargetTracking != nil {
+		allErrs = append(allErrs, field.Forbidden(policyPath.Child("targetTracking"), "can only be set if policyType is TargetTrackingScaling"))
+	}
+
+	step := policy.StepScaling
+	if step == nil {
+		return append(allErrs, field.Required(policyPath.Child("stepScaling"), "is required if policyType is StepScaling"))
+	}
+
+	stepPath := policyPath.Child("stepScaling")
+	if step.MinAdjustmentMagnitude != nil && step.AdjustmentType != "PercentChangeInCapacity" {
+		allErrs = append(allErrs, field.Forbidden(stepPath.Child("minAdjustmentMagnitude"), "can only be set if adjustmentType is PercentChangeInCapacity"))
+	}
+
+	if len(step.StepAdjustments) == 0 {
+		allErrs = append(allErrs, field.Required(stepPath.Child("stepAdjustments"), "at least one step adjustment is required"))
+	}
+
+	for i, adjustment := range step.StepAdjustments {
+		if adjustment.MetricIntervalLowerBound == nil && adjustment.MetricIntervalUpperBound == nil {
+			allErrs = append(allErrs, field.Invalid(stepPath.Child("stepAdjustments").Index(i), "", "at least one of metricIntervalLowerBound and metricIntervalUpperBound must be set"))
+			continue
+		}
+		if adjustment.MetricIntervalLowerBound != nil && adjustment.MetricIntervalUpperBound != nil &&
+			adjustment.MetricIntervalLowerBound.Cmp(*adjustment.MetricIntervalUpperBound) >= 0 {
+			allErrs = append(allErrs, field.Invalid(stepPath.Child("stepAdjustments").Index(i).Child("metricIntervalUpperBound"), adjustment.MetricIntervalUpperBound.String(), "must be greater than metricIntervalLowerBound"))
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSMachinePool) validateScheduledActions() field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := make(map[string]bool, len(r.Spec.ScheduledActions))
+	for i, action := range r.Spec.ScheduledActions {
+		actionPath := field.NewPath("spec", "scheduledActions").Index(i)
+
+		if names[action.Name] {
+			allErrs = append(allErrs, field.Duplicate(actionPath.Child("name"), action.Name))
+		}
+		names[action.Name] = true
+
+		if action.MinSize == nil && action.MaxSize == nil && action.DesiredCapacity == nil {
+			allErrs = append(allErrs, field.Required(actionPath, "at least one of minSize, maxSize and desiredCapacity must be set"))
+		}
+
+		if action.Recurrence == "" && action.StartTime == nil {
+			allErrs = append(allErrs, field.Required(actionPath, "at least one of recurrence and startTime must be set"))
+		}
+
+		if action.Recurrence != "" && len(strings.Fields(action.Recurrence)) != 5 {
+			allErrs = append(allErrs, field.Invalid(actionPath.Child("recurrence"), action.Recurrence, "must be a cron expression with 5 fields"))
+		}
+
+		if action.StartTime != nil && action.EndTime != nil && !action.EndTime.After(action.StartTime.Time) {
+			allErrs = append(allErrs, field.Invalid(actionPath.Child("endTime"), action.EndTime.String(), "must be after startTime"))
+		}
+
+		if action.MinSize != nil && action.MaxSize != nil && *action.MinSize > *action.MaxSize {
+			allErrs = append(allErrs, field.Invalid(actionPath.Child("maxSize"), *action.MaxSize, "must be greater than or equal to minSize"))
+		}
+
+		if action.DesiredCapacity != nil {
+			if action.MinSize != nil && *action.DesiredCapacity < *action.MinSize {
+				allErrs = append(allErrs, field.Invalid(actionPath.Child("desiredCapacity"), *action.DesiredCapacity, "must be greater than or equal to minSize"))
+			}
+			if action.MaxSize != nil && *action.DesiredCapacity > *action.MaxSize {
+				allErrs = append(allErrs, field.Invalid(actionPath.Child("desiredCapacity"), *action.DesiredCapacity, "must be less than or equal to maxSize"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func (r *AWSMachinePool) validateLifecycleHooks() field.ErrorList {
 	return validateLifecycleHooks(r.Spec.AWSLifecycleHooks)
 }
@@ -215,6 +454,10 @@ func (*AWSMachinePoolWebhook) ValidateCreate(_ context.Context, obj runtime.Obje
 	allErrs = append(allErrs, r.validateCapacityReservation()...)
 	allErrs = append(allErrs, r.validateLifecycleHooks()...)
 	allErrs = append(allErrs, r.validateIgnition()...)
+	allErrs = append(allErrs, r.validateWarmPool()...)
+	allErrs = append(allErrs, r.validateScalingPolicies()...)
+	allErrs = append(allErrs, r.validateScheduledActions()...)
+	allErrs = append(allErrs, r.validateMixedInstancesPolicy()...)
 
 	if len(allErrs) == 0 {
 		return nil, nil
@@ -281,6 +524,10 @@ func (*AWSMachinePoolWebhook) ValidateUpdate(_ context.Context, oldObj, newObj r
 	allErrs = append(allErrs, r.validateSpotInstances()...)
 	allErrs = append(allErrs, r.validateRefreshPreferences()...)
 	allErrs = append(allErrs, r.validateLifecycleHooks()...)
+	allErrs = append(allErrs, r.validateWarmPool()...)
+	allErrs = append(allErrs, r.validateScalingPolicies()...)
+	allErrs = append(allErrs, r.validateScheduledActions()...)
+	allErrs = append(allErrs, r.validateMixedInstancesPolicy()...)
 
 	if len(allErrs) == 0 {
 		return nil, nil
@@ -24,6 +24,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
@@ -362,6 +363,277 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErrToContain: nil,
 		},
+		{
+			name: "Should succeed on correct warm pool",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					WarmPool: &WarmPool{
+						MinSize:                  ptr.To[int32](1),
+						MaxGroupPreparedCapacity: ptr.To[int32](5),
+						PoolState:                WarmPoolStateStopped,
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if warm pool is used with a mixed instances policy",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{{InstanceType: "t3.medium"}},
+					},
+					WarmPool: &WarmPool{},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.warmPool: Forbidden"),
+		},
+		{
+			name: "Should fail if warm pool is used with spot instances",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						SpotMarketOptions: &infrav1.SpotMarketOptions{MaxPrice: aws.String("0.1")},
+					},
+					WarmPool: &WarmPool{},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.warmPool: Forbidden"),
+		},
+		{
+			name: "Should fail if warm pool maxGroupPreparedCapacity is lower than minSize",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					WarmPool: &WarmPool{
+						MinSize:                  ptr.To[int32](5),
+						MaxGroupPreparedCapacity: ptr.To[int32](2),
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.warmPool.maxGroupPreparedCapacity"),
+		},
+		{
+			name: "Should succeed on correct scaling policies and scheduled actions",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScalingPolicies: []ScalingPolicy{
+						{
+							Name:       "cpu",
+							PolicyType: ScalingPolicyTypeTargetTracking,
+							TargetTracking: &TargetTrackingConfiguration{
+								PredefinedMetric: &PredefinedMetricSpecification{MetricType: PredefinedMetricTypeASGAverageCPUUtilization},
+								TargetValue:      resource.MustParse("60"),
+							},
+						},
+						{
+							Name:       "queue-depth",
+							PolicyType: ScalingPolicyTypeStepScaling,
+							StepScaling: &StepScalingConfiguration{
+								AdjustmentType: "ChangeInCapacity",
+								StepAdjustments: []StepAdjustment{
+									{MetricIntervalLowerBound: ptr.To(resource.MustParse("0")), MetricIntervalUpperBound: ptr.To(resource.MustParse("100")), ScalingAdjustment: 1},
+									{MetricIntervalLowerBound: ptr.To(resource.MustParse("100")), ScalingAdjustment: 3},
+								},
+							},
+						},
+					},
+					ScheduledActions: []ScheduledAction{
+						{
+							Name:            "business-hours",
+							Recurrence:      "0 8 * * 1-5",
+							TimeZone:        "Europe/Berlin",
+							DesiredCapacity: ptr.To[int32](5),
+						},
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if scaling policy names are not unique",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScalingPolicies: []ScalingPolicy{
+						{Name: "cpu", TargetTracking: &TargetTrackingConfiguration{PredefinedMetric: &PredefinedMetricSpecification{MetricType: PredefinedMetricTypeASGAverageCPUUtilization}, TargetValue: resource.MustParse("60")}},
+						{Name: "cpu", TargetTracking: &TargetTrackingConfiguration{PredefinedMetric: &PredefinedMetricSpecification{MetricType: PredefinedMetricTypeASGAverageCPUUtilization}, TargetValue: resource.MustParse("70")}},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scalingPolicies[1].name: Duplicate value"),
+		},
+		{
+			name: "Should fail if target tracking policy has no metric",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScalingPolicies: []ScalingPolicy{
+						{Name: "cpu", TargetTracking: &TargetTrackingConfiguration{TargetValue: resource.MustParse("60")}},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("exactly one of predefinedMetric and customMetric must be set"),
+		},
+		{
+			name: "Should fail if ALB request count policy has no resource label",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScalingPolicies: []ScalingPolicy{
+						{Name: "alb", TargetTracking: &TargetTrackingConfiguration{PredefinedMetric: &PredefinedMetricSpecification{MetricType: PredefinedMetricTypeALBRequestCountPerTarget}, TargetValue: resource.MustParse("1000")}},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scalingPolicies[0].targetTracking.predefinedMetric.resourceLabel: Required value"),
+		},
+		{
+			name: "Should fail if step scaling policy has no configuration",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScalingPolicies: []ScalingPolicy{
+						{Name: "step", PolicyType: ScalingPolicyTypeStepScaling},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scalingPolicies[0].stepScaling: Required value"),
+		},
+		{
+			name: "Should fail if step adjustment bounds are inverted",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScalingPolicies: []ScalingPolicy{
+						{
+							Name:       "step",
+							PolicyType: ScalingPolicyTypeStepScaling,
+							StepScaling: &StepScalingConfiguration{
+								AdjustmentType: "ChangeInCapacity",
+								StepAdjustments: []StepAdjustment{
+									{MetricIntervalLowerBound: ptr.To(resource.MustParse("10")), MetricIntervalUpperBound: ptr.To(resource.MustParse("5")), ScalingAdjustment: 1},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scalingPolicies[0].stepScaling.stepAdjustments[0].metricIntervalUpperBound"),
+		},
+		{
+			name: "Should fail if scheduled action has no schedule",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScheduledActions: []ScheduledAction{
+						{Name: "scale-up", DesiredCapacity: ptr.To[int32](5)},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("at least one of recurrence and startTime must be set"),
+		},
+		{
+			name: "Should fail if scheduled action recurrence is not a cron expression",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScheduledActions: []ScheduledAction{
+						{Name: "scale-up", Recurrence: "every monday", DesiredCapacity: ptr.To[int32](5)},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scheduledActions[0].recurrence"),
+		},
+		{
+			name: "Should fail if scheduled action desired capacity exceeds its max size",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScheduledActions: []ScheduledAction{
+						{Name: "scale-up", Recurrence: "0 8 * * *", MaxSize: ptr.To[int32](3), DesiredCapacity: ptr.To[int32](5)},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scheduledActions[0].desiredCapacity"),
+		},
+		{
+			name: "Should succeed on mixed instances policy with instance requirements",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						InstancesDistribution: &InstancesDistribution{
+							OnDemandAllocationStrategy: OnDemandAllocationStrategyLowestPrice,
+							SpotAllocationStrategy:     SpotAllocationStrategyPriceCapacityOptimized,
+						},
+						InstanceRequirements: &InstanceRequirements{
+							VCPUCount:                             IntegerRange{Min: 2, Max: ptr.To[int32](8)},
+							MemoryMiB:                             IntegerRange{Min: 4096},
+							CPUManufacturers:                      []CPUManufacturer{CPUManufacturerIntel, CPUManufacturerAMD},
+							BurstablePerformance:                  InstanceTypeFeatureExcluded,
+							ExcludedInstanceTypes:                 []string{"m5a.*"},
+							SpotMaxPricePercentageOverLowestPrice: ptr.To[int32](50),
+						},
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if override sets both instance type and instance requirements",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{{
+							InstanceType:         "m5.large",
+							InstanceRequirements: &InstanceRequirements{VCPUCount: IntegerRange{Min: 2}, MemoryMiB: IntegerRange{Min: 4096}},
+						}},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("exactly one of instanceType and instanceRequirements must be set"),
+		},
+		{
+			name: "Should fail if overrides mix instance types and instance requirements",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{
+							{InstanceType: "m5.large"},
+							{InstanceRequirements: &InstanceRequirements{VCPUCount: IntegerRange{Min: 2}, MemoryMiB: IntegerRange{Min: 4096}}},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.mixedInstancesPolicy.overrides: Forbidden"),
+		},
+		{
+			name: "Should fail if policy instance requirements are used together with overrides",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides:            []Overrides{{InstanceType: "m5.large"}},
+						InstanceRequirements: &InstanceRequirements{VCPUCount: IntegerRange{Min: 2}, MemoryMiB: IntegerRange{Min: 4096}},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.mixedInstancesPolicy.instanceRequirements: Forbidden"),
+		},
+		{
+			name: "Should fail if instance requirements range is inverted",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						InstanceRequirements: &InstanceRequirements{VCPUCount: IntegerRange{Min: 8, Max: ptr.To[int32](4)}, MemoryMiB: IntegerRange{Min: 4096}},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.mixedInstancesPolicy.instanceRequirements.vCPUCount.max"),
+		},
+		{
+			name: "Should fail if instance requirements are used with the prioritized allocation strategy",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						InstancesDistribution: &InstancesDistribution{
+							OnDemandAllocationStrategy: OnDemandAllocationStrategyPrioritized,
+						},
+						InstanceRequirements: &InstanceRequirements{VCPUCount: IntegerRange{Min: 2}, MemoryMiB: IntegerRange{Min: 4096}},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.mixedInstancesPolicy.instancesDistribution.onDemandAllocationStrategy"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
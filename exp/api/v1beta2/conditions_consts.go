@@ -30,6 +30,15 @@ const (
 	// ASGDeletionInProgress ASG is in a deletion in progress state.
 	ASGDeletionInProgress = "ASGDeletionInProgress"
 
+	// FleetReadyCondition reports on current status of the EC2 Fleet. Ready indicates the fleet is provisioned.
+	FleetReadyCondition clusterv1beta1.ConditionType = "FleetReady"
+	// FleetNotFoundReason used when the EC2 Fleet couldn't be retrieved.
+	FleetNotFoundReason = "FleetNotFound"
+	// FleetProvisionFailedReason used for failures during EC2 Fleet provisioning.
+	FleetProvisionFailedReason = "FleetProvisionFailed"
+	// FleetDeletionInProgressReason used when the EC2 Fleet is being deleted.
+	FleetDeletionInProgressReason = "FleetDeletionInProgress"
+
 	// LaunchTemplateReadyCondition represents the status of an AWSMachinePool's associated Launch Template.
 	LaunchTemplateReadyCondition clusterv1beta1.ConditionType = "LaunchTemplateReady"
 	// LaunchTemplateNotFoundReason is used when an associated Launch Template can't be found.
@@ -69,6 +78,20 @@ const (
 	LifecycleHookUpdateFailedReason = "LifecycleHookUpdateFailed"
 	// LifecycleHookDeletionFailedReason used for failures during lifecycle hook deletion.
 	LifecycleHookDeletionFailedReason = "LifecycleHookDeletionFailed"
+
+	// WarmPoolReadyCondition reports on the status of the warm pool of the autoscaling group.
+	WarmPoolReadyCondition clusterv1beta1.ConditionType = "WarmPoolReady"
+	// WarmPoolReconcileFailedReason used for failures while creating or updating the warm pool.
+	WarmPoolReconcileFailedReason = "WarmPoolReconcileFailed"
+	// WarmPoolDeletionFailedReason used for failures during warm pool deletion.
+	WarmPoolDeletionFailedReason = "WarmPoolDeletionFailed"
+
+	// ScalingPoliciesReadyCondition reports on the status of the scaling policies and scheduled actions of the autoscaling group.
+	ScalingPoliciesReadyCondition clusterv1beta1.ConditionType = "ScalingPoliciesReady"
+	// ScalingPolicyReconcileFailedReason used for failures while reconciling scaling policies.
+	ScalingPolicyReconcileFailedReason = "ScalingPolicyReconcileFailed"
+	// ScheduledActionReconcileFailedReason used for failures while reconciling scheduled actions.
+	ScheduledActionReconcileFailedReason = "ScheduledActionReconcileFailed"
 )
 
 const (
@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -158,8 +159,123 @@ type AWSLaunchTemplate struct {
 
 // Overrides are used to override the instance type specified by the launch template with multiple
 // instance types that can be used to launch On-Demand Instances and Spot Instances.
+// Exactly one of InstanceType and InstanceRequirements must be set.
 type Overrides struct {
-	InstanceType string `json:"instanceType"`
+	// InstanceType is the instance type to launch.
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// InstanceRequirements specifies the attributes of the instance types to launch. Any instance type
+	// matching the requirements can be launched, including instance types released in the future.
+	// +optional
+	InstanceRequirements *InstanceRequirements `json:"instanceRequirements,omitempty"`
+}
+
+// CPUManufacturer is a CPU manufacturer used to select instance types.
+type CPUManufacturer string
+
+const (
+	// CPUManufacturerIntel selects instance types with Intel CPUs.
+	CPUManufacturerIntel CPUManufacturer = "intel"
+	// CPUManufacturerAMD selects instance types with AMD CPUs.
+	CPUManufacturerAMD CPUManufacturer = "amd"
+	// CPUManufacturerAWS selects instance types with AWS CPUs (Graviton).
+	CPUManufacturerAWS CPUManufacturer = "amazon-web-services"
+	// CPUManufacturerApple selects instance types with Apple CPUs.
+	CPUManufacturerApple CPUManufacturer = "apple"
+)
+
+// InstanceTypeFeatureRequirement indicates whether instance types with a feature are included, excluded or required.
+type InstanceTypeFeatureRequirement string
+
+const (
+	// InstanceTypeFeatureIncluded includes instance types with the feature.
+	InstanceTypeFeatureIncluded InstanceTypeFeatureRequirement = "included"
+	// InstanceTypeFeatureExcluded excludes instance types with the feature.
+	InstanceTypeFeatureExcluded InstanceTypeFeatureRequirement = "excluded"
+	// InstanceTypeFeatureRequired only includes instance types with the feature.
+	InstanceTypeFeatureRequired InstanceTypeFeatureRequirement = "required"
+)
+
+// InstanceRequirements describes the attributes of the instance types an Auto Scaling group can launch,
+// using attribute-based instance type selection.
+type InstanceRequirements struct {
+	// VCPUCount is the range of the number of vCPUs.
+	VCPUCount IntegerRange `json:"vCPUCount"`
+
+	// MemoryMiB is the range of the amount of memory, in MiB.
+	MemoryMiB IntegerRange `json:"memoryMiB"`
+
+	// CPUManufacturers restricts the CPU manufacturers of the instance types.
+	// If not specified, all CPU manufacturers are allowed.
+	// +optional
+	// +kubebuilder:validation:items:Enum=intel;amd;amazon-web-services;apple
+	CPUManufacturers []CPUManufacturer `json:"cpuManufacturers,omitempty"`
+
+	// InstanceGenerations restricts the generations of the instance types.
+	// If not specified, current and previous generation instance types are allowed.
+	// +optional
+	// +kubebuilder:validation:items:Enum=current;previous
+	InstanceGenerations []string `json:"instanceGenerations,omitempty"`
+
+	// BurstablePerformance indicates whether burstable performance instance types (T family) are
+	// included, excluded or required. Defaults to excluded.
+	// +kubebuilder:validation:Enum=included;excluded;required
+	// +optional
+	BurstablePerformance InstanceTypeFeatureRequirement `json:"burstablePerformance,omitempty"`
+
+	// BareMetal indicates whether bare metal instance types are included, excluded or required.
+	// Defaults to excluded.
+	// +kubebuilder:validation:Enum=included;excluded;required
+	// +optional
+	BareMetal InstanceTypeFeatureRequirement `json:"bareMetal,omitempty"`
+
+	// AcceleratorTypes restricts the types of accelerators of the instance types.
+	// If not specified, all accelerator types are allowed.
+	// +optional
+	// +kubebuilder:validation:items:Enum=gpu;fpga;inference
+	AcceleratorTypes []string `json:"acceleratorTypes,omitempty"`
+
+	// AcceleratorCount is the range of the number of accelerators. Set the maximum to 0 to
+	// exclude instance types with accelerators.
+	// +optional
+	AcceleratorCount *IntegerRange `json:"acceleratorCount,omitempty"`
+
+	// AllowedInstanceTypes restricts the selection to the given instance types. Wildcards are
+	// supported (e.g. "m5.*"). Can't be used together with ExcludedInstanceTypes.
+	// +optional
+	// +kubebuilder:validation:MaxItems=400
+	AllowedInstanceTypes []string `json:"allowedInstanceTypes,omitempty"`
+
+	// ExcludedInstanceTypes excludes the given instance types from the selection. Wildcards are
+	// supported (e.g. "m5a.*"). Can't be used together with AllowedInstanceTypes.
+	// +optional
+	// +kubebuilder:validation:MaxItems=400
+	ExcludedInstanceTypes []string `json:"excludedInstanceTypes,omitempty"`
+
+	// SpotMaxPricePercentageOverLowestPrice is the price protection threshold for Spot instances,
+	// as a percentage over the price of the cheapest matching instance type.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	SpotMaxPricePercentageOverLowestPrice *int32 `json:"spotMaxPricePercentageOverLowestPrice,omitempty"`
+
+	// OnDemandMaxPricePercentageOverLowestPrice is the price protection threshold for On-Demand
+	// instances, as a percentage over the price of the cheapest matching instance type.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	OnDemandMaxPricePercentageOverLowestPrice *int32 `json:"onDemandMaxPricePercentageOverLowestPrice,omitempty"`
+}
+
+// IntegerRange is a range of integers. The bounds are inclusive.
+type IntegerRange struct {
+	// Min is the minimum value.
+	// +kubebuilder:validation:Minimum=0
+	Min int32 `json:"min"`
+
+	// Max is the maximum value. If not specified, there is no maximum.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Max *int32 `json:"max,omitempty"`
 }
 
 // OnDemandAllocationStrategy indicates how to allocate instance types to fulfill On-Demand capacity.
@@ -221,6 +337,11 @@ type InstancesDistribution struct {
 type MixedInstancesPolicy struct {
 	InstancesDistribution *InstancesDistribution `json:"instancesDistribution,omitempty"`
 	Overrides             []Overrides            `json:"overrides,omitempty"`
+
+	// InstanceRequirements specifies the attributes of the instance types to launch, as a shorthand
+	// for a single override with instance requirements. Can't be used together with Overrides.
+	// +optional
+	InstanceRequirements *InstanceRequirements `json:"instanceRequirements,omitempty"`
 }
 
 // Tags is a mapping for tags.
@@ -242,11 +363,28 @@ type AutoScalingGroup struct {
 	CapacityRebalance     bool            `json:"capacityRebalance,omitempty"`
 
 	MixedInstancesPolicy      *MixedInstancesPolicy `json:"mixedInstancesPolicy,omitempty"`
+	WarmPool                  *WarmPool             `json:"warmPool,omitempty"`
+	WarmPoolStatus            string                `json:"warmPoolStatus,omitempty"`
 	Status                    ASGStatus
 	Instances                 []infrav1.Instance `json:"instances,omitempty"`
 	CurrentlySuspendProcesses []string           `json:"currentlySuspendProcesses,omitempty"`
 }
 
+// EC2Fleet describes an AWS EC2 Fleet.
+type EC2Fleet struct {
+	ID                        string             `json:"id,omitempty"`
+	State                     string             `json:"state,omitempty"`
+	TotalTargetCapacity       int32              `json:"totalTargetCapacity,omitempty"`
+	OnDemandTargetCapacity    int32              `json:"onDemandTargetCapacity,omitempty"`
+	SpotTargetCapacity        int32              `json:"spotTargetCapacity,omitempty"`
+	DefaultTargetCapacityType FleetCapacityType  `json:"defaultTargetCapacityType,omitempty"`
+	LaunchTemplateID          string             `json:"launchTemplateID,omitempty"`
+	LaunchTemplateVersion     string             `json:"launchTemplateVersion,omitempty"`
+	Subnets                   []string           `json:"subnets,omitempty"`
+	Overrides                 []Overrides        `json:"overrides,omitempty"`
+	Instances                 []infrav1.Instance `json:"instances,omitempty"`
+}
+
 // AWSLifecycleHook describes an AWS lifecycle hook
 type AWSLifecycleHook struct {
 	// The name of the lifecycle hook.
@@ -312,6 +450,263 @@ func (d LifecycleHookDefaultResult) String() string {
 	return string(d)
 }
 
+// WarmPoolState is the state instances in a warm pool are kept in after they have been initialized.
+type WarmPoolState string
+
+const (
+	// WarmPoolStateStopped keeps instances in the warm pool stopped. This is the cheapest option,
+	// only EBS volumes and Elastic IPs attached to the instances are billed.
+	WarmPoolStateStopped WarmPoolState = "Stopped"
+	// WarmPoolStateRunning keeps instances in the warm pool running.
+	WarmPoolStateRunning WarmPoolState = "Running"
+	// WarmPoolStateHibernated keeps instances in the warm pool hibernated. The instance memory
+	// is persisted to the root volume, which must be encrypted and large enough to hold it.
+	WarmPoolStateHibernated WarmPoolState = "Hibernated"
+)
+
+func (s WarmPoolState) String() string {
+	return string(s)
+}
+
+// WarmPool describes a warm pool of pre-initialized instances for an autoscaling group.
+// Note that warm pool instances run the user data when they are initialized, so the bootstrap
+// process must tolerate being stopped (or hibernated) before the instance joins the cluster.
+type WarmPool struct {
+	// MinSize is the minimum number of instances to maintain in the warm pool.
+	// Defaults to 0 if not specified.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinSize *int32 `json:"minSize,omitempty"`
+
+	// MaxGroupPreparedCapacity is the maximum number of instances that are allowed to be in the
+	// warm pool or in any state except Terminated for the autoscaling group.
+	// If not specified, the maximum size of the autoscaling group is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxGroupPreparedCapacity *int32 `json:"maxGroupPreparedCapacity,omitempty"`
+
+	// PoolState is the state instances are kept in while they are in the warm pool.
+	// +kubebuilder:validation:Enum=Stopped;Running;Hibernated
+	// +kubebuilder:default=Stopped
+	// +optional
+	PoolState WarmPoolState `json:"poolState,omitempty"`
+
+	// ReuseOnScaleIn indicates whether instances in the autoscaling group can be returned to the
+	// warm pool on scale in, instead of being terminated.
+	// +optional
+	ReuseOnScaleIn bool `json:"reuseOnScaleIn,omitempty"`
+}
+
+// ScalingPolicyType is the type of a scaling policy of an autoscaling group.
+type ScalingPolicyType string
+
+const (
+	// ScalingPolicyTypeTargetTracking scales the autoscaling group to keep a metric at a target value.
+	ScalingPolicyTypeTargetTracking ScalingPolicyType = "TargetTrackingScaling"
+	// ScalingPolicyTypeStepScaling scales the autoscaling group in steps, based on the size of an alarm breach.
+	ScalingPolicyTypeStepScaling ScalingPolicyType = "StepScaling"
+)
+
+func (t ScalingPolicyType) String() string {
+	return string(t)
+}
+
+// PredefinedMetricType is a metric type predefined by EC2 Auto Scaling for target tracking scaling policies.
+type PredefinedMetricType string
+
+const (
+	// PredefinedMetricTypeASGAverageCPUUtilization is the average CPU utilization of the autoscaling group.
+	PredefinedMetricTypeASGAverageCPUUtilization PredefinedMetricType = "ASGAverageCPUUtilization"
+	// PredefinedMetricTypeASGAverageNetworkIn is the average number of bytes received by a single instance.
+	PredefinedMetricTypeASGAverageNetworkIn PredefinedMetricType = "ASGAverageNetworkIn"
+	// PredefinedMetricTypeASGAverageNetworkOut is the average number of bytes sent out by a single instance.
+	PredefinedMetricTypeASGAverageNetworkOut PredefinedMetricType = "ASGAverageNetworkOut"
+	// PredefinedMetricTypeALBRequestCountPerTarget is the average number of requests per target of an
+	// Application Load Balancer target group.
+	PredefinedMetricTypeALBRequestCountPerTarget PredefinedMetricType = "ALBRequestCountPerTarget"
+)
+
+func (t PredefinedMetricType) String() string {
+	return string(t)
+}
+
+// ScalingPolicy defines a scaling policy of an autoscaling group.
+type ScalingPolicy struct {
+	// Name is the name of the scaling policy. It must be unique within the autoscaling group.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// PolicyType is the type of the scaling policy.
+	// +kubebuilder:validation:Enum=TargetTrackingScaling;StepScaling
+	// +kubebuilder:default=TargetTrackingScaling
+	// +optional
+	PolicyType ScalingPolicyType `json:"policyType,omitempty"`
+
+	// TargetTracking configures a target tracking scaling policy.
+	// Required if the policy type is TargetTrackingScaling.
+	// +optional
+	TargetTracking *TargetTrackingConfiguration `json:"targetTracking,omitempty"`
+
+	// StepScaling configures a step scaling policy.
+	// Required if the policy type is StepScaling.
+	// +optional
+	StepScaling *StepScalingConfiguration `json:"stepScaling,omitempty"`
+
+	// EstimatedInstanceWarmup is the time until a newly launched instance can contribute to the
+	// metrics used by the policy. If not specified, the default instance warmup of the group is used.
+	// +optional
+	EstimatedInstanceWarmup *metav1.Duration `json:"estimatedInstanceWarmup,omitempty"`
+
+	// Enabled indicates whether the scaling policy is enabled. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// TargetTrackingConfiguration defines a target tracking scaling policy.
+// Exactly one of PredefinedMetric and CustomMetric must be set.
+type TargetTrackingConfiguration struct {
+	// PredefinedMetric is the predefined metric to track.
+	// +optional
+	PredefinedMetric *PredefinedMetricSpecification `json:"predefinedMetric,omitempty"`
+
+	// CustomMetric is the CloudWatch metric to track.
+	// +optional
+	CustomMetric *CustomizedMetricSpecification `json:"customMetric,omitempty"`
+
+	// TargetValue is the target value for the metric.
+	TargetValue resource.Quantity `json:"targetValue"`
+
+	// DisableScaleIn indicates whether scaling in by the policy is disabled.
+	// +optional
+	DisableScaleIn bool `json:"disableScaleIn,omitempty"`
+}
+
+// PredefinedMetricSpecification defines a metric predefined by EC2 Auto Scaling.
+type PredefinedMetricSpecification struct {
+	// MetricType is the type of the predefined metric.
+	// +kubebuilder:validation:Enum=ASGAverageCPUUtilization;ASGAverageNetworkIn;ASGAverageNetworkOut;ALBRequestCountPerTarget
+	MetricType PredefinedMetricType `json:"metricType"`
+
+	// ResourceLabel identifies the target group of an Application Load Balancer, in the format
+	// app/<load-balancer-name>/<load-balancer-id>/targetgroup/<target-group-name>/<target-group-id>.
+	// Required if the metric type is ALBRequestCountPerTarget.
+	// +optional
+	ResourceLabel string `json:"resourceLabel,omitempty"`
+}
+
+// CustomizedMetricSpecification defines a CloudWatch metric used by a scaling policy.
+type CustomizedMetricSpecification struct {
+	// MetricName is the name of the metric.
+	MetricName string `json:"metricName"`
+
+	// Namespace is the namespace of the metric.
+	Namespace string `json:"namespace"`
+
+	// Statistic is the statistic of the metric.
+	// +kubebuilder:validation:Enum=Average;Minimum;Maximum;SampleCount;Sum
+	Statistic string `json:"statistic"`
+
+	// Unit is the unit of the metric.
+	// +optional
+	Unit string `json:"unit,omitempty"`
+
+	// Dimensions are the dimensions of the metric.
+	// +optional
+	Dimensions []MetricDimension `json:"dimensions,omitempty"`
+}
+
+// MetricDimension is a name/value pair identifying a CloudWatch metric.
+type MetricDimension struct {
+	// Name is the name of the dimension.
+	Name string `json:"name"`
+
+	// Value is the value of the dimension.
+	Value string `json:"value"`
+}
+
+// StepScalingConfiguration defines a step scaling policy. The policy is triggered by
+// CloudWatch alarms, which use the ARN of the policy reported in the status as their action.
+type StepScalingConfiguration struct {
+	// AdjustmentType specifies how the scaling adjustment is interpreted.
+	// +kubebuilder:validation:Enum=ChangeInCapacity;ExactCapacity;PercentChangeInCapacity
+	AdjustmentType string `json:"adjustmentType"`
+
+	// MetricAggregationType is the aggregation type for the CloudWatch metrics.
+	// +kubebuilder:validation:Enum=Average;Minimum;Maximum
+	// +kubebuilder:default=Average
+	// +optional
+	MetricAggregationType string `json:"metricAggregationType,omitempty"`
+
+	// MinAdjustmentMagnitude is the minimum number of instances to scale.
+	// Only valid if the adjustment type is PercentChangeInCapacity.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinAdjustmentMagnitude *int32 `json:"minAdjustmentMagnitude,omitempty"`
+
+	// StepAdjustments are the adjustments applied depending on the size of the alarm breach.
+	// +kubebuilder:validation:MinItems=1
+	StepAdjustments []StepAdjustment `json:"stepAdjustments"`
+}
+
+// StepAdjustment defines a scaling adjustment for a range of metric values, relative to the
+// alarm threshold. The lower bound is inclusive and the upper bound is exclusive.
+type StepAdjustment struct {
+	// MetricIntervalLowerBound is the lower bound of the metric interval.
+	// If not specified, the interval has no lower bound.
+	// +optional
+	MetricIntervalLowerBound *resource.Quantity `json:"metricIntervalLowerBound,omitempty"`
+
+	// MetricIntervalUpperBound is the upper bound of the metric interval.
+	// If not specified, the interval has no upper bound.
+	// +optional
+	MetricIntervalUpperBound *resource.Quantity `json:"metricIntervalUpperBound,omitempty"`
+
+	// ScalingAdjustment is the amount by which to scale, as specified by the adjustment type.
+	ScalingAdjustment int32 `json:"scalingAdjustment"`
+}
+
+// ScheduledAction defines a scheduled scaling action of an autoscaling group.
+type ScheduledAction struct {
+	// Name is the name of the scheduled action. It must be unique within the autoscaling group.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// Recurrence is the recurring schedule of the action, in Unix cron syntax
+	// (e.g. "30 0 1 1,6,12 *"). Either Recurrence or StartTime must be set.
+	// +optional
+	Recurrence string `json:"recurrence,omitempty"`
+
+	// StartTime is the time the action is performed for the first time.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is the time after which a recurring action is no longer performed.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// TimeZone is the IANA time zone the recurrence is evaluated in (e.g. "Europe/Berlin").
+	// Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// MinSize is the minimum size of the autoscaling group set by the action.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinSize *int32 `json:"minSize,omitempty"`
+
+	// MaxSize is the maximum size of the autoscaling group set by the action.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxSize *int32 `json:"maxSize,omitempty"`
+
+	// DesiredCapacity is the desired capacity of the autoscaling group set by the action.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	DesiredCapacity *int32 `json:"desiredCapacity,omitempty"`
+}
+
 // ASGStatus is a status string returned by the autoscaling API.
 type ASGStatus string
 
@@ -89,6 +89,171 @@ func (in *AWSFargateProfileList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSFleetMachinePool) DeepCopyInto(out *AWSFleetMachinePool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSFleetMachinePool.
+func (in *AWSFleetMachinePool) DeepCopy() *AWSFleetMachinePool {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSFleetMachinePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSFleetMachinePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSFleetMachinePoolList) DeepCopyInto(out *AWSFleetMachinePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSFleetMachinePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSFleetMachinePoolList.
+func (in *AWSFleetMachinePoolList) DeepCopy() *AWSFleetMachinePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSFleetMachinePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSFleetMachinePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSFleetMachinePoolSpec) DeepCopyInto(out *AWSFleetMachinePoolSpec) {
+	*out = *in
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AvailabilityZoneSubnetType != nil {
+		in, out := &in.AvailabilityZoneSubnetType, &out.AvailabilityZoneSubnetType
+		*out = new(AZSubnetType)
+		**out = **in
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]apiv1beta2.AWSResourceReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalTags != nil {
+		in, out := &in.AdditionalTags, &out.AdditionalTags
+		*out = make(apiv1beta2.Tags, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.AWSLaunchTemplate.DeepCopyInto(&out.AWSLaunchTemplate)
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]Overrides, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CapacityDistribution != nil {
+		in, out := &in.CapacityDistribution, &out.CapacityDistribution
+		*out = new(FleetCapacityDistribution)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProviderIDList != nil {
+		in, out := &in.ProviderIDList, &out.ProviderIDList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ignition != nil {
+		in, out := &in.Ignition, &out.Ignition
+		*out = new(apiv1beta2.Ignition)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSFleetMachinePoolSpec.
+func (in *AWSFleetMachinePoolSpec) DeepCopy() *AWSFleetMachinePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSFleetMachinePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSFleetMachinePoolStatus) DeepCopyInto(out *AWSFleetMachinePoolStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(v1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]AWSMachinePoolInstanceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LaunchTemplateVersion != nil {
+		in, out := &in.LaunchTemplateVersion, &out.LaunchTemplateVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSFleetMachinePoolStatus.
+func (in *AWSFleetMachinePoolStatus) DeepCopy() *AWSFleetMachinePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSFleetMachinePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSLaunchTemplate) DeepCopyInto(out *AWSLaunchTemplate) {
 	*out = *in
@@ -335,6 +500,25 @@ func (in *AWSMachinePoolSpec) DeepCopyInto(out *AWSMachinePoolSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WarmPool != nil {
+		in, out := &in.WarmPool, &out.WarmPool
+		*out = new(WarmPool)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScalingPolicies != nil {
+		in, out := &in.ScalingPolicies, &out.ScalingPolicies
+		*out = make([]ScalingPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ScheduledActions != nil {
+		in, out := &in.ScheduledActions, &out.ScheduledActions
+		*out = make([]ScheduledAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachinePoolSpec.
@@ -384,6 +568,21 @@ func (in *AWSMachinePoolStatus) DeepCopyInto(out *AWSMachinePoolStatus) {
 		*out = new(ASGStatus)
 		**out = **in
 	}
+	if in.WarmPool != nil {
+		in, out := &in.WarmPool, &out.WarmPool
+		*out = new(WarmPoolStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScalingPolicies != nil {
+		in, out := &in.ScalingPolicies, &out.ScalingPolicies
+		*out = make([]ScalingPolicyStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScheduledActions != nil {
+		in, out := &in.ScheduledActions, &out.ScheduledActions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachinePoolStatus.
@@ -686,6 +885,11 @@ func (in *AutoScalingGroup) DeepCopyInto(out *AutoScalingGroup) {
 		*out = new(MixedInstancesPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WarmPool != nil {
+		in, out := &in.WarmPool, &out.WarmPool
+		*out = new(WarmPool)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Instances != nil {
 		in, out := &in.Instances, &out.Instances
 		*out = make([]apiv1beta2.Instance, len(*in))
@@ -741,6 +945,26 @@ func (in *CFResource) DeepCopy() *CFResource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomizedMetricSpecification) DeepCopyInto(out *CustomizedMetricSpecification) {
+	*out = *in
+	if in.Dimensions != nil {
+		in, out := &in.Dimensions, &out.Dimensions
+		*out = make([]MetricDimension, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomizedMetricSpecification.
+func (in *CustomizedMetricSpecification) DeepCopy() *CustomizedMetricSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomizedMetricSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EBS) DeepCopyInto(out *EBS) {
 	*out = *in
@@ -756,6 +980,40 @@ func (in *EBS) DeepCopy() *EBS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2Fleet) DeepCopyInto(out *EC2Fleet) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]Overrides, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]apiv1beta2.Instance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2Fleet.
+func (in *EC2Fleet) DeepCopy() *EC2Fleet {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2Fleet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FargateProfileSpec) DeepCopyInto(out *FargateProfileSpec) {
 	*out = *in
@@ -844,6 +1102,83 @@ func (in *FargateSelector) DeepCopy() *FargateSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetCapacityDistribution) DeepCopyInto(out *FleetCapacityDistribution) {
+	*out = *in
+	if in.OnDemandBaseCapacity != nil {
+		in, out := &in.OnDemandBaseCapacity, &out.OnDemandBaseCapacity
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetCapacityDistribution.
+func (in *FleetCapacityDistribution) DeepCopy() *FleetCapacityDistribution {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetCapacityDistribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceRequirements) DeepCopyInto(out *InstanceRequirements) {
+	*out = *in
+	in.VCPUCount.DeepCopyInto(&out.VCPUCount)
+	in.MemoryMiB.DeepCopyInto(&out.MemoryMiB)
+	if in.CPUManufacturers != nil {
+		in, out := &in.CPUManufacturers, &out.CPUManufacturers
+		*out = make([]CPUManufacturer, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstanceGenerations != nil {
+		in, out := &in.InstanceGenerations, &out.InstanceGenerations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AcceleratorTypes != nil {
+		in, out := &in.AcceleratorTypes, &out.AcceleratorTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AcceleratorCount != nil {
+		in, out := &in.AcceleratorCount, &out.AcceleratorCount
+		*out = new(IntegerRange)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedInstanceTypes != nil {
+		in, out := &in.AllowedInstanceTypes, &out.AllowedInstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedInstanceTypes != nil {
+		in, out := &in.ExcludedInstanceTypes, &out.ExcludedInstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SpotMaxPricePercentageOverLowestPrice != nil {
+		in, out := &in.SpotMaxPricePercentageOverLowestPrice, &out.SpotMaxPricePercentageOverLowestPrice
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OnDemandMaxPricePercentageOverLowestPrice != nil {
+		in, out := &in.OnDemandMaxPricePercentageOverLowestPrice, &out.OnDemandMaxPricePercentageOverLowestPrice
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceRequirements.
+func (in *InstanceRequirements) DeepCopy() *InstanceRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstancesDistribution) DeepCopyInto(out *InstancesDistribution) {
 	*out = *in
@@ -869,6 +1204,26 @@ func (in *InstancesDistribution) DeepCopy() *InstancesDistribution {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegerRange) DeepCopyInto(out *IntegerRange) {
+	*out = *in
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegerRange.
+func (in *IntegerRange) DeepCopy() *IntegerRange {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegerRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedMachinePoolScaling) DeepCopyInto(out *ManagedMachinePoolScaling) {
 	*out = *in
@@ -919,6 +1274,21 @@ func (in *ManagedRemoteAccess) DeepCopy() *ManagedRemoteAccess {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricDimension) DeepCopyInto(out *MetricDimension) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricDimension.
+func (in *MetricDimension) DeepCopy() *MetricDimension {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricDimension)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MixedInstancesPolicy) DeepCopyInto(out *MixedInstancesPolicy) {
 	*out = *in
@@ -930,7 +1300,14 @@ func (in *MixedInstancesPolicy) DeepCopyInto(out *MixedInstancesPolicy) {
 	if in.Overrides != nil {
 		in, out := &in.Overrides, &out.Overrides
 		*out = make([]Overrides, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InstanceRequirements != nil {
+		in, out := &in.InstanceRequirements, &out.InstanceRequirements
+		*out = new(InstanceRequirements)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -983,6 +1360,11 @@ func (in *OperatorRoleConfig) DeepCopy() *OperatorRoleConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Overrides) DeepCopyInto(out *Overrides) {
 	*out = *in
+	if in.InstanceRequirements != nil {
+		in, out := &in.InstanceRequirements, &out.InstanceRequirements
+		*out = new(InstanceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Overrides.
@@ -995,6 +1377,21 @@ func (in *Overrides) DeepCopy() *Overrides {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PredefinedMetricSpecification) DeepCopyInto(out *PredefinedMetricSpecification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PredefinedMetricSpecification.
+func (in *PredefinedMetricSpecification) DeepCopy() *PredefinedMetricSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(PredefinedMetricSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Processes) DeepCopyInto(out *Processes) {
 	*out = *in
@@ -1657,6 +2054,94 @@ func (in *RosaUpdateConfig) DeepCopy() *RosaUpdateConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingPolicy) DeepCopyInto(out *ScalingPolicy) {
+	*out = *in
+	if in.TargetTracking != nil {
+		in, out := &in.TargetTracking, &out.TargetTracking
+		*out = new(TargetTrackingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StepScaling != nil {
+		in, out := &in.StepScaling, &out.StepScaling
+		*out = new(StepScalingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EstimatedInstanceWarmup != nil {
+		in, out := &in.EstimatedInstanceWarmup, &out.EstimatedInstanceWarmup
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingPolicy.
+func (in *ScalingPolicy) DeepCopy() *ScalingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingPolicyStatus) DeepCopyInto(out *ScalingPolicyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingPolicyStatus.
+func (in *ScalingPolicyStatus) DeepCopy() *ScalingPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledAction) DeepCopyInto(out *ScheduledAction) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DesiredCapacity != nil {
+		in, out := &in.DesiredCapacity, &out.DesiredCapacity
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledAction.
+func (in *ScheduledAction) DeepCopy() *ScheduledAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SharedVPCConfig) DeepCopyInto(out *SharedVPCConfig) {
 	*out = *in
@@ -1672,6 +2157,58 @@ func (in *SharedVPCConfig) DeepCopy() *SharedVPCConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepAdjustment) DeepCopyInto(out *StepAdjustment) {
+	*out = *in
+	if in.MetricIntervalLowerBound != nil {
+		in, out := &in.MetricIntervalLowerBound, &out.MetricIntervalLowerBound
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MetricIntervalUpperBound != nil {
+		in, out := &in.MetricIntervalUpperBound, &out.MetricIntervalUpperBound
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepAdjustment.
+func (in *StepAdjustment) DeepCopy() *StepAdjustment {
+	if in == nil {
+		return nil
+	}
+	out := new(StepAdjustment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepScalingConfiguration) DeepCopyInto(out *StepScalingConfiguration) {
+	*out = *in
+	if in.MinAdjustmentMagnitude != nil {
+		in, out := &in.MinAdjustmentMagnitude, &out.MinAdjustmentMagnitude
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StepAdjustments != nil {
+		in, out := &in.StepAdjustments, &out.StepAdjustments
+		*out = make([]StepAdjustment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepScalingConfiguration.
+func (in *StepScalingConfiguration) DeepCopy() *StepScalingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(StepScalingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuspendProcessesTypes) DeepCopyInto(out *SuspendProcessesTypes) {
 	*out = *in
@@ -1747,6 +2284,32 @@ func (in Taints) DeepCopy() Taints {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetTrackingConfiguration) DeepCopyInto(out *TargetTrackingConfiguration) {
+	*out = *in
+	if in.PredefinedMetric != nil {
+		in, out := &in.PredefinedMetric, &out.PredefinedMetric
+		*out = new(PredefinedMetricSpecification)
+		**out = **in
+	}
+	if in.CustomMetric != nil {
+		in, out := &in.CustomMetric, &out.CustomMetric
+		*out = new(CustomizedMetricSpecification)
+		(*in).DeepCopyInto(*out)
+	}
+	out.TargetValue = in.TargetValue.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetTrackingConfiguration.
+func (in *TargetTrackingConfiguration) DeepCopy() *TargetTrackingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetTrackingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpdateConfig) DeepCopyInto(out *UpdateConfig) {
 	*out = *in
@@ -1771,3 +2334,63 @@ func (in *UpdateConfig) DeepCopy() *UpdateConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarmPool) DeepCopyInto(out *WarmPool) {
+	*out = *in
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxGroupPreparedCapacity != nil {
+		in, out := &in.MaxGroupPreparedCapacity, &out.MaxGroupPreparedCapacity
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarmPool.
+func (in *WarmPool) DeepCopy() *WarmPool {
+	if in == nil {
+		return nil
+	}
+	out := new(WarmPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarmPoolInstanceStatus) DeepCopyInto(out *WarmPoolInstanceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarmPoolInstanceStatus.
+func (in *WarmPoolInstanceStatus) DeepCopy() *WarmPoolInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WarmPoolInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarmPoolStatus) DeepCopyInto(out *WarmPoolStatus) {
+	*out = *in
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]WarmPoolInstanceStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarmPoolStatus.
+func (in *WarmPoolStatus) DeepCopy() *WarmPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WarmPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
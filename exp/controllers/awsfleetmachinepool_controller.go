@@ -0,0 +1,481 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/controllers"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/s3"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/cluster-api/util/predicates"
+)
+
+// AWSFleetMachinePoolReconciler reconciles a AWSFleetMachinePool object.
+type AWSFleetMachinePoolReconciler struct {
+	client.Client
+	Recorder                     record.EventRecorder
+	WatchFilterValue             string
+	ec2ServiceFactory            func(scope.EC2Scope) services.EC2Interface
+	fleetServiceFactory          func(scope.EC2Scope) services.FleetInterface
+	reconcileServiceFactory      func(scope.EC2Scope) services.MachinePoolReconcileInterface
+	objectStoreServiceFactory    func(scope.S3Scope) services.ObjectStoreInterface
+	TagUnmanagedNetworkResources bool
+}
+
+func (r *AWSFleetMachinePoolReconciler) getEC2Service(scope scope.EC2Scope) services.EC2Interface {
+	if r.ec2ServiceFactory != nil {
+		return r.ec2ServiceFactory(scope)
+	}
+
+	return ec2.NewService(scope)
+}
+
+func (r *AWSFleetMachinePoolReconciler) getFleetService(scope scope.EC2Scope) services.FleetInterface {
+	if r.fleetServiceFactory != nil {
+		return r.fleetServiceFactory(scope)
+	}
+
+	return ec2.NewService(scope)
+}
+
+func (r *AWSFleetMachinePoolReconciler) getReconcileService(scope scope.EC2Scope) services.MachinePoolReconcileInterface {
+	if r.reconcileServiceFactory != nil {
+		return r.reconcileServiceFactory(scope)
+	}
+
+	return ec2.NewService(scope)
+}
+
+func (r *AWSFleetMachinePoolReconciler) getObjectStoreService(scope scope.S3Scope) services.ObjectStoreInterface {
+	if scope.Bucket() == nil {
+		// S3 bucket usage not enabled, so object store service not needed
+		return nil
+	}
+
+	if r.objectStoreServiceFactory != nil {
+		return r.objectStoreServiceFactory(scope)
+	}
+
+	return s3.NewService(scope)
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsfleetmachinepools,verbs=get;list;watch;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsfleetmachinepools/finalizers,verbs=delete;update
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsfleetmachinepools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools;machinepools/status,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
+
+// Reconcile is the reconciliation loop for AWSFleetMachinePool.
+func (r *AWSFleetMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := logger.FromContext(ctx)
+
+	// Fetch the AWSFleetMachinePool.
+	awsFleetMachinePool := &expinfrav1.AWSFleetMachinePool{}
+	err := r.Get(ctx, req.NamespacedName, awsFleetMachinePool)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Fetch the CAPI MachinePool
+	machinePool, err := getOwnerMachinePool(ctx, r.Client, awsFleetMachinePool.ObjectMeta)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if machinePool == nil {
+		log.Info("MachinePool Controller has not yet set OwnerRef")
+		return reconcile.Result{}, nil
+	}
+	log = log.WithValues("machinePool", klog.KObj(machinePool))
+
+	// Fetch the Cluster.
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, machinePool.ObjectMeta)
+	if err != nil {
+		log.Info("MachinePool is missing cluster label or cluster does not exist")
+		return reconcile.Result{}, nil
+	}
+
+	log = log.WithValues("cluster", klog.KObj(cluster))
+
+	infraCluster, s3Scope, err := r.getInfraCluster(ctx, log, cluster, awsFleetMachinePool)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("getting infra provider cluster or control plane object: %w", err)
+	}
+	if infraCluster == nil {
+		log.Info("AWSCluster or AWSManagedControlPlane is not ready yet")
+		return ctrl.Result{}, nil
+	}
+
+	// Return early if the object or Cluster is paused.
+	if annotations.IsPaused(cluster, awsFleetMachinePool) {
+		log.Info("Reconciliation is paused for this object")
+		return ctrl.Result{}, nil
+	}
+
+	// Create the machine pool scope
+	machinePoolScope, err := scope.NewFleetMachinePoolScope(scope.FleetMachinePoolScopeParams{
+		Client:              r.Client,
+		Logger:              log,
+		Cluster:             cluster,
+		MachinePool:         machinePool,
+		InfraCluster:        infraCluster,
+		AWSFleetMachinePool: awsFleetMachinePool,
+	})
+	if err != nil {
+		log.Error(err, "failed to create scope")
+		return ctrl.Result{}, err
+	}
+
+	// Always close the scope when exiting this function so we can persist any AWSFleetMachinePool changes.
+	defer func() {
+		// set Ready condition before AWSFleetMachinePool is patched
+		v1beta1conditions.SetSummary(machinePoolScope.AWSFleetMachinePool,
+			v1beta1conditions.WithConditions(
+				expinfrav1.FleetReadyCondition,
+				expinfrav1.LaunchTemplateReadyCondition,
+			),
+			v1beta1conditions.WithStepCounterIfOnly(
+				expinfrav1.FleetReadyCondition,
+				expinfrav1.LaunchTemplateReadyCondition,
+			),
+		)
+
+		if err := machinePoolScope.Close(); err != nil && reterr == nil {
+			reterr = err
+		}
+	}()
+
+	if !awsFleetMachinePool.ObjectMeta.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.reconcileDelete(ctx, machinePoolScope, infraCluster)
+	}
+
+	return r.reconcileNormal(ctx, machinePoolScope, infraCluster, s3Scope)
+}
+
+func (r *AWSFleetMachinePoolReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&expinfrav1.AWSFleetMachinePool{}).
+		Watches(
+			&clusterv1.MachinePool{},
+			handler.EnqueueRequestsFromMapFunc(machinePoolToInfrastructureMapFunc(expinfrav1.GroupVersion.WithKind("AWSFleetMachinePool"))),
+		).
+		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(mgr.GetScheme(), logger.FromContext(ctx).GetLogger(), r.WatchFilterValue)).
+		WithEventFilter(
+			predicate.Funcs{
+				// Avoid reconciling if the event triggering the reconciliation is related to incremental status updates
+				// for AWSFleetMachinePool resources only
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					if e.ObjectOld.GetObjectKind().GroupVersionKind().Kind != "AWSFleetMachinePool" {
+						return true
+					}
+
+					oldPool := e.ObjectOld.(*expinfrav1.AWSFleetMachinePool).DeepCopy()
+					newPool := e.ObjectNew.(*expinfrav1.AWSFleetMachinePool).DeepCopy()
+
+					oldPool.Status = expinfrav1.AWSFleetMachinePoolStatus{}
+					newPool.Status = expinfrav1.AWSFleetMachinePoolStatus{}
+
+					oldPool.ObjectMeta.ResourceVersion = ""
+					newPool.ObjectMeta.ResourceVersion = ""
+
+					return !cmp.Equal(oldPool, newPool)
+				},
+			},
+		).
+		Complete(r)
+}
+
+func (r *AWSFleetMachinePoolReconciler) reconcileNormal(ctx context.Context, machinePoolScope *scope.FleetMachinePoolScope, ec2Scope scope.EC2Scope, s3Scope scope.S3Scope) (ctrl.Result, error) {
+	machinePoolScope.Info("Reconciling AWSFleetMachinePool")
+
+	// If the AWSFleetMachinePool is in an error state, return early.
+	if machinePoolScope.HasFailed() {
+		machinePoolScope.Info("Error state detected, skipping reconciliation")
+		return ctrl.Result{}, nil
+	}
+
+	// If the AWSFleetMachinePool doesn't have our finalizer, add it
+	if controllerutil.AddFinalizer(machinePoolScope.AWSFleetMachinePool, expinfrav1.FleetMachinePoolFinalizer) {
+		// Register finalizer immediately to avoid orphaning AWS resources
+		if err := machinePoolScope.PatchObject(); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if !ptr.Deref(machinePoolScope.Cluster.Status.Initialization.InfrastructureProvisioned, false) {
+		machinePoolScope.Info("Cluster infrastructure is not ready yet")
+		v1beta1conditions.MarkFalse(machinePoolScope.AWSFleetMachinePool, expinfrav1.FleetReadyCondition, infrav1.WaitingForClusterInfrastructureReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return ctrl.Result{}, nil
+	}
+
+	// Make sure bootstrap data is available and populated
+	if machinePoolScope.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName == nil {
+		machinePoolScope.Info("Bootstrap data secret reference is not yet available")
+		v1beta1conditions.MarkFalse(machinePoolScope.AWSFleetMachinePool, expinfrav1.FleetReadyCondition, infrav1.WaitingForBootstrapDataReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return ctrl.Result{}, nil
+	}
+
+	ec2Svc := r.getEC2Service(ec2Scope)
+	fleetSvc := r.getFleetService(ec2Scope)
+	reconSvc := r.getReconcileService(ec2Scope)
+	objectStoreSvc := r.getObjectStoreService(s3Scope)
+
+	fleet, err := fleetSvc.GetFleet(machinePoolScope)
+	if err != nil {
+		v1beta1conditions.MarkUnknown(machinePoolScope.AWSFleetMachinePool, expinfrav1.FleetReadyCondition, expinfrav1.FleetNotFoundReason, "%s", err.Error())
+		return ctrl.Result{}, err
+	}
+
+	// An EC2 Fleet has no instance refresh: new launch template versions are rolled out to the fleet
+	// by the update below, and only apply to the instances launched afterwards. A fleet that is still
+	// being modified would reject that update, so the launch template isn't changed until it's done.
+	canUpdateLaunchTemplate := func() (bool, *autoscalingtypes.InstanceRefreshStatus, error) {
+		if fleet == nil {
+			return true, nil, nil
+		}
+		return fleet.State != string(ec2types.FleetStateCodeModifying), nil, nil
+	}
+	cancelInstanceRefresh := func() error {
+		return nil
+	}
+	runPostLaunchTemplateUpdateOperation := func() error {
+		return nil
+	}
+	res, err := reconSvc.ReconcileLaunchTemplate(ctx, machinePoolScope, machinePoolScope, s3Scope, ec2Svc, objectStoreSvc, canUpdateLaunchTemplate, cancelInstanceRefresh, runPostLaunchTemplateUpdateOperation)
+	if err != nil {
+		r.Recorder.Eventf(machinePoolScope.AWSFleetMachinePool, corev1.EventTypeWarning, "FailedLaunchTemplateReconcile", "Failed to reconcile launch template: %v", err)
+		machinePoolScope.Error(err, "failed to reconcile launch template")
+		return ctrl.Result{}, err
+	}
+	if res != nil {
+		return *res, nil
+	}
+
+	// set the LaunchTemplateReady condition
+	v1beta1conditions.MarkTrue(machinePoolScope.AWSFleetMachinePool, expinfrav1.LaunchTemplateReadyCondition)
+
+	if fleet == nil {
+		fleetID, err := fleetSvc.CreateFleet(machinePoolScope)
+		if err != nil {
+			v1beta1conditions.MarkFalse(machinePoolScope.AWSFleetMachinePool, expinfrav1.FleetReadyCondition, expinfrav1.FleetProvisionFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+			return ctrl.Result{}, err
+		}
+		machinePoolScope.AWSFleetMachinePool.Status.FleetID = fleetID
+		return ctrl.Result{
+			RequeueAfter: 15 * time.Second,
+		}, nil
+	}
+
+	machinePoolScope.AWSFleetMachinePool.Status.FleetID = fleet.ID
+	machinePoolScope.AWSFleetMachinePool.Status.FleetState = fleet.State
+
+	// A fleet that is being modified rejects further modifications, the next reconciliation picks up the changes.
+	if fleet.State != string(ec2types.FleetStateCodeModifying) {
+		needsUpdate, err := fleetSvc.FleetNeedsUpdate(machinePoolScope, fleet)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if needsUpdate {
+			machinePoolScope.Info("Updating EC2 Fleet", "id", fleet.ID)
+			if err := fleetSvc.UpdateFleet(machinePoolScope, fleet.ID); err != nil {
+				machinePoolScope.Error(err, "error updating AWSFleetMachinePool")
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	launchTemplateID := machinePoolScope.GetLaunchTemplateIDStatus()
+	fleetID := fleet.ID
+	resourceServiceToUpdate := []scope.ResourceServiceToUpdate{
+		{
+			ResourceID:      &launchTemplateID,
+			ResourceService: ec2Svc,
+		},
+		{
+			ResourceID:      &fleetID,
+			ResourceService: ec2Svc,
+		},
+	}
+	err = reconSvc.ReconcileTags(machinePoolScope, resourceServiceToUpdate)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error updating tags")
+	}
+
+	// Make sure Spec.ProviderID is always set.
+	machinePoolScope.AWSFleetMachinePool.Spec.ProviderID = fleet.ID
+	providerIDList := make([]string, len(fleet.Instances))
+	instanceStatuses := make([]expinfrav1.AWSMachinePoolInstanceStatus, len(fleet.Instances))
+
+	for i, instance := range fleet.Instances {
+		providerIDList[i] = fmt.Sprintf("aws:///%s/%s", instance.AvailabilityZone, instance.ID)
+		instanceStatuses[i] = expinfrav1.AWSMachinePoolInstanceStatus{
+			InstanceID: instance.ID,
+		}
+	}
+
+	machinePoolScope.AWSFleetMachinePool.Spec.ProviderIDList = providerIDList
+	machinePoolScope.AWSFleetMachinePool.Status.Instances = instanceStatuses
+	machinePoolScope.AWSFleetMachinePool.Status.Replicas = int32(len(providerIDList)) //#nosec G115
+	machinePoolScope.AWSFleetMachinePool.Status.Ready = true
+	v1beta1conditions.MarkTrue(machinePoolScope.AWSFleetMachinePool, expinfrav1.FleetReadyCondition)
+
+	// The fleet replaces interrupted and unhealthy instances on its own, requeue to keep the
+	// provider IDs of the MachinePool in sync with the instances of the fleet.
+	return ctrl.Result{RequeueAfter: time.Minute}, nil
+}
+
+func (r *AWSFleetMachinePoolReconciler) reconcileDelete(_ context.Context, machinePoolScope *scope.FleetMachinePoolScope, ec2Scope scope.EC2Scope) error {
+	machinePoolScope.Info("Handling deleted AWSFleetMachinePool")
+
+	ec2Svc := r.getEC2Service(ec2Scope)
+	fleetSvc := r.getFleetService(ec2Scope)
+
+	fleet, err := fleetSvc.GetFleet(machinePoolScope)
+	if err != nil {
+		return err
+	}
+
+	if fleet == nil {
+		machinePoolScope.Warn("Unable to locate EC2 Fleet")
+		r.Recorder.Eventf(machinePoolScope.AWSFleetMachinePool, corev1.EventTypeNormal, expinfrav1.FleetNotFoundReason, "Unable to find matching EC2 Fleet")
+	} else {
+		machinePoolScope.SetNotReady()
+		v1beta1conditions.MarkFalse(machinePoolScope.AWSFleetMachinePool, expinfrav1.FleetReadyCondition, expinfrav1.FleetDeletionInProgressReason, clusterv1beta1.ConditionSeverityWarning, "")
+		machinePoolScope.Info("Deleting EC2 Fleet", "id", fleet.ID, "state", fleet.State)
+		if err := fleetSvc.DeleteFleet(fleet.ID); err != nil {
+			r.Recorder.Eventf(machinePoolScope.AWSFleetMachinePool, corev1.EventTypeWarning, "FailedDelete", "Failed to delete EC2 Fleet %q: %v", fleet.ID, err)
+			return errors.Wrap(err, "failed to delete EC2 Fleet")
+		}
+	}
+
+	launchTemplate, _, _, _, err := ec2Svc.GetLaunchTemplate(machinePoolScope.LaunchTemplateName()) //nolint:dogsled
+	if err != nil {
+		return err
+	}
+
+	if launchTemplate == nil {
+		machinePoolScope.Debug("Unable to locate launch template")
+		controllerutil.RemoveFinalizer(machinePoolScope.AWSFleetMachinePool, expinfrav1.FleetMachinePoolFinalizer)
+		return nil
+	}
+
+	launchTemplateID := machinePoolScope.AWSFleetMachinePool.Status.LaunchTemplateID
+	machinePoolScope.Info("deleting launch template", "name", launchTemplate.Name)
+	if err := ec2Svc.DeleteLaunchTemplate(launchTemplateID); err != nil {
+		r.Recorder.Eventf(machinePoolScope.AWSFleetMachinePool, corev1.EventTypeWarning, "FailedDelete", "Failed to delete launch template %q: %v", launchTemplate.Name, err)
+		return errors.Wrap(err, "failed to delete launch template")
+	}
+
+	machinePoolScope.Info("successfully deleted EC2 Fleet and Launch Template")
+
+	// remove finalizer
+	controllerutil.RemoveFinalizer(machinePoolScope.AWSFleetMachinePool, expinfrav1.FleetMachinePoolFinalizer)
+
+	return nil
+}
+
+func (r *AWSFleetMachinePoolReconciler) getInfraCluster(ctx context.Context, log *logger.Logger, cluster *clusterv1.Cluster, awsFleetMachinePool *expinfrav1.AWSFleetMachinePool) (scope.EC2Scope, scope.S3Scope, error) {
+	if cluster.Spec.ControlPlaneRef.IsDefined() && cluster.Spec.ControlPlaneRef.Kind == controllers.AWSManagedControlPlaneRefKind {
+		controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{}
+		controlPlaneName := client.ObjectKey{
+			Namespace: awsFleetMachinePool.Namespace,
+			Name:      cluster.Spec.ControlPlaneRef.Name,
+		}
+
+		if err := r.Get(ctx, controlPlaneName, controlPlane); err != nil {
+			// AWSManagedControlPlane is not ready
+			return nil, nil, nil //nolint:nilerr
+		}
+
+		managedControlPlaneScope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+			Client:                       r.Client,
+			Logger:                       log,
+			Cluster:                      cluster,
+			ControlPlane:                 controlPlane,
+			ControllerName:               "awsManagedControlPlane",
+			TagUnmanagedNetworkResources: r.TagUnmanagedNetworkResources,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return managedControlPlaneScope, managedControlPlaneScope, nil
+	}
+
+	awsCluster := &infrav1.AWSCluster{}
+
+	infraClusterName := client.ObjectKey{
+		Namespace: awsFleetMachinePool.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+
+	if err := r.Client.Get(ctx, infraClusterName, awsCluster); err != nil {
+		// AWSCluster is not ready
+		return nil, nil, nil //nolint:nilerr
+	}
+
+	// Create the cluster scope
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:                       r.Client,
+		Logger:                       log,
+		Cluster:                      cluster,
+		AWSCluster:                   awsCluster,
+		ControllerName:               "awsfleetmachinepool",
+		TagUnmanagedNetworkResources: r.TagUnmanagedNetworkResources,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clusterScope, clusterScope, nil
+}
@@ -0,0 +1,494 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+)
+
+func TestAWSFleetMachinePoolReconciler(t *testing.T) {
+	var (
+		reconciler          AWSFleetMachinePoolReconciler
+		cs                  *scope.ClusterScope
+		ms                  *scope.FleetMachinePoolScope
+		mockCtrl            *gomock.Controller
+		ec2Svc              *mock_services.MockEC2Interface
+		fleetSvc            *mock_services.MockFleetInterface
+		reconSvc            *mock_services.MockMachinePoolReconcileInterface
+		recorder            *record.FakeRecorder
+		awsFleetMachinePool *expinfrav1.AWSFleetMachinePool
+		secret              *corev1.Secret
+	)
+	setup := func(t *testing.T, g *WithT) {
+		t.Helper()
+
+		var err error
+		ctx := context.TODO()
+
+		awsFleetMachinePool = &expinfrav1.AWSFleetMachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-fleet",
+				Namespace: "default",
+			},
+			Spec: expinfrav1.AWSFleetMachinePoolSpec{
+				Overrides: []expinfrav1.Overrides{
+					{
+						InstanceType: "m6a.large",
+					},
+				},
+			},
+		}
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fleet-bootstrap-data",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				"value": []byte("shell-script"),
+			},
+		}
+
+		g.Expect(testEnv.Create(ctx, awsFleetMachinePool)).To(Succeed())
+		g.Expect(testEnv.Create(ctx, secret)).To(Succeed())
+
+		cs, err = setupCluster("test-cluster")
+		g.Expect(err).To(BeNil())
+
+		ms, err = scope.NewFleetMachinePoolScope(
+			scope.FleetMachinePoolScopeParams{
+				Client: testEnv.Client,
+				Cluster: &clusterv1.Cluster{
+					Status: clusterv1.ClusterStatus{
+						Initialization: clusterv1.ClusterInitializationStatus{
+							InfrastructureProvisioned: ptr.To(true),
+						},
+					},
+				},
+				MachinePool: &clusterv1.MachinePool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "mp",
+						Namespace: "default",
+						UID:       "1",
+					},
+					Spec: clusterv1.MachinePoolSpec{
+						ClusterName: "test",
+						Replicas:    ptr.To[int32](2),
+						Template: clusterv1.MachineTemplateSpec{
+							Spec: clusterv1.MachineSpec{
+								ClusterName: "test",
+								Bootstrap: clusterv1.Bootstrap{
+									DataSecretName: ptr.To[string]("fleet-bootstrap-data"),
+								},
+							},
+						},
+					},
+				},
+				InfraCluster:        cs,
+				AWSFleetMachinePool: awsFleetMachinePool,
+			},
+		)
+		g.Expect(err).To(BeNil())
+
+		mockCtrl = gomock.NewController(t)
+		ec2Svc = mock_services.NewMockEC2Interface(mockCtrl)
+		fleetSvc = mock_services.NewMockFleetInterface(mockCtrl)
+		reconSvc = mock_services.NewMockMachinePoolReconcileInterface(mockCtrl)
+
+		// If the test hangs for 9 minutes, increase the value here to the number of events during a reconciliation loop
+		recorder = record.NewFakeRecorder(2)
+
+		reconciler = AWSFleetMachinePoolReconciler{
+			Client: testEnv.Client,
+			ec2ServiceFactory: func(scope.EC2Scope) services.EC2Interface {
+				return ec2Svc
+			},
+			fleetServiceFactory: func(scope.EC2Scope) services.FleetInterface {
+				return fleetSvc
+			},
+			reconcileServiceFactory: func(scope.EC2Scope) services.MachinePoolReconcileInterface {
+				return reconSvc
+			},
+			Recorder: recorder,
+		}
+	}
+
+	teardown := func(t *testing.T, g *WithT) {
+		t.Helper()
+
+		ctx := context.TODO()
+		mpPh, err := patch.NewHelper(awsFleetMachinePool, testEnv)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		awsFleetMachinePool.SetFinalizers([]string{})
+		g.Expect(mpPh.Patch(ctx, awsFleetMachinePool)).To(Succeed())
+		g.Expect(testEnv.Delete(ctx, awsFleetMachinePool)).To(Succeed())
+		g.Expect(testEnv.Delete(ctx, secret)).To(Succeed())
+		mockCtrl.Finish()
+	}
+
+	expectCondition := func(g *WithT, conditionType clusterv1beta1.ConditionType, status corev1.ConditionStatus, reason string) {
+		actual := v1beta1conditions.Get(ms.AWSFleetMachinePool, conditionType)
+		g.Expect(actual).To(Not(BeNil()))
+		g.Expect(actual.Status).To(Equal(status))
+		g.Expect(actual.Reason).To(Equal(reason))
+	}
+
+	t.Run("Reconciling an AWSFleetMachinePool", func(t *testing.T) {
+		t.Run("should exit immediately on an error state", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			er := "CreateError"
+			ms.AWSFleetMachinePool.Status.FailureReason = &er
+			ms.AWSFleetMachinePool.Status.FailureMessage = ptr.To[string]("Couldn't create fleet")
+
+			buf := new(bytes.Buffer)
+			klog.SetOutput(buf)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(buf).To(ContainSubstring("Error state detected, skipping reconciliation"))
+		})
+		t.Run("should add our finalizer to the machinepool", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			ms.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName = nil
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(ms.AWSFleetMachinePool.Finalizers).To(ContainElement(expinfrav1.FleetMachinePoolFinalizer))
+		})
+		t.Run("should exit immediately if cluster infra isn't ready", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			ms.Cluster.Status.Initialization.InfrastructureProvisioned = ptr.To(false)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(BeNil())
+			expectCondition(g, expinfrav1.FleetReadyCondition, corev1.ConditionFalse, infrav1.WaitingForClusterInfrastructureReason)
+		})
+		t.Run("should exit immediately if bootstrap data secret reference isn't available", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			ms.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName = nil
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(BeNil())
+			expectCondition(g, expinfrav1.FleetReadyCondition, corev1.ConditionFalse, infrav1.WaitingForBootstrapDataReason)
+		})
+		t.Run("should return an error when the fleet can't be retrieved", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			expectedErr := errors.New("no connection available ")
+			fleetSvc.EXPECT().GetFleet(gomock.Any()).Return(nil, expectedErr)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
+			expectCondition(g, expinfrav1.FleetReadyCondition, corev1.ConditionUnknown, expinfrav1.FleetNotFoundReason)
+		})
+		t.Run("should return an error when the launch template can't be reconciled", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			expectedErr := errors.New("no connection available ")
+			fleetSvc.EXPECT().GetFleet(gomock.Any()).Return(nil, nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+			fleetSvc.EXPECT().CreateFleet(gomock.Any()).Times(0)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
+			g.Eventually(recorder.Events).Should(Receive(ContainSubstring("FailedLaunchTemplateReconcile")))
+		})
+		t.Run("should create the fleet when it doesn't exist", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			fleetSvc.EXPECT().GetFleet(gomock.Any()).Return(nil, nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			fleetSvc.EXPECT().CreateFleet(gomock.Any()).DoAndReturn(func(scope *scope.FleetMachinePoolScope) (string, error) {
+				g.Expect(scope.Name()).To(Equal("test-fleet"))
+				return "fleet-1", nil
+			})
+
+			res, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(res.RequeueAfter).To(Equal(15 * time.Second))
+			g.Expect(ms.AWSFleetMachinePool.Status.FleetID).To(Equal("fleet-1"))
+			expectCondition(g, expinfrav1.LaunchTemplateReadyCondition, corev1.ConditionTrue, "")
+		})
+		t.Run("should mark the fleet as failed when it can't be created", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			expectedErr := errors.New("invalid fleet request")
+			fleetSvc.EXPECT().GetFleet(gomock.Any()).Return(nil, nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			fleetSvc.EXPECT().CreateFleet(gomock.Any()).Return("", expectedErr)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
+			expectCondition(g, expinfrav1.FleetReadyCondition, corev1.ConditionFalse, expinfrav1.FleetProvisionFailedReason)
+		})
+		t.Run("should update the fleet and set the provider IDs of its instances", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			fleet := &expinfrav1.EC2Fleet{
+				ID:    "fleet-1",
+				State: string(ec2types.FleetStateCodeActive),
+				Instances: []infrav1.Instance{
+					{ID: "i-1", AvailabilityZone: "us-east-1a"},
+					{ID: "i-2", AvailabilityZone: "us-east-1b"},
+				},
+			}
+			fleetSvc.EXPECT().GetFleet(gomock.Any()).Return(fleet, nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			fleetSvc.EXPECT().FleetNeedsUpdate(gomock.Any(), fleet).Return(true, nil)
+			fleetSvc.EXPECT().UpdateFleet(gomock.Any(), "fleet-1").Return(nil)
+			reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
+
+			res, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(res.RequeueAfter).To(Equal(time.Minute))
+			g.Expect(ms.AWSFleetMachinePool.Spec.ProviderID).To(Equal("fleet-1"))
+			g.Expect(ms.AWSFleetMachinePool.Spec.ProviderIDList).To(Equal([]string{"aws:///us-east-1a/i-1", "aws:///us-east-1b/i-2"}))
+			g.Expect(ms.AWSFleetMachinePool.Status.Replicas).To(Equal(int32(2)))
+			g.Expect(ms.AWSFleetMachinePool.Status.Ready).To(BeTrue())
+			expectCondition(g, expinfrav1.FleetReadyCondition, corev1.ConditionTrue, "")
+		})
+		t.Run("should not update the fleet when it doesn't need an update", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			fleet := &expinfrav1.EC2Fleet{
+				ID:    "fleet-1",
+				State: string(ec2types.FleetStateCodeActive),
+			}
+			fleetSvc.EXPECT().GetFleet(gomock.Any()).Return(fleet, nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			fleetSvc.EXPECT().FleetNeedsUpdate(gomock.Any(), fleet).Return(false, nil)
+			fleetSvc.EXPECT().UpdateFleet(gomock.Any(), gomock.Any()).Times(0)
+			reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(ms.AWSFleetMachinePool.Status.Replicas).To(Equal(int32(0)))
+		})
+		t.Run("should scale the fleet to the replicas of the MachinePool", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			ms.MachinePool.Spec.Replicas = ptr.To[int32](5)
+			fleet := &expinfrav1.EC2Fleet{
+				ID:                  "fleet-1",
+				State:               string(ec2types.FleetStateCodeActive),
+				TotalTargetCapacity: 2,
+			}
+			fleetSvc.EXPECT().GetFleet(gomock.Any()).Return(fleet, nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			fleetSvc.EXPECT().FleetNeedsUpdate(gomock.Any(), fleet).DoAndReturn(func(scope *scope.FleetMachinePoolScope, existing *expinfrav1.EC2Fleet) (bool, error) {
+				return scope.TargetCapacity() != existing.TotalTargetCapacity, nil
+			})
+			fleetSvc.EXPECT().UpdateFleet(gomock.Any(), "fleet-1").DoAndReturn(func(scope *scope.FleetMachinePoolScope, _ string) error {
+				g.Expect(scope.TargetCapacity()).To(Equal(int32(5)))
+				return nil
+			})
+			reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(BeNil())
+		})
+		t.Run("should not modify a fleet that is being modified", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			fleet := &expinfrav1.EC2Fleet{
+				ID:    "fleet-1",
+				State: string(ec2types.FleetStateCodeModifying),
+			}
+			fleetSvc.EXPECT().GetFleet(gomock.Any()).Return(fleet, nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ scope.IgnitionScope, _ scope.LaunchTemplateScope, _ scope.S3Scope, _ services.EC2Interface, _ services.ObjectStoreInterface, canUpdateLaunchTemplate func() (bool, *autoscalingtypes.InstanceRefreshStatus, error), _ func() error, _ func() error) (*ctrl.Result, error) {
+					canUpdate, refreshStatus, err := canUpdateLaunchTemplate()
+					g.Expect(err).To(BeNil())
+					g.Expect(refreshStatus).To(BeNil())
+					g.Expect(canUpdate).To(BeFalse())
+					return &ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+				})
+			fleetSvc.EXPECT().FleetNeedsUpdate(gomock.Any(), gomock.Any()).Times(0)
+			fleetSvc.EXPECT().UpdateFleet(gomock.Any(), gomock.Any()).Times(0)
+
+			res, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(res.RequeueAfter).To(Equal(30 * time.Second))
+		})
+		t.Run("should allow launch template updates before the fleet is created", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			fleetSvc.EXPECT().GetFleet(gomock.Any()).Return(nil, nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ scope.IgnitionScope, _ scope.LaunchTemplateScope, _ scope.S3Scope, _ services.EC2Interface, _ services.ObjectStoreInterface, canUpdateLaunchTemplate func() (bool, *autoscalingtypes.InstanceRefreshStatus, error), _ func() error, _ func() error) (*ctrl.Result, error) {
+					canUpdate, _, err := canUpdateLaunchTemplate()
+					g.Expect(err).To(BeNil())
+					g.Expect(canUpdate).To(BeTrue())
+					return nil, nil
+				})
+			fleetSvc.EXPECT().CreateFleet(gomock.Any()).Return("fleet-1", nil)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(BeNil())
+		})
+	})
+
+	t.Run("Deleting an AWSFleetMachinePool", func(t *testing.T) {
+		finalizer := func(t *testing.T, g *WithT) {
+			t.Helper()
+
+			ms.AWSFleetMachinePool.Finalizers = []string{
+				expinfrav1.FleetMachinePoolFinalizer,
+				metav1.FinalizerDeleteDependents,
+			}
+		}
+		t.Run("should exit immediately on an error state", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+			finalizer(t, g)
+
+			expectedErr := errors.New("no connection available ")
+			fleetSvc.EXPECT().GetFleet(gomock.Any()).Return(nil, expectedErr)
+
+			err := reconciler.reconcileDelete(context.Background(), ms, cs)
+			g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
+			g.Expect(ms.AWSFleetMachinePool.Finalizers).To(ContainElement(expinfrav1.FleetMachinePoolFinalizer))
+		})
+		t.Run("should log and remove finalizer when no fleet exists", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+			finalizer(t, g)
+
+			fleetSvc.EXPECT().GetFleet(gomock.Any()).Return(nil, nil)
+			ec2Svc.EXPECT().GetLaunchTemplate(gomock.Any()).Return(nil, "", nil, nil, nil)
+
+			buf := new(bytes.Buffer)
+			klog.SetOutput(buf)
+
+			err := reconciler.reconcileDelete(context.Background(), ms, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(buf.String()).To(ContainSubstring("Unable to locate EC2 Fleet"))
+			g.Expect(ms.AWSFleetMachinePool.Finalizers).To(ConsistOf(metav1.FinalizerDeleteDependents))
+			g.Eventually(recorder.Events).Should(Receive(ContainSubstring(expinfrav1.FleetNotFoundReason)))
+		})
+		t.Run("should delete the fleet and the launch template", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+			finalizer(t, g)
+
+			ms.AWSFleetMachinePool.Status.LaunchTemplateID = "lt-1"
+			fleetSvc.EXPECT().GetFleet(gomock.Any()).Return(&expinfrav1.EC2Fleet{
+				ID:    "fleet-1",
+				State: string(ec2types.FleetStateCodeActive),
+			}, nil)
+			fleetSvc.EXPECT().DeleteFleet("fleet-1").Return(nil)
+			ec2Svc.EXPECT().GetLaunchTemplate(gomock.Eq("test-fleet")).Return(&expinfrav1.AWSLaunchTemplate{Name: "test-fleet"}, "", nil, nil, nil)
+			ec2Svc.EXPECT().DeleteLaunchTemplate("lt-1").Return(nil)
+
+			err := reconciler.reconcileDelete(context.Background(), ms, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(ms.AWSFleetMachinePool.Status.Ready).To(BeFalse())
+			g.Expect(ms.AWSFleetMachinePool.Finalizers).To(ConsistOf(metav1.FinalizerDeleteDependents))
+			expectCondition(g, expinfrav1.FleetReadyCondition, corev1.ConditionFalse, expinfrav1.FleetDeletionInProgressReason)
+		})
+		t.Run("should keep the finalizer when the fleet can't be deleted", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+			finalizer(t, g)
+
+			expectedErr := errors.New("no connection available ")
+			fleetSvc.EXPECT().GetFleet(gomock.Any()).Return(&expinfrav1.EC2Fleet{ID: "fleet-1"}, nil)
+			fleetSvc.EXPECT().DeleteFleet("fleet-1").Return(expectedErr)
+			ec2Svc.EXPECT().DeleteLaunchTemplate(gomock.Any()).Times(0)
+
+			err := reconciler.reconcileDelete(context.Background(), ms, cs)
+			g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
+			g.Expect(ms.AWSFleetMachinePool.Finalizers).To(ContainElement(expinfrav1.FleetMachinePoolFinalizer))
+			g.Eventually(recorder.Events).Should(Receive(ContainSubstring("FailedDelete")))
+		})
+		t.Run("should keep the finalizer when the launch template can't be deleted", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+			finalizer(t, g)
+
+			expectedErr := errors.New("no connection available ")
+			ms.AWSFleetMachinePool.Status.LaunchTemplateID = "lt-1"
+			fleetSvc.EXPECT().GetFleet(gomock.Any()).Return(nil, nil)
+			ec2Svc.EXPECT().GetLaunchTemplate(gomock.Any()).Return(&expinfrav1.AWSLaunchTemplate{Name: "test-fleet"}, "", nil, nil, nil)
+			ec2Svc.EXPECT().DeleteLaunchTemplate("lt-1").Return(expectedErr)
+
+			err := reconciler.reconcileDelete(context.Background(), ms, cs)
+			g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
+			g.Expect(ms.AWSFleetMachinePool.Finalizers).To(ContainElement(expinfrav1.FleetMachinePoolFinalizer))
+		})
+	})
+}
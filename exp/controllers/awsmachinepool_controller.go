@@ -62,6 +62,10 @@ import (
 	"sigs.k8s.io/cluster-api/util/predicates"
 )
 
+// warmPoolDeletionRequeueAfter is how often an AWSMachinePool is reconciled while its warm pool is
+// deleted to replace its instances.
+const warmPoolDeletionRequeueAfter = 30 * time.Second
+
 // AWSMachinePoolReconciler reconciles a AWSMachinePool object.
 type AWSMachinePoolReconciler struct {
 	client.Client
@@ -341,7 +345,20 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		// Launch Template version, and the difference between the older and current versions is _more_
 		// than userdata, we should start an Instance Refresh.
 		machinePoolScope.Info("starting instance refresh", "number of instances", machinePoolScope.MachinePool.Spec.Replicas)
-		return asgsvc.StartASGInstanceRefresh(machinePoolScope)
+		if err := asgsvc.StartASGInstanceRefresh(machinePoolScope); err != nil {
+			return err
+		}
+		// The instance refresh only replaces the instances of the group. Stopped or hibernated instances in the warm
+		// pool keep the launch template version they were launched with and would join the group stale on scale out,
+		// so the warm pool is deleted and recreated once the deletion completes.
+		if asg.WarmPool != nil && asg.WarmPoolStatus != string(autoscalingtypes.WarmPoolStatusPendingDelete) {
+			machinePoolScope.Info("deleting warm pool to replace its instances")
+			if err := asgsvc.DeleteWarmPool(ctx, asg.Name); err != nil {
+				return err
+			}
+			asg.WarmPoolStatus = string(autoscalingtypes.WarmPoolStatusPendingDelete)
+		}
+		return nil
 	}
 	res, err := reconSvc.ReconcileLaunchTemplate(ctx, machinePoolScope, machinePoolScope, s3Scope, ec2Svc, objectStoreSvc, canStartInstanceRefresh, cancelInstanceRefresh, runPostLaunchTemplateUpdateOperation)
 	if err != nil {
@@ -391,7 +408,17 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile lifecycle hooks")
 	}
 
-	if annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+	if err := r.reconcileWarmPool(ctx, machinePoolScope, asgsvc, asg); err != nil {
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedWarmPoolReconcile", "Failed to reconcile warm pool: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile warm pool")
+	}
+
+	if err := r.reconcileScalingPolicies(ctx, machinePoolScope, asgsvc); err != nil {
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedScalingPoliciesReconcile", "Failed to reconcile scaling policies: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile scaling policies")
+	}
+
+	if machinePoolScope.ReplicasManagedExternally() {
 		// Set MachinePool replicas to the ASG DesiredCapacity
 		if *machinePoolScope.MachinePool.Spec.Replicas != *asg.DesiredCapacity {
 			machinePoolScope.Info("Setting MachinePool replicas to ASG DesiredCapacity",
@@ -446,6 +473,11 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		machinePoolScope.Error(err, "failed updating instances", "instances", asg.Instances)
 	}
 
+	if machinePoolScope.AWSMachinePool.Spec.WarmPool != nil && asg.WarmPoolStatus == string(autoscalingtypes.WarmPoolStatusPendingDelete) {
+		// The warm pool is recreated once its deletion completes, which doesn't trigger a reconcile.
+		return ctrl.Result{RequeueAfter: warmPoolDeletionRequeueAfter}, nil
+	}
+
 	if feature.Gates.Enabled(feature.MachinePoolMachines) {
 		return ctrl.Result{
 			// Regularly update `AWSMachine` objects, for example if ASG was scaled or refreshed instances
@@ -635,7 +667,7 @@ func (r *AWSMachinePoolReconciler) findASG(machinePoolScope *scope.MachinePoolSc
 func diffASG(machinePoolScope *scope.MachinePoolScope, existingASG *expinfrav1.AutoScalingGroup) string {
 	detectedMachinePoolSpec := machinePoolScope.MachinePool.Spec.DeepCopy()
 
-	if !annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+	if !machinePoolScope.ReplicasManagedExternally() {
 		detectedMachinePoolSpec.Replicas = existingASG.DesiredCapacity
 	}
 	if diff := cmp.Diff(machinePoolScope.MachinePool.Spec, *detectedMachinePoolSpec); diff != "" {
@@ -655,8 +687,16 @@ func diffASG(machinePoolScope *scope.MachinePoolScope, existingASG *expinfrav1.A
 			mixedInstancesPolicy = machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy.DeepCopy()
 			mixedInstancesPolicy.InstancesDistribution = existingASG.MixedInstancesPolicy.InstancesDistribution
 		}
+		// Instance requirements on the policy are a shorthand for a single override,
+		// which is how AWS reports them.
+		if mixedInstancesPolicy != nil && mixedInstancesPolicy.InstanceRequirements != nil {
+			mixedInstancesPolicy = mixedInstancesPolicy.DeepCopy()
+			mixedInstancesPolicy.Overrides = append(mixedInstancesPolicy.Overrides, expinfrav1.Overrides{InstanceRequirements: mixedInstancesPolicy.InstanceRequirements})
+			mixedInstancesPolicy.InstanceRequirements = nil
+		}
 
-		if !cmp.Equal(mixedInstancesPolicy, existingASG.MixedInstancesPolicy) {
+		// AWS returns empty lists for the unset lists of instance requirements.
+		if !cmp.Equal(mixedInstancesPolicy, existingASG.MixedInstancesPolicy, cmpopts.EquateEmpty()) {
 			detectedAWSMachinePoolSpec.MixedInstancesPolicy = existingASG.MixedInstancesPolicy
 		}
 	}
@@ -723,6 +763,45 @@ func (r *AWSMachinePoolReconciler) reconcileLifecycleHooks(ctx context.Context,
 	return asg.ReconcileLifecycleHooks(ctx, asgsvc, asgName, machinePoolScope.GetLifecycleHooks(), map[string]bool{}, machinePoolScope.GetMachinePool(), machinePoolScope)
 }
 
+// reconcileWarmPool reconciles the warm pool of the ASG and records its instances in the AWSMachinePool status.
+// Warm pool instances are not part of the ASG capacity, so they are never added to the ProviderIDList.
+func (r *AWSMachinePoolReconciler) reconcileWarmPool(ctx context.Context, machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface, existingASG *expinfrav1.AutoScalingGroup) error {
+	warmPoolStatus, err := asg.ReconcileWarmPool(ctx, asgsvc, existingASG, machinePoolScope.AWSMachinePool.Spec.WarmPool, machinePoolScope.AWSMachinePool, machinePoolScope)
+	if err != nil {
+		return err
+	}
+
+	machinePoolScope.AWSMachinePool.Status.WarmPool = warmPoolStatus
+	return nil
+}
+
+// reconcileScalingPolicies reconciles the scaling policies and scheduled actions of the ASG and records
+// the ones created by CAPA in the AWSMachinePool status, so that they can be deleted once removed from the spec.
+func (r *AWSMachinePoolReconciler) reconcileScalingPolicies(ctx context.Context, machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface) error {
+	asgName := machinePoolScope.Name()
+	awsMachinePool := machinePoolScope.AWSMachinePool
+
+	managedPolicies, err := asg.ReconcileScalingPolicies(ctx, asgsvc, asgName, awsMachinePool.Spec.ScalingPolicies, awsMachinePool.Status.ScalingPolicies, awsMachinePool, machinePoolScope)
+	awsMachinePool.Status.ScalingPolicies = managedPolicies
+	if err != nil {
+		return err
+	}
+
+	managedActions, err := asg.ReconcileScheduledActions(ctx, asgsvc, asgName, awsMachinePool.Spec.ScheduledActions, awsMachinePool.Status.ScheduledActions, awsMachinePool, machinePoolScope)
+	awsMachinePool.Status.ScheduledActions = managedActions
+	if err != nil {
+		return err
+	}
+
+	if len(managedPolicies) == 0 && len(managedActions) == 0 {
+		v1beta1conditions.Delete(awsMachinePool, expinfrav1.ScalingPoliciesReadyCondition)
+		return nil
+	}
+
+	v1beta1conditions.MarkTrue(awsMachinePool, expinfrav1.ScalingPoliciesReadyCondition)
+	return nil
+}
+
 func (r *AWSMachinePoolReconciler) getInfraCluster(ctx context.Context, log *logger.Logger, cluster *clusterv1.Cluster, awsMachinePool *expinfrav1.AWSMachinePool) (scope.EC2Scope, scope.S3Scope, error) {
 	var clusterScope *scope.ClusterScope
 	var managedControlPlaneScope *scope.ManagedControlPlaneScope
@@ -853,6 +853,73 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				g.Expect(err).To(Succeed())
 			})
 
+			t.Run("launch template and ASG with a warm pool exist and only AMI ID changed", func(t *testing.T) {
+				g := NewWithT(t)
+				setup(t, g)
+				reconciler.reconcileServiceFactory = nil // use real implementation, but keep EC2 calls mocked (`ec2ServiceFactory`)
+				reconSvc = nil                           // not used
+				defer teardown(t, g)
+
+				// Warm pools can't be combined with a mixed instances policy
+				ms.AWSMachinePool.Spec.MixedInstancesPolicy = nil
+				ms.AWSMachinePool.Spec.WarmPool = &expinfrav1.WarmPool{}
+
+				// Latest ID and version already stored, no need to retrieve it
+				ms.AWSMachinePool.Status.LaunchTemplateID = launchTemplateIDExisting
+				ms.AWSMachinePool.Status.LaunchTemplateVersion = ptr.To[string]("1")
+
+				ec2Svc.EXPECT().GetLaunchTemplate(gomock.Eq("test")).Return(
+					&expinfrav1.AWSLaunchTemplate{
+						Name: "test",
+						AMI: infrav1.AMIReference{
+							ID: ptr.To[string]("ami-existing"),
+						},
+					},
+					// No change to user data
+					userdata.ComputeHash([]byte("shell-script")),
+					&userDataSecretKey,
+					nil,
+					nil)
+				ec2Svc.EXPECT().DiscoverLaunchTemplateAMI(gomock.Any(), gomock.Any()).Return(ptr.To[string]("ami-different"), nil)
+				ec2Svc.EXPECT().LaunchTemplateNeedsUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+				asgSvc.EXPECT().CanStartASGInstanceRefresh(gomock.Any()).Return(true, nil, nil)
+				ec2Svc.EXPECT().PruneLaunchTemplateVersions(gomock.Any()).Return(nil, nil)
+				ec2Svc.EXPECT().CreateLaunchTemplateVersion(gomock.Any(), gomock.Any(), gomock.Eq(ptr.To[string]("ami-different")), gomock.Eq(apimachinerytypes.NamespacedName{Namespace: "default", Name: "bootstrap-data"}), gomock.Any(), gomock.Any()).Return(nil)
+				ec2Svc.EXPECT().GetLaunchTemplateLatestVersion(gomock.Any()).Return("2", nil)
+				// AMI change should trigger rolling out new nodes, including the instances of the warm pool
+				asgSvc.EXPECT().StartASGInstanceRefresh(gomock.Any())
+				asgSvc.EXPECT().DeleteWarmPool(gomock.Any(), "test").Return(nil)
+				// The warm pool must not be updated while it is being deleted
+				asgSvc.EXPECT().PutWarmPool(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				asgSvc.EXPECT().DescribeWarmPool(gomock.Any(), "test").Return(&expinfrav1.WarmPoolStatus{
+					Status: string(autoscalingtypes.WarmPoolStatusPendingDelete),
+				}, nil)
+
+				asgSvc.EXPECT().GetASGByName(gomock.Any()).DoAndReturn(func(scope *scope.MachinePoolScope) (*expinfrav1.AutoScalingGroup, error) {
+					g.Expect(scope.Name()).To(Equal("test"))
+
+					// No difference to `AWSMachinePool.spec`
+					return &expinfrav1.AutoScalingGroup{
+						Name: scope.Name(),
+						Subnets: []string{
+							"subnet-1",
+						},
+						MinSize:  awsMachinePool.Spec.MinSize,
+						MaxSize:  awsMachinePool.Spec.MaxSize,
+						WarmPool: &expinfrav1.WarmPool{},
+					}, nil
+				})
+				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
+				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet-1"}, nil) // no change
+				// No changes, so there must not be an ASG update!
+				asgSvc.EXPECT().UpdateASG(gomock.Any()).Times(0)
+
+				res, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs)
+				g.Expect(err).To(Succeed())
+				// The warm pool is recreated once its deletion completes
+				g.Expect(res.RequeueAfter).To(Equal(warmPoolDeletionRequeueAfter))
+			})
+
 			t.Run("launch template and ASG exist and only bootstrap data secret name changed", func(t *testing.T) {
 				g := NewWithT(t)
 				setup(t, g)
@@ -1464,6 +1531,7 @@ func TestDiffASG(t *testing.T) {
 							Replicas: ptr.To[int32](0),
 						},
 					},
+					AWSMachinePool: &expinfrav1.AWSMachinePool{},
 				},
 				existingASG: &expinfrav1.AutoScalingGroup{
 					DesiredCapacity: ptr.To[int32](1),
@@ -1480,6 +1548,7 @@ func TestDiffASG(t *testing.T) {
 							Replicas: nil,
 						},
 					},
+					AWSMachinePool: &expinfrav1.AWSMachinePool{},
 				},
 				existingASG: &expinfrav1.AutoScalingGroup{
 					DesiredCapacity: ptr.To[int32](1),
@@ -1496,6 +1565,7 @@ func TestDiffASG(t *testing.T) {
 							Replicas: ptr.To[int32](0),
 						},
 					},
+					AWSMachinePool: &expinfrav1.AWSMachinePool{},
 				},
 				existingASG: &expinfrav1.AutoScalingGroup{
 					DesiredCapacity: nil,
@@ -1788,6 +1858,52 @@ func TestDiffASG(t *testing.T) {
 			},
 			wantDifference: false,
 		},
+		{
+			name: "instance requirements match when AWS returns empty lists",
+			args: args{
+				machinePoolScope: &scope.MachinePoolScope{
+					MachinePool: &clusterv1.MachinePool{
+						Spec: clusterv1.MachinePoolSpec{
+							Replicas: ptr.To[int32](1),
+						},
+					},
+					AWSMachinePool: &expinfrav1.AWSMachinePool{
+						Spec: expinfrav1.AWSMachinePoolSpec{
+							MaxSize: 2,
+							MixedInstancesPolicy: &expinfrav1.MixedInstancesPolicy{
+								InstancesDistribution: &expinfrav1.InstancesDistribution{
+									OnDemandAllocationStrategy: expinfrav1.OnDemandAllocationStrategyPrioritized,
+								},
+								InstanceRequirements: &expinfrav1.InstanceRequirements{
+									VCPUCount: expinfrav1.IntegerRange{Min: 2},
+									MemoryMiB: expinfrav1.IntegerRange{Min: 4096},
+								},
+							},
+						},
+					},
+				},
+				existingASG: &expinfrav1.AutoScalingGroup{
+					DesiredCapacity: ptr.To[int32](1),
+					MaxSize:         2,
+					MixedInstancesPolicy: &expinfrav1.MixedInstancesPolicy{
+						InstancesDistribution: &expinfrav1.InstancesDistribution{
+							OnDemandAllocationStrategy: expinfrav1.OnDemandAllocationStrategyPrioritized,
+						},
+						Overrides: []expinfrav1.Overrides{{
+							InstanceRequirements: &expinfrav1.InstanceRequirements{
+								VCPUCount:             expinfrav1.IntegerRange{Min: 2},
+								MemoryMiB:             expinfrav1.IntegerRange{Min: 4096},
+								CPUManufacturers:      []expinfrav1.CPUManufacturer{},
+								InstanceGenerations:   []string{},
+								AllowedInstanceTypes:  []string{},
+								ExcludedInstanceTypes: []string{},
+							},
+						}},
+					},
+				},
+			},
+			wantDifference: false,
+		},
 		{
 			name: "externally managed annotation ignores difference between desiredCapacity and replicas",
 			args: args{
@@ -21,14 +21,16 @@ package instancestate
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"sync"
-	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -55,10 +57,19 @@ type AwsInstanceStateReconciler struct {
 	sqsServiceFactory func() instancestate.SQSAPI
 	queueURLs         sync.Map
 	WatchFilterValue  string
+
+	// DeleteMachineOnInterruption deletes the Machine owning an instance that received a spot interruption
+	// warning or a scheduled maintenance notice, so that its replacement starts before the instance goes away.
+	DeleteMachineOnInterruption bool
+
+	workloadClientFactory func(ctx context.Context, cluster client.ObjectKey) (client.Client, error)
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters,verbs=get;list;watch
-// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 func (r *AwsInstanceStateReconciler) getSQSService(region string) (instancestate.SQSAPI, error) {
 	if r.sqsServiceFactory != nil {
@@ -111,9 +122,9 @@ func (r *AwsInstanceStateReconciler) Reconcile(ctx context.Context, req ctrl.Req
 }
 
 func (r *AwsInstanceStateReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
-	go func() {
-		r.watchQueuesForInstanceEvents()
-	}()
+	if err := mgr.Add(newQueueConsumer(r)); err != nil {
+		return errors.Wrap(err, "failed to add queue consumer to manager")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1.AWSCluster{}).
 		Named("awsinstancestate").
@@ -122,95 +133,89 @@ func (r *AwsInstanceStateReconciler) SetupWithManager(ctx context.Context, mgr c
 		Complete(r)
 }
 
-func (r *AwsInstanceStateReconciler) watchQueuesForInstanceEvents() {
-	ctx := context.TODO()
-	awsClusterList := &infrav1.AWSClusterList{}
-	if err := r.Client.List(ctx, awsClusterList); err == nil {
-		for i, cluster := range awsClusterList.Items {
-			if URL, err := r.getQueueURL(ctx, &awsClusterList.Items[i]); err == nil {
-				r.queueURLs.Store(cluster.Name, queueParams{region: cluster.Spec.Region, URL: URL})
-			}
-		}
+// processMessageBody decodes an EventBridge event received from a queue and processes it.
+func (r *AwsInstanceStateReconciler) processMessageBody(ctx context.Context, body string) error {
+	m := message{}
+	if err := json.Unmarshal([]byte(body), &m); err != nil {
+		return errors.Wrap(err, "unable to unmarshal message")
+	}
+	return r.processMessage(ctx, m)
+}
+
+// processMessage triggers a reconcile on an AWSMachine if its EC2 instance state changed, and handles
+// the interruption of its EC2 instance.
+func (r *AwsInstanceStateReconciler) processMessage(ctx context.Context, msg message) error {
+	if msg.MessageDetail == nil {
+		return nil
 	}
-	for range time.Tick(1 * time.Second) {
-		// go through each cluster and check for messages on its queue
-		r.queueURLs.Range(func(key, val interface{}) bool {
-			go func() {
-				qp := val.(queueParams)
-				sqsSvs, err := r.getSQSService(qp.region)
-				if err != nil {
-					r.Log.Error(err, "unable to create SQS client")
-					return
-				}
-				resp, err := sqsSvs.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{QueueUrl: aws.String(qp.URL)})
-				if err != nil {
-					r.Log.Error(err, "failed to receive messages")
-					return
-				}
-				for _, msg := range resp.Messages {
-					m := message{}
-					err := json.Unmarshal([]byte(*msg.Body), &m)
-
-					if err != nil {
-						r.Log.Error(err, "unable to marshall")
-						return
-					}
-					// TODO: handle errors during process message. We currently deletes the message regardless.
-					r.processMessage(ctx, m)
-
-					_, err = sqsSvs.DeleteMessage(ctx, &sqs.DeleteMessageInput{
-						QueueUrl:      aws.String(qp.URL),
-						ReceiptHandle: msg.ReceiptHandle,
-					})
-
-					if err != nil {
-						r.Log.Error(err, "error deleting message", "queueURL", qp.URL, "messageReceiptHandle", msg.ReceiptHandle)
-					}
-				}
-			}()
-
-			return true
+
+	switch {
+	case msg.Source == "aws.ec2" && msg.DetailType == instancestate.Ec2StateChangeNotification:
+		return r.processStateChange(ctx, msg.MessageDetail)
+	case msg.Source == "aws.ec2" && msg.DetailType == instancestate.Ec2SpotInstanceInterruptionWarning:
+		return r.handleInterruption(ctx, interruption{
+			instanceID: msg.MessageDetail.InstanceID,
+			reason:     infrav1.SpotInstanceInterruptionReason,
+			message:    fmt.Sprintf("Spot instance interruption warning received, instance action is %q", msg.MessageDetail.InstanceAction),
 		})
+	case msg.Source == "aws.ec2" && msg.DetailType == instancestate.Ec2InstanceRebalanceRecommendation:
+		return r.handleInterruption(ctx, interruption{
+			instanceID: msg.MessageDetail.InstanceID,
+			reason:     infrav1.InstanceRebalanceRecommendationReason,
+			message:    "Instance rebalance recommendation received, the instance is at elevated risk of interruption",
+		})
+	case msg.Source == "aws.health" && msg.DetailType == instancestate.AWSHealthEvent:
+		if msg.MessageDetail.Service != "EC2" || msg.MessageDetail.EventTypeCategory != "scheduledChange" {
+			return nil
+		}
+		errs := []error{}
+		for _, entity := range msg.MessageDetail.AffectedEntities {
+			errs = append(errs, r.handleInterruption(ctx, interruption{
+				instanceID: entity.EntityValue,
+				reason:     infrav1.InstanceScheduledMaintenanceReason,
+				message:    fmt.Sprintf("Scheduled maintenance event %q received", msg.MessageDetail.EventTypeCode),
+			}))
+		}
+		return kerrors.NewAggregate(errs)
 	}
+	return nil
 }
 
-// processMessage triggers a reconcile on an AWSMachine if its EC2 instance state changed.
-func (r *AwsInstanceStateReconciler) processMessage(ctx context.Context, msg message) {
-	if msg.Source != "aws.ec2" || msg.DetailType != instancestate.Ec2StateChangeNotification || msg.MessageDetail == nil {
-		return
+func (r *AwsInstanceStateReconciler) handleInterruption(ctx context.Context, in interruption) error {
+	if in.instanceID == "" {
+		return nil
 	}
+	return errors.Wrapf(r.processInterruption(ctx, in), "unable to process %s of instance %q", in.reason, in.instanceID)
+}
 
+func (r *AwsInstanceStateReconciler) processStateChange(ctx context.Context, detail *messageDetail) error {
 	// Fetch the awsMachine instance by InstanceID
 	awsMachines := &infrav1.AWSMachineList{}
-	err := r.List(ctx, awsMachines, client.MatchingFields{controllers.InstanceIDIndex: msg.MessageDetail.InstanceID})
+	if err := r.List(ctx, awsMachines, client.MatchingFields{controllers.InstanceIDIndex: detail.InstanceID}); err != nil {
+		return errors.Wrapf(err, "unable to list machines by instance ID %q", detail.InstanceID)
+	}
 
+	if len(awsMachines.Items) == 0 {
+		return nil
+	}
+	machine := awsMachines.Items[0]
+	if !machine.ObjectMeta.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	patchHelper, err := patch.NewHelper(&machine, r.Client)
 	if err != nil {
-		r.Log.Error(err, "unable to list machines by instance ID", "instanceID", msg.MessageDetail.InstanceID)
+		return errors.Wrap(err, "unable to create patch helper")
+	}
+	// Trigger an update on the machine
+	labels := machine.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
 	}
 
-	if len(awsMachines.Items) > 0 {
-		machine := awsMachines.Items[0]
-		if !machine.ObjectMeta.DeletionTimestamp.IsZero() {
-			return
-		}
-		patchHelper, err := patch.NewHelper(&machine, r.Client)
-		if err != nil {
-			r.Log.Error(err, "unable to create patch helper")
-		}
-		// Trigger an update on the machine
-		labels := machine.GetLabels()
-		if labels == nil {
-			labels = make(map[string]string)
-		}
-
-		labels[Ec2InstanceStateLabelKey] = string(msg.MessageDetail.State)
-		machine.SetLabels(labels)
+	labels[Ec2InstanceStateLabelKey] = string(detail.State)
+	machine.SetLabels(labels)
 
-		err = patchHelper.Patch(ctx, &machine)
-		if err != nil {
-			r.Log.Error(err, "unable to patch AWS machine")
-		}
-	}
+	return errors.Wrap(patchHelper.Patch(ctx, &machine), "unable to patch AWS machine")
 }
 
 // getQueueURL retrieves the SQS queue URL for a given cluster.
@@ -249,6 +254,17 @@ type message struct {
 }
 
 type messageDetail struct {
-	InstanceID string                `json:"instance-id,omitempty"`
-	State      infrav1.InstanceState `json:"state,omitempty"`
+	InstanceID     string                `json:"instance-id,omitempty"`
+	State          infrav1.InstanceState `json:"state,omitempty"`
+	InstanceAction string                `json:"instance-action,omitempty"`
+
+	// Fields of AWS Health events.
+	Service           string           `json:"service,omitempty"`
+	EventTypeCode     string           `json:"eventTypeCode,omitempty"`
+	EventTypeCategory string           `json:"eventTypeCategory,omitempty"`
+	AffectedEntities  []affectedEntity `json:"affectedEntities,omitempty"`
+}
+
+type affectedEntity struct {
+	EntityValue string `json:"entityValue,omitempty"`
 }
@@ -65,7 +65,7 @@ func TestAWSInstanceStateController(t *testing.T) {
 			Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("aws-cluster-2-url")}, nil)
 		sqsSvs.EXPECT().GetQueueUrl(gomock.Any(), &sqs.GetQueueUrlInput{QueueName: aws.String("aws-cluster-3-queue")}).AnyTimes().
 			Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("aws-cluster-3-url")}, nil)
-		sqsSvs.EXPECT().ReceiveMessage(gomock.Any(), &sqs.ReceiveMessageInput{QueueUrl: aws.String("aws-cluster-1-url")}).AnyTimes().
+		sqsSvs.EXPECT().ReceiveMessage(gomock.Any(), receiveMessageInput("aws-cluster-1-url")).AnyTimes().
 			DoAndReturn(func(ctx context.Context, arg *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
 				m := &infrav1.AWSMachine{}
 				lookupKey := types.NamespacedName{
@@ -86,9 +86,9 @@ func TestAWSInstanceStateController(t *testing.T) {
 				return &sqs.ReceiveMessageOutput{Messages: []sqstypes.Message{}}, nil
 			})
 
-		sqsSvs.EXPECT().ReceiveMessage(gomock.Any(), &sqs.ReceiveMessageInput{QueueUrl: aws.String("aws-cluster-2-url")}).AnyTimes().
+		sqsSvs.EXPECT().ReceiveMessage(gomock.Any(), receiveMessageInput("aws-cluster-2-url")).AnyTimes().
 			Return(&sqs.ReceiveMessageOutput{Messages: []sqstypes.Message{}}, nil)
-		sqsSvs.EXPECT().ReceiveMessage(gomock.Any(), &sqs.ReceiveMessageInput{QueueUrl: aws.String("aws-cluster-3-url")}).AnyTimes().
+		sqsSvs.EXPECT().ReceiveMessage(gomock.Any(), receiveMessageInput("aws-cluster-3-url")).AnyTimes().
 			Return(&sqs.ReceiveMessageOutput{Messages: []sqstypes.Message{}}, nil)
 		sqsSvs.EXPECT().DeleteMessage(gomock.Any(), &sqs.DeleteMessageInput{QueueUrl: aws.String("aws-cluster-1-url"), ReceiptHandle: aws.String("message-receipt-handle")}).AnyTimes().
 			Return(nil, nil)
@@ -0,0 +1,251 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
+)
+
+const (
+	// receiveWaitTime is the long polling duration of ReceiveMessage calls, the maximum allowed by SQS.
+	receiveWaitTime = 20 * time.Second
+	// maxMessagesPerReceive is the maximum number of messages returned by a ReceiveMessage call.
+	maxMessagesPerReceive = 10
+	// visibilityTimeout hides a received message from the other consumers while it is processed.
+	// It is extended for as long as the message is being processed.
+	visibilityTimeout = 30 * time.Second
+	// processingTimeout bounds the processing of a single message, including during shutdown.
+	processingTimeout = 2 * time.Minute
+	// retryInterval is the delay before retrying to receive messages after a failure.
+	retryInterval = 5 * time.Second
+	// workerSyncInterval is the interval at which workers are started and stopped to match the tracked queues.
+	workerSyncInterval = 5 * time.Second
+)
+
+// queueConsumer runs a worker per tracked queue, each receiving messages in batches and processing them
+// before receiving the next batch. Messages are only deleted once successfully processed, the others
+// become visible again and end up in the dead-letter queue after instancestate.DeadLetterQueueMaxReceiveCount
+// attempts.
+type queueConsumer struct {
+	reconciler *AwsInstanceStateReconciler
+	log        logr.Logger
+
+	workers map[string]*queueWorker
+	wg      sync.WaitGroup
+}
+
+type queueWorker struct {
+	params queueParams
+	cancel context.CancelFunc
+}
+
+var _ manager.LeaderElectionRunnable = &queueConsumer{}
+
+func newQueueConsumer(r *AwsInstanceStateReconciler) *queueConsumer {
+	return &queueConsumer{
+		reconciler: r,
+		log:        r.Log.WithName("consumer"),
+		workers:    make(map[string]*queueWorker),
+	}
+}
+
+// NeedLeaderElection ensures that messages are only consumed by the leader.
+func (c *queueConsumer) NeedLeaderElection() bool {
+	return true
+}
+
+// Start runs the workers until the context is cancelled, then waits for them to finish
+// processing their current message.
+func (c *queueConsumer) Start(ctx context.Context) error {
+	ticker := time.NewTicker(workerSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		c.syncWorkers(ctx)
+
+		select {
+		case <-ctx.Done():
+			c.log.Info("Stopping queue workers")
+			for cluster, w := range c.workers {
+				w.cancel()
+				delete(c.workers, cluster)
+			}
+			c.wg.Wait()
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// syncWorkers starts a worker for each queue tracked by the reconciler and stops the workers
+// of the queues that aren't tracked anymore.
+func (c *queueConsumer) syncWorkers(ctx context.Context) {
+	tracked := make(map[string]queueParams)
+	c.reconciler.queueURLs.Range(func(key, val interface{}) bool {
+		tracked[key.(string)] = val.(queueParams)
+		return true
+	})
+
+	for cluster, w := range c.workers {
+		if qp, ok := tracked[cluster]; !ok || qp != w.params {
+			w.cancel()
+			delete(c.workers, cluster)
+		}
+	}
+
+	for cluster, qp := range tracked {
+		if _, ok := c.workers[cluster]; ok {
+			continue
+		}
+		workerCtx, cancel := context.WithCancel(ctx)
+		c.workers[cluster] = &queueWorker{params: qp, cancel: cancel}
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			c.runWorker(workerCtx, cluster, qp)
+		}()
+	}
+}
+
+func (c *queueConsumer) runWorker(ctx context.Context, cluster string, qp queueParams) {
+	log := c.log.WithValues("cluster", cluster, "queueURL", qp.URL)
+
+	var sqsSvc instancestate.SQSAPI
+	for ctx.Err() == nil {
+		if sqsSvc == nil {
+			svc, err := c.reconciler.getSQSService(qp.region)
+			if err != nil {
+				log.Error(err, "unable to create SQS client")
+				sleep(ctx, retryInterval)
+				continue
+			}
+			sqsSvc = svc
+		}
+
+		resp, err := sqsSvc.ReceiveMessage(ctx, receiveMessageInput(qp.URL))
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			messageFailures.WithLabelValues(cluster, stageReceive).Inc()
+			log.Error(err, "failed to receive messages")
+			sleep(ctx, retryInterval)
+			continue
+		}
+
+		// Messages are processed before receiving more, so that a slow processing holds the
+		// next messages in the queue rather than piling them up in memory.
+		for i := range resp.Messages {
+			if ctx.Err() != nil {
+				// The remaining messages will become visible again once their visibility timeout expires.
+				return
+			}
+			c.handleMessage(ctx, log, sqsSvc, cluster, qp.URL, &resp.Messages[i])
+		}
+	}
+}
+
+func (c *queueConsumer) handleMessage(ctx context.Context, log logr.Logger, sqsSvc instancestate.SQSAPI, cluster, queueURL string, msg *sqstypes.Message) {
+	log = log.WithValues("messageID", aws.ToString(msg.MessageId))
+	messagesReceived.WithLabelValues(cluster).Inc()
+	if sentTimestamp, ok := msg.Attributes[string(sqstypes.MessageSystemAttributeNameSentTimestamp)]; ok {
+		if ms, err := strconv.ParseInt(sentTimestamp, 10, 64); err == nil {
+			messageLagSeconds.WithLabelValues(cluster).Observe(time.Since(time.UnixMilli(ms)).Seconds())
+		}
+	}
+
+	// The message is processed to completion even if the consumer is stopping, so that its effects
+	// aren't left half applied.
+	processCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), processingTimeout)
+	defer cancel()
+
+	stopExtending := extendVisibility(processCtx, log, sqsSvc, queueURL, msg.ReceiptHandle)
+	err := c.reconciler.processMessageBody(processCtx, aws.ToString(msg.Body))
+	stopExtending()
+	if err != nil {
+		messageFailures.WithLabelValues(cluster, stageProcess).Inc()
+		log.Error(err, "failed to process message, it will be received again")
+		return
+	}
+
+	_, err = sqsSvc.DeleteMessage(processCtx, &sqs.DeleteMessageInput{
+		QueueUrl:      aws.String(queueURL),
+		ReceiptHandle: msg.ReceiptHandle,
+	})
+	if err != nil {
+		messageFailures.WithLabelValues(cluster, stageDelete).Inc()
+		log.Error(err, "error deleting message", "messageReceiptHandle", msg.ReceiptHandle)
+	}
+}
+
+// extendVisibility keeps the message hidden from the other consumers until the returned function is called.
+func extendVisibility(ctx context.Context, log logr.Logger, sqsSvc instancestate.SQSAPI, queueURL string, receiptHandle *string) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(visibilityTimeout / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_, err := sqsSvc.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+					QueueUrl:          aws.String(queueURL),
+					ReceiptHandle:     receiptHandle,
+					VisibilityTimeout: int32(visibilityTimeout.Seconds()),
+				})
+				if err != nil && ctx.Err() == nil {
+					log.Error(err, "failed to extend message visibility timeout")
+				}
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func receiveMessageInput(queueURL string) *sqs.ReceiveMessageInput {
+	return &sqs.ReceiveMessageInput{
+		QueueUrl:                    aws.String(queueURL),
+		MaxNumberOfMessages:         maxMessagesPerReceive,
+		WaitTimeSeconds:             int32(receiveWaitTime.Seconds()),
+		VisibilityTimeout:           int32(visibilityTimeout.Seconds()),
+		MessageSystemAttributeNames: []sqstypes.MessageSystemAttributeName{sqstypes.MessageSystemAttributeNameSentTimestamp},
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
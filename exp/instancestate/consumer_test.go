@@ -0,0 +1,110 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/controllers"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate/mock_sqsiface"
+)
+
+func TestQueueConsumer(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	scheme := runtime.NewScheme()
+	g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithIndex(&infrav1.AWSMachine{}, controllers.InstanceIDIndex, func(o client.Object) []string {
+			return nil
+		}).
+		Build()
+
+	sqsMock := mock_sqsiface.NewMockSQSAPI(mockCtrl)
+	r := &AwsInstanceStateReconciler{
+		Client: c,
+		Log:    ctrl.Log.WithName("controllers").WithName("AWSInstanceState"),
+		sqsServiceFactory: func() instancestate.SQSAPI {
+			return sqsMock
+		},
+	}
+	r.queueURLs.Store("cluster-1", queueParams{region: "us-east-1", URL: "cluster-1-url"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	received := false
+	sqsMock.EXPECT().ReceiveMessage(gomock.Any(), receiveMessageInput("cluster-1-url")).AnyTimes().
+		DoAndReturn(func(ctx context.Context, _ *sqs.ReceiveMessageInput, _ ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+			if received {
+				// Simulate long polling on an empty queue.
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+			received = true
+			return &sqs.ReceiveMessageOutput{Messages: []sqstypes.Message{
+				{
+					MessageId:     aws.String("valid"),
+					ReceiptHandle: aws.String("valid-receipt-handle"),
+					Body:          aws.String(messageBodyJSON),
+					Attributes: map[string]string{
+						string(sqstypes.MessageSystemAttributeNameSentTimestamp): "1700000000000",
+					},
+				},
+				{
+					MessageId:     aws.String("invalid"),
+					ReceiptHandle: aws.String("invalid-receipt-handle"),
+					Body:          aws.String("not json"),
+				},
+			}}, nil
+		})
+	deleted := make(chan string, 2)
+	sqsMock.EXPECT().DeleteMessage(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, input *sqs.DeleteMessageInput, _ ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error) {
+			deleted <- aws.ToString(input.ReceiptHandle)
+			return &sqs.DeleteMessageOutput{}, nil
+		})
+
+	consumer := newQueueConsumer(r)
+	stopped := make(chan error)
+	go func() {
+		stopped <- consumer.Start(ctx)
+	}()
+
+	// Only the message that was successfully processed is deleted, the other one is left for redelivery.
+	g.Eventually(deleted, 5*time.Second).Should(Receive(Equal("valid-receipt-handle")))
+	g.Consistently(deleted, time.Second).ShouldNot(Receive())
+
+	cancel()
+	g.Eventually(stopped, 5*time.Second).Should(Receive(BeNil()))
+}
@@ -0,0 +1,178 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/controllers"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	"sigs.k8s.io/cluster-api/controllers/remote"
+	"sigs.k8s.io/cluster-api/util"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"
+)
+
+// interruption describes an upcoming interruption of an EC2 instance notified by AWS.
+type interruption struct {
+	instanceID string
+	reason     string
+	message    string
+}
+
+// processInterruption marks the AWSMachine backing the interrupted instance, cordons and drains
+// its Node in the workload cluster on a spot interruption warning and, if enabled, deletes the owning
+// Machine so that a replacement is created before the instance goes away.
+func (r *AwsInstanceStateReconciler) processInterruption(ctx context.Context, in interruption) error {
+	awsMachines := &infrav1.AWSMachineList{}
+	if err := r.List(ctx, awsMachines, client.MatchingFields{controllers.InstanceIDIndex: in.instanceID}); err != nil {
+		return errors.Wrapf(err, "failed to list machines by instance ID %q", in.instanceID)
+	}
+	if len(awsMachines.Items) == 0 {
+		// Maintenance events are account wide, the instance may belong to another cluster.
+		return nil
+	}
+
+	awsMachine := &awsMachines.Items[0]
+	if !awsMachine.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	log := r.Log.WithValues("instanceID", in.instanceID, "awsMachine", client.ObjectKeyFromObject(awsMachine), "reason", in.reason)
+
+	patchHelper, err := v1beta1patch.NewHelper(awsMachine, r.Client)
+	if err != nil {
+		return errors.Wrap(err, "failed to create patch helper")
+	}
+	v1beta1conditions.MarkTrueWithNegativePolarity(awsMachine, infrav1.InstanceInterruptedCondition, in.reason, clusterv1beta1.ConditionSeverityWarning, "%s", in.message)
+	if err := patchHelper.Patch(ctx, awsMachine, v1beta1patch.WithOwnedConditions{Conditions: []clusterv1beta1.ConditionType{infrav1.InstanceInterruptedCondition}}); err != nil {
+		return errors.Wrap(err, "failed to patch AWSMachine")
+	}
+
+	machine, err := util.GetOwnerMachine(ctx, r.Client, awsMachine.ObjectMeta)
+	if err != nil {
+		return errors.Wrap(err, "failed to get owner Machine")
+	}
+	if machine == nil {
+		log.Info("AWSMachine has no owner Machine yet, skipping drain")
+		return nil
+	}
+
+	// The instance keeps running after a rebalance recommendation or a scheduled maintenance and nothing
+	// would uncordon its Node afterwards, so the Node is only drained when the instance is about to be
+	// reclaimed. The deletion of the Machine, if enabled, drains it otherwise.
+	if in.reason == infrav1.SpotInstanceInterruptionReason && machine.Status.NodeRef.IsDefined() {
+		remoteClient, err := r.getWorkloadClusterClient(ctx, client.ObjectKey{Namespace: machine.Namespace, Name: machine.Spec.ClusterName})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create client for cluster %q", machine.Spec.ClusterName)
+		}
+		log.Info("Draining node of interrupted instance", "node", machine.Status.NodeRef.Name)
+		if err := drainNode(ctx, remoteClient, machine.Status.NodeRef.Name); err != nil {
+			return errors.Wrapf(err, "failed to drain node %q", machine.Status.NodeRef.Name)
+		}
+	}
+
+	// Control plane machines are owned by the control plane provider, which is in charge of replacing them.
+	if !r.DeleteMachineOnInterruption || util.IsControlPlaneMachine(machine) || !machine.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	// A rebalance recommendation isn't a termination notice, the instance may keep running.
+	if in.reason == infrav1.InstanceRebalanceRecommendationReason {
+		return nil
+	}
+	log.Info("Deleting Machine of interrupted instance", "machine", client.ObjectKeyFromObject(machine))
+	if err := r.Delete(ctx, machine); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete Machine %q", machine.Name)
+	}
+	return nil
+}
+
+func (r *AwsInstanceStateReconciler) getWorkloadClusterClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error) {
+	if r.workloadClientFactory != nil {
+		return r.workloadClientFactory(ctx, cluster)
+	}
+	return remote.NewClusterClient(ctx, "awsinstancestate", r.Client, cluster)
+}
+
+// drainNode cordons the node and evicts the pods running on it. DaemonSet and mirror pods are skipped,
+// as are pods whose eviction is blocked by a PodDisruptionBudget: the instance is going away regardless
+// and blocking here would only delay the other evictions.
+func drainNode(ctx context.Context, c client.Client, nodeName string) error {
+	node := &corev1.Node{}
+	if err := c.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if !node.Spec.Unschedulable {
+		patch := client.MergeFrom(node.DeepCopy())
+		node.Spec.Unschedulable = true
+		if err := c.Patch(ctx, node, patch); err != nil {
+			return errors.Wrap(err, "failed to cordon node")
+		}
+	}
+
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.MatchingFieldsSelector{Selector: fields.OneTermEqualSelector("spec.nodeName", nodeName)}); err != nil {
+		return errors.Wrap(err, "failed to list pods")
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if skipEviction(pod) {
+			continue
+		}
+		eviction := &policyv1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pod.Name,
+				Namespace: pod.Namespace,
+			},
+		}
+		if err := c.SubResource("eviction").Create(ctx, pod, eviction); err != nil && !apierrors.IsNotFound(err) && !apierrors.IsTooManyRequests(err) {
+			return errors.Wrapf(err, "failed to evict pod %s/%s", pod.Namespace, pod.Name)
+		}
+	}
+	return nil
+}
+
+func skipEviction(pod *corev1.Pod) bool {
+	if !pod.DeletionTimestamp.IsZero() {
+		return true
+	}
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return true
+	}
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return true
+	}
+	for _, ref := range pod.OwnerReferences {
+		if ref.Kind == "DaemonSet" && ref.Controller != nil && *ref.Controller {
+			return true
+		}
+	}
+	return false
+}
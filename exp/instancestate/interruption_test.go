@@ -0,0 +1,216 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/controllers"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+func TestProcessInterruption(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		msg                  message
+		deleteMachine        bool
+		controlPlane         bool
+		expectReason         string
+		expectDrained        bool
+		expectMachineDeleted bool
+	}{
+		{
+			name: "spot interruption warning marks the AWSMachine and drains the node",
+			msg: message{
+				Source:        "aws.ec2",
+				DetailType:    instancestate.Ec2SpotInstanceInterruptionWarning,
+				MessageDetail: &messageDetail{InstanceID: "i-1", InstanceAction: "terminate"},
+			},
+			expectReason:  infrav1.SpotInstanceInterruptionReason,
+			expectDrained: true,
+		},
+		{
+			name: "spot interruption warning deletes the Machine when enabled",
+			msg: message{
+				Source:        "aws.ec2",
+				DetailType:    instancestate.Ec2SpotInstanceInterruptionWarning,
+				MessageDetail: &messageDetail{InstanceID: "i-1", InstanceAction: "terminate"},
+			},
+			deleteMachine:        true,
+			expectReason:         infrav1.SpotInstanceInterruptionReason,
+			expectDrained:        true,
+			expectMachineDeleted: true,
+		},
+		{
+			name: "rebalance recommendation neither drains the node nor deletes the Machine",
+			msg: message{
+				Source:        "aws.ec2",
+				DetailType:    instancestate.Ec2InstanceRebalanceRecommendation,
+				MessageDetail: &messageDetail{InstanceID: "i-1"},
+			},
+			deleteMachine: true,
+			expectReason:  infrav1.InstanceRebalanceRecommendationReason,
+		},
+		{
+			name: "scheduled maintenance of a control plane instance doesn't delete the Machine",
+			msg: message{
+				Source:     "aws.health",
+				DetailType: instancestate.AWSHealthEvent,
+				MessageDetail: &messageDetail{
+					Service:           "EC2",
+					EventTypeCode:     "AWS_EC2_INSTANCE_RETIREMENT_SCHEDULED",
+					EventTypeCategory: "scheduledChange",
+					AffectedEntities:  []affectedEntity{{EntityValue: "i-unknown"}, {EntityValue: "i-1"}},
+				},
+			},
+			deleteMachine: true,
+			controlPlane:  true,
+			expectReason:  infrav1.InstanceScheduledMaintenanceReason,
+		},
+		{
+			name: "scheduled maintenance deletes the Machine without draining the node",
+			msg: message{
+				Source:     "aws.health",
+				DetailType: instancestate.AWSHealthEvent,
+				MessageDetail: &messageDetail{
+					Service:           "EC2",
+					EventTypeCode:     "AWS_EC2_INSTANCE_RETIREMENT_SCHEDULED",
+					EventTypeCategory: "scheduledChange",
+					AffectedEntities:  []affectedEntity{{EntityValue: "i-1"}},
+				},
+			},
+			deleteMachine:        true,
+			expectReason:         infrav1.InstanceScheduledMaintenanceReason,
+			expectMachineDeleted: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.TODO()
+
+			scheme := runtime.NewScheme()
+			g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine-1", Namespace: "default"},
+				Spec:       clusterv1.MachineSpec{ClusterName: "cluster-1"},
+				Status:     clusterv1.MachineStatus{NodeRef: clusterv1.MachineNodeReference{Name: "node-1"}},
+			}
+			if tc.controlPlane {
+				machine.Labels = map[string]string{clusterv1.MachineControlPlaneLabel: ""}
+			}
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "aws-machine-1",
+					Namespace: "default",
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Machine",
+						Name:       machine.Name,
+					}},
+				},
+				Spec: infrav1.AWSMachineSpec{InstanceID: ptr.To[string]("i-1")},
+			}
+			mgmtClient := fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(machine, awsMachine).
+				WithStatusSubresource(awsMachine).
+				WithIndex(&infrav1.AWSMachine{}, controllers.InstanceIDIndex, func(o client.Object) []string {
+					return []string{ptr.Deref(o.(*infrav1.AWSMachine).Spec.InstanceID, "")}
+				}).
+				Build()
+
+			workloadClient := fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(
+					&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+					&corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+						Spec:       corev1.PodSpec{NodeName: "node-1"},
+					},
+					&corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:            "daemon",
+							Namespace:       "default",
+							OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "ds", Controller: ptr.To(true)}},
+						},
+						Spec: corev1.PodSpec{NodeName: "node-1"},
+					},
+					&corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+						Spec:       corev1.PodSpec{NodeName: "node-2"},
+					},
+				).
+				WithIndex(&corev1.Pod{}, "spec.nodeName", func(o client.Object) []string {
+					return []string{o.(*corev1.Pod).Spec.NodeName}
+				}).
+				Build()
+
+			r := &AwsInstanceStateReconciler{
+				Client:                      mgmtClient,
+				Log:                         ctrl.Log.WithName("controllers").WithName("AWSInstanceState"),
+				DeleteMachineOnInterruption: tc.deleteMachine,
+				workloadClientFactory: func(_ context.Context, cluster client.ObjectKey) (client.Client, error) {
+					g.Expect(cluster).To(Equal(client.ObjectKey{Namespace: "default", Name: "cluster-1"}))
+					return workloadClient, nil
+				},
+			}
+			g.Expect(r.processMessage(ctx, tc.msg)).To(Succeed())
+
+			g.Expect(mgmtClient.Get(ctx, client.ObjectKeyFromObject(awsMachine), awsMachine)).To(Succeed())
+			condition := v1beta1conditions.Get(awsMachine, infrav1.InstanceInterruptedCondition)
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+			g.Expect(condition.Reason).To(Equal(tc.expectReason))
+
+			node := &corev1.Node{}
+			g.Expect(workloadClient.Get(ctx, client.ObjectKey{Name: "node-1"}, node)).To(Succeed())
+			g.Expect(node.Spec.Unschedulable).To(Equal(tc.expectDrained))
+			err := workloadClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "app"}, &corev1.Pod{})
+			if tc.expectDrained {
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(workloadClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "daemon"}, &corev1.Pod{})).To(Succeed())
+			g.Expect(workloadClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "other"}, &corev1.Pod{})).To(Succeed())
+
+			err = mgmtClient.Get(ctx, client.ObjectKeyFromObject(machine), &clusterv1.Machine{})
+			if tc.expectMachineDeleted {
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricInstanceStateSubsystem = "instancestate"
+	metricClusterLabel           = "cluster"
+	metricStageLabel             = "stage"
+
+	stageReceive = "receive"
+	stageProcess = "process"
+	stageDelete  = "delete"
+)
+
+var (
+	messagesReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricInstanceStateSubsystem,
+		Name:      "messages_received_total",
+		Help:      "Total number of instance event messages received from the SQS queue of a cluster",
+	}, []string{metricClusterLabel})
+
+	messageFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricInstanceStateSubsystem,
+		Name:      "message_failures_total",
+		Help:      "Total number of failures while receiving, processing or deleting instance event messages",
+	}, []string{metricClusterLabel, metricStageLabel})
+
+	messageLagSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: metricInstanceStateSubsystem,
+		Name:      "message_lag_seconds",
+		Help:      "Time between an instance event message being sent to the SQS queue and its processing",
+		Buckets:   []float64{0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, []string{metricClusterLabel})
+)
+
+func init() {
+	metrics.Registry.MustRegister(messagesReceived)
+	metrics.Registry.MustRegister(messageFailures)
+	metrics.Registry.MustRegister(messageLagSeconds)
+}
@@ -102,6 +102,7 @@ var (
 	profilerAddress             string
 	awsClusterConcurrency       int
 	instanceStateConcurrency    int
+	deleteMachineOnInterruption bool
 	awsMachineConcurrency       int
 	waitInfraPeriod             time.Duration
 	maxWaitActiveUpdateDelete   time.Duration
@@ -392,14 +393,31 @@ func setupReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager,
 			setupLog.Error(err, "unable to create webhook", "webhook", "AWSMachinePool")
 			os.Exit(1)
 		}
+
+		setupLog.Debug("enabling fleet machine pool controller and webhook")
+		if err := (&expcontrollers.AWSFleetMachinePoolReconciler{
+			Client:                       mgr.GetClient(),
+			Recorder:                     mgr.GetEventRecorderFor("awsfleetmachinepool-controller"),
+			WatchFilterValue:             watchFilterValue,
+			TagUnmanagedNetworkResources: feature.Gates.Enabled(feature.TagUnmanagedNetworkResources),
+		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: instanceStateConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AWSFleetMachinePool")
+			os.Exit(1)
+		}
+
+		if err := (&expinfrav1.AWSFleetMachinePool{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "AWSFleetMachinePool")
+			os.Exit(1)
+		}
 	}
 
 	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
 		setupLog.Info("EventBridge notifications enabled. enabling AWSInstanceStateController")
 		if err := (&instancestate.AwsInstanceStateReconciler{
-			Client:           mgr.GetClient(),
-			Log:              ctrl.Log.WithName("controllers").WithName("AWSInstanceStateController"),
-			WatchFilterValue: watchFilterValue,
+			Client:                      mgr.GetClient(),
+			Log:                         ctrl.Log.WithName("controllers").WithName("AWSInstanceStateController"),
+			WatchFilterValue:            watchFilterValue,
+			DeleteMachineOnInterruption: deleteMachineOnInterruption,
 		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: instanceStateConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "AWSInstanceStateController")
 			os.Exit(1)
@@ -621,6 +639,12 @@ func initFlags(fs *pflag.FlagSet) {
 		"Number of concurrent watches for instance state changes",
 	)
 
+	fs.BoolVar(&deleteMachineOnInterruption,
+		"delete-machine-on-instance-interruption",
+		false,
+		"Delete the Machine of an instance that received a spot interruption warning or a scheduled maintenance notice, so that its replacement is created before the instance is terminated. Requires the EventBridgeInstanceState feature gate.",
+	)
+
 	fs.IntVar(&awsMachineConcurrency,
 		"awsmachine-concurrency",
 		10,
@@ -28,9 +28,11 @@ import (
 
 // Error singletons for AWS errors.
 const (
+	AllocationIDNotFound              = "InvalidAllocationID.NotFound"
 	AssociationIDNotFound             = "InvalidAssociationID.NotFound"
 	AuthFailure                       = "AuthFailure"
 	BucketAlreadyOwnedByYou           = "BucketAlreadyOwnedByYou"
+	DependencyViolation               = "DependencyViolation"
 	EIPNotFound                       = "InvalidElasticIpID.NotFound"
 	GatewayNotFound                   = "InvalidGatewayID.NotFound"
 	GroupNotFound                     = "InvalidGroup.NotFound"
@@ -45,16 +47,22 @@ const (
 	LaunchTemplateNameNotFound        = "InvalidLaunchTemplateName.NotFoundException"
 	LoadBalancerNotFound              = "LoadBalancerNotFound"
 	NATGatewayNotFound                = "InvalidNatGatewayID.NotFound"
+	NetworkInterfaceNotFound          = "InvalidNetworkInterfaceID.NotFound"
 	//nolint:gosec
 	NoCredentialProviders                   = "NoCredentialProviders"
 	NoSuchKey                               = "NoSuchKey"
 	PermissionNotFound                      = "InvalidPermission.NotFound"
 	ResourceExists                          = "ResourceExistsException"
 	ResourceNotFound                        = "InvalidResourceID.NotFound"
+	RouteNotFound                           = "InvalidRoute.NotFound"
 	RouteTableNotFound                      = "InvalidRouteTableID.NotFound"
+	SnapshotNotFound                        = "InvalidSnapshot.NotFound"
 	SubnetNotFound                          = "InvalidSubnetID.NotFound"
+	TransitGatewayAttachmentNotFound        = "InvalidTransitGatewayAttachmentID.NotFound"
+	TransitGatewayNotFound                  = "InvalidTransitGatewayID.NotFound"
 	UnrecognizedClientException             = "UnrecognizedClientException"
 	UnauthorizedOperation                   = "UnauthorizedOperation"
+	VolumeNotFound                          = "InvalidVolume.NotFound"
 	VPCNotFound                             = "InvalidVpcID.NotFound"
 	VPCMissingParameter                     = "MissingParameter"
 	ErrCodeRepositoryAlreadyExistsException = "RepositoryAlreadyExistsException"
@@ -24,6 +24,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
@@ -57,6 +58,7 @@ var (
 		"ssm":                  ssm.ServiceID,
 		"sts":                  sts.ServiceID,
 		"secretsmanager":       secretsmanager.ServiceID,
+		"elasticfilesystem":    efs.ServiceID,
 	}
 )
 
@@ -269,6 +271,28 @@ func (s *RGAPIEndpointResolver) ResolveEndpoint(ctx context.Context, params rgap
 	return rgapi.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
 }
 
+// EFSEndpointResolver implements EndpointResolverV2 interface for EFS.
+type EFSEndpointResolver struct {
+	*MultiServiceEndpointResolver
+}
+
+// ResolveEndpoint for EFS.
+func (s *EFSEndpointResolver) ResolveEndpoint(ctx context.Context, params efs.EndpointParameters) (smithyendpoints.Endpoint, error) {
+	// If custom endpoint not found, return default endpoint for the service
+	log := logger.FromContext(ctx)
+	endpoint, ok := s.endpoints[efs.ServiceID]
+
+	if !ok {
+		log.Debug("Custom endpoint not found, using default endpoint")
+		return efs.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+	}
+
+	log.Debug("Custom endpoint found, using custom endpoint", "endpoint", endpoint.URL)
+	params.Endpoint = &endpoint.URL
+	params.Region = &endpoint.SigningRegion
+	return efs.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+}
+
 // SQSEndpointResolver implements EndpointResolverV2 interface for SQS.
 type SQSEndpointResolver struct {
 	*MultiServiceEndpointResolver
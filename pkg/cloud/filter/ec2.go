@@ -160,6 +160,35 @@ func (ec2Filters) VPCStates(states ...types.VpcState) types.Filter {
 	}
 }
 
+// TransitGateway returns a filter based on the id of the transit gateway.
+func (ec2Filters) TransitGateway(transitGatewayID string) types.Filter {
+	return types.Filter{
+		Name:   aws.String("transit-gateway-id"),
+		Values: []string{transitGatewayID},
+	}
+}
+
+// TransitGatewayAttachmentStates returns a filter based on the list of states passed in.
+func (ec2Filters) TransitGatewayAttachmentStates(states ...types.TransitGatewayAttachmentState) types.Filter {
+	stateStrings := make([]string, len(states))
+	for i, state := range states {
+		stateStrings[i] = string(state)
+	}
+
+	return types.Filter{
+		Name:   aws.String("state"),
+		Values: stateStrings,
+	}
+}
+
+// FlowLogResource returns a filter based on the id of the resource flow logs are created for.
+func (ec2Filters) FlowLogResource(resourceID string) types.Filter {
+	return types.Filter{
+		Name:   aws.String("resource-id"),
+		Values: []string{resourceID},
+	}
+}
+
 // SubnetStates returns a filter based on the list of states passed in.
 func (ec2Filters) SubnetStates(states ...types.SubnetState) types.Filter {
 	stateStrings := make([]string, len(states))
@@ -193,3 +222,11 @@ func (ec2Filters) SecurityGroupName(name string) types.Filter {
 		Values: []string{name},
 	}
 }
+
+// PrefixListName returns a filter based on the managed prefix list name.
+func (ec2Filters) PrefixListName(name string) types.Filter {
+	return types.Filter{
+		Name:   aws.String("prefix-list-name"),
+		Values: []string{name},
+	}
+}
@@ -19,17 +19,20 @@ package scope
 import (
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	rgapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	stsv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
@@ -194,6 +197,26 @@ func NewGlobalSQSClient(scopeUser cloud.ScopeUsage, session cloud.Session) *sqs.
 	return sqs.NewFromConfig(cfg, opts...)
 }
 
+// NewEFSClient creates a new EFS API client for a given session.
+func NewEFSClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) *efs.Client {
+	cfg := session.Session()
+	multiSvcEndpointResolver := endpoints.NewMultiServiceEndpointResolver()
+	endpointResolver := &endpoints.EFSEndpointResolver{
+		MultiServiceEndpointResolver: multiSvcEndpointResolver,
+	}
+
+	opts := []func(*efs.Options){
+		func(o *efs.Options) {
+			o.Logger = logger.GetAWSLogger()
+			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
+			o.EndpointResolverV2 = endpointResolver
+		},
+		efs.WithAPIOptions(awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target), awsmetrics.WithCAPAUserAgentMiddleware()),
+	}
+
+	return efs.NewFromConfig(cfg, opts...)
+}
+
 // NewResourgeTaggingClient creates a new Resource Tagging API client for a given session.
 func NewResourgeTaggingClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) *rgapi.Client {
 	cfg := session.Session()
@@ -335,6 +358,42 @@ func NewS3Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logge
 	return s3.NewFromConfig(cfg, s3Opts...)
 }
 
+// NewRoute53Client creates a new Route53 API client for a given session.
+func NewRoute53Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) *route53.Client {
+	cfg := session.Session()
+
+	route53Opts := []func(*route53.Options){
+		func(o *route53.Options) {
+			o.Logger = logger.GetAWSLogger()
+			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
+		},
+		route53.WithAPIOptions(
+			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
+			awsmetrics.WithCAPAUserAgentMiddleware(),
+		),
+	}
+
+	return route53.NewFromConfig(cfg, route53Opts...)
+}
+
+// NewWAFv2Client creates a new WAFv2 API client for a given session.
+func NewWAFv2Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) *wafv2.Client {
+	cfg := session.Session()
+
+	wafv2Opts := []func(*wafv2.Options){
+		func(o *wafv2.Options) {
+			o.Logger = logger.GetAWSLogger()
+			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
+		},
+		wafv2.WithAPIOptions(
+			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
+			awsmetrics.WithCAPAUserAgentMiddleware(),
+		),
+	}
+
+	return wafv2.NewFromConfig(cfg, wafv2Opts...)
+}
+
 // AWSClients contains all the aws clients used by the scopes.
 type AWSClients struct {
 	ELB             *elb.Client
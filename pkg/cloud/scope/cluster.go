@@ -201,7 +201,20 @@ func (s *ClusterScope) ControlPlaneLoadBalancer() *infrav1.AWSLoadBalancerSpec {
 }
 
 // ControlPlaneLoadBalancers returns load balancers configured for the control plane.
+// Until a migration of the control plane load balancer to another type is cut over, the classic
+// load balancer it migrates from is returned as the primary load balancer and the new one takes
+// the place of the secondary load balancer.
 func (s *ClusterScope) ControlPlaneLoadBalancers() []*infrav1.AWSLoadBalancerSpec {
+	if migration := s.AWSCluster.Status.ControlPlaneLoadBalancerMigration; migration != nil && !migration.IsCutOver() {
+		return []*infrav1.AWSLoadBalancerSpec{
+			{
+				Name:             s.AWSCluster.Spec.ControlPlaneLoadBalancer.Name,
+				Scheme:           s.AWSCluster.Spec.ControlPlaneLoadBalancer.Scheme,
+				LoadBalancerType: infrav1.LoadBalancerTypeClassic,
+			},
+			s.AWSCluster.Spec.ControlPlaneLoadBalancer,
+		}
+	}
 	return []*infrav1.AWSLoadBalancerSpec{
 		s.AWSCluster.Spec.ControlPlaneLoadBalancer,
 		s.AWSCluster.Spec.SecondaryControlPlaneLoadBalancer,
@@ -239,6 +252,37 @@ func (s *ClusterScope) Bucket() *infrav1.S3Bucket {
 	return s.AWSCluster.Spec.S3Bucket
 }
 
+// ControlPlaneDNS returns the Route53 record configuration of the control plane endpoint.
+func (s *ClusterScope) ControlPlaneDNS() *infrav1.ControlPlaneDNS {
+	return s.AWSCluster.Spec.ControlPlaneDNS
+}
+
+// ControlPlaneDNSStatus returns the Route53 record created for the control plane endpoint.
+func (s *ClusterScope) ControlPlaneDNSStatus() *infrav1.ControlPlaneDNSStatus {
+	return s.AWSCluster.Status.ControlPlaneDNS
+}
+
+// SetControlPlaneDNSStatus sets the Route53 record created for the control plane endpoint.
+func (s *ClusterScope) SetControlPlaneDNSStatus(status *infrav1.ControlPlaneDNSStatus) {
+	s.AWSCluster.Status.ControlPlaneDNS = status
+}
+
+// ControlPlaneLoadBalancerMigration returns the status of the migration of the control plane load balancer
+// to another load balancer type, or nil if no migration is in progress.
+func (s *ClusterScope) ControlPlaneLoadBalancerMigration() *infrav1.LoadBalancerMigrationStatus {
+	return s.AWSCluster.Status.ControlPlaneLoadBalancerMigration
+}
+
+// SetControlPlaneLoadBalancerMigration sets the status of the migration of the control plane load balancer.
+func (s *ClusterScope) SetControlPlaneLoadBalancerMigration(status *infrav1.LoadBalancerMigrationStatus) {
+	s.AWSCluster.Status.ControlPlaneLoadBalancerMigration = status
+}
+
+// IngressLoadBalancer returns the spec of the ingress load balancer, or nil if there is none.
+func (s *ClusterScope) IngressLoadBalancer() *infrav1.IngressLoadBalancerSpec {
+	return s.AWSCluster.Spec.IngressLoadBalancer
+}
+
 // ControlPlaneConfigMapName returns the name of the ConfigMap used to
 // coordinate the bootstrapping of control plane nodes.
 func (s *ClusterScope) ControlPlaneConfigMapName() string {
@@ -279,6 +323,10 @@ func (s *ClusterScope) PatchObject() error {
 		}
 	}
 
+	if s.AWSCluster.Spec.ControlPlaneDNS != nil {
+		applicableConditions = append(applicableConditions, infrav1.ControlPlaneDNSReadyCondition)
+	}
+
 	v1beta1conditions.SetSummary(s.AWSCluster,
 		v1beta1conditions.WithConditions(applicableConditions...),
 		v1beta1conditions.WithStepCounterIf(s.AWSCluster.ObjectMeta.DeletionTimestamp.IsZero()),
@@ -300,6 +348,8 @@ func (s *ClusterScope) PatchObject() error {
 			infrav1.ClusterSecurityGroupsReadyCondition,
 			infrav1.BastionHostReadyCondition,
 			infrav1.LoadBalancerReadyCondition,
+			infrav1.ControlPlaneDNSReadyCondition,
+			infrav1.ControlPlaneLoadBalancerMigrationCondition,
 			infrav1.PrincipalUsageAllowedCondition,
 			infrav1.PrincipalCredentialRetrievedCondition,
 		}})
@@ -445,3 +495,26 @@ func (s *ClusterScope) UnstructuredControlPlane() (*unstructured.Unstructured, e
 func (s *ClusterScope) NodePortIngressRuleCidrBlocks() infrav1.CidrBlocks {
 	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().NodePortIngressRuleCidrBlocks
 }
+
+// AdditionalControlPlaneEgressRules returns the additional egress rules for the control plane security group.
+func (s *ClusterScope) AdditionalControlPlaneEgressRules() []infrav1.EgressRule {
+	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().AdditionalControlPlaneEgressRules
+}
+
+// AdditionalNodeEgressRules returns the additional egress rules for the node security group.
+func (s *ClusterScope) AdditionalNodeEgressRules() []infrav1.EgressRule {
+	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().AdditionalNodeEgressRules
+}
+
+// SecurityGroupEgressMode returns how the egress rules of the security groups are managed.
+func (s *ClusterScope) SecurityGroupEgressMode() infrav1.SecurityGroupEgressMode {
+	if s.AWSCluster.Spec.NetworkSpec.SecurityGroupEgressMode == "" {
+		return infrav1.SecurityGroupEgressModeAllowAll
+	}
+	return s.AWSCluster.Spec.NetworkSpec.SecurityGroupEgressMode
+}
+
+// ManagedPrefixLists returns the managed prefix lists owned by the cluster.
+func (s *ClusterScope) ManagedPrefixLists() []infrav1.ManagedPrefixListSpec {
+	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().ManagedPrefixLists
+}
@@ -57,4 +57,14 @@ type ELBScope interface {
 	// ControlPlaneLoadBalancers returns both the ControlPlaneLoadBalancer and SecondaryControlPlaneLoadBalancer AWSLoadBalancerSpecs.
 	// The control plane load balancers should always be returned in the above order.
 	ControlPlaneLoadBalancers() []*infrav1.AWSLoadBalancerSpec
+
+	// ControlPlaneLoadBalancerMigration returns the status of the migration of the control plane load balancer
+	// to another load balancer type, or nil if no migration is in progress.
+	ControlPlaneLoadBalancerMigration() *infrav1.LoadBalancerMigrationStatus
+
+	// SetControlPlaneLoadBalancerMigration sets the status of the migration of the control plane load balancer.
+	SetControlPlaneLoadBalancerMigration(status *infrav1.LoadBalancerMigrationStatus)
+
+	// IngressLoadBalancer returns the spec of the ingress load balancer, or nil if there is none.
+	IngressLoadBalancer() *infrav1.IngressLoadBalancerSpec
 }
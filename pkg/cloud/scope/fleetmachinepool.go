@@ -0,0 +1,295 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"
+)
+
+// FleetMachinePoolScope defines a scope defined around an AWSFleetMachinePool and its cluster.
+type FleetMachinePoolScope struct {
+	logger.Logger
+	client.Client
+	patchHelper *v1beta1patch.Helper
+
+	Cluster             *clusterv1.Cluster
+	MachinePool         *clusterv1.MachinePool
+	InfraCluster        EC2Scope
+	AWSFleetMachinePool *expinfrav1.AWSFleetMachinePool
+}
+
+// FleetMachinePoolScopeParams defines the input parameters used to create a new FleetMachinePoolScope.
+type FleetMachinePoolScopeParams struct {
+	client.Client
+	Logger *logger.Logger
+
+	Cluster             *clusterv1.Cluster
+	MachinePool         *clusterv1.MachinePool
+	InfraCluster        EC2Scope
+	AWSFleetMachinePool *expinfrav1.AWSFleetMachinePool
+}
+
+// NewFleetMachinePoolScope creates a new FleetMachinePoolScope from the supplied parameters.
+// This is meant to be called for each reconcile iteration.
+func NewFleetMachinePoolScope(params FleetMachinePoolScopeParams) (*FleetMachinePoolScope, error) {
+	if params.Client == nil {
+		return nil, errors.New("client is required when creating a FleetMachinePoolScope")
+	}
+	if params.MachinePool == nil {
+		return nil, errors.New("machinepool is required when creating a FleetMachinePoolScope")
+	}
+	if params.Cluster == nil {
+		return nil, errors.New("cluster is required when creating a FleetMachinePoolScope")
+	}
+	if params.AWSFleetMachinePool == nil {
+		return nil, errors.New("aws fleet machine pool is required when creating a FleetMachinePoolScope")
+	}
+	if params.InfraCluster == nil {
+		return nil, errors.New("aws cluster is required when creating a FleetMachinePoolScope")
+	}
+
+	if params.Logger == nil {
+		log := klog.Background()
+		params.Logger = logger.NewLogger(log)
+	}
+
+	fmpHelper, err := v1beta1patch.NewHelper(params.AWSFleetMachinePool, params.Client)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init AWSFleetMachinePool patch helper")
+	}
+
+	return &FleetMachinePoolScope{
+		Logger:      *params.Logger,
+		Client:      params.Client,
+		patchHelper: fmpHelper,
+
+		Cluster:             params.Cluster,
+		MachinePool:         params.MachinePool,
+		InfraCluster:        params.InfraCluster,
+		AWSFleetMachinePool: params.AWSFleetMachinePool,
+	}, nil
+}
+
+// Ignition gets the ignition config.
+func (m *FleetMachinePoolScope) Ignition() *infrav1.Ignition {
+	return m.AWSFleetMachinePool.Spec.Ignition
+}
+
+// Name returns the AWSFleetMachinePool name.
+func (m *FleetMachinePoolScope) Name() string {
+	return m.AWSFleetMachinePool.Name
+}
+
+// Namespace returns the namespace name.
+func (m *FleetMachinePoolScope) Namespace() string {
+	return m.AWSFleetMachinePool.Namespace
+}
+
+// GetRawBootstrapData returns the bootstrap data from the secret in the MachinePool's bootstrap.dataSecretName,
+// including the secret's namespaced name.
+func (m *FleetMachinePoolScope) GetRawBootstrapData() ([]byte, string, *types.NamespacedName, error) {
+	if m.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName == nil {
+		return nil, "", nil, errors.New("error retrieving bootstrap data: linked MachinePool's bootstrap.dataSecretName is nil")
+	}
+
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: m.Namespace(), Name: *m.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName}
+
+	if err := m.Client.Get(context.TODO(), key, secret); err != nil {
+		return nil, "", nil, errors.Wrapf(err, "failed to retrieve bootstrap data secret %s for AWSFleetMachinePool %s/%s", key.Name, m.Namespace(), m.Name())
+	}
+
+	value, ok := secret.Data["value"]
+	if !ok {
+		return nil, "", nil, errors.New("error retrieving bootstrap data: secret value key is missing")
+	}
+
+	return value, string(secret.Data["format"]), &key, nil
+}
+
+// AdditionalTags merges AdditionalTags from the scope's AWSCluster and AWSFleetMachinePool. If the same key is present in both,
+// the value from AWSFleetMachinePool takes precedence. The returned Tags will never be nil.
+func (m *FleetMachinePoolScope) AdditionalTags() infrav1.Tags {
+	tags := make(infrav1.Tags)
+
+	// Start with the cluster-wide tags...
+	tags.Merge(m.InfraCluster.AdditionalTags())
+	// ... and merge in the fleet's
+	tags.Merge(m.AWSFleetMachinePool.Spec.AdditionalTags)
+
+	return tags
+}
+
+// PatchObject persists the AWSFleetMachinePool spec and status.
+func (m *FleetMachinePoolScope) PatchObject() error {
+	return m.patchHelper.Patch(
+		context.TODO(),
+		m.AWSFleetMachinePool,
+		v1beta1patch.WithOwnedConditions{Conditions: []clusterv1beta1.ConditionType{
+			expinfrav1.FleetReadyCondition,
+			expinfrav1.LaunchTemplateReadyCondition,
+		}})
+}
+
+// Close the FleetMachinePoolScope by updating the AWSFleetMachinePool spec and status.
+func (m *FleetMachinePoolScope) Close() error {
+	return m.PatchObject()
+}
+
+// SetFailureMessage sets the AWSFleetMachinePool status failure message.
+func (m *FleetMachinePoolScope) SetFailureMessage(v error) {
+	m.AWSFleetMachinePool.Status.FailureMessage = ptr.To[string](v.Error())
+}
+
+// SetFailureReason sets the AWSFleetMachinePool status failure reason.
+func (m *FleetMachinePoolScope) SetFailureReason(v string) {
+	m.AWSFleetMachinePool.Status.FailureReason = &v
+}
+
+// HasFailed returns true when the AWSFleetMachinePool's Failure reason or Failure message is populated.
+func (m *FleetMachinePoolScope) HasFailed() bool {
+	return m.AWSFleetMachinePool.Status.FailureReason != nil || m.AWSFleetMachinePool.Status.FailureMessage != nil
+}
+
+// SetNotReady sets the AWSFleetMachinePool Ready Status to false.
+func (m *FleetMachinePoolScope) SetNotReady() {
+	m.AWSFleetMachinePool.Status.Ready = false
+}
+
+// GetObjectMeta returns the AWSFleetMachinePool ObjectMeta.
+func (m *FleetMachinePoolScope) GetObjectMeta() *metav1.ObjectMeta {
+	return &m.AWSFleetMachinePool.ObjectMeta
+}
+
+// GetSetter returns the AWSFleetMachinePool object setter.
+func (m *FleetMachinePoolScope) GetSetter() v1beta1conditions.Setter {
+	return m.AWSFleetMachinePool
+}
+
+// GetEC2Scope returns the EC2 scope.
+func (m *FleetMachinePoolScope) GetEC2Scope() EC2Scope {
+	return m.InfraCluster
+}
+
+// GetLaunchTemplateIDStatus returns the launch template ID status.
+func (m *FleetMachinePoolScope) GetLaunchTemplateIDStatus() string {
+	return m.AWSFleetMachinePool.Status.LaunchTemplateID
+}
+
+// SetLaunchTemplateIDStatus sets the launch template ID status.
+func (m *FleetMachinePoolScope) SetLaunchTemplateIDStatus(id string) {
+	m.AWSFleetMachinePool.Status.LaunchTemplateID = id
+}
+
+// GetLaunchTemplateLatestVersionStatus returns the launch template latest version status.
+func (m *FleetMachinePoolScope) GetLaunchTemplateLatestVersionStatus() string {
+	if m.AWSFleetMachinePool.Status.LaunchTemplateVersion != nil {
+		return *m.AWSFleetMachinePool.Status.LaunchTemplateVersion
+	}
+	return ""
+}
+
+// SetLaunchTemplateLatestVersionStatus sets the launch template latest version status.
+func (m *FleetMachinePoolScope) SetLaunchTemplateLatestVersionStatus(version string) {
+	m.AWSFleetMachinePool.Status.LaunchTemplateVersion = &version
+}
+
+// IsEKSManaged checks if the AWSFleetMachinePool is EKS managed.
+func (m *FleetMachinePoolScope) IsEKSManaged() bool {
+	return m.InfraCluster.InfraCluster().GetObjectKind().GroupVersionKind().Kind == ekscontrolplanev1.AWSManagedControlPlaneKind
+}
+
+// SubnetIDs returns the fleet subnet IDs.
+func (m *FleetMachinePoolScope) SubnetIDs(subnetIDs []string) ([]string, error) {
+	strategy, err := newDefaultSubnetPlacementStrategy(&m.Logger)
+	if err != nil {
+		return subnetIDs, fmt.Errorf("getting subnet placement strategy: %w", err)
+	}
+
+	return strategy.Place(&placementInput{
+		SpecSubnetIDs:           subnetIDs,
+		SpecAvailabilityZones:   m.AWSFleetMachinePool.Spec.AvailabilityZones,
+		ParentAvailabilityZones: m.MachinePool.Spec.FailureDomains,
+		ControlplaneSubnets:     m.InfraCluster.Subnets(),
+		SubnetPlacementType:     m.AWSFleetMachinePool.Spec.AvailabilityZoneSubnetType,
+	})
+}
+
+// GetLaunchTemplate returns the launch template.
+func (m *FleetMachinePoolScope) GetLaunchTemplate() *expinfrav1.AWSLaunchTemplate {
+	return &m.AWSFleetMachinePool.Spec.AWSLaunchTemplate
+}
+
+// GetMachinePool returns the machine pool object.
+func (m *FleetMachinePoolScope) GetMachinePool() *clusterv1.MachinePool {
+	return m.MachinePool
+}
+
+// LaunchTemplateName returns the name of the launch template.
+func (m *FleetMachinePoolScope) LaunchTemplateName() string {
+	return m.Name()
+}
+
+// TargetCapacity returns the total target capacity of the fleet, which is the number of replicas of the MachinePool.
+func (m *FleetMachinePoolScope) TargetCapacity() int32 {
+	return ptr.Deref(m.MachinePool.Spec.Replicas, 0)
+}
+
+// CapacityDistribution returns the capacity distribution of the fleet, with the defaults applied.
+func (m *FleetMachinePoolScope) CapacityDistribution() expinfrav1.FleetCapacityDistribution {
+	distribution := expinfrav1.FleetCapacityDistribution{
+		DefaultTargetCapacityType:  expinfrav1.FleetCapacityTypeSpot,
+		OnDemandAllocationStrategy: expinfrav1.FleetOnDemandAllocationStrategyLowestPrice,
+		SpotAllocationStrategy:     expinfrav1.FleetSpotAllocationStrategyPriceCapacityOptimized,
+	}
+
+	spec := m.AWSFleetMachinePool.Spec.CapacityDistribution
+	if spec == nil {
+		return distribution
+	}
+
+	distribution.OnDemandBaseCapacity = spec.OnDemandBaseCapacity
+	if spec.DefaultTargetCapacityType != "" {
+		distribution.DefaultTargetCapacityType = spec.DefaultTargetCapacityType
+	}
+	if spec.OnDemandAllocationStrategy != "" {
+		distribution.OnDemandAllocationStrategy = spec.OnDemandAllocationStrategy
+	}
+	if spec.SpotAllocationStrategy != "" {
+		distribution.SpotAllocationStrategy = spec.SpotAllocationStrategy
+	}
+
+	return distribution
+}
@@ -38,6 +38,7 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/controllers/remote"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"
 	"sigs.k8s.io/cluster-api/util/patch"
@@ -399,3 +400,24 @@ func (m *MachinePoolScope) GetRuntimeObject() runtime.Object {
 func (m *MachinePoolScope) GetLifecycleHooks() []expinfrav1.AWSLifecycleHook {
 	return m.AWSMachinePool.Spec.AWSLifecycleHooks
 }
+
+// ReplicasManagedExternally returns true if the desired capacity of the ASG is not owned by the
+// MachinePool replicas, but by an external autoscaler or by the scaling policies and scheduled
+// actions of the AWSMachinePool. In that case, the MachinePool replicas follow the ASG instead.
+func (m *MachinePoolScope) ReplicasManagedExternally() bool {
+	if annotations.ReplicasManagedByExternalAutoscaler(m.MachinePool) {
+		return true
+	}
+
+	if len(m.AWSMachinePool.Spec.ScalingPolicies) > 0 {
+		return true
+	}
+
+	for _, action := range m.AWSMachinePool.Spec.ScheduledActions {
+		if action.DesiredCapacity != nil {
+			return true
+		}
+	}
+
+	return false
+}
@@ -467,6 +467,22 @@ func (s *ManagedControlPlaneScope) ControlPlaneLoadBalancers() []*infrav1.AWSLoa
 	return nil
 }
 
+// ControlPlaneLoadBalancerMigration returns the status of the control plane load balancer migration.
+// Managed control planes have no control plane load balancer to migrate.
+func (s *ManagedControlPlaneScope) ControlPlaneLoadBalancerMigration() *infrav1.LoadBalancerMigrationStatus {
+	return nil
+}
+
+// SetControlPlaneLoadBalancerMigration is a no-op for managed control planes.
+func (s *ManagedControlPlaneScope) SetControlPlaneLoadBalancerMigration(_ *infrav1.LoadBalancerMigrationStatus) {
+}
+
+// IngressLoadBalancer returns the spec of the ingress load balancer.
+// Managed control planes don't support an ingress load balancer.
+func (s *ManagedControlPlaneScope) IngressLoadBalancer() *infrav1.IngressLoadBalancerSpec {
+	return nil
+}
+
 // Partition returns the cluster partition.
 func (s *ManagedControlPlaneScope) Partition() string {
 	if s.ControlPlane.Spec.Partition == "" {
@@ -496,6 +512,28 @@ func (s *ManagedControlPlaneScope) NodePortIngressRuleCidrBlocks() infrav1.CidrB
 	return nil
 }
 
+// AdditionalControlPlaneEgressRules returns the additional egress rules for the control plane security group.
+// Egress rules are not managed for EKS clusters.
+func (s *ManagedControlPlaneScope) AdditionalControlPlaneEgressRules() []infrav1.EgressRule {
+	return nil
+}
+
+// AdditionalNodeEgressRules returns the additional egress rules for the node security group.
+// Egress rules are not managed for EKS clusters.
+func (s *ManagedControlPlaneScope) AdditionalNodeEgressRules() []infrav1.EgressRule {
+	return nil
+}
+
+// SecurityGroupEgressMode returns how the egress rules of the security groups are managed.
+func (s *ManagedControlPlaneScope) SecurityGroupEgressMode() infrav1.SecurityGroupEgressMode {
+	return infrav1.SecurityGroupEgressModeAllowAll
+}
+
+// ManagedPrefixLists returns the managed prefix lists owned by the cluster.
+func (s *ManagedControlPlaneScope) ManagedPrefixLists() []infrav1.ManagedPrefixListSpec {
+	return s.ControlPlane.Spec.NetworkSpec.DeepCopy().ManagedPrefixLists
+}
+
 // MaxWaitDuration returns time waiting for operation.
 func (s *ManagedControlPlaneScope) MaxWaitDuration() time.Duration {
 	return s.MaxWaitActiveUpdateDelete
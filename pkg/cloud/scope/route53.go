@@ -0,0 +1,39 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+)
+
+// Route53Scope is the interface for the scope to be used with the route53 service.
+type Route53Scope interface {
+	cloud.ClusterScoper
+
+	// ControlPlaneDNS returns the Route53 record configuration of the control plane endpoint.
+	ControlPlaneDNS() *infrav1.ControlPlaneDNS
+
+	// ControlPlaneDNSStatus returns the Route53 record created for the control plane endpoint.
+	ControlPlaneDNSStatus() *infrav1.ControlPlaneDNSStatus
+
+	// SetControlPlaneDNSStatus sets the Route53 record created for the control plane endpoint.
+	SetControlPlaneDNSStatus(status *infrav1.ControlPlaneDNSStatus)
+
+	// Network returns the cluster network object.
+	Network() *infrav1.NetworkStatus
+}
@@ -66,4 +66,19 @@ type SGScope interface {
 
 	// NodePortIngressRuleCidrBlocks returns the CIDR blocks for the node NodePort ingress rules.
 	NodePortIngressRuleCidrBlocks() infrav1.CidrBlocks
+
+	// AdditionalControlPlaneEgressRules returns the additional egress rules for the control plane security group.
+	AdditionalControlPlaneEgressRules() []infrav1.EgressRule
+
+	// AdditionalNodeEgressRules returns the additional egress rules for the node security group.
+	AdditionalNodeEgressRules() []infrav1.EgressRule
+
+	// SecurityGroupEgressMode returns how the egress rules of the security groups are managed.
+	SecurityGroupEgressMode() infrav1.SecurityGroupEgressMode
+
+	// ManagedPrefixLists returns the managed prefix lists owned by the cluster.
+	ManagedPrefixLists() []infrav1.ManagedPrefixListSpec
+
+	// IngressLoadBalancer returns the spec of the ingress load balancer, or nil if there is none.
+	IngressLoadBalancer() *infrav1.IngressLoadBalancerSpec
 }
@@ -37,11 +37,17 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/utils"
-	"sigs.k8s.io/cluster-api/util/annotations"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
+// warmedLifecycleStatePrefix is the prefix of the lifecycle states of instances in a warm pool.
+const warmedLifecycleStatePrefix = "Warmed:"
+
 // SDKToAutoScalingGroup converts an AWS EC2 SDK AutoScalingGroup to the CAPA AutoScalingGroup type.
 func (s *Service) SDKToAutoScalingGroup(v *autoscalingtypes.AutoScalingGroup) (*expinfrav1.AutoScalingGroup, error) {
 	i := &expinfrav1.AutoScalingGroup{
@@ -68,7 +74,10 @@ func (s *Service) SDKToAutoScalingGroup(v *autoscalingtypes.AutoScalingGroup) (*
 		}
 
 		for _, override := range v.MixedInstancesPolicy.LaunchTemplate.Overrides {
-			i.MixedInstancesPolicy.Overrides = append(i.MixedInstancesPolicy.Overrides, expinfrav1.Overrides{InstanceType: aws.ToString(override.InstanceType)})
+			i.MixedInstancesPolicy.Overrides = append(i.MixedInstancesPolicy.Overrides, expinfrav1.Overrides{
+				InstanceType:         aws.ToString(override.InstanceType),
+				InstanceRequirements: sdkToInstanceRequirements(override.InstanceRequirements),
+			})
 		}
 
 		onDemandAllocationStrategy := aws.ToString(v.MixedInstancesPolicy.InstancesDistribution.OnDemandAllocationStrategy)
@@ -104,8 +113,18 @@ func (s *Service) SDKToAutoScalingGroup(v *autoscalingtypes.AutoScalingGroup) (*
 		i.Tags = converters.ASGTagsToMap(v.Tags)
 	}
 
+	if v.WarmPoolConfiguration != nil {
+		i.WarmPool = sdkToWarmPool(v.WarmPoolConfiguration)
+		i.WarmPoolStatus = string(v.WarmPoolConfiguration.Status)
+	}
+
 	if len(v.Instances) > 0 {
 		for _, autoscalingInstance := range v.Instances {
+			// Instances moving in or out of the warm pool are not part of the group's capacity,
+			// so they must not be reported as machine pool instances.
+			if strings.HasPrefix(string(autoscalingInstance.LifecycleState), warmedLifecycleStatePrefix) {
+				continue
+			}
 			tmp := &infrav1.Instance{
 				ID:               aws.ToString(autoscalingInstance.InstanceId),
 				State:            infrav1.InstanceState(autoscalingInstance.LifecycleState),
@@ -126,6 +145,25 @@ func (s *Service) SDKToAutoScalingGroup(v *autoscalingtypes.AutoScalingGroup) (*
 	return i, nil
 }
 
+// sdkToWarmPool converts an AWS SDK WarmPoolConfiguration to the CAPA warm pool type.
+func sdkToWarmPool(v *autoscalingtypes.WarmPoolConfiguration) *expinfrav1.WarmPool {
+	warmPool := &expinfrav1.WarmPool{
+		MinSize:   v.MinSize,
+		PoolState: expinfrav1.WarmPoolState(v.PoolState),
+	}
+
+	// AWS reports -1 when the maximum prepared capacity defaults to the maximum size of the group.
+	if v.MaxGroupPreparedCapacity != nil && *v.MaxGroupPreparedCapacity >= 0 {
+		warmPool.MaxGroupPreparedCapacity = v.MaxGroupPreparedCapacity
+	}
+
+	if v.InstanceReusePolicy != nil {
+		warmPool.ReuseOnScaleIn = aws.ToBool(v.InstanceReusePolicy.ReuseOnScaleIn)
+	}
+
+	return warmPool
+}
+
 // ASGIfExists returns the existing autoscaling group or nothing if it doesn't exist.
 func (s *Service) ASGIfExists(name *string) (*expinfrav1.AutoScalingGroup, error) {
 	if name == nil {
@@ -177,7 +215,7 @@ func (s *Service) CreateASG(machinePoolScope *scope.MachinePoolScope) (*expinfra
 	// Ignore the problem for externally managed clusters because MachinePool replicas will be updated to the right value automatically.
 	if mpReplicas >= machinePoolScope.AWSMachinePool.Spec.MinSize && mpReplicas <= machinePoolScope.AWSMachinePool.Spec.MaxSize {
 		desiredCapacity = &mpReplicas
-	} else if !annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+	} else if !machinePoolScope.ReplicasManagedExternally() {
 		return nil, fmt.Errorf("incorrect number of replicas %d in MachinePool %v", mpReplicas, machinePoolScope.MachinePool.Name)
 	}
 
@@ -227,6 +265,14 @@ func (s *Service) CreateASG(machinePoolScope *scope.MachinePoolScope) (*expinfra
 		return nil, errors.Wrap(err, "failed to create autoscaling group")
 	}
 
+	// The warm pool can't be specified when creating the group, so it is added right after.
+	// Failures are retried by the warm pool reconciliation of the existing group.
+	if warmPool := machinePoolScope.AWSMachinePool.Spec.WarmPool; warmPool != nil {
+		if err := s.PutWarmPool(context.TODO(), name, warmPool); err != nil {
+			record.Warnf(machinePoolScope.AWSMachinePool, "FailedCreateWarmPool", "Failed to create warm pool for ASG %s: %v", name, err)
+		}
+	}
+
 	record.Eventf(machinePoolScope.AWSMachinePool, "SuccessfulCreate", "Created new ASG: %s", machinePoolScope.Name())
 
 	return nil, nil
@@ -285,7 +331,7 @@ func (s *Service) UpdateASG(machinePoolScope *scope.MachinePoolScope) error {
 		CapacityRebalance:    aws.Bool(machinePoolScope.AWSMachinePool.Spec.CapacityRebalance),
 	}
 
-	if machinePoolScope.MachinePool.Spec.Replicas != nil && !annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+	if machinePoolScope.MachinePool.Spec.Replicas != nil && !machinePoolScope.ReplicasManagedExternally() {
 		input.DesiredCapacity = aws.Int32(*machinePoolScope.MachinePool.Spec.Replicas)
 	}
 
@@ -305,6 +351,129 @@ func (s *Service) UpdateASG(machinePoolScope *scope.MachinePoolScope) error {
 	return nil
 }
 
+// PutWarmPool creates or updates the warm pool of the given AutoScalingGroup.
+func (s *Service) PutWarmPool(ctx context.Context, asgName string, warmPool *expinfrav1.WarmPool) error {
+	input := &autoscaling.PutWarmPoolInput{
+		AutoScalingGroupName: aws.String(asgName),
+		MinSize:              aws.Int32(ptr.Deref(warmPool.MinSize, 0)),
+		// -1 resets the maximum prepared capacity to the maximum size of the group.
+		MaxGroupPreparedCapacity: aws.Int32(ptr.Deref(warmPool.MaxGroupPreparedCapacity, -1)),
+		PoolState:                autoscalingtypes.WarmPoolState(warmPoolStateOrDefault(warmPool.PoolState)),
+		InstanceReusePolicy: &autoscalingtypes.InstanceReusePolicy{
+			ReuseOnScaleIn: aws.Bool(warmPool.ReuseOnScaleIn),
+		},
+	}
+
+	if _, err := s.ASGClient.PutWarmPool(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to put warm pool for AutoScalingGroup: %q", asgName)
+	}
+
+	return nil
+}
+
+// DeleteWarmPool deletes the warm pool of the given AutoScalingGroup.
+func (s *Service) DeleteWarmPool(ctx context.Context, asgName string) error {
+	input := &autoscaling.DeleteWarmPoolInput{
+		AutoScalingGroupName: aws.String(asgName),
+	}
+
+	if _, err := s.ASGClient.DeleteWarmPool(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to delete warm pool for AutoScalingGroup: %q", asgName)
+	}
+
+	return nil
+}
+
+// DescribeWarmPool returns the observed state of the warm pool of the given AutoScalingGroup,
+// or nil if the group has no warm pool.
+func (s *Service) DescribeWarmPool(ctx context.Context, asgName string) (*expinfrav1.WarmPoolStatus, error) {
+	input := &autoscaling.DescribeWarmPoolInput{
+		AutoScalingGroupName: aws.String(asgName),
+	}
+
+	var status *expinfrav1.WarmPoolStatus
+	paginator := autoscaling.NewDescribeWarmPoolPaginator(s.ASGClient, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe warm pool for AutoScalingGroup: %q", asgName)
+		}
+
+		if out.WarmPoolConfiguration == nil {
+			return nil, nil
+		}
+
+		if status == nil {
+			status = &expinfrav1.WarmPoolStatus{
+				Status: string(out.WarmPoolConfiguration.Status),
+			}
+		}
+
+		for _, instance := range out.Instances {
+			status.Instances = append(status.Instances, expinfrav1.WarmPoolInstanceStatus{
+				InstanceID:       aws.ToString(instance.InstanceId),
+				LifecycleState:   string(instance.LifecycleState),
+				AvailabilityZone: aws.ToString(instance.AvailabilityZone),
+			})
+		}
+	}
+
+	if status != nil {
+		status.Size = int32(len(status.Instances)) //#nosec G115
+	}
+
+	return status, nil
+}
+
+func warmPoolStateOrDefault(state expinfrav1.WarmPoolState) expinfrav1.WarmPoolState {
+	if state == "" {
+		return expinfrav1.WarmPoolStateStopped
+	}
+	return state
+}
+
+func warmPoolNeedsUpdate(existing *expinfrav1.WarmPool, expected *expinfrav1.WarmPool) bool {
+	return ptr.Deref(existing.MinSize, 0) != ptr.Deref(expected.MinSize, 0) ||
+		ptr.Deref(existing.MaxGroupPreparedCapacity, -1) != ptr.Deref(expected.MaxGroupPreparedCapacity, -1) ||
+		warmPoolStateOrDefault(existing.PoolState) != warmPoolStateOrDefault(expected.PoolState) ||
+		existing.ReuseOnScaleIn != expected.ReuseOnScaleIn
+}
+
+// ReconcileWarmPool reconciles the warm pool of an existing ASG by creating,
+// updating or deleting it to match the wanted configuration, and returns the
+// observed state of the warm pool.
+func ReconcileWarmPool(ctx context.Context, asgService services.ASGInterface, existingASG *expinfrav1.AutoScalingGroup, wantedWarmPool *expinfrav1.WarmPool, storeConditionsOnObject v1beta1conditions.Setter, log logger.Wrapper) (*expinfrav1.WarmPoolStatus, error) {
+	if existingASG.WarmPoolStatus == string(autoscalingtypes.WarmPoolStatusPendingDelete) {
+		// A warm pool can't be modified while it is being deleted, it will be recreated
+		// on a later reconciliation if it is still wanted.
+		log.Info("Warm pool deletion in progress")
+		return asgService.DescribeWarmPool(ctx, existingASG.Name)
+	}
+
+	if wantedWarmPool == nil {
+		if existingASG.WarmPool != nil {
+			log.Info("Deleting warm pool")
+			if err := asgService.DeleteWarmPool(ctx, existingASG.Name); err != nil {
+				v1beta1conditions.MarkFalse(storeConditionsOnObject, expinfrav1.WarmPoolReadyCondition, expinfrav1.WarmPoolDeletionFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+				return nil, err
+			}
+		}
+		v1beta1conditions.Delete(storeConditionsOnObject, expinfrav1.WarmPoolReadyCondition)
+		return nil, nil
+	}
+
+	if existingASG.WarmPool == nil || warmPoolNeedsUpdate(existingASG.WarmPool, wantedWarmPool) {
+		log.Info("Creating or updating warm pool")
+		if err := asgService.PutWarmPool(ctx, existingASG.Name, wantedWarmPool); err != nil {
+			v1beta1conditions.MarkFalse(storeConditionsOnObject, expinfrav1.WarmPoolReadyCondition, expinfrav1.WarmPoolReconcileFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+			return nil, err
+		}
+	}
+
+	v1beta1conditions.MarkTrue(storeConditionsOnObject, expinfrav1.WarmPoolReadyCondition)
+	return asgService.DescribeWarmPool(ctx, existingASG.Name)
+}
+
 // CanStartASGInstanceRefresh checks if a new ASG instance refresh can currently be started, and returns the status if there is an existing, unfinished refresh.
 func (s *Service) CanStartASGInstanceRefresh(scope *scope.MachinePoolScope) (bool, *autoscalingtypes.InstanceRefreshStatus, error) {
 	describeInput := &autoscaling.DescribeInstanceRefreshesInput{AutoScalingGroupName: aws.String(scope.Name())}
@@ -404,14 +573,102 @@ func createSDKMixedInstancesPolicy(name string, i *expinfrav1.MixedInstancesPoli
 	}
 
 	for _, override := range i.Overrides {
+		sdkOverride := autoscalingtypes.LaunchTemplateOverrides{
+			InstanceRequirements: createSDKInstanceRequirements(override.InstanceRequirements),
+		}
+		if override.InstanceType != "" {
+			sdkOverride.InstanceType = aws.String(override.InstanceType)
+		}
+		mixedInstancesPolicy.LaunchTemplate.Overrides = append(mixedInstancesPolicy.LaunchTemplate.Overrides, sdkOverride)
+	}
+
+	// Instance requirements on the policy are a shorthand for a single override.
+	if i.InstanceRequirements != nil {
 		mixedInstancesPolicy.LaunchTemplate.Overrides = append(mixedInstancesPolicy.LaunchTemplate.Overrides, autoscalingtypes.LaunchTemplateOverrides{
-			InstanceType: aws.String(override.InstanceType),
+			InstanceRequirements: createSDKInstanceRequirements(i.InstanceRequirements),
 		})
 	}
 
 	return mixedInstancesPolicy
 }
 
+func createSDKInstanceRequirements(r *expinfrav1.InstanceRequirements) *autoscalingtypes.InstanceRequirements {
+	if r == nil {
+		return nil
+	}
+
+	requirements := &autoscalingtypes.InstanceRequirements{
+		VCpuCount: &autoscalingtypes.VCpuCountRequest{
+			Min: aws.Int32(r.VCPUCount.Min),
+			Max: r.VCPUCount.Max,
+		},
+		MemoryMiB: &autoscalingtypes.MemoryMiBRequest{
+			Min: aws.Int32(r.MemoryMiB.Min),
+			Max: r.MemoryMiB.Max,
+		},
+		BurstablePerformance:                      autoscalingtypes.BurstablePerformance(r.BurstablePerformance),
+		BareMetal:                                 autoscalingtypes.BareMetal(r.BareMetal),
+		AllowedInstanceTypes:                      r.AllowedInstanceTypes,
+		ExcludedInstanceTypes:                     r.ExcludedInstanceTypes,
+		SpotMaxPricePercentageOverLowestPrice:     r.SpotMaxPricePercentageOverLowestPrice,
+		OnDemandMaxPricePercentageOverLowestPrice: r.OnDemandMaxPricePercentageOverLowestPrice,
+	}
+
+	for _, manufacturer := range r.CPUManufacturers {
+		requirements.CpuManufacturers = append(requirements.CpuManufacturers, autoscalingtypes.CpuManufacturer(manufacturer))
+	}
+	for _, generation := range r.InstanceGenerations {
+		requirements.InstanceGenerations = append(requirements.InstanceGenerations, autoscalingtypes.InstanceGeneration(generation))
+	}
+	for _, acceleratorType := range r.AcceleratorTypes {
+		requirements.AcceleratorTypes = append(requirements.AcceleratorTypes, autoscalingtypes.AcceleratorType(acceleratorType))
+	}
+	if r.AcceleratorCount != nil {
+		requirements.AcceleratorCount = &autoscalingtypes.AcceleratorCountRequest{
+			Min: aws.Int32(r.AcceleratorCount.Min),
+			Max: r.AcceleratorCount.Max,
+		}
+	}
+
+	return requirements
+}
+
+func sdkToInstanceRequirements(v *autoscalingtypes.InstanceRequirements) *expinfrav1.InstanceRequirements {
+	if v == nil {
+		return nil
+	}
+
+	requirements := &expinfrav1.InstanceRequirements{
+		BurstablePerformance:                      expinfrav1.InstanceTypeFeatureRequirement(v.BurstablePerformance),
+		BareMetal:                                 expinfrav1.InstanceTypeFeatureRequirement(v.BareMetal),
+		AllowedInstanceTypes:                      v.AllowedInstanceTypes,
+		ExcludedInstanceTypes:                     v.ExcludedInstanceTypes,
+		SpotMaxPricePercentageOverLowestPrice:     v.SpotMaxPricePercentageOverLowestPrice,
+		OnDemandMaxPricePercentageOverLowestPrice: v.OnDemandMaxPricePercentageOverLowestPrice,
+	}
+
+	if v.VCpuCount != nil {
+		requirements.VCPUCount = expinfrav1.IntegerRange{Min: aws.ToInt32(v.VCpuCount.Min), Max: v.VCpuCount.Max}
+	}
+	if v.MemoryMiB != nil {
+		requirements.MemoryMiB = expinfrav1.IntegerRange{Min: aws.ToInt32(v.MemoryMiB.Min), Max: v.MemoryMiB.Max}
+	}
+	for _, manufacturer := range v.CpuManufacturers {
+		requirements.CPUManufacturers = append(requirements.CPUManufacturers, expinfrav1.CPUManufacturer(manufacturer))
+	}
+	for _, generation := range v.InstanceGenerations {
+		requirements.InstanceGenerations = append(requirements.InstanceGenerations, string(generation))
+	}
+	for _, acceleratorType := range v.AcceleratorTypes {
+		requirements.AcceleratorTypes = append(requirements.AcceleratorTypes, string(acceleratorType))
+	}
+	if v.AcceleratorCount != nil {
+		requirements.AcceleratorCount = &expinfrav1.IntegerRange{Min: aws.ToInt32(v.AcceleratorCount.Min), Max: v.AcceleratorCount.Max}
+	}
+
+	return requirements
+}
+
 // BuildTagsFromMap takes a map of keys and values and returns them as autoscaling group tags.
 func BuildTagsFromMap(asgName string, inTags map[string]string) []autoscalingtypes.Tag {
 	if inTags == nil {
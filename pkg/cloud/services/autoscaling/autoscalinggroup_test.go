@@ -189,6 +189,64 @@ func TestServiceSDKToAutoScalingGroup(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid input - instance requirements",
+			input: &autoscalingtypes.AutoScalingGroup{
+				DesiredCapacity: aws.Int32(1),
+				MaxSize:         aws.Int32(5),
+				MinSize:         aws.Int32(1),
+				MixedInstancesPolicy: &autoscalingtypes.MixedInstancesPolicy{
+					InstancesDistribution: &autoscalingtypes.InstancesDistribution{
+						OnDemandAllocationStrategy:          aws.String("lowest-price"),
+						OnDemandBaseCapacity:                aws.Int32(0),
+						OnDemandPercentageAboveBaseCapacity: aws.Int32(0),
+						SpotAllocationStrategy:              aws.String("price-capacity-optimized"),
+					},
+					LaunchTemplate: &autoscalingtypes.LaunchTemplate{
+						Overrides: []autoscalingtypes.LaunchTemplateOverrides{
+							{
+								InstanceRequirements: &autoscalingtypes.InstanceRequirements{
+									VCpuCount:                             &autoscalingtypes.VCpuCountRequest{Min: aws.Int32(2), Max: aws.Int32(8)},
+									MemoryMiB:                             &autoscalingtypes.MemoryMiBRequest{Min: aws.Int32(4096)},
+									CpuManufacturers:                      []autoscalingtypes.CpuManufacturer{autoscalingtypes.CpuManufacturerIntel},
+									BareMetal:                             autoscalingtypes.BareMetalExcluded,
+									AcceleratorCount:                      &autoscalingtypes.AcceleratorCountRequest{Min: aws.Int32(0), Max: aws.Int32(0)},
+									ExcludedInstanceTypes:                 []string{"m5a.*"},
+									SpotMaxPricePercentageOverLowestPrice: aws.Int32(50),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: &expinfrav1.AutoScalingGroup{
+				DesiredCapacity: aws.Int32(1),
+				MaxSize:         int32(5),
+				MinSize:         int32(1),
+				MixedInstancesPolicy: &expinfrav1.MixedInstancesPolicy{
+					InstancesDistribution: &expinfrav1.InstancesDistribution{
+						OnDemandAllocationStrategy:          expinfrav1.OnDemandAllocationStrategyLowestPrice,
+						OnDemandBaseCapacity:                aws.Int64(0),
+						OnDemandPercentageAboveBaseCapacity: aws.Int64(0),
+						SpotAllocationStrategy:              expinfrav1.SpotAllocationStrategyPriceCapacityOptimized,
+					},
+					Overrides: []expinfrav1.Overrides{
+						{
+							InstanceRequirements: &expinfrav1.InstanceRequirements{
+								VCPUCount:                             expinfrav1.IntegerRange{Min: 2, Max: aws.Int32(8)},
+								MemoryMiB:                             expinfrav1.IntegerRange{Min: 4096},
+								CPUManufacturers:                      []expinfrav1.CPUManufacturer{expinfrav1.CPUManufacturerIntel},
+								BareMetal:                             expinfrav1.InstanceTypeFeatureExcluded,
+								AcceleratorCount:                      &expinfrav1.IntegerRange{Min: 0, Max: aws.Int32(0)},
+								ExcludedInstanceTypes:                 []string{"m5a.*"},
+								SpotMaxPricePercentageOverLowestPrice: aws.Int32(50),
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "valid input - suspended processes",
 			input: &autoscalingtypes.AutoScalingGroup{
@@ -1045,6 +1103,275 @@ func TestServiceDeleteASG(t *testing.T) {
 	}
 }
 
+func TestServicePutWarmPool(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name     string
+		warmPool *expinfrav1.WarmPool
+		wantErr  bool
+		expect   func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+	}{
+		{
+			name:     "Put warm pool with defaults",
+			warmPool: &expinfrav1.WarmPool{},
+			wantErr:  false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.PutWarmPool(context.TODO(), gomock.Eq(&autoscaling.PutWarmPoolInput{
+					AutoScalingGroupName:     aws.String("asgName"),
+					MinSize:                  aws.Int32(0),
+					MaxGroupPreparedCapacity: aws.Int32(-1),
+					PoolState:                autoscalingtypes.WarmPoolStateStopped,
+					InstanceReusePolicy: &autoscalingtypes.InstanceReusePolicy{
+						ReuseOnScaleIn: aws.Bool(false),
+					},
+				})).
+					Return(&autoscaling.PutWarmPoolOutput{}, nil)
+			},
+		},
+		{
+			name: "Put warm pool with all fields set",
+			warmPool: &expinfrav1.WarmPool{
+				MinSize:                  ptr.To[int32](2),
+				MaxGroupPreparedCapacity: ptr.To[int32](10),
+				PoolState:                expinfrav1.WarmPoolStateHibernated,
+				ReuseOnScaleIn:           true,
+			},
+			wantErr: false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.PutWarmPool(context.TODO(), gomock.Eq(&autoscaling.PutWarmPoolInput{
+					AutoScalingGroupName:     aws.String("asgName"),
+					MinSize:                  aws.Int32(2),
+					MaxGroupPreparedCapacity: aws.Int32(10),
+					PoolState:                autoscalingtypes.WarmPoolStateHibernated,
+					InstanceReusePolicy: &autoscalingtypes.InstanceReusePolicy{
+						ReuseOnScaleIn: aws.Bool(true),
+					},
+				})).
+					Return(&autoscaling.PutWarmPoolOutput{}, nil)
+			},
+		},
+		{
+			name:     "Put warm pool should fail when the API call fails",
+			warmPool: &expinfrav1.WarmPool{},
+			wantErr:  true,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.PutWarmPool(context.TODO(), gomock.Any()).
+					Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fakeClient := getFakeClient()
+
+			clusterScope, err := getClusterScope(fakeClient)
+			g.Expect(err).ToNot(HaveOccurred())
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			err = s.PutWarmPool(context.TODO(), "asgName", tt.warmPool)
+			checkErr(tt.wantErr, err, g)
+		})
+	}
+}
+
+func TestServiceDescribeWarmPool(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name       string
+		wantErr    bool
+		wantStatus *expinfrav1.WarmPoolStatus
+		expect     func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+	}{
+		{
+			name:       "Should return nil if the ASG has no warm pool",
+			wantErr:    false,
+			wantStatus: nil,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeWarmPool(gomock.Any(), gomock.Eq(&autoscaling.DescribeWarmPoolInput{
+					AutoScalingGroupName: aws.String("asgName"),
+				}), gomock.Any()).
+					Return(&autoscaling.DescribeWarmPoolOutput{}, nil)
+			},
+		},
+		{
+			name:    "Should return the warm pool instances",
+			wantErr: false,
+			wantStatus: &expinfrav1.WarmPoolStatus{
+				Size: 1,
+				Instances: []expinfrav1.WarmPoolInstanceStatus{
+					{
+						InstanceID:       "instanceID",
+						LifecycleState:   "Warmed:Stopped",
+						AvailabilityZone: "us-east-1a",
+					},
+				},
+			},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeWarmPool(gomock.Any(), gomock.Eq(&autoscaling.DescribeWarmPoolInput{
+					AutoScalingGroupName: aws.String("asgName"),
+				}), gomock.Any()).
+					Return(&autoscaling.DescribeWarmPoolOutput{
+						WarmPoolConfiguration: &autoscalingtypes.WarmPoolConfiguration{
+							PoolState: autoscalingtypes.WarmPoolStateStopped,
+						},
+						Instances: []autoscalingtypes.Instance{
+							{
+								InstanceId:       aws.String("instanceID"),
+								LifecycleState:   autoscalingtypes.LifecycleStateWarmedStopped,
+								AvailabilityZone: aws.String("us-east-1a"),
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name:    "Should fail when the API call fails",
+			wantErr: true,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeWarmPool(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fakeClient := getFakeClient()
+
+			clusterScope, err := getClusterScope(fakeClient)
+			g.Expect(err).ToNot(HaveOccurred())
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			status, err := s.DescribeWarmPool(context.TODO(), "asgName")
+			checkErr(tt.wantErr, err, g)
+			g.Expect(status).To(BeComparableTo(tt.wantStatus))
+		})
+	}
+}
+
+func TestWarmPoolNeedsUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		existing   expinfrav1.WarmPool
+		expected   expinfrav1.WarmPool
+		wantUpdate bool
+	}{
+		{
+			name: "AWS defaults match an empty warm pool",
+			existing: expinfrav1.WarmPool{
+				MinSize:   ptr.To[int32](0),
+				PoolState: expinfrav1.WarmPoolStateStopped,
+			},
+			expected:   expinfrav1.WarmPool{},
+			wantUpdate: false,
+		},
+		{
+			name: "pool state changed",
+			existing: expinfrav1.WarmPool{
+				PoolState: expinfrav1.WarmPoolStateStopped,
+			},
+			expected: expinfrav1.WarmPool{
+				PoolState: expinfrav1.WarmPoolStateRunning,
+			},
+			wantUpdate: true,
+		},
+		{
+			name: "max group prepared capacity removed",
+			existing: expinfrav1.WarmPool{
+				MaxGroupPreparedCapacity: ptr.To[int32](5),
+			},
+			expected:   expinfrav1.WarmPool{},
+			wantUpdate: true,
+		},
+		{
+			name:     "reuse on scale in enabled",
+			existing: expinfrav1.WarmPool{},
+			expected: expinfrav1.WarmPool{
+				ReuseOnScaleIn: true,
+			},
+			wantUpdate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(warmPoolNeedsUpdate(&tt.existing, &tt.expected)).To(Equal(tt.wantUpdate))
+		})
+	}
+}
+
+func TestCreateSDKMixedInstancesPolicy(t *testing.T) {
+	requirements := &expinfrav1.InstanceRequirements{
+		VCPUCount:            expinfrav1.IntegerRange{Min: 2, Max: aws.Int32(8)},
+		MemoryMiB:            expinfrav1.IntegerRange{Min: 4096},
+		BurstablePerformance: expinfrav1.InstanceTypeFeatureExcluded,
+		AcceleratorTypes:     []string{"gpu"},
+	}
+	sdkRequirements := &autoscalingtypes.InstanceRequirements{
+		VCpuCount:            &autoscalingtypes.VCpuCountRequest{Min: aws.Int32(2), Max: aws.Int32(8)},
+		MemoryMiB:            &autoscalingtypes.MemoryMiBRequest{Min: aws.Int32(4096)},
+		BurstablePerformance: autoscalingtypes.BurstablePerformanceExcluded,
+		AcceleratorTypes:     []autoscalingtypes.AcceleratorType{autoscalingtypes.AcceleratorTypeGpu},
+	}
+
+	tests := []struct {
+		name          string
+		policy        *expinfrav1.MixedInstancesPolicy
+		wantOverrides []autoscalingtypes.LaunchTemplateOverrides
+	}{
+		{
+			name: "instance type overrides",
+			policy: &expinfrav1.MixedInstancesPolicy{
+				Overrides: []expinfrav1.Overrides{{InstanceType: "m5.large"}, {InstanceType: "m6i.large"}},
+			},
+			wantOverrides: []autoscalingtypes.LaunchTemplateOverrides{
+				{InstanceType: aws.String("m5.large")},
+				{InstanceType: aws.String("m6i.large")},
+			},
+		},
+		{
+			name: "instance requirements overrides",
+			policy: &expinfrav1.MixedInstancesPolicy{
+				Overrides: []expinfrav1.Overrides{{InstanceRequirements: requirements}},
+			},
+			wantOverrides: []autoscalingtypes.LaunchTemplateOverrides{
+				{InstanceRequirements: sdkRequirements},
+			},
+		},
+		{
+			name: "policy instance requirements are translated to a single override",
+			policy: &expinfrav1.MixedInstancesPolicy{
+				InstanceRequirements: requirements,
+			},
+			wantOverrides: []autoscalingtypes.LaunchTemplateOverrides{
+				{InstanceRequirements: sdkRequirements},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got := createSDKMixedInstancesPolicy("test-asg", tt.policy)
+			g.Expect(got.LaunchTemplate.Overrides).To(Equal(tt.wantOverrides))
+		})
+	}
+}
+
 func TestServiceDeleteASGAndWait(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -0,0 +1,604 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+const defaultMetricAggregationType = "Average"
+
+// DescribeScalingPolicies returns the target tracking and step scaling policies of the given AutoScalingGroup, keyed by policy ARN.
+func (s *Service) DescribeScalingPolicies(ctx context.Context, asgName string) (map[string]*expinfrav1.ScalingPolicy, error) {
+	input := &autoscaling.DescribePoliciesInput{
+		AutoScalingGroupName: aws.String(asgName),
+		PolicyTypes: []string{
+			expinfrav1.ScalingPolicyTypeTargetTracking.String(),
+			expinfrav1.ScalingPolicyTypeStepScaling.String(),
+		},
+	}
+
+	policies := map[string]*expinfrav1.ScalingPolicy{}
+	paginator := autoscaling.NewDescribePoliciesPaginator(s.ASGClient, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe scaling policies for AutoScalingGroup: %q", asgName)
+		}
+
+		for _, policy := range out.ScalingPolicies {
+			converted, err := SDKToScalingPolicy(policy)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to convert scaling policy %q of AutoScalingGroup: %q", aws.ToString(policy.PolicyName), asgName)
+			}
+			policies[aws.ToString(policy.PolicyARN)] = converted
+		}
+	}
+
+	return policies, nil
+}
+
+// PutScalingPolicy creates or updates a scaling policy for the given AutoScalingGroup and returns its ARN.
+func (s *Service) PutScalingPolicy(ctx context.Context, asgName string, policy *expinfrav1.ScalingPolicy) (string, error) {
+	out, err := s.ASGClient.PutScalingPolicy(ctx, getPutScalingPolicyInput(asgName, policy))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to put scaling policy %q for AutoScalingGroup: %q", policy.Name, asgName)
+	}
+
+	return aws.ToString(out.PolicyARN), nil
+}
+
+// DeleteScalingPolicy deletes a scaling policy of the given AutoScalingGroup.
+func (s *Service) DeleteScalingPolicy(ctx context.Context, asgName string, policyName string) error {
+	input := &autoscaling.DeletePolicyInput{
+		AutoScalingGroupName: aws.String(asgName),
+		PolicyName:           aws.String(policyName),
+	}
+
+	if _, err := s.ASGClient.DeletePolicy(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to delete scaling policy %q for AutoScalingGroup: %q", policyName, asgName)
+	}
+
+	return nil
+}
+
+// DescribeScheduledActions returns the scheduled actions of the given AutoScalingGroup.
+func (s *Service) DescribeScheduledActions(ctx context.Context, asgName string) ([]*expinfrav1.ScheduledAction, error) {
+	input := &autoscaling.DescribeScheduledActionsInput{
+		AutoScalingGroupName: aws.String(asgName),
+	}
+
+	var actions []*expinfrav1.ScheduledAction
+	paginator := autoscaling.NewDescribeScheduledActionsPaginator(s.ASGClient, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe scheduled actions for AutoScalingGroup: %q", asgName)
+		}
+
+		for _, action := range out.ScheduledUpdateGroupActions {
+			actions = append(actions, SDKToScheduledAction(action))
+		}
+	}
+
+	return actions, nil
+}
+
+// PutScheduledAction creates or updates a scheduled action for the given AutoScalingGroup.
+func (s *Service) PutScheduledAction(ctx context.Context, asgName string, action *expinfrav1.ScheduledAction) error {
+	input := &autoscaling.PutScheduledUpdateGroupActionInput{
+		AutoScalingGroupName: aws.String(asgName),
+		ScheduledActionName:  aws.String(action.Name),
+		MinSize:              action.MinSize,
+		MaxSize:              action.MaxSize,
+		DesiredCapacity:      action.DesiredCapacity,
+	}
+	if action.Recurrence != "" {
+		input.Recurrence = aws.String(action.Recurrence)
+	}
+	if action.TimeZone != "" {
+		input.TimeZone = aws.String(action.TimeZone)
+	}
+	if action.StartTime != nil {
+		input.StartTime = aws.Time(action.StartTime.Time)
+	}
+	if action.EndTime != nil {
+		input.EndTime = aws.Time(action.EndTime.Time)
+	}
+
+	if _, err := s.ASGClient.PutScheduledUpdateGroupAction(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to put scheduled action %q for AutoScalingGroup: %q", action.Name, asgName)
+	}
+
+	return nil
+}
+
+// DeleteScheduledAction deletes a scheduled action of the given AutoScalingGroup.
+func (s *Service) DeleteScheduledAction(ctx context.Context, asgName string, actionName string) error {
+	input := &autoscaling.DeleteScheduledActionInput{
+		AutoScalingGroupName: aws.String(asgName),
+		ScheduledActionName:  aws.String(actionName),
+	}
+
+	if _, err := s.ASGClient.DeleteScheduledAction(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to delete scheduled action %q for AutoScalingGroup: %q", actionName, asgName)
+	}
+
+	return nil
+}
+
+func getPutScalingPolicyInput(asgName string, policy *expinfrav1.ScalingPolicy) *autoscaling.PutScalingPolicyInput {
+	input := &autoscaling.PutScalingPolicyInput{
+		AutoScalingGroupName: aws.String(asgName),
+		PolicyName:           aws.String(policy.Name),
+		PolicyType:           aws.String(scalingPolicyTypeOrDefault(policy.PolicyType).String()),
+		Enabled:              aws.Bool(ptr.Deref(policy.Enabled, true)),
+	}
+
+	if policy.EstimatedInstanceWarmup != nil {
+		input.EstimatedInstanceWarmup = aws.Int32(int32(policy.EstimatedInstanceWarmup.Duration.Seconds()))
+	}
+
+	if tt := policy.TargetTracking; tt != nil {
+		input.TargetTrackingConfiguration = &autoscalingtypes.TargetTrackingConfiguration{
+			TargetValue:    aws.Float64(tt.TargetValue.AsApproximateFloat64()),
+			DisableScaleIn: aws.Bool(tt.DisableScaleIn),
+		}
+		if tt.PredefinedMetric != nil {
+			spec := &autoscalingtypes.PredefinedMetricSpecification{
+				PredefinedMetricType: autoscalingtypes.MetricType(tt.PredefinedMetric.MetricType),
+			}
+			if tt.PredefinedMetric.ResourceLabel != "" {
+				spec.ResourceLabel = aws.String(tt.PredefinedMetric.ResourceLabel)
+			}
+			input.TargetTrackingConfiguration.PredefinedMetricSpecification = spec
+		}
+		if tt.CustomMetric != nil {
+			spec := &autoscalingtypes.CustomizedMetricSpecification{
+				MetricName: aws.String(tt.CustomMetric.MetricName),
+				Namespace:  aws.String(tt.CustomMetric.Namespace),
+				Statistic:  autoscalingtypes.MetricStatistic(tt.CustomMetric.Statistic),
+			}
+			if tt.CustomMetric.Unit != "" {
+				spec.Unit = aws.String(tt.CustomMetric.Unit)
+			}
+			for _, dimension := range tt.CustomMetric.Dimensions {
+				spec.Dimensions = append(spec.Dimensions, autoscalingtypes.MetricDimension{
+					Name:  aws.String(dimension.Name),
+					Value: aws.String(dimension.Value),
+				})
+			}
+			input.TargetTrackingConfiguration.CustomizedMetricSpecification = spec
+		}
+	}
+
+	if step := policy.StepScaling; step != nil {
+		input.AdjustmentType = aws.String(step.AdjustmentType)
+		input.MetricAggregationType = aws.String(metricAggregationTypeOrDefault(step.MetricAggregationType))
+		input.MinAdjustmentMagnitude = step.MinAdjustmentMagnitude
+		for _, adjustment := range step.StepAdjustments {
+			sdkAdjustment := autoscalingtypes.StepAdjustment{
+				ScalingAdjustment: aws.Int32(adjustment.ScalingAdjustment),
+			}
+			if adjustment.MetricIntervalLowerBound != nil {
+				sdkAdjustment.MetricIntervalLowerBound = aws.Float64(adjustment.MetricIntervalLowerBound.AsApproximateFloat64())
+			}
+			if adjustment.MetricIntervalUpperBound != nil {
+				sdkAdjustment.MetricIntervalUpperBound = aws.Float64(adjustment.MetricIntervalUpperBound.AsApproximateFloat64())
+			}
+			input.StepAdjustments = append(input.StepAdjustments, sdkAdjustment)
+		}
+	}
+
+	return input
+}
+
+// SDKToScalingPolicy converts an AWS SDK ScalingPolicy to the CAPA scaling policy type.
+func SDKToScalingPolicy(v autoscalingtypes.ScalingPolicy) (*expinfrav1.ScalingPolicy, error) {
+	policy := &expinfrav1.ScalingPolicy{
+		Name:       aws.ToString(v.PolicyName),
+		PolicyType: expinfrav1.ScalingPolicyType(aws.ToString(v.PolicyType)),
+		Enabled:    v.Enabled,
+	}
+
+	if v.EstimatedInstanceWarmup != nil {
+		policy.EstimatedInstanceWarmup = &metav1.Duration{Duration: time.Duration(*v.EstimatedInstanceWarmup) * time.Second}
+	}
+
+	if tt := v.TargetTrackingConfiguration; tt != nil {
+		targetValue, err := quantityFromFloat(aws.ToFloat64(tt.TargetValue))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid target value")
+		}
+		policy.TargetTracking = &expinfrav1.TargetTrackingConfiguration{
+			TargetValue:    *targetValue,
+			DisableScaleIn: aws.ToBool(tt.DisableScaleIn),
+		}
+		if tt.PredefinedMetricSpecification != nil {
+			policy.TargetTracking.PredefinedMetric = &expinfrav1.PredefinedMetricSpecification{
+				MetricType:    expinfrav1.PredefinedMetricType(tt.PredefinedMetricSpecification.PredefinedMetricType),
+				ResourceLabel: aws.ToString(tt.PredefinedMetricSpecification.ResourceLabel),
+			}
+		}
+		if tt.CustomizedMetricSpecification != nil {
+			custom := &expinfrav1.CustomizedMetricSpecification{
+				MetricName: aws.ToString(tt.CustomizedMetricSpecification.MetricName),
+				Namespace:  aws.ToString(tt.CustomizedMetricSpecification.Namespace),
+				Statistic:  string(tt.CustomizedMetricSpecification.Statistic),
+				Unit:       aws.ToString(tt.CustomizedMetricSpecification.Unit),
+			}
+			for _, dimension := range tt.CustomizedMetricSpecification.Dimensions {
+				custom.Dimensions = append(custom.Dimensions, expinfrav1.MetricDimension{
+					Name:  aws.ToString(dimension.Name),
+					Value: aws.ToString(dimension.Value),
+				})
+			}
+			policy.TargetTracking.CustomMetric = custom
+		}
+	}
+
+	if policy.PolicyType == expinfrav1.ScalingPolicyTypeStepScaling {
+		policy.StepScaling = &expinfrav1.StepScalingConfiguration{
+			AdjustmentType:         aws.ToString(v.AdjustmentType),
+			MetricAggregationType:  aws.ToString(v.MetricAggregationType),
+			MinAdjustmentMagnitude: v.MinAdjustmentMagnitude,
+		}
+		for _, adjustment := range v.StepAdjustments {
+			capaAdjustment := expinfrav1.StepAdjustment{
+				ScalingAdjustment: aws.ToInt32(adjustment.ScalingAdjustment),
+			}
+			if adjustment.MetricIntervalLowerBound != nil {
+				lowerBound, err := quantityFromFloat(*adjustment.MetricIntervalLowerBound)
+				if err != nil {
+					return nil, errors.Wrap(err, "invalid step adjustment lower bound")
+				}
+				capaAdjustment.MetricIntervalLowerBound = lowerBound
+			}
+			if adjustment.MetricIntervalUpperBound != nil {
+				upperBound, err := quantityFromFloat(*adjustment.MetricIntervalUpperBound)
+				if err != nil {
+					return nil, errors.Wrap(err, "invalid step adjustment upper bound")
+				}
+				capaAdjustment.MetricIntervalUpperBound = upperBound
+			}
+			policy.StepScaling.StepAdjustments = append(policy.StepScaling.StepAdjustments, capaAdjustment)
+		}
+	}
+
+	return policy, nil
+}
+
+// SDKToScheduledAction converts an AWS SDK ScheduledUpdateGroupAction to the CAPA scheduled action type.
+func SDKToScheduledAction(v autoscalingtypes.ScheduledUpdateGroupAction) *expinfrav1.ScheduledAction {
+	action := &expinfrav1.ScheduledAction{
+		Name:            aws.ToString(v.ScheduledActionName),
+		Recurrence:      aws.ToString(v.Recurrence),
+		TimeZone:        aws.ToString(v.TimeZone),
+		MinSize:         v.MinSize,
+		MaxSize:         v.MaxSize,
+		DesiredCapacity: v.DesiredCapacity,
+	}
+	if v.StartTime != nil {
+		action.StartTime = &metav1.Time{Time: *v.StartTime}
+	}
+	if v.EndTime != nil {
+		action.EndTime = &metav1.Time{Time: *v.EndTime}
+	}
+
+	return action
+}
+
+// quantityFromFloat converts a value returned by the AWS API to a quantity, failing on values
+// that can't be represented as a quantity such as NaN or infinities.
+func quantityFromFloat(f float64) (*resource.Quantity, error) {
+	q, err := resource.ParseQuantity(strconv.FormatFloat(f, 'f', -1, 64))
+	if err != nil {
+		return nil, err
+	}
+	return &q, nil
+}
+
+func scalingPolicyTypeOrDefault(policyType expinfrav1.ScalingPolicyType) expinfrav1.ScalingPolicyType {
+	if policyType == "" {
+		return expinfrav1.ScalingPolicyTypeTargetTracking
+	}
+	return policyType
+}
+
+func metricAggregationTypeOrDefault(aggregationType string) string {
+	if aggregationType == "" {
+		return defaultMetricAggregationType
+	}
+	return aggregationType
+}
+
+func quantitiesEqual(a, b *resource.Quantity) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Cmp(*b) == 0
+}
+
+func scalingPolicyNeedsUpdate(existing *expinfrav1.ScalingPolicy, expected *expinfrav1.ScalingPolicy) bool {
+	if scalingPolicyTypeOrDefault(existing.PolicyType) != scalingPolicyTypeOrDefault(expected.PolicyType) ||
+		ptr.Deref(existing.Enabled, true) != ptr.Deref(expected.Enabled, true) ||
+		ptr.Deref(existing.EstimatedInstanceWarmup, metav1.Duration{}) != ptr.Deref(expected.EstimatedInstanceWarmup, metav1.Duration{}) {
+		return true
+	}
+
+	if (existing.TargetTracking == nil) != (expected.TargetTracking == nil) {
+		return true
+	}
+	if expected.TargetTracking != nil && targetTrackingNeedsUpdate(existing.TargetTracking, expected.TargetTracking) {
+		return true
+	}
+
+	if (existing.StepScaling == nil) != (expected.StepScaling == nil) {
+		return true
+	}
+	return expected.StepScaling != nil && stepScalingNeedsUpdate(existing.StepScaling, expected.StepScaling)
+}
+
+func targetTrackingNeedsUpdate(existing *expinfrav1.TargetTrackingConfiguration, expected *expinfrav1.TargetTrackingConfiguration) bool {
+	if !quantitiesEqual(&existing.TargetValue, &expected.TargetValue) ||
+		existing.DisableScaleIn != expected.DisableScaleIn ||
+		ptr.Deref(existing.PredefinedMetric, expinfrav1.PredefinedMetricSpecification{}) != ptr.Deref(expected.PredefinedMetric, expinfrav1.PredefinedMetricSpecification{}) {
+		return true
+	}
+
+	if (existing.CustomMetric == nil) != (expected.CustomMetric == nil) {
+		return true
+	}
+	if expected.CustomMetric == nil {
+		return false
+	}
+
+	existingMetric, expectedMetric := existing.CustomMetric, expected.CustomMetric
+	if existingMetric.MetricName != expectedMetric.MetricName ||
+		existingMetric.Namespace != expectedMetric.Namespace ||
+		existingMetric.Statistic != expectedMetric.Statistic ||
+		existingMetric.Unit != expectedMetric.Unit ||
+		len(existingMetric.Dimensions) != len(expectedMetric.Dimensions) {
+		return true
+	}
+	for i := range expectedMetric.Dimensions {
+		if existingMetric.Dimensions[i] != expectedMetric.Dimensions[i] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func stepScalingNeedsUpdate(existing *expinfrav1.StepScalingConfiguration, expected *expinfrav1.StepScalingConfiguration) bool {
+	if existing.AdjustmentType != expected.AdjustmentType ||
+		metricAggregationTypeOrDefault(existing.MetricAggregationType) != metricAggregationTypeOrDefault(expected.MetricAggregationType) ||
+		ptr.Deref(existing.MinAdjustmentMagnitude, 0) != ptr.Deref(expected.MinAdjustmentMagnitude, 0) ||
+		len(existing.StepAdjustments) != len(expected.StepAdjustments) {
+		return true
+	}
+
+	for i := range expected.StepAdjustments {
+		existingAdjustment, expectedAdjustment := existing.StepAdjustments[i], expected.StepAdjustments[i]
+		if existingAdjustment.ScalingAdjustment != expectedAdjustment.ScalingAdjustment ||
+			!quantitiesEqual(existingAdjustment.MetricIntervalLowerBound, expectedAdjustment.MetricIntervalLowerBound) ||
+			!quantitiesEqual(existingAdjustment.MetricIntervalUpperBound, expectedAdjustment.MetricIntervalUpperBound) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func scheduledActionNeedsUpdate(existing *expinfrav1.ScheduledAction, expected *expinfrav1.ScheduledAction) bool {
+	if existing.Recurrence != expected.Recurrence ||
+		existing.TimeZone != expected.TimeZone ||
+		ptr.Deref(existing.MinSize, -1) != ptr.Deref(expected.MinSize, -1) ||
+		ptr.Deref(existing.MaxSize, -1) != ptr.Deref(expected.MaxSize, -1) ||
+		ptr.Deref(existing.DesiredCapacity, -1) != ptr.Deref(expected.DesiredCapacity, -1) ||
+		!timesEqual(existing.EndTime, expected.EndTime) {
+		return true
+	}
+
+	// AWS reports the next occurrence of a recurring action as its start time,
+	// so the start time is only compared for one-off actions.
+	return expected.Recurrence == "" && !timesEqual(existing.StartTime, expected.StartTime)
+}
+
+func timesEqual(a, b *metav1.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(b)
+}
+
+// ReconcileScalingPolicies reconciles the scaling policies of an ASG by creating
+// missing policies, updating mismatching policies and deleting policies that were
+// previously created for the ASG (as listed in managedPolicies) but are no longer
+// wanted. Policies created outside of CAPA are left untouched. It returns the
+// policies that are managed for the ASG afterwards, which is also meaningful on error.
+func ReconcileScalingPolicies(ctx context.Context, asgService services.ASGInterface, asgName string, wantedPolicies []expinfrav1.ScalingPolicy, managedPolicies []expinfrav1.ScalingPolicyStatus, storeConditionsOnObject v1beta1conditions.Setter, log logger.Wrapper) ([]expinfrav1.ScalingPolicyStatus, error) {
+	if len(wantedPolicies) == 0 && len(managedPolicies) == 0 {
+		return nil, nil
+	}
+
+	existingPolicies, err := asgService.DescribeScalingPolicies(ctx, asgName)
+	if err != nil {
+		v1beta1conditions.MarkFalse(storeConditionsOnObject, expinfrav1.ScalingPoliciesReadyCondition, expinfrav1.ScalingPolicyReconcileFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		return managedPolicies, err
+	}
+
+	wanted := make(map[string]bool, len(wantedPolicies))
+	for _, policy := range wantedPolicies {
+		wanted[policy.Name] = true
+	}
+
+	existing := make(map[string]bool, len(existingPolicies))
+	for _, policy := range existingPolicies {
+		existing[policy.Name] = true
+	}
+
+	for _, managedPolicy := range managedPolicies {
+		// Policies that were already deleted out of band don't need to be deleted again.
+		if wanted[managedPolicy.Name] || !existing[managedPolicy.Name] {
+			continue
+		}
+
+		log.Info("Deleting scaling policy", "policy", managedPolicy.Name)
+		if err := asgService.DeleteScalingPolicy(ctx, asgName, managedPolicy.Name); err != nil {
+			v1beta1conditions.MarkFalse(storeConditionsOnObject, expinfrav1.ScalingPoliciesReadyCondition, expinfrav1.ScalingPolicyReconcileFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+			return managedPolicies, err
+		}
+	}
+
+	result := make([]expinfrav1.ScalingPolicyStatus, 0, len(wantedPolicies))
+
+	for i := range wantedPolicies {
+		wantedPolicy := &wantedPolicies[i]
+
+		var existingARN string
+		var existingPolicy *expinfrav1.ScalingPolicy
+		for arn, policy := range existingPolicies {
+			if policy.Name == wantedPolicy.Name {
+				existingARN, existingPolicy = arn, policy
+				break
+			}
+		}
+
+		if existingPolicy == nil || scalingPolicyNeedsUpdate(existingPolicy, wantedPolicy) {
+			log.Info("Creating or updating scaling policy", "policy", wantedPolicy.Name)
+			arn, err := asgService.PutScalingPolicy(ctx, asgName, wantedPolicy)
+			if err != nil {
+				v1beta1conditions.MarkFalse(storeConditionsOnObject, expinfrav1.ScalingPoliciesReadyCondition, expinfrav1.ScalingPolicyReconcileFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+				return appendUnreconciledScalingPolicies(result, managedPolicies, wantedPolicies[i:]), err
+			}
+			existingARN = arn
+		}
+
+		result = append(result, expinfrav1.ScalingPolicyStatus{Name: wantedPolicy.Name, ARN: existingARN})
+	}
+
+	return result, nil
+}
+
+// appendUnreconciledScalingPolicies keeps track of the managed policies that weren't reconciled yet,
+// so they are still deleted by a later reconciliation if they are removed from the spec in the meantime.
+func appendUnreconciledScalingPolicies(result []expinfrav1.ScalingPolicyStatus, managedPolicies []expinfrav1.ScalingPolicyStatus, unreconciled []expinfrav1.ScalingPolicy) []expinfrav1.ScalingPolicyStatus {
+	for _, policy := range unreconciled {
+		for _, managedPolicy := range managedPolicies {
+			if managedPolicy.Name == policy.Name {
+				result = append(result, managedPolicy)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// ReconcileScheduledActions reconciles the scheduled actions of an ASG by creating
+// missing actions, updating mismatching actions and deleting actions that were
+// previously created for the ASG (as listed in managedActions) but are no longer
+// wanted. Actions created outside of CAPA are left untouched. It returns the names
+// of the actions that are managed for the ASG afterwards, which is also meaningful on error.
+func ReconcileScheduledActions(ctx context.Context, asgService services.ASGInterface, asgName string, wantedActions []expinfrav1.ScheduledAction, managedActions []string, storeConditionsOnObject v1beta1conditions.Setter, log logger.Wrapper) ([]string, error) {
+	if len(wantedActions) == 0 && len(managedActions) == 0 {
+		return nil, nil
+	}
+
+	existingActions, err := asgService.DescribeScheduledActions(ctx, asgName)
+	if err != nil {
+		v1beta1conditions.MarkFalse(storeConditionsOnObject, expinfrav1.ScalingPoliciesReadyCondition, expinfrav1.ScheduledActionReconcileFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		return managedActions, err
+	}
+
+	wanted := make(map[string]bool, len(wantedActions))
+	for _, action := range wantedActions {
+		wanted[action.Name] = true
+	}
+
+	existing := make(map[string]bool, len(existingActions))
+	for _, action := range existingActions {
+		existing[action.Name] = true
+	}
+
+	for _, managedAction := range managedActions {
+		// Actions that were already deleted out of band don't need to be deleted again.
+		if wanted[managedAction] || !existing[managedAction] {
+			continue
+		}
+
+		log.Info("Deleting scheduled action", "action", managedAction)
+		if err := asgService.DeleteScheduledAction(ctx, asgName, managedAction); err != nil {
+			v1beta1conditions.MarkFalse(storeConditionsOnObject, expinfrav1.ScalingPoliciesReadyCondition, expinfrav1.ScheduledActionReconcileFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+			return managedActions, err
+		}
+	}
+
+	result := make([]string, 0, len(wantedActions))
+
+	for i := range wantedActions {
+		wantedAction := &wantedActions[i]
+
+		var existingAction *expinfrav1.ScheduledAction
+		for _, action := range existingActions {
+			if action.Name == wantedAction.Name {
+				existingAction = action
+				break
+			}
+		}
+
+		if existingAction == nil || scheduledActionNeedsUpdate(existingAction, wantedAction) {
+			log.Info("Creating or updating scheduled action", "action", wantedAction.Name)
+			if err := asgService.PutScheduledAction(ctx, asgName, wantedAction); err != nil {
+				v1beta1conditions.MarkFalse(storeConditionsOnObject, expinfrav1.ScalingPoliciesReadyCondition, expinfrav1.ScheduledActionReconcileFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+				return appendUnreconciledScheduledActions(result, managedActions, wantedActions[i:]), err
+			}
+		}
+
+		result = append(result, wantedAction.Name)
+	}
+
+	return result, nil
+}
+
+// appendUnreconciledScheduledActions keeps track of the managed actions that weren't reconciled yet,
+// so they are still deleted by a later reconciliation if they are removed from the spec in the meantime.
+func appendUnreconciledScheduledActions(result []string, managedActions []string, unreconciled []expinfrav1.ScheduledAction) []string {
+	for _, action := range unreconciled {
+		if slices.Contains(managedActions, action.Name) {
+			result = append(result, action.Name)
+		}
+	}
+	return result
+}
@@ -0,0 +1,465 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+func targetTrackingPolicy(name string, target string) expinfrav1.ScalingPolicy {
+	return expinfrav1.ScalingPolicy{
+		Name:       name,
+		PolicyType: expinfrav1.ScalingPolicyTypeTargetTracking,
+		TargetTracking: &expinfrav1.TargetTrackingConfiguration{
+			PredefinedMetric: &expinfrav1.PredefinedMetricSpecification{
+				MetricType: expinfrav1.PredefinedMetricTypeASGAverageCPUUtilization,
+			},
+			TargetValue: resource.MustParse(target),
+		},
+	}
+}
+
+func stepScalingPolicy(name string) expinfrav1.ScalingPolicy {
+	return expinfrav1.ScalingPolicy{
+		Name:                    name,
+		PolicyType:              expinfrav1.ScalingPolicyTypeStepScaling,
+		EstimatedInstanceWarmup: &metav1.Duration{Duration: 120 * time.Second},
+		StepScaling: &expinfrav1.StepScalingConfiguration{
+			AdjustmentType: "ChangeInCapacity",
+			StepAdjustments: []expinfrav1.StepAdjustment{
+				{MetricIntervalLowerBound: ptr.To(resource.MustParse("0")), MetricIntervalUpperBound: ptr.To(resource.MustParse("10.5")), ScalingAdjustment: 1},
+				{MetricIntervalLowerBound: ptr.To(resource.MustParse("10.5")), ScalingAdjustment: 2},
+			},
+		},
+	}
+}
+
+// sdkScalingPolicy returns the policy as it is reported by AWS after it has been put.
+func sdkScalingPolicy(policy expinfrav1.ScalingPolicy) autoscalingtypes.ScalingPolicy {
+	input := getPutScalingPolicyInput("asg", &policy)
+	return autoscalingtypes.ScalingPolicy{
+		AutoScalingGroupName:        input.AutoScalingGroupName,
+		PolicyName:                  input.PolicyName,
+		PolicyARN:                   aws.String("arn:aws:autoscaling:" + policy.Name),
+		PolicyType:                  input.PolicyType,
+		Enabled:                     input.Enabled,
+		EstimatedInstanceWarmup:     input.EstimatedInstanceWarmup,
+		AdjustmentType:              input.AdjustmentType,
+		MetricAggregationType:       input.MetricAggregationType,
+		MinAdjustmentMagnitude:      input.MinAdjustmentMagnitude,
+		StepAdjustments:             input.StepAdjustments,
+		TargetTrackingConfiguration: input.TargetTrackingConfiguration,
+	}
+}
+
+func mustSDKToScalingPolicy(t *testing.T, policy autoscalingtypes.ScalingPolicy) *expinfrav1.ScalingPolicy {
+	t.Helper()
+	converted, err := SDKToScalingPolicy(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return converted
+}
+
+func TestSDKToScalingPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      autoscalingtypes.ScalingPolicy
+		expectError bool
+	}{
+		{
+			name:   "target value",
+			policy: sdkScalingPolicy(targetTrackingPolicy("cpu", "60.5")),
+		},
+		{
+			name: "NaN target value",
+			policy: autoscalingtypes.ScalingPolicy{
+				PolicyName:                  aws.String("cpu"),
+				PolicyType:                  aws.String(expinfrav1.ScalingPolicyTypeTargetTracking.String()),
+				TargetTrackingConfiguration: &autoscalingtypes.TargetTrackingConfiguration{TargetValue: aws.Float64(math.NaN())},
+			},
+			expectError: true,
+		},
+		{
+			name: "infinite step adjustment bound",
+			policy: autoscalingtypes.ScalingPolicy{
+				PolicyName: aws.String("step"),
+				PolicyType: aws.String(expinfrav1.ScalingPolicyTypeStepScaling.String()),
+				StepAdjustments: []autoscalingtypes.StepAdjustment{{
+					ScalingAdjustment:        aws.Int32(1),
+					MetricIntervalUpperBound: aws.Float64(math.Inf(1)),
+				}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			policy, err := SDKToScalingPolicy(tc.policy)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(policy.Name).To(Equal(aws.ToString(tc.policy.PolicyName)))
+		})
+	}
+}
+
+func TestScalingPolicyNeedsUpdate(t *testing.T) {
+	customMetricPolicy := expinfrav1.ScalingPolicy{
+		Name: "queue",
+		TargetTracking: &expinfrav1.TargetTrackingConfiguration{
+			CustomMetric: &expinfrav1.CustomizedMetricSpecification{
+				MetricName: "ApproximateNumberOfMessagesVisible",
+				Namespace:  "AWS/SQS",
+				Statistic:  "Average",
+				Dimensions: []expinfrav1.MetricDimension{{Name: "QueueName", Value: "jobs"}},
+			},
+			TargetValue: resource.MustParse("100"),
+		},
+	}
+
+	tests := []struct {
+		name       string
+		existing   *expinfrav1.ScalingPolicy
+		expected   expinfrav1.ScalingPolicy
+		wantUpdate bool
+	}{
+		{
+			name:       "target tracking policy reported by AWS is unchanged",
+			existing:   mustSDKToScalingPolicy(t, sdkScalingPolicy(targetTrackingPolicy("cpu", "60"))),
+			expected:   targetTrackingPolicy("cpu", "60"),
+			wantUpdate: false,
+		},
+		{
+			name:       "target tracking policy with a fractional target reported by AWS is unchanged",
+			existing:   mustSDKToScalingPolicy(t, sdkScalingPolicy(targetTrackingPolicy("cpu", "60.5"))),
+			expected:   targetTrackingPolicy("cpu", "60.5"),
+			wantUpdate: false,
+		},
+		{
+			name:       "custom metric policy reported by AWS is unchanged",
+			existing:   mustSDKToScalingPolicy(t, sdkScalingPolicy(customMetricPolicy)),
+			expected:   customMetricPolicy,
+			wantUpdate: false,
+		},
+		{
+			name:       "step scaling policy reported by AWS is unchanged",
+			existing:   mustSDKToScalingPolicy(t, sdkScalingPolicy(stepScalingPolicy("step"))),
+			expected:   stepScalingPolicy("step"),
+			wantUpdate: false,
+		},
+		{
+			name:       "target value changed",
+			existing:   mustSDKToScalingPolicy(t, sdkScalingPolicy(targetTrackingPolicy("cpu", "60"))),
+			expected:   targetTrackingPolicy("cpu", "70"),
+			wantUpdate: true,
+		},
+		{
+			name:     "policy disabled",
+			existing: mustSDKToScalingPolicy(t, sdkScalingPolicy(targetTrackingPolicy("cpu", "60"))),
+			expected: func() expinfrav1.ScalingPolicy {
+				p := targetTrackingPolicy("cpu", "60")
+				p.Enabled = ptr.To(false)
+				return p
+			}(),
+			wantUpdate: true,
+		},
+		{
+			name:     "step adjustment changed",
+			existing: mustSDKToScalingPolicy(t, sdkScalingPolicy(stepScalingPolicy("step"))),
+			expected: func() expinfrav1.ScalingPolicy {
+				p := stepScalingPolicy("step")
+				p.StepScaling.StepAdjustments[1].ScalingAdjustment = 4
+				return p
+			}(),
+			wantUpdate: true,
+		},
+		{
+			name:       "policy type changed",
+			existing:   mustSDKToScalingPolicy(t, sdkScalingPolicy(targetTrackingPolicy("policy", "60"))),
+			expected:   stepScalingPolicy("policy"),
+			wantUpdate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(scalingPolicyNeedsUpdate(tt.existing, &tt.expected)).To(Equal(tt.wantUpdate))
+		})
+	}
+}
+
+func TestScheduledActionNeedsUpdate(t *testing.T) {
+	start := metav1.NewTime(time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC))
+	nextStart := metav1.NewTime(time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name       string
+		existing   expinfrav1.ScheduledAction
+		expected   expinfrav1.ScheduledAction
+		wantUpdate bool
+	}{
+		{
+			name:       "recurring action with start time reported by AWS is unchanged",
+			existing:   expinfrav1.ScheduledAction{Name: "daily", Recurrence: "0 8 * * *", StartTime: &nextStart, DesiredCapacity: ptr.To[int32](3)},
+			expected:   expinfrav1.ScheduledAction{Name: "daily", Recurrence: "0 8 * * *", DesiredCapacity: ptr.To[int32](3)},
+			wantUpdate: false,
+		},
+		{
+			name:       "one-off action start time changed",
+			existing:   expinfrav1.ScheduledAction{Name: "once", StartTime: &start, DesiredCapacity: ptr.To[int32](3)},
+			expected:   expinfrav1.ScheduledAction{Name: "once", StartTime: &nextStart, DesiredCapacity: ptr.To[int32](3)},
+			wantUpdate: true,
+		},
+		{
+			name:       "recurrence changed",
+			existing:   expinfrav1.ScheduledAction{Name: "daily", Recurrence: "0 8 * * *", DesiredCapacity: ptr.To[int32](3)},
+			expected:   expinfrav1.ScheduledAction{Name: "daily", Recurrence: "0 9 * * *", DesiredCapacity: ptr.To[int32](3)},
+			wantUpdate: true,
+		},
+		{
+			name:       "desired capacity removed",
+			existing:   expinfrav1.ScheduledAction{Name: "daily", Recurrence: "0 8 * * *", MinSize: ptr.To[int32](1), DesiredCapacity: ptr.To[int32](3)},
+			expected:   expinfrav1.ScheduledAction{Name: "daily", Recurrence: "0 8 * * *", MinSize: ptr.To[int32](1)},
+			wantUpdate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(scheduledActionNeedsUpdate(&tt.existing, &tt.expected)).To(Equal(tt.wantUpdate))
+		})
+	}
+}
+
+func TestReconcileScalingPolicies(t *testing.T) {
+	const asgName = "test-asg"
+
+	tests := []struct {
+		name            string
+		wantedPolicies  []expinfrav1.ScalingPolicy
+		managedPolicies []expinfrav1.ScalingPolicyStatus
+		expect          func(m *mock_services.MockASGInterfaceMockRecorder)
+		wantManaged     []expinfrav1.ScalingPolicyStatus
+		wantErr         bool
+	}{
+		{
+			name: "does nothing without wanted or managed policies",
+		},
+		{
+			name:           "creates missing policies",
+			wantedPolicies: []expinfrav1.ScalingPolicy{targetTrackingPolicy("cpu", "60")},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScalingPolicies(gomock.Any(), asgName).Return(map[string]*expinfrav1.ScalingPolicy{}, nil)
+				m.PutScalingPolicy(gomock.Any(), asgName, gomock.Any()).Return("arn:cpu", nil)
+			},
+			wantManaged: []expinfrav1.ScalingPolicyStatus{{Name: "cpu", ARN: "arn:cpu"}},
+		},
+		{
+			name:            "leaves unchanged policies alone",
+			wantedPolicies:  []expinfrav1.ScalingPolicy{targetTrackingPolicy("cpu", "60")},
+			managedPolicies: []expinfrav1.ScalingPolicyStatus{{Name: "cpu", ARN: "arn:cpu"}},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScalingPolicies(gomock.Any(), asgName).Return(map[string]*expinfrav1.ScalingPolicy{
+					"arn:cpu": mustSDKToScalingPolicy(t, sdkScalingPolicy(targetTrackingPolicy("cpu", "60"))),
+				}, nil)
+			},
+			wantManaged: []expinfrav1.ScalingPolicyStatus{{Name: "cpu", ARN: "arn:cpu"}},
+		},
+		{
+			name:            "deletes managed policies removed from the spec but not unmanaged ones",
+			managedPolicies: []expinfrav1.ScalingPolicyStatus{{Name: "cpu", ARN: "arn:cpu"}},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScalingPolicies(gomock.Any(), asgName).Return(map[string]*expinfrav1.ScalingPolicy{
+					"arn:cpu":       mustSDKToScalingPolicy(t, sdkScalingPolicy(targetTrackingPolicy("cpu", "60"))),
+					"arn:unmanaged": mustSDKToScalingPolicy(t, sdkScalingPolicy(targetTrackingPolicy("unmanaged", "60"))),
+				}, nil)
+				m.DeleteScalingPolicy(gomock.Any(), asgName, "cpu").Return(nil)
+			},
+			wantManaged: []expinfrav1.ScalingPolicyStatus{},
+		},
+		{
+			name:            "forgets managed policies that were deleted out of band",
+			managedPolicies: []expinfrav1.ScalingPolicyStatus{{Name: "cpu", ARN: "arn:cpu"}},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScalingPolicies(gomock.Any(), asgName).Return(map[string]*expinfrav1.ScalingPolicy{}, nil)
+			},
+			wantManaged: []expinfrav1.ScalingPolicyStatus{},
+		},
+		{
+			name:            "keeps all managed policies when deleting a policy fails",
+			wantedPolicies:  []expinfrav1.ScalingPolicy{targetTrackingPolicy("cpu", "60")},
+			managedPolicies: []expinfrav1.ScalingPolicyStatus{{Name: "cpu", ARN: "arn:cpu"}, {Name: "memory", ARN: "arn:memory"}},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScalingPolicies(gomock.Any(), asgName).Return(map[string]*expinfrav1.ScalingPolicy{
+					"arn:cpu":    mustSDKToScalingPolicy(t, sdkScalingPolicy(targetTrackingPolicy("cpu", "60"))),
+					"arn:memory": mustSDKToScalingPolicy(t, sdkScalingPolicy(targetTrackingPolicy("memory", "60"))),
+				}, nil)
+				m.DeleteScalingPolicy(gomock.Any(), asgName, "memory").Return(errors.New("some error"))
+			},
+			wantManaged: []expinfrav1.ScalingPolicyStatus{{Name: "cpu", ARN: "arn:cpu"}, {Name: "memory", ARN: "arn:memory"}},
+			wantErr:     true,
+		},
+		{
+			name:            "keeps track of managed policies on failure",
+			wantedPolicies:  []expinfrav1.ScalingPolicy{targetTrackingPolicy("cpu", "70"), targetTrackingPolicy("memory", "60")},
+			managedPolicies: []expinfrav1.ScalingPolicyStatus{{Name: "cpu", ARN: "arn:cpu"}},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScalingPolicies(gomock.Any(), asgName).Return(map[string]*expinfrav1.ScalingPolicy{
+					"arn:cpu": mustSDKToScalingPolicy(t, sdkScalingPolicy(targetTrackingPolicy("cpu", "60"))),
+				}, nil)
+				m.PutScalingPolicy(gomock.Any(), asgName, gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantManaged: []expinfrav1.ScalingPolicyStatus{{Name: "cpu", ARN: "arn:cpu"}},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			asgService := mock_services.NewMockASGInterface(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(asgService.EXPECT())
+			}
+
+			awsMachinePool := &expinfrav1.AWSMachinePool{}
+			managed, err := ReconcileScalingPolicies(context.TODO(), asgService, asgName, tt.wantedPolicies, tt.managedPolicies, awsMachinePool, logger.NewLogger(klog.Background()))
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(v1beta1conditions.IsFalse(awsMachinePool, expinfrav1.ScalingPoliciesReadyCondition)).To(BeTrue())
+				g.Expect(v1beta1conditions.GetSeverity(awsMachinePool, expinfrav1.ScalingPoliciesReadyCondition)).To(Equal(ptr.To(clusterv1beta1.ConditionSeverityError)))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(managed).To(Equal(tt.wantManaged))
+		})
+	}
+}
+
+func TestReconcileScheduledActions(t *testing.T) {
+	const asgName = "test-asg"
+
+	daily := expinfrav1.ScheduledAction{Name: "daily", Recurrence: "0 8 * * *", DesiredCapacity: ptr.To[int32](3)}
+
+	tests := []struct {
+		name           string
+		wantedActions  []expinfrav1.ScheduledAction
+		managedActions []string
+		expect         func(m *mock_services.MockASGInterfaceMockRecorder)
+		wantManaged    []string
+		wantErr        bool
+	}{
+		{
+			name:          "creates missing actions",
+			wantedActions: []expinfrav1.ScheduledAction{daily},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScheduledActions(gomock.Any(), asgName).Return(nil, nil)
+				m.PutScheduledAction(gomock.Any(), asgName, gomock.Any()).Return(nil)
+			},
+			wantManaged: []string{"daily"},
+		},
+		{
+			name:           "leaves unchanged actions alone",
+			wantedActions:  []expinfrav1.ScheduledAction{daily},
+			managedActions: []string{"daily"},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScheduledActions(gomock.Any(), asgName).Return([]*expinfrav1.ScheduledAction{daily.DeepCopy()}, nil)
+			},
+			wantManaged: []string{"daily"},
+		},
+		{
+			name:           "deletes managed actions removed from the spec",
+			managedActions: []string{"daily"},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScheduledActions(gomock.Any(), asgName).Return([]*expinfrav1.ScheduledAction{daily.DeepCopy()}, nil)
+				m.DeleteScheduledAction(gomock.Any(), asgName, "daily").Return(nil)
+			},
+			wantManaged: []string{},
+		},
+		{
+			name:           "forgets managed actions that were deleted out of band",
+			managedActions: []string{"daily"},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScheduledActions(gomock.Any(), asgName).Return(nil, nil)
+			},
+			wantManaged: []string{},
+		},
+		{
+			name:           "keeps track of managed actions on failure",
+			wantedActions:  []expinfrav1.ScheduledAction{daily},
+			managedActions: []string{"daily", "nightly"},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScheduledActions(gomock.Any(), asgName).Return([]*expinfrav1.ScheduledAction{daily.DeepCopy(), {Name: "nightly"}}, nil)
+				m.DeleteScheduledAction(gomock.Any(), asgName, "nightly").Return(errors.New("some error"))
+			},
+			wantManaged: []string{"daily", "nightly"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			asgService := mock_services.NewMockASGInterface(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(asgService.EXPECT())
+			}
+
+			awsMachinePool := &expinfrav1.AWSMachinePool{}
+			managed, err := ReconcileScheduledActions(context.TODO(), asgService, asgName, tt.wantedActions, tt.managedActions, awsMachinePool, logger.NewLogger(klog.Background()))
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(v1beta1conditions.IsFalse(awsMachinePool, expinfrav1.ScalingPoliciesReadyCondition)).To(BeTrue())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(managed).To(Equal(tt.wantManaged))
+		})
+	}
+}
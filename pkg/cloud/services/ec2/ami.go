@@ -336,6 +336,49 @@ func (s *Service) defaultBastionAMILookup() (string, error) {
 	return *latestImage.ImageId, nil
 }
 
+// bastionAMILookup returns the most recent AMI matching the AMI lookup of the bastion host.
+func (s *Service) bastionAMILookup(lookup *infrav1.BastionAMILookup) (string, error) {
+	architecture := lookup.Architecture
+	if architecture == "" {
+		architecture = Amd64ArchitectureTag
+	}
+
+	describeImageInput := &ec2.DescribeImagesInput{
+		Owners: lookup.Owners,
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("name"),
+				Values: []string{lookup.Name},
+			},
+			{
+				Name:   aws.String("architecture"),
+				Values: []string{architecture},
+			},
+			{
+				Name:   aws.String("state"),
+				Values: []string{"available"},
+			},
+			{
+				Name:   aws.String("virtualization-type"),
+				Values: []string{"hvm"},
+			},
+		},
+	}
+
+	out, err := s.EC2Client.DescribeImages(context.TODO(), describeImageInput)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe images within region: %q", s.scope.Region())
+	}
+	if len(out.Images) == 0 {
+		return "", errors.Errorf("found no AMIs named %q within the region: %q", lookup.Name, s.scope.Region())
+	}
+	latestImage, err := GetLatestImage(out.Images)
+	if err != nil {
+		return "", err
+	}
+	return *latestImage.ImageId, nil
+}
+
 func (s *Service) eksAMILookup(ctx context.Context, kubernetesVersion string, architecture string, amiType *infrav1.EKSAMILookupType) (string, error) {
 	// format ssm parameter path properly
 	formattedVersion, err := formatVersionForEKS(kubernetesVersion)
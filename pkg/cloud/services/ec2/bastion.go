@@ -49,6 +49,9 @@ var (
 func (s *Service) ReconcileBastion() error {
 	if !s.scope.Bastion().Enabled {
 		s.scope.Trace("Skipping bastion reconcile")
+		if s.scope.Bastion().AutoScaling != nil {
+			return s.DeleteBastion()
+		}
 		_, err := s.describeBastionInstance()
 		if err != nil {
 			if awserrors.IsNotFound(err) {
@@ -65,12 +68,23 @@ func (s *Service) ReconcileBastion() error {
 	if len(subnets.FilterPrivate()) == 0 {
 		s.scope.Debug("No private subnets available, skipping bastion host")
 		return nil
-	} else if len(subnets.FilterPublic()) == 0 {
+	} else if !s.scope.Bastion().IsSSM() && len(subnets.FilterPublic()) == 0 {
 		return errors.New("failed to reconcile bastion host, no public subnets are available")
 	}
 
+	if s.scope.Bastion().AutoScaling != nil {
+		return s.reconcileBastionAutoScalingGroup()
+	}
+
 	// Describe bastion instance, if any.
 	instance, err := s.describeBastionInstance()
+	if err == nil && s.bastionNeedsReplacement(instance) {
+		s.scope.Info("Replacing bastion host after a change of its configuration", "id", instance.ID)
+		if err := s.DeleteBastion(); err != nil {
+			return err
+		}
+		instance, err = nil, awserrors.NewNotFound("bastion host not found")
+	}
 	if awserrors.IsNotFound(err) { //nolint:nestif
 		if !v1beta1conditions.Has(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition) {
 			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition, infrav1.BastionCreationStartedReason, clusterv1beta1.ConditionSeverityInfo, "")
@@ -107,14 +121,21 @@ func (s *Service) ReconcileBastion() error {
 // DeleteBastion deletes the Bastion instance.
 func (s *Service) DeleteBastion() error {
 	instance, err := s.describeBastionInstance()
-	if err != nil {
-		if awserrors.IsNotFound(err) {
-			s.scope.Trace("bastion instance does not exist")
-			return nil
-		}
+	if err != nil && !awserrors.IsNotFound(err) {
 		return errors.Wrap(err, "unable to describe bastion instance")
 	}
 
+	if s.scope.Bastion().AutoScaling != nil || (instance != nil && isAutoScalingInstance(instance)) {
+		if err := s.deleteBastionAutoScalingGroup(); err != nil {
+			return err
+		}
+	}
+
+	if instance == nil {
+		s.scope.Trace("bastion instance does not exist")
+		return nil
+	}
+
 	v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition, clusterv1beta1.DeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
 	if err := s.scope.PatchObject(); err != nil {
 		return err
@@ -136,6 +157,21 @@ func (s *Service) DeleteBastion() error {
 }
 
 func (s *Service) describeBastionInstance() (*infrav1.Instance, error) {
+	instances, err := s.describeBastionInstances()
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: properly handle multiple bastions found rather than just returning
+	// the first non-terminated.
+	if len(instances) == 0 {
+		return nil, awserrors.NewNotFound("bastion host not found")
+	}
+	return instances[0], nil
+}
+
+// describeBastionInstances returns all the non-terminated bastion instances of the cluster.
+func (s *Service) describeBastionInstances() ([]*infrav1.Instance, error) {
 	input := &ec2.DescribeInstancesInput{
 		Filters: []types.Filter{
 			filter.EC2.ProviderRole(infrav1.BastionRoleTagValue),
@@ -155,30 +191,59 @@ func (s *Service) describeBastionInstance() (*infrav1.Instance, error) {
 		return nil, errors.Wrap(err, "failed to describe bastion host")
 	}
 
-	// TODO: properly handle multiple bastions found rather than just returning
-	// the first non-terminated.
+	var instances []*infrav1.Instance
 	for _, res := range out.Reservations {
 		for _, instance := range res.Instances {
 			if instance.State.Name != types.InstanceStateNameTerminated {
-				return s.SDKToInstance(instance)
+				i, err := s.SDKToInstance(instance)
+				if err != nil {
+					return nil, err
+				}
+				instances = append(instances, i)
 			}
 		}
 	}
 
-	return nil, awserrors.NewNotFound("bastion host not found")
+	return instances, nil
+}
+
+// bastionNeedsReplacement returns true if the bastion host was launched by an Auto Scaling Group, or
+// was created for another mode or, in ssm mode, with another instance profile, as neither its subnet
+// nor its instance profile are changed in place.
+func (s *Service) bastionNeedsReplacement(instance *infrav1.Instance) bool {
+	if isAutoScalingInstance(instance) {
+		return true
+	}
+	subnet := s.scope.Subnets().FindByID(instance.SubnetID)
+	if subnet == nil {
+		return false
+	}
+	if s.scope.Bastion().IsSSM() {
+		return subnet.IsPublic || instance.IAMProfile != s.scope.Bastion().InstanceProfile
+	}
+	return !subnet.IsPublic
 }
 
 func (s *Service) getDefaultBastion(instanceType, ami string) (*infrav1.Instance, error) {
 	name := fmt.Sprintf("%s-bastion", s.scope.Name())
-	userData, _ := userdata.NewBastion(&userdata.BastionInput{})
+	userData := s.scope.Bastion().UserData
+	if userData == "" {
+		userData, _ = userdata.NewBastion(&userdata.BastionInput{})
+	}
 
-	// If SSHKeyName WAS NOT provided, use the defaultSSHKeyName
+	// If SSHKeyName WAS NOT provided, use the defaultSSHKeyName.
+	// Bastion hosts accessed through Session Manager don't need a key pair.
 	keyName := s.scope.SSHKeyName()
-	if keyName == nil {
+	if keyName == nil && !s.scope.Bastion().IsSSM() {
 		keyName = aws.String(defaultSSHKeyName)
 	}
 
-	subnet := s.scope.Subnets().FilterPublic()[0]
+	var subnet infrav1.SubnetSpec
+	if s.scope.Bastion().IsSSM() {
+		subnet = s.scope.Subnets().FilterPrivate()[0]
+	} else {
+		subnet = s.scope.Subnets().FilterPublic()[0]
+	}
 
 	if instanceType == "" {
 		if strings.Contains(subnet.AvailabilityZone, "us-east-1") {
@@ -190,7 +255,11 @@ func (s *Service) getDefaultBastion(instanceType, ami string) (*infrav1.Instance
 
 	if ami == "" {
 		var err error
-		ami, err = s.defaultBastionAMILookup()
+		if lookup := s.scope.Bastion().AMILookup; lookup != nil {
+			ami, err = s.bastionAMILookup(lookup)
+		} else {
+			ami, err = s.defaultBastionAMILookup()
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -202,6 +271,8 @@ func (s *Service) getDefaultBastion(instanceType, ami string) (*infrav1.Instance
 		ImageID:    ami,
 		SSHKeyName: keyName,
 		UserData:   aws.String(base64.StdEncoding.EncodeToString([]byte(userData))),
+
+		InstanceMetadataOptions: s.scope.Bastion().InstanceMetadataOptions.DeepCopy(),
 		SecurityGroupIDs: []string{
 			s.scope.Network().SecurityGroups[infrav1.SecurityGroupBastion].ID,
 		},
@@ -214,5 +285,9 @@ func (s *Service) getDefaultBastion(instanceType, ami string) (*infrav1.Instance
 		}),
 	}
 
+	if s.scope.Bastion().IsSSM() {
+		i.IAMProfile = s.scope.Bastion().InstanceProfile
+	}
+
 	return i, nil
 }
@@ -0,0 +1,494 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	asg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+const (
+	// defaultBastionHealthCheckGracePeriod is the default health check grace period, in seconds,
+	// of the Auto Scaling Group of the bastion host.
+	defaultBastionHealthCheckGracePeriod int32 = 300
+
+	// autoScalingGroupNameTagKey is the tag set by Amazon EC2 Auto Scaling on the instances it launches.
+	autoScalingGroupNameTagKey = "aws:autoscaling:groupName"
+
+	// bastionAutoScalingGroupDeletionTimeout is how long to wait for the Auto Scaling Group of the bastion host
+	// to be deleted, which includes the termination of its instance.
+	bastionAutoScalingGroupDeletionTimeout = 5 * time.Minute
+)
+
+// reconcileBastionAutoScalingGroup ensures the bastion host runs as a single-instance Auto Scaling Group
+// and, outside of ssm mode, that the Elastic IP of the bastion host is associated with its instance.
+func (s *Service) reconcileBastionAutoScalingGroup() error {
+	// Bastion hosts created as plain instances are replaced by the instance of the Auto Scaling Group.
+	instances, err := s.describeBastionInstances()
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		if isAutoScalingInstance(instance) {
+			continue
+		}
+		s.scope.Info("Replacing bastion host with an Auto Scaling Group", "id", instance.ID)
+		if err := s.TerminateInstanceAndWait(instance.ID); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedTerminateBastion", "Failed to terminate bastion instance %q: %v", instance.ID, err)
+			return errors.Wrap(err, "unable to delete bastion instance")
+		}
+	}
+
+	defaultBastion, err := s.getDefaultBastion(s.scope.Bastion().InstanceType, s.scope.Bastion().AMI)
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedFetchingBastion", "Failed to fetch default bastion instance: %v", err)
+		return err
+	}
+
+	version, err := s.reconcileBastionLaunchTemplate(defaultBastion)
+	if err != nil {
+		return err
+	}
+
+	group, err := s.describeBastionAutoScalingGroup()
+	if err != nil {
+		return err
+	}
+	if group != nil && group.Status != nil {
+		return errors.Errorf("bastion Auto Scaling Group %q is being deleted", s.bastionResourceName())
+	}
+
+	subnetIDs := s.bastionSubnetIDs()
+	if group == nil {
+		if !v1beta1conditions.Has(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition) {
+			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition, infrav1.BastionCreationStartedReason, clusterv1beta1.ConditionSeverityInfo, "")
+			if err := s.scope.PatchObject(); err != nil {
+				return errors.Wrap(err, "failed to patch conditions")
+			}
+		}
+		if err := s.createBastionAutoScalingGroup(version, subnetIDs, defaultBastion.Tags); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateBastion", "Failed to create bastion Auto Scaling Group: %v", err)
+			return err
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateBastion", "Created bastion Auto Scaling Group %q", s.bastionResourceName())
+		s.scope.Info("Created new bastion Auto Scaling Group", "name", s.bastionResourceName())
+	} else if bastionAutoScalingGroupNeedsUpdate(group, version, subnetIDs) {
+		if err := s.updateBastionAutoScalingGroup(version, subnetIDs); err != nil {
+			return err
+		}
+		if s.scope.Bastion().IsSSM() {
+			// Bastion hosts accessed through Session Manager don't have an Elastic IP.
+			if err := s.netService.ReleaseAddressByRole(infrav1.BastionRoleTagValue); err != nil {
+				return errors.Wrap(err, "failed to release bastion Elastic IP")
+			}
+		}
+		if !s.scope.Bastion().AutoScaling.DisableInstanceRefresh {
+			if err := s.startBastionInstanceRefresh(); err != nil {
+				return err
+			}
+		}
+	}
+
+	instanceID := inServiceInstanceID(group)
+	if instanceID == "" {
+		s.scope.Info("Waiting for the bastion Auto Scaling Group to have an instance in service", "name", s.bastionResourceName())
+		s.scope.SetBastionInstance(nil)
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition, infrav1.BastionCreationStartedReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return nil
+	}
+
+	if !s.scope.Bastion().IsSSM() {
+		if err := s.associateBastionAddress(instanceID); err != nil {
+			return err
+		}
+	}
+
+	instance, err := s.InstanceIfExists(aws.String(instanceID))
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe bastion instance %q", instanceID)
+	}
+
+	s.scope.SetBastionInstance(instance.DeepCopy())
+	v1beta1conditions.MarkTrue(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition)
+	s.scope.Debug("Reconcile bastion completed successfully")
+
+	return nil
+}
+
+// deleteBastionAutoScalingGroup deletes the Auto Scaling Group, the launch template and the Elastic IP
+// of the bastion host. The instance of the Auto Scaling Group is terminated along with it, and the
+// Auto Scaling Group is only gone once its instance is terminated.
+func (s *Service) deleteBastionAutoScalingGroup() error {
+	group, err := s.describeBastionAutoScalingGroup()
+	if err != nil {
+		return err
+	}
+	if group != nil {
+		if group.Status == nil {
+			if _, err := s.ASGClient.DeleteAutoScalingGroup(context.TODO(), &autoscaling.DeleteAutoScalingGroupInput{
+				AutoScalingGroupName: aws.String(s.bastionResourceName()),
+				ForceDelete:          aws.Bool(true),
+			}); err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedDeleteBastion", "Failed to delete bastion Auto Scaling Group: %v", err)
+				return errors.Wrapf(err, "failed to delete bastion Auto Scaling Group %q", s.bastionResourceName())
+			}
+		}
+
+		s.scope.Debug("Waiting for bastion Auto Scaling Group to be deleted", "name", s.bastionResourceName())
+		if err := autoscaling.NewGroupNotExistsWaiter(s.ASGClient).Wait(context.TODO(), &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []string{s.bastionResourceName()},
+		}, bastionAutoScalingGroupDeletionTimeout); err != nil {
+			return errors.Wrapf(err, "failed to wait for bastion Auto Scaling Group %q deletion", s.bastionResourceName())
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteBastion", "Deleted bastion Auto Scaling Group %q", s.bastionResourceName())
+		s.scope.Info("Deleted bastion Auto Scaling Group", "name", s.bastionResourceName())
+	}
+
+	if _, err := s.EC2Client.DeleteLaunchTemplate(context.TODO(), &ec2.DeleteLaunchTemplateInput{
+		LaunchTemplateName: aws.String(s.bastionResourceName()),
+	}); err != nil && !awserrors.IsInvalidNotFoundError(err) {
+		return errors.Wrapf(err, "failed to delete bastion launch template %q", s.bastionResourceName())
+	}
+
+	if err := s.netService.ReleaseAddressByRole(infrav1.BastionRoleTagValue); err != nil {
+		return errors.Wrap(err, "failed to release bastion Elastic IP")
+	}
+
+	return nil
+}
+
+// bastionResourceName is the name of both the Auto Scaling Group and the launch template of the bastion host.
+func (s *Service) bastionResourceName() string {
+	return fmt.Sprintf("%s-bastion", s.scope.Name())
+}
+
+// bastionSubnetIDs returns the sorted IDs of the subnets the bastion host can run in.
+func (s *Service) bastionSubnetIDs() []string {
+	subnets := s.scope.Subnets().FilterPublic()
+	if s.scope.Bastion().IsSSM() {
+		subnets = s.scope.Subnets().FilterPrivate()
+	}
+
+	ids := make([]string, 0, len(subnets))
+	for _, subnet := range subnets {
+		ids = append(ids, subnet.GetResourceID())
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// reconcileBastionLaunchTemplate ensures the latest version of the launch template of the bastion host
+// matches the given instance and returns that version.
+func (s *Service) reconcileBastionLaunchTemplate(instance *infrav1.Instance) (string, error) {
+	data := bastionLaunchTemplateData(instance)
+
+	out, err := s.EC2Client.DescribeLaunchTemplateVersions(context.TODO(), &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateName: aws.String(s.bastionResourceName()),
+		Versions:           []string{"$Latest"},
+	})
+	switch {
+	case awserrors.IsInvalidNotFoundError(err):
+		created, err := s.EC2Client.CreateLaunchTemplate(context.TODO(), &ec2.CreateLaunchTemplateInput{
+			LaunchTemplateName: aws.String(s.bastionResourceName()),
+			LaunchTemplateData: data,
+			TagSpecifications:  []types.TagSpecification{bastionTagSpecification(types.ResourceTypeLaunchTemplate, instance.Tags)},
+		})
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to create bastion launch template %q", s.bastionResourceName())
+		}
+		s.scope.Info("Created bastion launch template", "name", s.bastionResourceName())
+		return strconv.FormatInt(aws.ToInt64(created.LaunchTemplate.LatestVersionNumber), 10), nil
+	case err != nil:
+		return "", errors.Wrapf(err, "failed to describe bastion launch template %q", s.bastionResourceName())
+	case len(out.LaunchTemplateVersions) == 0:
+		return "", errors.Errorf("no version found for bastion launch template %q", s.bastionResourceName())
+	}
+
+	latest := out.LaunchTemplateVersions[0]
+	if !bastionLaunchTemplateNeedsUpdate(data, latest.LaunchTemplateData) {
+		return strconv.FormatInt(aws.ToInt64(latest.VersionNumber), 10), nil
+	}
+
+	created, err := s.EC2Client.CreateLaunchTemplateVersion(context.TODO(), &ec2.CreateLaunchTemplateVersionInput{
+		LaunchTemplateName: aws.String(s.bastionResourceName()),
+		LaunchTemplateData: data,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create bastion launch template version for %q", s.bastionResourceName())
+	}
+	s.scope.Info("Created bastion launch template version", "name", s.bastionResourceName(), "version", aws.ToInt64(created.LaunchTemplateVersion.VersionNumber))
+	return strconv.FormatInt(aws.ToInt64(created.LaunchTemplateVersion.VersionNumber), 10), nil
+}
+
+func (s *Service) describeBastionAutoScalingGroup() (*autoscalingtypes.AutoScalingGroup, error) {
+	out, err := s.ASGClient.DescribeAutoScalingGroups(context.TODO(), &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{s.bastionResourceName()},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe bastion Auto Scaling Group %q", s.bastionResourceName())
+	}
+	if len(out.AutoScalingGroups) == 0 {
+		return nil, nil
+	}
+	return &out.AutoScalingGroups[0], nil
+}
+
+func (s *Service) createBastionAutoScalingGroup(version string, subnetIDs []string, tags infrav1.Tags) error {
+	gracePeriod := defaultBastionHealthCheckGracePeriod
+	if s.scope.Bastion().AutoScaling.HealthCheckGracePeriod != nil {
+		gracePeriod = *s.scope.Bastion().AutoScaling.HealthCheckGracePeriod
+	}
+
+	_, err := s.ASGClient.CreateAutoScalingGroup(context.TODO(), &autoscaling.CreateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(s.bastionResourceName()),
+		LaunchTemplate: &autoscalingtypes.LaunchTemplateSpecification{
+			LaunchTemplateName: aws.String(s.bastionResourceName()),
+			Version:            aws.String(version),
+		},
+		MinSize:                aws.Int32(1),
+		MaxSize:                aws.Int32(1),
+		DesiredCapacity:        aws.Int32(1),
+		VPCZoneIdentifier:      aws.String(strings.Join(subnetIDs, ",")),
+		HealthCheckGracePeriod: aws.Int32(gracePeriod),
+		Tags:                   asg.BuildTagsFromMap(s.bastionResourceName(), tags),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create bastion Auto Scaling Group %q", s.bastionResourceName())
+	}
+	return nil
+}
+
+func (s *Service) updateBastionAutoScalingGroup(version string, subnetIDs []string) error {
+	input := &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(s.bastionResourceName()),
+		LaunchTemplate: &autoscalingtypes.LaunchTemplateSpecification{
+			LaunchTemplateName: aws.String(s.bastionResourceName()),
+			Version:            aws.String(version),
+		},
+		VPCZoneIdentifier: aws.String(strings.Join(subnetIDs, ",")),
+	}
+	if s.scope.Bastion().AutoScaling.HealthCheckGracePeriod != nil {
+		input.HealthCheckGracePeriod = s.scope.Bastion().AutoScaling.HealthCheckGracePeriod
+	}
+
+	if _, err := s.ASGClient.UpdateAutoScalingGroup(context.TODO(), input); err != nil {
+		return errors.Wrapf(err, "failed to update bastion Auto Scaling Group %q", s.bastionResourceName())
+	}
+	s.scope.Info("Updated bastion Auto Scaling Group", "name", s.bastionResourceName(), "launch-template-version", version)
+	return nil
+}
+
+// startBastionInstanceRefresh replaces the instance of the Auto Scaling Group of the bastion host,
+// launching the new instance before terminating the current one.
+func (s *Service) startBastionInstanceRefresh() error {
+	_, err := s.ASGClient.StartInstanceRefresh(context.TODO(), &autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(s.bastionResourceName()),
+		Strategy:             autoscalingtypes.RefreshStrategyRolling,
+		Preferences: &autoscalingtypes.RefreshPreferences{
+			MinHealthyPercentage: aws.Int32(100),
+			MaxHealthyPercentage: aws.Int32(200),
+		},
+	})
+	var inProgress *autoscalingtypes.InstanceRefreshInProgressFault
+	switch {
+	case errors.As(err, &inProgress):
+		s.scope.Info("Instance refresh of the bastion Auto Scaling Group already in progress", "name", s.bastionResourceName())
+	case err != nil:
+		return errors.Wrapf(err, "failed to start instance refresh of bastion Auto Scaling Group %q", s.bastionResourceName())
+	default:
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulStartBastionInstanceRefresh", "Started instance refresh of bastion Auto Scaling Group %q", s.bastionResourceName())
+	}
+	return nil
+}
+
+// associateBastionAddress ensures the Elastic IP of the bastion host is associated with the given instance,
+// allocating it first if needed.
+func (s *Service) associateBastionAddress(instanceID string) error {
+	out, err := s.netService.GetAddresses(infrav1.BastionRoleTagValue)
+	if err != nil {
+		return errors.Wrap(err, "failed to describe bastion Elastic IP")
+	}
+
+	var allocationID string
+	for _, address := range out.Addresses {
+		if aws.ToString(address.InstanceId) == instanceID {
+			return nil
+		}
+		if allocationID == "" {
+			allocationID = aws.ToString(address.AllocationId)
+		}
+	}
+
+	if allocationID == "" {
+		ids, err := s.netService.GetOrAllocateAddresses(nil, 1, infrav1.BastionRoleTagValue)
+		if err != nil {
+			return errors.Wrap(err, "failed to allocate bastion Elastic IP")
+		}
+		allocationID = ids[0]
+	}
+
+	if _, err := s.EC2Client.AssociateAddress(context.TODO(), &ec2.AssociateAddressInput{
+		AllocationId:       aws.String(allocationID),
+		InstanceId:         aws.String(instanceID),
+		AllowReassociation: aws.Bool(true),
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAssociateAddress", "Failed to associate bastion Elastic IP %q with instance %q: %v", allocationID, instanceID, err)
+		return errors.Wrapf(err, "failed to associate bastion Elastic IP %q with instance %q", allocationID, instanceID)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAssociateAddress", "Associated bastion Elastic IP %q with instance %q", allocationID, instanceID)
+	return nil
+}
+
+func bastionLaunchTemplateData(instance *infrav1.Instance) *types.RequestLaunchTemplateData {
+	data := &types.RequestLaunchTemplateData{
+		ImageId:          aws.String(instance.ImageID),
+		InstanceType:     types.InstanceType(instance.Type),
+		KeyName:          instance.SSHKeyName,
+		UserData:         instance.UserData,
+		SecurityGroupIds: instance.SecurityGroupIDs,
+		TagSpecifications: []types.LaunchTemplateTagSpecificationRequest{
+			{ResourceType: types.ResourceTypeInstance, Tags: bastionTagSpecification(types.ResourceTypeInstance, instance.Tags).Tags},
+			{ResourceType: types.ResourceTypeVolume, Tags: bastionTagSpecification(types.ResourceTypeVolume, instance.Tags).Tags},
+		},
+	}
+
+	if instance.IAMProfile != "" {
+		data.IamInstanceProfile = &types.LaunchTemplateIamInstanceProfileSpecificationRequest{
+			Name: aws.String(instance.IAMProfile),
+		}
+	}
+
+	if options := instance.InstanceMetadataOptions; options != nil {
+		data.MetadataOptions = &types.LaunchTemplateInstanceMetadataOptionsRequest{
+			HttpEndpoint:         types.LaunchTemplateInstanceMetadataEndpointState(string(options.HTTPEndpoint)),
+			HttpTokens:           types.LaunchTemplateHttpTokensState(string(options.HTTPTokens)),
+			InstanceMetadataTags: types.LaunchTemplateInstanceMetadataTagsState(string(options.InstanceMetadataTags)),
+		}
+		if options.HTTPPutResponseHopLimit != 0 {
+			data.MetadataOptions.HttpPutResponseHopLimit = aws.Int32(int32(options.HTTPPutResponseHopLimit)) //nolint:gosec // the hop limit is at most 64.
+		}
+	}
+
+	return data
+}
+
+// bastionLaunchTemplateNeedsUpdate returns true if the latest version of the launch template of the bastion host
+// differs from the desired one.
+func bastionLaunchTemplateNeedsUpdate(want *types.RequestLaunchTemplateData, have *types.ResponseLaunchTemplateData) bool {
+	if have == nil {
+		return true
+	}
+
+	haveProfile := ""
+	if have.IamInstanceProfile != nil {
+		haveProfile = aws.ToString(have.IamInstanceProfile.Name)
+	}
+	wantProfile := ""
+	if want.IamInstanceProfile != nil {
+		wantProfile = aws.ToString(want.IamInstanceProfile.Name)
+	}
+
+	wantGroups := slices.Sorted(slices.Values(want.SecurityGroupIds))
+	haveGroups := slices.Sorted(slices.Values(have.SecurityGroupIds))
+
+	if aws.ToString(want.ImageId) != aws.ToString(have.ImageId) ||
+		want.InstanceType != have.InstanceType ||
+		aws.ToString(want.KeyName) != aws.ToString(have.KeyName) ||
+		aws.ToString(want.UserData) != aws.ToString(have.UserData) ||
+		wantProfile != haveProfile ||
+		!slices.Equal(wantGroups, haveGroups) {
+		return true
+	}
+
+	if want.MetadataOptions != nil {
+		if have.MetadataOptions == nil {
+			return true
+		}
+		return string(want.MetadataOptions.HttpEndpoint) != string(have.MetadataOptions.HttpEndpoint) ||
+			string(want.MetadataOptions.HttpTokens) != string(have.MetadataOptions.HttpTokens) ||
+			string(want.MetadataOptions.InstanceMetadataTags) != string(have.MetadataOptions.InstanceMetadataTags) ||
+			(want.MetadataOptions.HttpPutResponseHopLimit != nil && aws.ToInt32(want.MetadataOptions.HttpPutResponseHopLimit) != aws.ToInt32(have.MetadataOptions.HttpPutResponseHopLimit))
+	}
+
+	return false
+}
+
+// bastionAutoScalingGroupNeedsUpdate returns true if the Auto Scaling Group of the bastion host doesn't use
+// the given launch template version or subnets.
+func bastionAutoScalingGroupNeedsUpdate(group *autoscalingtypes.AutoScalingGroup, version string, subnetIDs []string) bool {
+	if group.LaunchTemplate == nil || aws.ToString(group.LaunchTemplate.Version) != version {
+		return true
+	}
+
+	current := strings.Split(aws.ToString(group.VPCZoneIdentifier), ",")
+	sort.Strings(current)
+	return !slices.Equal(current, subnetIDs)
+}
+
+// inServiceInstanceID returns the ID of the healthy instance in service of the Auto Scaling Group, if any.
+func inServiceInstanceID(group *autoscalingtypes.AutoScalingGroup) string {
+	if group == nil {
+		return ""
+	}
+	for _, instance := range group.Instances {
+		if instance.LifecycleState == autoscalingtypes.LifecycleStateInService && aws.ToString(instance.HealthStatus) == "Healthy" {
+			return aws.ToString(instance.InstanceId)
+		}
+	}
+	return ""
+}
+
+// isAutoScalingInstance returns true if the instance was launched by an Auto Scaling Group.
+func isAutoScalingInstance(instance *infrav1.Instance) bool {
+	_, ok := instance.Tags[autoScalingGroupNameTagKey]
+	return ok
+}
+
+func bastionTagSpecification(resourceType types.ResourceType, tags infrav1.Tags) types.TagSpecification {
+	spec := types.TagSpecification{ResourceType: resourceType}
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		spec.Tags = append(spec.Tags, types.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(tags[key]),
+		})
+	}
+	return spec
+}
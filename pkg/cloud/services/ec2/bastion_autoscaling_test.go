@@ -0,0 +1,439 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestServiceReconcileBastionAutoScaling(t *testing.T) {
+	userData := base64.StdEncoding.EncodeToString([]byte("#!/bin/bash"))
+
+	latestVersion := func(imageID string) *ec2.DescribeLaunchTemplateVersionsOutput {
+		return &ec2.DescribeLaunchTemplateVersionsOutput{
+			LaunchTemplateVersions: []types.LaunchTemplateVersion{
+				{
+					VersionNumber: aws.Int64(1),
+					LaunchTemplateData: &types.ResponseLaunchTemplateData{
+						ImageId:          aws.String(imageID),
+						InstanceType:     types.InstanceTypeT3Micro,
+						KeyName:          aws.String(defaultSSHKeyName),
+						UserData:         aws.String(userData),
+						SecurityGroupIds: []string{"sg-bastion"},
+					},
+				},
+			},
+		}
+	}
+
+	inServiceGroup := &autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []autoscalingtypes.AutoScalingGroup{
+			{
+				AutoScalingGroupName: aws.String("test-cluster-bastion"),
+				LaunchTemplate: &autoscalingtypes.LaunchTemplateSpecification{
+					LaunchTemplateName: aws.String("test-cluster-bastion"),
+					Version:            aws.String("1"),
+				},
+				VPCZoneIdentifier: aws.String("subnet-3,subnet-2"),
+				Instances: []autoscalingtypes.Instance{
+					{
+						InstanceId:     aws.String("i-old"),
+						LifecycleState: autoscalingtypes.LifecycleStateTerminating,
+						HealthStatus:   aws.String("Unhealthy"),
+					},
+					{
+						InstanceId:     aws.String("i-new"),
+						LifecycleState: autoscalingtypes.LifecycleStateInService,
+						HealthStatus:   aws.String("Healthy"),
+					},
+				},
+			},
+		},
+	}
+
+	expectDescribeBastionInstance := func(m *mocks.MockEC2APIMockRecorder) {
+		m.DescribeInstances(context.TODO(), gomock.Eq(&ec2.DescribeInstancesInput{InstanceIds: []string{"i-new"}})).
+			Return(&ec2.DescribeInstancesOutput{
+				Reservations: []types.Reservation{
+					{
+						Instances: []types.Instance{
+							{
+								InstanceId:      aws.String("i-new"),
+								InstanceType:    types.InstanceTypeT3Micro,
+								SubnetId:        aws.String("subnet-3"),
+								ImageId:         aws.String("ami-bastion"),
+								PublicIpAddress: aws.String("1.2.3.4"),
+								State: &types.InstanceState{
+									Name: types.InstanceStateNameRunning,
+								},
+								Placement: &types.Placement{
+									AvailabilityZone: aws.String("us-east-1b"),
+								},
+							},
+						},
+					},
+				},
+			}, nil)
+	}
+
+	tests := []struct {
+		name        string
+		bastion     infrav1.Bastion
+		expectEC2   func(m *mocks.MockEC2APIMockRecorder)
+		expectASG   func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		expectReady bool
+	}{
+		{
+			name: "Should create the launch template and the Auto Scaling Group",
+			bastion: infrav1.Bastion{
+				Enabled:   true,
+				UserData:  "#!/bin/bash",
+				AMILookup: &infrav1.BastionAMILookup{Owners: []string{"amazon"}, Name: "al2023-ami-*"},
+				InstanceMetadataOptions: &infrav1.InstanceMetadataOptions{
+					HTTPEndpoint:            infrav1.InstanceMetadataEndpointStateEnabled,
+					HTTPTokens:              infrav1.HTTPTokensStateRequired,
+					HTTPPutResponseHopLimit: 1,
+				},
+				AutoScaling: &infrav1.BastionAutoScaling{},
+			},
+			expectEC2: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstances(context.TODO(), gomock.Any()).Return(&ec2.DescribeInstancesOutput{}, nil)
+				m.DescribeImages(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.DescribeImagesInput, _ ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error) {
+						if len(input.Owners) != 1 || input.Owners[0] != "amazon" {
+							return nil, errors.Errorf("unexpected owners %v", input.Owners)
+						}
+						return &ec2.DescribeImagesOutput{Images: []types.Image{
+							{
+								ImageId:      aws.String("ami-bastion"),
+								CreationDate: aws.String("2019-02-08T17:02:31.000Z"),
+							},
+						}}, nil
+					})
+				m.DescribeLaunchTemplateVersions(context.TODO(), gomock.Any()).
+					Return(nil, &smithy.GenericAPIError{Code: awserrors.LaunchTemplateNameNotFound})
+				m.CreateLaunchTemplate(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateLaunchTemplateInput, _ ...func(*ec2.Options)) (*ec2.CreateLaunchTemplateOutput, error) {
+						data := input.LaunchTemplateData
+						switch {
+						case aws.ToString(input.LaunchTemplateName) != "test-cluster-bastion":
+							return nil, errors.Errorf("unexpected launch template name %q", aws.ToString(input.LaunchTemplateName))
+						case aws.ToString(data.ImageId) != "ami-bastion":
+							return nil, errors.Errorf("unexpected image %q", aws.ToString(data.ImageId))
+						case aws.ToString(data.UserData) != userData:
+							return nil, errors.New("unexpected user data")
+						case data.MetadataOptions == nil || data.MetadataOptions.HttpTokens != types.LaunchTemplateHttpTokensStateRequired:
+							return nil, errors.New("expected IMDSv2 to be required")
+						}
+						return &ec2.CreateLaunchTemplateOutput{
+							LaunchTemplate: &types.LaunchTemplate{LatestVersionNumber: aws.Int64(1)},
+						}, nil
+					})
+			},
+			expectASG: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeAutoScalingGroups(context.TODO(), gomock.Any()).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+				m.CreateAutoScalingGroup(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *autoscaling.CreateAutoScalingGroupInput, _ ...func(*autoscaling.Options)) (*autoscaling.CreateAutoScalingGroupOutput, error) {
+						switch {
+						case aws.ToInt32(input.MinSize) != 1 || aws.ToInt32(input.MaxSize) != 1:
+							return nil, errors.New("expected a single-instance Auto Scaling Group")
+						case aws.ToString(input.VPCZoneIdentifier) != "subnet-2,subnet-3":
+							return nil, errors.Errorf("unexpected subnets %q", aws.ToString(input.VPCZoneIdentifier))
+						case aws.ToString(input.LaunchTemplate.Version) != "1":
+							return nil, errors.Errorf("unexpected launch template version %q", aws.ToString(input.LaunchTemplate.Version))
+						case aws.ToInt32(input.HealthCheckGracePeriod) != defaultBastionHealthCheckGracePeriod:
+							return nil, errors.New("expected the default health check grace period")
+						}
+						return &autoscaling.CreateAutoScalingGroupOutput{}, nil
+					})
+			},
+		},
+		{
+			name: "Should associate the Elastic IP with the instance in service",
+			bastion: infrav1.Bastion{
+				Enabled:      true,
+				AMI:          "ami-bastion",
+				InstanceType: "t3.micro",
+				UserData:     "#!/bin/bash",
+				AutoScaling:  &infrav1.BastionAutoScaling{},
+			},
+			expectEC2: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstances(context.TODO(), gomock.Any()).Return(&ec2.DescribeInstancesOutput{}, nil)
+				m.DescribeLaunchTemplateVersions(context.TODO(), gomock.Any()).Return(latestVersion("ami-bastion"), nil)
+				m.DescribeAddresses(context.TODO(), gomock.Any()).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []types.Address{
+						{
+							AllocationId: aws.String("eipalloc-bastion"),
+							InstanceId:   aws.String("i-old"),
+							PublicIp:     aws.String("1.2.3.4"),
+						},
+					},
+				}, nil)
+				m.AssociateAddress(context.TODO(), gomock.Eq(&ec2.AssociateAddressInput{
+					AllocationId:       aws.String("eipalloc-bastion"),
+					InstanceId:         aws.String("i-new"),
+					AllowReassociation: aws.Bool(true),
+				})).Return(&ec2.AssociateAddressOutput{}, nil)
+				expectDescribeBastionInstance(m)
+			},
+			expectASG: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeAutoScalingGroups(context.TODO(), gomock.Any()).Return(inServiceGroup, nil)
+			},
+			expectReady: true,
+		},
+		{
+			name: "Should start an instance refresh when the launch template changes",
+			bastion: infrav1.Bastion{
+				Enabled:      true,
+				AMI:          "ami-bastion",
+				InstanceType: "t3.micro",
+				UserData:     "#!/bin/bash",
+				AutoScaling:  &infrav1.BastionAutoScaling{},
+			},
+			expectEC2: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstances(context.TODO(), gomock.Any()).Return(&ec2.DescribeInstancesOutput{}, nil)
+				m.DescribeLaunchTemplateVersions(context.TODO(), gomock.Any()).Return(latestVersion("ami-previous"), nil)
+				m.CreateLaunchTemplateVersion(context.TODO(), gomock.Any()).Return(&ec2.CreateLaunchTemplateVersionOutput{
+					LaunchTemplateVersion: &types.LaunchTemplateVersion{VersionNumber: aws.Int64(2)},
+				}, nil)
+				m.DescribeAddresses(context.TODO(), gomock.Any()).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []types.Address{
+						{
+							AllocationId: aws.String("eipalloc-bastion"),
+							InstanceId:   aws.String("i-new"),
+							PublicIp:     aws.String("1.2.3.4"),
+						},
+					},
+				}, nil)
+				expectDescribeBastionInstance(m)
+			},
+			expectASG: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeAutoScalingGroups(context.TODO(), gomock.Any()).Return(inServiceGroup, nil)
+				m.UpdateAutoScalingGroup(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *autoscaling.UpdateAutoScalingGroupInput, _ ...func(*autoscaling.Options)) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+						if aws.ToString(input.LaunchTemplate.Version) != "2" {
+							return nil, errors.Errorf("unexpected launch template version %q", aws.ToString(input.LaunchTemplate.Version))
+						}
+						return &autoscaling.UpdateAutoScalingGroupOutput{}, nil
+					})
+				m.StartInstanceRefresh(context.TODO(), gomock.Any()).Return(nil, &autoscalingtypes.InstanceRefreshInProgressFault{})
+			},
+			expectReady: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mocks.NewMockEC2API(mockControl)
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockControl)
+
+			s, clusterScope := newBastionAutoScalingTestService(g, tc.bastion)
+			s.EC2Client = ec2Mock
+			s.netService.EC2Client = ec2Mock
+			s.ASGClient = asgMock
+			tc.expectEC2(ec2Mock.EXPECT())
+			tc.expectASG(asgMock.EXPECT())
+
+			g.Expect(s.ReconcileBastion()).To(Succeed())
+			if !tc.expectReady {
+				g.Expect(clusterScope.AWSCluster.Status.Bastion).To(BeNil())
+				return
+			}
+			g.Expect(clusterScope.AWSCluster.Status.Bastion.ID).To(Equal("i-new"))
+			g.Expect(clusterScope.AWSCluster.Status.Bastion.PublicIP).To(Equal(aws.String("1.2.3.4")))
+		})
+	}
+}
+
+func TestServiceDeleteBastionAutoScaling(t *testing.T) {
+	testCases := []struct {
+		name         string
+		status       *string
+		expectDelete bool
+	}{
+		{
+			name:         "Should delete the Auto Scaling Group and wait for it to be gone",
+			expectDelete: true,
+		},
+		{
+			name:   "Should wait for an Auto Scaling Group that is already being deleted",
+			status: aws.String("Delete in progress"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mocks.NewMockEC2API(mockControl)
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockControl)
+
+			s, clusterScope := newBastionAutoScalingTestService(g, infrav1.Bastion{
+				AutoScaling: &infrav1.BastionAutoScaling{},
+			})
+			s.EC2Client = ec2Mock
+			s.netService.EC2Client = ec2Mock
+			s.ASGClient = asgMock
+
+			ec2Mock.EXPECT().DescribeInstances(context.TODO(), gomock.Any()).Return(&ec2.DescribeInstancesOutput{
+				Reservations: []types.Reservation{
+					{
+						Instances: []types.Instance{
+							{
+								InstanceId: aws.String("i-bastion"),
+								State: &types.InstanceState{
+									Name: types.InstanceStateNameRunning,
+								},
+								Placement: &types.Placement{
+									AvailabilityZone: aws.String("us-east-1a"),
+								},
+								Tags: []types.Tag{
+									{Key: aws.String(autoScalingGroupNameTagKey), Value: aws.String("test-cluster-bastion")},
+								},
+							},
+						},
+					},
+				},
+			}, nil)
+			describeGroup := asgMock.EXPECT().DescribeAutoScalingGroups(context.TODO(), gomock.Any()).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+				AutoScalingGroups: []autoscalingtypes.AutoScalingGroup{
+					{AutoScalingGroupName: aws.String("test-cluster-bastion"), Status: tc.status},
+				},
+			}, nil)
+			if tc.expectDelete {
+				asgMock.EXPECT().DeleteAutoScalingGroup(context.TODO(), gomock.Eq(&autoscaling.DeleteAutoScalingGroupInput{
+					AutoScalingGroupName: aws.String("test-cluster-bastion"),
+					ForceDelete:          aws.Bool(true),
+				})).Return(&autoscaling.DeleteAutoScalingGroupOutput{}, nil)
+			}
+			// The launch template can only be deleted once the Auto Scaling Group is gone.
+			groupDeleted := asgMock.EXPECT().DescribeAutoScalingGroups(gomock.Any(), gomock.Eq(&autoscaling.DescribeAutoScalingGroupsInput{
+				AutoScalingGroupNames: []string{"test-cluster-bastion"},
+			}), gomock.Any()).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil).After(describeGroup)
+			ec2Mock.EXPECT().DeleteLaunchTemplate(context.TODO(), gomock.Eq(&ec2.DeleteLaunchTemplateInput{
+				LaunchTemplateName: aws.String("test-cluster-bastion"),
+			})).Return(&ec2.DeleteLaunchTemplateOutput{}, nil).After(groupDeleted)
+			ec2Mock.EXPECT().DescribeAddresses(context.TODO(), gomock.Any()).Return(&ec2.DescribeAddressesOutput{}, nil)
+			ec2Mock.EXPECT().TerminateInstances(context.TODO(), gomock.Eq(&ec2.TerminateInstancesInput{
+				InstanceIds: []string{"i-bastion"},
+			})).Return(nil, nil)
+			ec2Mock.EXPECT().DescribeInstances(gomock.Any(), gomock.Eq(&ec2.DescribeInstancesInput{
+				InstanceIds: []string{"i-bastion"},
+			}), gomock.Any()).Return(&ec2.DescribeInstancesOutput{
+				Reservations: []types.Reservation{
+					{
+						Instances: []types.Instance{
+							{
+								State: &types.InstanceState{
+									Name: types.InstanceStateNameTerminated,
+								},
+							},
+						},
+					},
+				},
+			}, nil)
+
+			g.Expect(s.ReconcileBastion()).To(Succeed())
+			g.Expect(clusterScope.AWSCluster.Status.Bastion).To(BeNil())
+		})
+	}
+}
+
+func newBastionAutoScalingTestService(g *WithT, bastion infrav1.Bastion) (*Service, *scope.ClusterScope) {
+	scheme, err := setupScheme()
+	g.Expect(err).To(BeNil())
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpcID",
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:               "subnet-1",
+						AvailabilityZone: "us-east-1a",
+					},
+					infrav1.SubnetSpec{
+						ID:               "subnet-3",
+						AvailabilityZone: "us-east-1b",
+						IsPublic:         true,
+					},
+					infrav1.SubnetSpec{
+						ID:               "subnet-2",
+						AvailabilityZone: "us-east-1a",
+						IsPublic:         true,
+					},
+				},
+			},
+			Bastion: bastion,
+		},
+		Status: infrav1.AWSClusterStatus{
+			Network: infrav1.NetworkStatus{
+				SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+					infrav1.SecurityGroupBastion: {ID: "sg-bastion"},
+				},
+			},
+		},
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).WithStatusSubresource(awsCluster).Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "test-cluster",
+			},
+		},
+		AWSCluster: awsCluster,
+		Client:     client,
+	})
+	g.Expect(err).To(BeNil())
+
+	return NewService(clusterScope), clusterScope
+}
@@ -734,3 +734,180 @@ func TestServiceReconcileBastionUSGOV(t *testing.T) {
 		}
 	}
 }
+
+func TestServiceReconcileBastionSSM(t *testing.T) {
+	clusterName := "cluster"
+
+	describeInput := &ec2.DescribeInstancesInput{
+		Filters: []types.Filter{
+			filter.EC2.ProviderRole(infrav1.BastionRoleTagValue),
+			filter.EC2.Cluster(clusterName),
+			filter.EC2.InstanceStates(
+				types.InstanceStateNamePending,
+				types.InstanceStateNameRunning,
+				types.InstanceStateNameStopping,
+				types.InstanceStateNameStopped,
+			),
+		},
+	}
+
+	runOutput := &ec2.RunInstancesOutput{
+		Instances: []types.Instance{
+			{
+				State: &types.InstanceState{
+					Name: types.InstanceStateNameRunning,
+				},
+				IamInstanceProfile: &types.IamInstanceProfile{
+					Arn: aws.String("arn:aws:iam::123456789012:instance-profile/bastion"),
+				},
+				InstanceId:   aws.String("id456"),
+				InstanceType: types.InstanceTypeT3Micro,
+				SubnetId:     aws.String("subnet-1"),
+				ImageId:      aws.String("ubuntu-ami-id-latest"),
+				Placement: &types.Placement{
+					AvailabilityZone: aws.String("us-east-1"),
+				},
+			},
+		},
+	}
+
+	expectCreate := func(m *mocks.MockEC2APIMockRecorder) {
+		m.DescribeImages(context.TODO(), gomock.Any()).Return(&ec2.DescribeImagesOutput{Images: images{
+			{
+				ImageId:      aws.String("ubuntu-ami-id-latest"),
+				CreationDate: aws.String("2019-02-08T17:02:31.000Z"),
+			},
+		}}, nil)
+		m.RunInstances(context.TODO(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...func(*ec2.Options)) (*ec2.RunInstancesOutput, error) {
+				if input.KeyName != nil {
+					return nil, errors.Errorf("expected no key pair, got %q", aws.ToString(input.KeyName))
+				}
+				if aws.ToString(input.NetworkInterfaces[0].SubnetId) != "subnet-1" {
+					return nil, errors.Errorf("expected private subnet, got %q", aws.ToString(input.NetworkInterfaces[0].SubnetId))
+				}
+				if input.IamInstanceProfile == nil || aws.ToString(input.IamInstanceProfile.Name) != "bastion" {
+					return nil, errors.New("expected bastion instance profile")
+				}
+				return runOutput, nil
+			})
+	}
+
+	tests := []struct {
+		name   string
+		expect func(m *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name: "Should create bastion in a private subnet with the instance profile",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstances(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeInstancesOutput{}, nil)
+				expectCreate(m)
+			},
+		},
+		{
+			name: "Should replace bastion in a public subnet",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstances(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeInstancesOutput{
+						Reservations: []types.Reservation{
+							{
+								Instances: []types.Instance{
+									{
+										InstanceId: aws.String("id123"),
+										SubnetId:   aws.String("subnet-2"),
+										State: &types.InstanceState{
+											Name: types.InstanceStateNameRunning,
+										},
+										Placement: &types.Placement{
+											AvailabilityZone: aws.String("us-east-1"),
+										},
+									},
+								},
+							},
+						},
+					}, nil).Times(2)
+				m.TerminateInstances(context.TODO(), gomock.Eq(&ec2.TerminateInstancesInput{
+					InstanceIds: []string{"id123"},
+				})).Return(nil, nil)
+				m.DescribeInstances(gomock.Any(), gomock.Eq(&ec2.DescribeInstancesInput{
+					InstanceIds: []string{"id123"},
+				}), gomock.Any()).Return(&ec2.DescribeInstancesOutput{
+					Reservations: []types.Reservation{
+						{
+							Instances: []types.Instance{
+								{
+									State: &types.InstanceState{
+										Name: types.InstanceStateNameTerminated,
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+				expectCreate(m)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mocks.NewMockEC2API(mockControl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).To(BeNil())
+
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{
+							ID: "vpcID",
+						},
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID: "subnet-1",
+							},
+							infrav1.SubnetSpec{
+								ID:       "subnet-2",
+								IsPublic: true,
+							},
+						},
+					},
+					Bastion: infrav1.Bastion{
+						Enabled:         true,
+						Mode:            infrav1.BastionModeSSM,
+						InstanceProfile: "bastion",
+					},
+				},
+			}
+
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).WithStatusSubresource(awsCluster).Build()
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				AWSCluster: awsCluster,
+				Client:     client,
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(ec2Mock.EXPECT())
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.ReconcileBastion()).To(Succeed())
+			g.Expect(scope.AWSCluster.Status.Bastion.ID).To(Equal("id456"))
+			g.Expect(scope.AWSCluster.Status.Bastion.IAMProfile).To(Equal("bastion"))
+		})
+	}
+}
@@ -0,0 +1,573 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// maxFilterValues is the maximum number of values the EC2 API accepts in a single filter.
+const maxFilterValues = 200
+
+// GetFleet returns the EC2 Fleet of the AWSFleetMachinePool, or nil if it doesn't exist or has been deleted.
+// The fleet is looked up by the ID recorded in the status first, then by its tags, so that a fleet created
+// by a reconciliation that failed to persist the status is adopted instead of being created twice.
+func (s *Service) GetFleet(scope *scope.FleetMachinePoolScope) (*expinfrav1.EC2Fleet, error) {
+	var fleetData *types.FleetData
+
+	if fleetID := scope.AWSFleetMachinePool.Status.FleetID; fleetID != "" {
+		out, err := s.EC2Client.DescribeFleets(context.TODO(), &ec2.DescribeFleetsInput{
+			FleetIds: []string{fleetID},
+		})
+		switch {
+		case awserrors.IsNotFound(err):
+		case err != nil:
+			return nil, errors.Wrapf(err, "failed to describe EC2 Fleet %q", fleetID)
+		case len(out.Fleets) > 0 && !isFleetDeleted(out.Fleets[0].FleetState):
+			fleetData = &out.Fleets[0]
+		}
+	}
+
+	if fleetData == nil {
+		var err error
+		fleetData, err = s.findFleetByName(scope.Name())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if fleetData == nil {
+		return nil, nil
+	}
+
+	fleet := sdkToEC2Fleet(fleetData)
+	instances, err := s.describeFleetInstances(fleet.ID)
+	if err != nil {
+		return nil, err
+	}
+	fleet.Instances = instances
+
+	return fleet, nil
+}
+
+// findFleetByName returns the active EC2 Fleet of the cluster with the given name tag, or nil if there is none.
+func (s *Service) findFleetByName(name string) (*types.FleetData, error) {
+	input := &ec2.DescribeFleetsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("fleet-state"),
+				Values: []string{string(types.FleetStateCodeSubmitted), string(types.FleetStateCodeActive), string(types.FleetStateCodeModifying)},
+			},
+			{
+				Name:   aws.String("type"),
+				Values: []string{string(types.FleetTypeMaintain)},
+			},
+		},
+	}
+
+	clusterTagKey := infrav1.ClusterTagKey(s.scope.KubernetesClusterName())
+	paginator := ec2.NewDescribeFleetsPaginator(s.EC2Client, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe EC2 Fleets")
+		}
+
+		for i := range out.Fleets {
+			tags := converters.TagsToMap(out.Fleets[i].Tags)
+			if tags[clusterTagKey] == string(infrav1.ResourceLifecycleOwned) && tags["Name"] == name {
+				return &out.Fleets[i], nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateFleet creates an EC2 Fleet of type "maintain" for the AWSFleetMachinePool and returns its ID.
+func (s *Service) CreateFleet(scope *scope.FleetMachinePoolScope) (string, error) {
+	if scope.GetLaunchTemplateIDStatus() == "" {
+		return "", errors.New("AWSFleetMachinePool has no LaunchTemplateID")
+	}
+
+	launchTemplateConfigs, err := s.fleetLaunchTemplateConfigs(scope)
+	if err != nil {
+		return "", err
+	}
+
+	distribution := scope.CapacityDistribution()
+
+	additionalTags := scope.AdditionalTags()
+	additionalTags[infrav1.ClusterAWSCloudProviderTagKey(s.scope.KubernetesClusterName())] = string(infrav1.ResourceLifecycleOwned)
+	tags := infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.KubernetesClusterName(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(scope.Name()),
+		Role:        aws.String("node"),
+		Additional:  additionalTags,
+	})
+
+	input := &ec2.CreateFleetInput{
+		Type:                            types.FleetTypeMaintain,
+		LaunchTemplateConfigs:           launchTemplateConfigs,
+		TargetCapacitySpecification:     fleetTargetCapacity(scope.TargetCapacity(), distribution),
+		ExcessCapacityTerminationPolicy: types.FleetExcessCapacityTerminationPolicyTermination,
+		ReplaceUnhealthyInstances:       aws.Bool(scope.AWSFleetMachinePool.Spec.ReplaceUnhealthyInstances),
+		OnDemandOptions: &types.OnDemandOptionsRequest{
+			AllocationStrategy: types.FleetOnDemandAllocationStrategy(distribution.OnDemandAllocationStrategy),
+		},
+		SpotOptions: &types.SpotOptionsRequest{
+			AllocationStrategy: types.SpotAllocationStrategy(distribution.SpotAllocationStrategy),
+		},
+		TagSpecifications: []types.TagSpecification{
+			{
+				ResourceType: types.ResourceTypeFleet,
+				Tags:         converters.MapToTags(tags),
+			},
+		},
+	}
+
+	s.scope.Info("Creating EC2 Fleet", "name", scope.Name())
+	out, err := s.EC2Client.CreateFleet(context.TODO(), input)
+	if err != nil {
+		record.Warnf(scope.AWSFleetMachinePool, "FailedCreateFleet", "Failed to create EC2 Fleet: %v", err)
+		return "", errors.Wrapf(err, "failed to create EC2 Fleet for AWSFleetMachinePool %q", scope.Name())
+	}
+
+	fleetID := aws.ToString(out.FleetId)
+	record.Eventf(scope.AWSFleetMachinePool, "SuccessfulCreateFleet", "Created new EC2 Fleet %q", fleetID)
+
+	return fleetID, nil
+}
+
+// FleetNeedsUpdate checks if the target capacity, the launch template version, the subnets or the overrides
+// of the existing EC2 Fleet differ from the ones of the AWSFleetMachinePool.
+func (s *Service) FleetNeedsUpdate(scope *scope.FleetMachinePoolScope, existing *expinfrav1.EC2Fleet) (bool, error) {
+	desiredCapacity := fleetTargetCapacity(scope.TargetCapacity(), scope.CapacityDistribution())
+	if existing.TotalTargetCapacity != aws.ToInt32(desiredCapacity.TotalTargetCapacity) ||
+		existing.OnDemandTargetCapacity != aws.ToInt32(desiredCapacity.OnDemandTargetCapacity) ||
+		existing.SpotTargetCapacity != aws.ToInt32(desiredCapacity.SpotTargetCapacity) {
+		return true, nil
+	}
+
+	if existing.LaunchTemplateVersion != scope.GetLaunchTemplateLatestVersionStatus() {
+		return true, nil
+	}
+
+	subnetIDs, err := s.fleetSubnetIDs(scope)
+	if err != nil {
+		return false, err
+	}
+	less := func(a, b string) bool { return a < b }
+	if !cmp.Equal(subnetIDs, existing.Subnets, cmpopts.SortSlices(less), cmpopts.EquateEmpty()) {
+		return true, nil
+	}
+
+	return !cmp.Equal(scope.AWSFleetMachinePool.Spec.Overrides, existing.Overrides, cmpopts.EquateEmpty()), nil
+}
+
+// UpdateFleet updates the target capacity and the launch template configuration of an EC2 Fleet.
+// Instances that are already running are not replaced when the launch template configuration changes.
+func (s *Service) UpdateFleet(scope *scope.FleetMachinePoolScope, fleetID string) error {
+	launchTemplateConfigs, err := s.fleetLaunchTemplateConfigs(scope)
+	if err != nil {
+		return err
+	}
+
+	targetCapacity := fleetTargetCapacity(scope.TargetCapacity(), scope.CapacityDistribution())
+	// The default target capacity type can't be modified.
+	targetCapacity.DefaultTargetCapacityType = ""
+
+	input := &ec2.ModifyFleetInput{
+		FleetId:                         aws.String(fleetID),
+		LaunchTemplateConfigs:           launchTemplateConfigs,
+		TargetCapacitySpecification:     targetCapacity,
+		ExcessCapacityTerminationPolicy: types.FleetExcessCapacityTerminationPolicyTermination,
+	}
+
+	if _, err := s.EC2Client.ModifyFleet(context.TODO(), input); err != nil {
+		record.Warnf(scope.AWSFleetMachinePool, "FailedUpdateFleet", "Failed to update EC2 Fleet %q: %v", fleetID, err)
+		return errors.Wrapf(err, "failed to update EC2 Fleet %q", fleetID)
+	}
+
+	record.Eventf(scope.AWSFleetMachinePool, "SuccessfulUpdateFleet", "Updated EC2 Fleet %q", fleetID)
+	return nil
+}
+
+// DeleteFleet deletes an EC2 Fleet and terminates its instances.
+func (s *Service) DeleteFleet(fleetID string) error {
+	s.scope.Debug("Attempting to delete EC2 Fleet", "id", fleetID)
+
+	out, err := s.EC2Client.DeleteFleets(context.TODO(), &ec2.DeleteFleetsInput{
+		FleetIds:           []string{fleetID},
+		TerminateInstances: aws.Bool(true),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete EC2 Fleet %q", fleetID)
+	}
+
+	for _, item := range out.UnsuccessfulFleetDeletions {
+		if item.Error == nil || item.Error.Code == types.DeleteFleetErrorCodeFleetIdDoesNotExist {
+			continue
+		}
+		return errors.Errorf("failed to delete EC2 Fleet %q: %s: %s", fleetID, item.Error.Code, aws.ToString(item.Error.Message))
+	}
+
+	s.scope.Info("Deleted EC2 Fleet", "id", fleetID)
+	return nil
+}
+
+// describeFleetInstances returns the running instances of an EC2 Fleet, sorted by ID.
+func (s *Service) describeFleetInstances(fleetID string) ([]infrav1.Instance, error) {
+	instanceIDs := make([]string, 0)
+
+	input := &ec2.DescribeFleetInstancesInput{FleetId: aws.String(fleetID)}
+	for {
+		out, err := s.EC2Client.DescribeFleetInstances(context.TODO(), input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe instances of EC2 Fleet %q", fleetID)
+		}
+
+		for _, instance := range out.ActiveInstances {
+			instanceIDs = append(instanceIDs, aws.ToString(instance.InstanceId))
+		}
+
+		if aws.ToString(out.NextToken) == "" {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	// Active instances don't report their availability zone, which is part of their provider ID.
+	instances := make([]infrav1.Instance, 0, len(instanceIDs))
+	for start := 0; start < len(instanceIDs); start += maxFilterValues {
+		end := min(start+maxFilterValues, len(instanceIDs))
+
+		paginator := ec2.NewDescribeInstancesPaginator(s.EC2Client, &ec2.DescribeInstancesInput{
+			Filters: []types.Filter{
+				{
+					Name:   aws.String("instance-id"),
+					Values: instanceIDs[start:end],
+				},
+				{
+					Name:   aws.String("instance-state-name"),
+					Values: []string{string(types.InstanceStateNamePending), string(types.InstanceStateNameRunning)},
+				},
+			},
+		})
+		for paginator.HasMorePages() {
+			out, err := paginator.NextPage(context.TODO())
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to describe instances of EC2 Fleet %q", fleetID)
+			}
+
+			for _, reservation := range out.Reservations {
+				for _, instance := range reservation.Instances {
+					i := infrav1.Instance{
+						ID:       aws.ToString(instance.InstanceId),
+						Type:     string(instance.InstanceType),
+						SubnetID: aws.ToString(instance.SubnetId),
+					}
+					if instance.State != nil {
+						i.State = infrav1.InstanceState(instance.State.Name)
+					}
+					if instance.Placement != nil {
+						i.AvailabilityZone = aws.ToString(instance.Placement.AvailabilityZone)
+					}
+					instances = append(instances, i)
+				}
+			}
+		}
+	}
+
+	sort.Slice(instances, func(i, j int) bool { return instances[i].ID < instances[j].ID })
+	return instances, nil
+}
+
+// fleetSubnetIDs returns the IDs of the subnets the fleet launches instances into.
+func (s *Service) fleetSubnetIDs(scope *scope.FleetMachinePoolScope) ([]string, error) {
+	subnetIDs := make([]string, 0)
+	inputFilters := make([]types.Filter, 0)
+
+	for _, subnet := range scope.AWSFleetMachinePool.Spec.Subnets {
+		switch {
+		case subnet.ID != nil:
+			subnetIDs = append(subnetIDs, aws.ToString(subnet.ID))
+		case subnet.Filters != nil:
+			for _, eachFilter := range subnet.Filters {
+				inputFilters = append(inputFilters, types.Filter{
+					Name:   aws.String(eachFilter.Name),
+					Values: eachFilter.Values,
+				})
+			}
+		}
+	}
+
+	if len(inputFilters) > 0 {
+		out, err := s.EC2Client.DescribeSubnets(context.TODO(), &ec2.DescribeSubnetsInput{
+			Filters: inputFilters,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, subnet := range out.Subnets {
+			tags := converters.TagsToMap(subnet.Tags)
+			if tags[infrav1.NameAWSSubnetAssociation] == infrav1.SecondarySubnetTagValue {
+				// Subnet belongs to a secondary CIDR block which won't be used to create instances
+				continue
+			}
+
+			subnetIDs = append(subnetIDs, *subnet.SubnetId)
+		}
+
+		if len(subnetIDs) == 0 {
+			errMessage := fmt.Sprintf("failed to create EC2 Fleet %q, no subnets available matching criteria %v", scope.Name(), inputFilters)
+			record.Warnf(scope.AWSFleetMachinePool, "FailedCreateFleet", errMessage)
+			return subnetIDs, awserrors.NewFailedDependency(errMessage)
+		}
+	}
+
+	return scope.SubnetIDs(subnetIDs)
+}
+
+// fleetLaunchTemplateConfigs returns the launch template configuration of the fleet, with an override
+// for each combination of subnet and instance type, or instance requirements.
+func (s *Service) fleetLaunchTemplateConfigs(scope *scope.FleetMachinePoolScope) ([]types.FleetLaunchTemplateConfigRequest, error) {
+	subnetIDs, err := s.fleetSubnetIDs(scope)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting subnets for EC2 Fleet")
+	}
+
+	specOverrides := scope.AWSFleetMachinePool.Spec.Overrides
+	overrides := make([]types.FleetLaunchTemplateOverridesRequest, 0, len(subnetIDs)*max(len(specOverrides), 1))
+	for _, subnetID := range subnetIDs {
+		if len(specOverrides) == 0 {
+			overrides = append(overrides, types.FleetLaunchTemplateOverridesRequest{SubnetId: aws.String(subnetID)})
+			continue
+		}
+		for i := range specOverrides {
+			override := fleetOverride(specOverrides, i)
+			override.SubnetId = aws.String(subnetID)
+			overrides = append(overrides, override)
+		}
+	}
+
+	// Without subnets, the instances are launched in the network of the launch template.
+	if len(subnetIDs) == 0 {
+		for i := range specOverrides {
+			overrides = append(overrides, fleetOverride(specOverrides, i))
+		}
+	}
+
+	return []types.FleetLaunchTemplateConfigRequest{
+		{
+			LaunchTemplateSpecification: &types.FleetLaunchTemplateSpecificationRequest{
+				LaunchTemplateId: aws.String(scope.GetLaunchTemplateIDStatus()),
+				Version:          aws.String(scope.GetLaunchTemplateLatestVersionStatus()),
+			},
+			Overrides: overrides,
+		},
+	}, nil
+}
+
+// fleetOverride returns the fleet override for the instance type, or instance requirements, at index i.
+func fleetOverride(overrides []expinfrav1.Overrides, i int) types.FleetLaunchTemplateOverridesRequest {
+	if overrides[i].InstanceRequirements != nil {
+		return types.FleetLaunchTemplateOverridesRequest{
+			InstanceRequirements: createSDKFleetInstanceRequirements(overrides[i].InstanceRequirements),
+		}
+	}
+
+	return types.FleetLaunchTemplateOverridesRequest{
+		InstanceType: types.InstanceType(overrides[i].InstanceType),
+		// The priority is only used by the prioritized allocation strategies, the lower the number the higher the priority.
+		Priority: aws.Float64(float64(i)),
+	}
+}
+
+// fleetTargetCapacity splits the total target capacity of the fleet between On-Demand and Spot instances.
+func fleetTargetCapacity(total int32, distribution expinfrav1.FleetCapacityDistribution) *types.TargetCapacitySpecificationRequest {
+	onDemand := min(ptr.Deref(distribution.OnDemandBaseCapacity, 0), total)
+	if distribution.DefaultTargetCapacityType == expinfrav1.FleetCapacityTypeOnDemand {
+		onDemand = total
+	}
+
+	return &types.TargetCapacitySpecificationRequest{
+		TotalTargetCapacity:       aws.Int32(total),
+		OnDemandTargetCapacity:    aws.Int32(onDemand),
+		SpotTargetCapacity:        aws.Int32(total - onDemand),
+		DefaultTargetCapacityType: types.DefaultTargetCapacityType(distribution.DefaultTargetCapacityType),
+	}
+}
+
+func isFleetDeleted(state types.FleetStateCode) bool {
+	return strings.HasPrefix(string(state), "deleted")
+}
+
+func sdkToEC2Fleet(v *types.FleetData) *expinfrav1.EC2Fleet {
+	fleet := &expinfrav1.EC2Fleet{
+		ID:    aws.ToString(v.FleetId),
+		State: string(v.FleetState),
+	}
+
+	if capacity := v.TargetCapacitySpecification; capacity != nil {
+		fleet.TotalTargetCapacity = aws.ToInt32(capacity.TotalTargetCapacity)
+		fleet.OnDemandTargetCapacity = aws.ToInt32(capacity.OnDemandTargetCapacity)
+		fleet.SpotTargetCapacity = aws.ToInt32(capacity.SpotTargetCapacity)
+		fleet.DefaultTargetCapacityType = expinfrav1.FleetCapacityType(capacity.DefaultTargetCapacityType)
+	}
+
+	if len(v.LaunchTemplateConfigs) == 0 {
+		return fleet
+	}
+
+	config := v.LaunchTemplateConfigs[0]
+	if config.LaunchTemplateSpecification != nil {
+		fleet.LaunchTemplateID = aws.ToString(config.LaunchTemplateSpecification.LaunchTemplateId)
+		fleet.LaunchTemplateVersion = aws.ToString(config.LaunchTemplateSpecification.Version)
+	}
+
+	// The overrides are the cross product of the subnets and the instance types, or instance requirements.
+	subnets := map[string]struct{}{}
+	instanceTypes := map[string]float64{}
+	for _, override := range config.Overrides {
+		if subnetID := aws.ToString(override.SubnetId); subnetID != "" {
+			if _, ok := subnets[subnetID]; !ok {
+				subnets[subnetID] = struct{}{}
+				fleet.Subnets = append(fleet.Subnets, subnetID)
+			}
+		}
+
+		if override.InstanceRequirements != nil {
+			requirements := sdkToFleetInstanceRequirements(override.InstanceRequirements)
+			found := false
+			for _, o := range fleet.Overrides {
+				if cmp.Equal(o.InstanceRequirements, requirements) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				fleet.Overrides = append(fleet.Overrides, expinfrav1.Overrides{InstanceRequirements: requirements})
+			}
+			continue
+		}
+
+		if override.InstanceType != "" {
+			instanceTypes[string(override.InstanceType)] = aws.ToFloat64(override.Priority)
+		}
+	}
+
+	typeOverrides := make([]expinfrav1.Overrides, 0, len(instanceTypes))
+	for instanceType := range instanceTypes {
+		typeOverrides = append(typeOverrides, expinfrav1.Overrides{InstanceType: instanceType})
+	}
+	sort.Slice(typeOverrides, func(i, j int) bool {
+		return instanceTypes[typeOverrides[i].InstanceType] < instanceTypes[typeOverrides[j].InstanceType]
+	})
+	fleet.Overrides = append(fleet.Overrides, typeOverrides...)
+
+	return fleet
+}
+
+func createSDKFleetInstanceRequirements(r *expinfrav1.InstanceRequirements) *types.InstanceRequirementsRequest {
+	requirements := &types.InstanceRequirementsRequest{
+		VCpuCount: &types.VCpuCountRangeRequest{
+			Min: aws.Int32(r.VCPUCount.Min),
+			Max: r.VCPUCount.Max,
+		},
+		MemoryMiB: &types.MemoryMiBRequest{
+			Min: aws.Int32(r.MemoryMiB.Min),
+			Max: r.MemoryMiB.Max,
+		},
+		BurstablePerformance:                      types.BurstablePerformance(r.BurstablePerformance),
+		BareMetal:                                 types.BareMetal(r.BareMetal),
+		AllowedInstanceTypes:                      r.AllowedInstanceTypes,
+		ExcludedInstanceTypes:                     r.ExcludedInstanceTypes,
+		SpotMaxPricePercentageOverLowestPrice:     r.SpotMaxPricePercentageOverLowestPrice,
+		OnDemandMaxPricePercentageOverLowestPrice: r.OnDemandMaxPricePercentageOverLowestPrice,
+	}
+
+	for _, manufacturer := range r.CPUManufacturers {
+		requirements.CpuManufacturers = append(requirements.CpuManufacturers, types.CpuManufacturer(manufacturer))
+	}
+	for _, generation := range r.InstanceGenerations {
+		requirements.InstanceGenerations = append(requirements.InstanceGenerations, types.InstanceGeneration(generation))
+	}
+	for _, acceleratorType := range r.AcceleratorTypes {
+		requirements.AcceleratorTypes = append(requirements.AcceleratorTypes, types.AcceleratorType(acceleratorType))
+	}
+	if r.AcceleratorCount != nil {
+		requirements.AcceleratorCount = &types.AcceleratorCountRequest{
+			Min: aws.Int32(r.AcceleratorCount.Min),
+			Max: r.AcceleratorCount.Max,
+		}
+	}
+
+	return requirements
+}
+
+func sdkToFleetInstanceRequirements(v *types.InstanceRequirements) *expinfrav1.InstanceRequirements {
+	requirements := &expinfrav1.InstanceRequirements{
+		BurstablePerformance:                      expinfrav1.InstanceTypeFeatureRequirement(v.BurstablePerformance),
+		BareMetal:                                 expinfrav1.InstanceTypeFeatureRequirement(v.BareMetal),
+		AllowedInstanceTypes:                      v.AllowedInstanceTypes,
+		ExcludedInstanceTypes:                     v.ExcludedInstanceTypes,
+		SpotMaxPricePercentageOverLowestPrice:     v.SpotMaxPricePercentageOverLowestPrice,
+		OnDemandMaxPricePercentageOverLowestPrice: v.OnDemandMaxPricePercentageOverLowestPrice,
+	}
+
+	if v.VCpuCount != nil {
+		requirements.VCPUCount = expinfrav1.IntegerRange{Min: aws.ToInt32(v.VCpuCount.Min), Max: v.VCpuCount.Max}
+	}
+	if v.MemoryMiB != nil {
+		requirements.MemoryMiB = expinfrav1.IntegerRange{Min: aws.ToInt32(v.MemoryMiB.Min), Max: v.MemoryMiB.Max}
+	}
+	for _, manufacturer := range v.CpuManufacturers {
+		requirements.CPUManufacturers = append(requirements.CPUManufacturers, expinfrav1.CPUManufacturer(manufacturer))
+	}
+	for _, generation := range v.InstanceGenerations {
+		requirements.InstanceGenerations = append(requirements.InstanceGenerations, string(generation))
+	}
+	for _, acceleratorType := range v.AcceleratorTypes {
+		requirements.AcceleratorTypes = append(requirements.AcceleratorTypes, string(acceleratorType))
+	}
+	if v.AcceleratorCount != nil {
+		requirements.AcceleratorCount = &expinfrav1.IntegerRange{Min: aws.ToInt32(v.AcceleratorCount.Min), Max: v.AcceleratorCount.Max}
+	}
+
+	return requirements
+}
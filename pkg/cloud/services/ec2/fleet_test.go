@@ -0,0 +1,198 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestFleetTargetCapacity(t *testing.T) {
+	testCases := []struct {
+		name             string
+		total            int32
+		distribution     expinfrav1.FleetCapacityDistribution
+		expectedOnDemand int32
+		expectedSpot     int32
+	}{
+		{
+			name:             "spot capacity above the on-demand base capacity",
+			total:            5,
+			distribution:     expinfrav1.FleetCapacityDistribution{OnDemandBaseCapacity: ptr.To[int32](2), DefaultTargetCapacityType: expinfrav1.FleetCapacityTypeSpot},
+			expectedOnDemand: 2,
+			expectedSpot:     3,
+		},
+		{
+			name:             "on-demand base capacity larger than the total capacity",
+			total:            1,
+			distribution:     expinfrav1.FleetCapacityDistribution{OnDemandBaseCapacity: ptr.To[int32](2), DefaultTargetCapacityType: expinfrav1.FleetCapacityTypeSpot},
+			expectedOnDemand: 1,
+			expectedSpot:     0,
+		},
+		{
+			name:             "on-demand default target capacity type",
+			total:            3,
+			distribution:     expinfrav1.FleetCapacityDistribution{DefaultTargetCapacityType: expinfrav1.FleetCapacityTypeOnDemand},
+			expectedOnDemand: 3,
+			expectedSpot:     0,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			capacity := fleetTargetCapacity(tc.total, tc.distribution)
+			g.Expect(aws.ToInt32(capacity.TotalTargetCapacity)).To(Equal(tc.total))
+			g.Expect(aws.ToInt32(capacity.OnDemandTargetCapacity)).To(Equal(tc.expectedOnDemand))
+			g.Expect(aws.ToInt32(capacity.SpotTargetCapacity)).To(Equal(tc.expectedSpot))
+			g.Expect(capacity.DefaultTargetCapacityType).To(Equal(ec2types.DefaultTargetCapacityType(tc.distribution.DefaultTargetCapacityType)))
+		})
+	}
+}
+
+func TestSDKToEC2Fleet(t *testing.T) {
+	g := NewWithT(t)
+
+	fleet := sdkToEC2Fleet(&ec2types.FleetData{
+		FleetId:    aws.String("fleet-1"),
+		FleetState: ec2types.FleetStateCodeActive,
+		TargetCapacitySpecification: &ec2types.TargetCapacitySpecification{
+			TotalTargetCapacity:       aws.Int32(3),
+			OnDemandTargetCapacity:    aws.Int32(1),
+			SpotTargetCapacity:        aws.Int32(2),
+			DefaultTargetCapacityType: ec2types.DefaultTargetCapacityTypeSpot,
+		},
+		LaunchTemplateConfigs: []ec2types.FleetLaunchTemplateConfig{
+			{
+				LaunchTemplateSpecification: &ec2types.FleetLaunchTemplateSpecification{
+					LaunchTemplateId: aws.String("lt-1"),
+					Version:          aws.String("2"),
+				},
+				Overrides: []ec2types.FleetLaunchTemplateOverrides{
+					{SubnetId: aws.String("subnet-a"), InstanceType: ec2types.InstanceTypeM6iLarge, Priority: aws.Float64(0)},
+					{SubnetId: aws.String("subnet-a"), InstanceType: ec2types.InstanceTypeM5Large, Priority: aws.Float64(1)},
+					{SubnetId: aws.String("subnet-b"), InstanceType: ec2types.InstanceTypeM5Large, Priority: aws.Float64(1)},
+					{SubnetId: aws.String("subnet-b"), InstanceType: ec2types.InstanceTypeM6iLarge, Priority: aws.Float64(0)},
+				},
+			},
+		},
+	})
+
+	g.Expect(fleet).To(Equal(&expinfrav1.EC2Fleet{
+		ID:                        "fleet-1",
+		State:                     "active",
+		TotalTargetCapacity:       3,
+		OnDemandTargetCapacity:    1,
+		SpotTargetCapacity:        2,
+		DefaultTargetCapacityType: expinfrav1.FleetCapacityTypeSpot,
+		LaunchTemplateID:          "lt-1",
+		LaunchTemplateVersion:     "2",
+		Subnets:                   []string{"subnet-a", "subnet-b"},
+		Overrides:                 []expinfrav1.Overrides{{InstanceType: "m6i.large"}, {InstanceType: "m5.large"}},
+	}))
+}
+
+func TestDeleteFleet(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name    string
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "Should delete the fleet and terminate its instances",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DeleteFleets(context.TODO(), gomock.Eq(&ec2.DeleteFleetsInput{
+					FleetIds:           []string{"fleet-1"},
+					TerminateInstances: aws.Bool(true),
+				})).Return(&ec2.DeleteFleetsOutput{}, nil)
+			},
+		},
+		{
+			name: "Should not return error if the fleet doesn't exist",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DeleteFleets(context.TODO(), gomock.Any()).Return(&ec2.DeleteFleetsOutput{
+					UnsuccessfulFleetDeletions: []ec2types.DeleteFleetErrorItem{
+						{
+							FleetId: aws.String("fleet-1"),
+							Error:   &ec2types.DeleteFleetError{Code: ec2types.DeleteFleetErrorCodeFleetIdDoesNotExist},
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Should return error if the fleet can't be deleted",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DeleteFleets(context.TODO(), gomock.Any()).Return(&ec2.DeleteFleetsOutput{
+					UnsuccessfulFleetDeletions: []ec2types.DeleteFleetErrorItem{
+						{
+							FleetId: aws.String("fleet-1"),
+							Error:   &ec2types.DeleteFleetError{Code: ec2types.DeleteFleetErrorCodeUnexpectedError, Message: aws.String("boom")},
+						},
+					},
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if the request fails",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DeleteFleets(context.TODO(), gomock.Any()).Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			cs, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+			mockEC2Client := mocks.NewMockEC2API(mockCtrl)
+
+			s := NewService(cs)
+			s.EC2Client = mockEC2Client
+			tc.expect(mockEC2Client.EXPECT())
+
+			err = s.DeleteFleet("fleet-1")
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
@@ -19,6 +19,7 @@ package ec2
 
 import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	asg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/common"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/network"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ssm"
@@ -36,6 +37,9 @@ type Service struct {
 	// SSMClient is used to look up the official EKS AMI ID
 	SSMClient ssm.SSMAPI
 
+	// ASGClient is used to manage the Auto Scaling Group of the bastion host
+	ASGClient asg.AutoScalingAPI
+
 	// RetryEC2Client is used for dedicated host operations with enhanced retry configuration
 	// If nil, a new retry client will be created as needed
 	RetryEC2Client common.EC2API
@@ -49,6 +53,7 @@ func NewService(clusterScope scope.EC2Scope) *Service {
 		scope:                         clusterScope,
 		EC2Client:                     scope.NewEC2Client(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
 		SSMClient:                     scope.NewSSMClient(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
+		ASGClient:                     scope.NewASGClient(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
 		netService:                    network.NewService(clusterScope.(scope.NetworkScope)),
 		InstanceTypeArchitectureCache: cache.InstanceTypeArchitectureCacheSingleton,
 	}
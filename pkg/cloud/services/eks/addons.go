@@ -19,22 +19,32 @@ package eks
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/pkg/errors"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
 	eksaddons "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks/addons"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
 func (s *Service) reconcileAddons(ctx context.Context) error {
 	s.scope.Info("Reconciling EKS addons")
 
+	// Addons are upgraded once the control plane upgrade has finished
+	if upgrade := s.scope.ControlPlane.Status.Upgrade; upgrade != nil && upgrade.Phase == ekscontrolplanev1.UpgradePhaseControlPlaneUpgrading {
+		s.scope.Info("EKS control plane upgrade in progress, deferring addons reconciliation", "version", upgrade.Version)
+		return nil
+	}
+
 	eksClusterName := s.scope.KubernetesClusterName()
 
 	// Get available addon names for the cluster
@@ -57,17 +67,28 @@ func (s *Service) reconcileAddons(ctx context.Context) error {
 	// If there are no addons desired or installed then do nothing
 	if len(installed) == 0 && len(desiredAddons) == 0 {
 		s.scope.Info("no addons installed and no addons to install, no action needed")
-		return nil
+		v1beta1conditions.Delete(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfigurationValidCondition)
+		return s.completeAddonsUpgrade()
 	}
 
 	//  Compute operations to move installed to desired
+	kubernetesVersion, err := s.addonsKubernetesVersion()
+	if err != nil {
+		return err
+	}
 	s.scope.Debug("creating eks addons plan", "cluster", eksClusterName, "numdesired", len(desiredAddons), "numinstalled", len(installed))
-	addonsPlan := eksaddons.NewPlan(eksClusterName, desiredAddons, installed, s.EKSClient, s.scope.MaxWaitActiveUpdateDelete)
+	addonsPlan := eksaddons.NewPlan(eksClusterName, kubernetesVersion, desiredAddons, installed, s.EKSClient, s.scope.MaxWaitActiveUpdateDelete)
 	procedures, err := addonsPlan.Create(ctx)
 	if err != nil {
+		var configurationErr *eksaddons.ConfigurationError
+		if errors.As(err, &configurationErr) {
+			v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfigurationValidCondition, ekscontrolplanev1.EKSAddonsConfigurationInvalidReason, clusterv1beta1.ConditionSeverityError, "%s", configurationErr.Error())
+			record.Warnf(s.scope.ControlPlane, "InvalidEKSAddonConfiguration", "Configuration of EKS addon %s is not valid: %s", configurationErr.Name, strings.Join(configurationErr.Errors, "; "))
+		}
 		s.scope.Error(err, "failed creating eks addons plane")
 		return fmt.Errorf("creating eks addons plan: %w", err)
 	}
+	v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfigurationValidCondition)
 	s.scope.Debug("computed EKS addons plan", "numprocs", len(procedures))
 
 	// Perform required operations
@@ -87,7 +108,11 @@ func (s *Service) reconcileAddons(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("getting installed state of eks addons: %w", err)
 	}
+	setResolvedVersions(addonState, s.scope.Addons(), desiredAddons)
 	s.scope.ControlPlane.Status.Addons = addonState
+	if err := s.completeAddonsUpgrade(); err != nil {
+		return err
+	}
 
 	// Persist status and record event
 	if err := s.scope.PatchObject(); err != nil {
@@ -135,6 +160,13 @@ func (s *Service) getClusterAddonsInstalled(ctx context.Context, eksClusterName
 		for k, v := range describeOutput.Addon.Tags {
 			installedAddon.Tags[k] = v
 		}
+		for _, associationARN := range describeOutput.Addon.PodIdentityAssociations {
+			association, err := s.describeAddonPodIdentityAssociation(ctx, eksClusterName, associationARN)
+			if err != nil {
+				return addonsInstalled, fmt.Errorf("describing pod identity association of eks addon %s: %w", addon, err)
+			}
+			installedAddon.PodIdentityAssociations = append(installedAddon.PodIdentityAssociations, *association)
+		}
 
 		addonsInstalled = append(addonsInstalled, installedAddon)
 	}
@@ -142,6 +174,24 @@ func (s *Service) getClusterAddonsInstalled(ctx context.Context, eksClusterName
 	return addonsInstalled, nil
 }
 
+// describeAddonPodIdentityAssociation describes a pod identity association owned by an addon,
+// which the addon only references by ARN.
+func (s *Service) describeAddonPodIdentityAssociation(ctx context.Context, eksClusterName, associationARN string) (*eksaddons.PodIdentityAssociation, error) {
+	associationID := associationARN[strings.LastIndex(associationARN, "/")+1:]
+	output, err := s.EKSClient.DescribePodIdentityAssociation(ctx, &eks.DescribePodIdentityAssociationInput{
+		ClusterName:   &eksClusterName,
+		AssociationId: &associationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &eksaddons.PodIdentityAssociation{
+		ServiceAccount: aws.ToString(output.Association.ServiceAccount),
+		RoleARN:        aws.ToString(output.Association.RoleArn),
+	}, nil
+}
+
 func (s *Service) getInstalledState(ctx context.Context, eksClusterName string, addonNames []string) ([]ekscontrolplanev1.AddonState, error) {
 	s.Debug("getting eks addons installed to create state")
 
@@ -191,8 +241,39 @@ func (s *Service) listAddons(ctx context.Context, eksClusterName string) ([]stri
 	return addons, nil
 }
 
+// addonsKubernetesVersion returns the Kubernetes version of the cluster that the addon
+// version selectors are resolved for, which is empty if the version is not known yet.
+func (s *Service) addonsKubernetesVersion() (string, error) {
+	if aws.ToString(s.scope.ControlPlane.Status.Version) == "" {
+		return "", nil
+	}
+
+	v, err := parseEKSVersion(*s.scope.ControlPlane.Status.Version)
+	if err != nil {
+		return "", fmt.Errorf("parsing EKS version from status: %w", err)
+	}
+
+	return versionToEKS(v), nil
+}
+
+// setResolvedVersions records the version that the version selector of each addon in the
+// spec resolved to in the addons plan.
+func setResolvedVersions(addonState []ekscontrolplanev1.AddonState, addons []ekscontrolplanev1.Addon, desiredAddons []*eksaddons.EKSAddon) {
+	resolved := map[string]string{}
+	for i := range addons {
+		if ekscontrolplanev1.IsAddonVersionSelector(addons[i].Version) {
+			resolved[addons[i].Name] = aws.ToString(desiredAddons[i].Version)
+		}
+	}
+
+	for i := range addonState {
+		addonState[i].ResolvedVersion = resolved[addonState[i].Name]
+	}
+}
+
 func (s *Service) translateAPIToAddon(addons []ekscontrolplanev1.Addon) []*eksaddons.EKSAddon {
 	converted := []*eksaddons.EKSAddon{}
+	upgrade := s.scope.ControlPlane.Status.Upgrade
 
 	for i := range addons {
 		addon := addons[i]
@@ -208,6 +289,13 @@ func (s *Service) translateAPIToAddon(addons []ekscontrolplanev1.Addon) []*eksad
 			ResolveConflict:       conflict,
 			ServiceAccountRoleARN: addon.ServiceAccountRoleArn,
 			Preserve:              addon.PreserveOnDelete,
+			AutoUpdate:            addon.UpdatePolicy == ekscontrolplanev1.AddonUpdatePolicyAlways || (upgrade != nil && upgrade.Phase == ekscontrolplanev1.UpgradePhaseAddonsUpgrading),
+		}
+		for _, association := range addon.PodIdentityAssociations {
+			convertedAddon.PodIdentityAssociations = append(convertedAddon.PodIdentityAssociations, eksaddons.PodIdentityAssociation{
+				ServiceAccount: association.ServiceAccount,
+				RoleARN:        association.RoleARN,
+			})
 		}
 
 		converted = append(converted, convertedAddon)
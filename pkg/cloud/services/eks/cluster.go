@@ -116,10 +116,14 @@ func (s *Service) reconcileCluster(ctx context.Context) error {
 		return errors.Wrap(err, "failed reconciling additional kubeconfigs")
 	}
 
+	// The insights are reconciled after the cluster version so that a blocked upgrade is
+	// re-checked against the insights once they are due for a refresh.
 	if err := s.reconcileClusterVersion(ctx, cluster); err != nil {
 		return errors.Wrap(err, "failed reconciling cluster version")
 	}
 
+	s.reconcileInsights(ctx)
+
 	if err := s.reconcileClusterConfig(ctx, cluster); err != nil {
 		return errors.Wrap(err, "failed reconciling cluster config")
 	}
@@ -132,6 +136,10 @@ func (s *Service) reconcileCluster(ctx context.Context) error {
 		return errors.Wrap(err, "failed reconciling access entries")
 	}
 
+	if err := s.reconcilePodIdentityAssociations(ctx); err != nil {
+		return errors.Wrap(err, "failed reconciling pod identity associations")
+	}
+
 	if err := s.reconcileLogging(ctx, cluster.Logging); err != nil {
 		return errors.Wrap(err, "failed reconciling logging")
 	}
@@ -780,11 +788,55 @@ func (s *Service) reconcileClusterVersion(ctx context.Context, cluster *ekstypes
 
 	clusterVersion := version.MustParseGeneric(*cluster.Version)
 
+	// Once the control plane has been upgraded the addons are upgraded before the
+	// next upgrade step is started, see completeAddonsUpgrade.
+	if upgrade := s.scope.ControlPlane.Status.Upgrade; upgrade != nil {
+		switch upgrade.Phase {
+		case ekscontrolplanev1.UpgradePhaseControlPlaneUpgrading:
+			upgradeVersion, err := parseEKSVersion(upgrade.Version)
+			if err != nil {
+				return fmt.Errorf("parsing EKS version from upgrade status: %w", err)
+			}
+			// If the cluster is not at the upgrade version the update failed, so it is retried.
+			if !clusterVersion.LessThan(upgradeVersion) {
+				upgrade.Phase = ekscontrolplanev1.UpgradePhaseAddonsUpgrading
+				return nil
+			}
+		case ekscontrolplanev1.UpgradePhaseAddonsUpgrading:
+			return nil
+		}
+	}
+
 	if specVersion != nil && clusterVersion.LessThan(specVersion) {
 		// NOTE: you can only upgrade increments of minor versions. If you want to upgrade 1.14 to 1.16 we
 		// need to go 1.14-> 1.15 and then 1.15 -> 1.16.
 		nextVersionString := versionToEKS(clusterVersion.WithMinor(clusterVersion.Minor() + 1))
 
+		previousUpgrade := s.scope.ControlPlane.Status.Upgrade
+		s.scope.ControlPlane.Status.Upgrade = &ekscontrolplanev1.UpgradeStatus{
+			Phase:   ekscontrolplanev1.UpgradePhasePreflight,
+			Version: nextVersionString,
+		}
+
+		if !s.scope.ControlPlane.Spec.SkipUpgradePreflightChecks {
+			if s.upgradeBlockedRecently(previousUpgrade, nextVersionString) {
+				s.scope.Debug("EKS control plane upgrade blocked by pre-flight checks, waiting for the insights refresh to check again", "version", nextVersionString)
+				return nil
+			}
+
+			failures, err := s.upgradePreflightChecks(ctx, nextVersionString)
+			if err != nil {
+				return errors.Wrap(err, "failed to run upgrade pre-flight checks")
+			}
+			if len(failures) > 0 {
+				message := strings.Join(failures, "; ")
+				v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSUpgradePreflightChecksPassedCondition, ekscontrolplanev1.EKSUpgradePreflightChecksFailedReason, clusterv1beta1.ConditionSeverityWarning, "%s", message)
+				record.Warnf(s.scope.ControlPlane, "FailedUpgradePreflightChecks", "Upgrade of EKS control plane %s to version %s blocked by pre-flight checks: %s", s.scope.KubernetesClusterName(), nextVersionString, message)
+				return nil
+			}
+		}
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSUpgradePreflightChecksPassedCondition)
+
 		input := &eks.UpdateClusterVersionInput{
 			Name:    aws.String(s.scope.KubernetesClusterName()),
 			Version: &nextVersionString,
@@ -807,6 +859,7 @@ func (s *Service) reconcileClusterVersion(ctx context.Context, cluster *ekstypes
 			}
 
 			v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition)
+			s.scope.ControlPlane.Status.Upgrade.Phase = ekscontrolplanev1.UpgradePhaseControlPlaneUpgrading
 			record.Eventf(s.scope.ControlPlane, "InitiatedUpdateEKSControlPlane", "Initiated update of EKS control plane %s to version %s", s.scope.KubernetesClusterName(), nextVersionString)
 
 			return true, nil
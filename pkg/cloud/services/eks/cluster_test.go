@@ -398,6 +398,19 @@ func TestReconcileClusterVersion(t *testing.T) {
 							Version: aws.String("1.14"),
 						},
 					}, nil)
+				m.
+					DescribeClusterVersions(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.DescribeClusterVersionsInput{})).
+					Return(&eks.DescribeClusterVersionsOutput{
+						ClusterVersions: []ekstypes.ClusterVersionInformation{
+							{
+								ClusterVersion: aws.String("1.15"),
+								VersionStatus:  ekstypes.VersionStatusStandardSupport,
+							},
+						},
+					}, nil)
+				m.
+					ListInsights(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.ListInsightsInput{})).
+					Return(&eks.ListInsightsOutput{}, nil)
 				m.WaitUntilClusterUpdating(
 					gomock.Eq(context.TODO()),
 					gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}),
@@ -420,6 +433,19 @@ func TestReconcileClusterVersion(t *testing.T) {
 							Version: aws.String("1.14"),
 						},
 					}, nil)
+				m.
+					DescribeClusterVersions(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.DescribeClusterVersionsInput{})).
+					Return(&eks.DescribeClusterVersionsOutput{
+						ClusterVersions: []ekstypes.ClusterVersionInformation{
+							{
+								ClusterVersion: aws.String("1.15"),
+								VersionStatus:  ekstypes.VersionStatusStandardSupport,
+							},
+						},
+					}, nil)
+				m.
+					ListInsights(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.ListInsightsInput{})).
+					Return(&eks.ListInsightsOutput{}, nil)
 				m.
 					UpdateClusterVersion(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.UpdateClusterVersionInput{})).
 					Return(&eks.UpdateClusterVersionOutput{}, errors.New(""))
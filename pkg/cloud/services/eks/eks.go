@@ -71,6 +71,11 @@ func (s *Service) ReconcileControlPlane(ctx context.Context) error {
 func (s *Service) DeleteControlPlane(ctx context.Context) (err error) {
 	s.scope.Debug("Deleting EKS control plane")
 
+	// Pod Identity associations and IAM roles
+	if err := s.deletePodIdentityAssociations(ctx); err != nil {
+		return err
+	}
+
 	// EKS Cluster
 	if err := s.deleteCluster(ctx); err != nil {
 		return err
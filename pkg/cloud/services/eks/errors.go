@@ -32,6 +32,8 @@ var (
 	ErrNodegroupRoleNotFound = errors.New("the specified nodegroup role couldn't be found")
 	// ErrFargateRoleNotFound is an error if the specified role couldn't be founbd in AWS.
 	ErrFargateRoleNotFound = errors.New("the specified fargate role couldn't be found")
+	// ErrPodIdentityRoleNotFound is an error if the role of a pod identity association couldn't be found in AWS.
+	ErrPodIdentityRoleNotFound = errors.New("the specified pod identity role couldn't be found")
 	// ErrCannotUseAdditionalRoles is an error if the spec contains additional role and the
 	// EKSAllowAddRoles feature flag isn't enabled.
 	ErrCannotUseAdditionalRoles = errors.New("additional rules cannot be added as this has been disabled")
@@ -44,6 +44,8 @@ import (
 const (
 	// EKSFargateService is the service to trust for fargate pod execution roles.
 	EKSFargateService = "eks-fargate-pods.amazonaws.com"
+	// EKSPodIdentityService is the service to trust for EKS Pod Identity roles.
+	EKSPodIdentityService = "pods.eks.amazonaws.com"
 )
 
 // IAMService defines the specs for an IAM service.
@@ -420,6 +422,28 @@ func NodegroupTrustRelationship() *iamv1.PolicyDocument {
 	return policy
 }
 
+// PodIdentityTrustRelationship will generate an EKS Pod Identity PolicyDocument.
+func PodIdentityTrustRelationship() *iamv1.PolicyDocument {
+	identity := make(iamv1.Principals)
+	identity["Service"] = []string{EKSPodIdentityService}
+
+	policy := &iamv1.PolicyDocument{
+		Version: "2012-10-17",
+		Statement: []iamv1.StatementEntry{
+			{
+				Effect: "Allow",
+				Action: []string{
+					"sts:AssumeRole",
+					"sts:TagSession",
+				},
+				Principal: identity,
+			},
+		},
+	}
+
+	return policy
+}
+
 func findStringInSlice(slice []string, toFind string) bool {
 	for _, item := range slice {
 		if item == toFind {
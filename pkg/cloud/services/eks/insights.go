@@ -0,0 +1,149 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+// insightsRefreshPeriod is how often the EKS cluster insights are fetched. EKS itself
+// refreshes the insights of a cluster once a day.
+const insightsRefreshPeriod = time.Hour
+
+// reconcileInsights periodically refreshes the EKS cluster insights in the status. Failing
+// to fetch the insights doesn't fail the reconciliation as they are informational until
+// an upgrade is started.
+func (s *Service) reconcileInsights(ctx context.Context) {
+	insights := s.scope.ControlPlane.Status.Insights
+	if insights != nil && insights.LastRefreshTime != nil && time.Since(insights.LastRefreshTime.Time) < insightsRefreshPeriod {
+		s.scope.Debug("EKS cluster insights refreshed recently, skipping refresh")
+		return
+	}
+
+	if err := s.refreshInsights(ctx); err != nil {
+		s.scope.Error(err, "failed to refresh EKS cluster insights")
+		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSUpgradeInsightsPassingCondition, ekscontrolplanev1.EKSUpgradeInsightsRefreshFailedReason, clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+	}
+}
+
+// refreshInsights fetches the EKS cluster insights that are not passing, records them in the
+// status and sets the EKSUpgradeInsightsPassing condition from the upgrade readiness insights.
+func (s *Service) refreshInsights(ctx context.Context) error {
+	s.scope.Debug("Refreshing EKS cluster insights")
+
+	input := &eks.ListInsightsInput{
+		ClusterName: aws.String(s.scope.KubernetesClusterName()),
+		Filter: &ekstypes.InsightsFilter{
+			Statuses: []ekstypes.InsightStatusValue{ekstypes.InsightStatusValueError, ekstypes.InsightStatusValueWarning},
+		},
+	}
+
+	failing := []ekscontrolplanev1.InsightState{}
+	for {
+		output, err := s.EKSClient.ListInsights(ctx, input)
+		if err != nil {
+			return errors.Wrap(err, "failed to list EKS cluster insights")
+		}
+
+		for _, summary := range output.Insights {
+			insight, err := s.describeInsight(ctx, aws.ToString(summary.Id))
+			if err != nil {
+				return err
+			}
+			failing = append(failing, insightToState(insight))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	sort.Slice(failing, func(i, j int) bool {
+		return failing[i].ID < failing[j].ID
+	})
+	s.scope.ControlPlane.Status.Insights = &ekscontrolplanev1.InsightsStatus{
+		LastRefreshTime: &metav1.Time{Time: time.Now()},
+		Failing:         failing,
+	}
+
+	blocking := []string{}
+	for _, insight := range failing {
+		if isBlockingUpgradeInsight(insight, "") {
+			blocking = append(blocking, fmt.Sprintf("%s: %s", insight.Name, insight.Reason))
+		}
+	}
+	if len(blocking) > 0 {
+		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSUpgradeInsightsPassingCondition, ekscontrolplanev1.EKSUpgradeInsightsFailingReason, clusterv1beta1.ConditionSeverityWarning, "%s", strings.Join(blocking, "; "))
+	} else {
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSUpgradeInsightsPassingCondition)
+	}
+
+	return nil
+}
+
+func (s *Service) describeInsight(ctx context.Context, id string) (*ekstypes.Insight, error) {
+	output, err := s.EKSClient.DescribeInsight(ctx, &eks.DescribeInsightInput{
+		ClusterName: aws.String(s.scope.KubernetesClusterName()),
+		Id:          aws.String(id),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe EKS cluster insight %s", id)
+	}
+
+	return output.Insight, nil
+}
+
+// isBlockingUpgradeInsight returns true if the insight is an upgrade readiness insight in an
+// ERROR state. If a Kubernetes version is given, only insights for that version are blocking.
+func isBlockingUpgradeInsight(insight ekscontrolplanev1.InsightState, kubernetesVersion string) bool {
+	if insight.Category != string(ekstypes.CategoryUpgradeReadiness) || insight.Status != string(ekstypes.InsightStatusValueError) {
+		return false
+	}
+
+	return kubernetesVersion == "" || insight.KubernetesVersion == "" || insight.KubernetesVersion == kubernetesVersion
+}
+
+func insightToState(insight *ekstypes.Insight) ekscontrolplanev1.InsightState {
+	state := ekscontrolplanev1.InsightState{
+		ID:                aws.ToString(insight.Id),
+		Name:              aws.ToString(insight.Name),
+		Category:          string(insight.Category),
+		KubernetesVersion: aws.ToString(insight.KubernetesVersion),
+		Recommendation:    aws.ToString(insight.Recommendation),
+	}
+	if insight.InsightStatus != nil {
+		state.Status = string(insight.InsightStatus.Status)
+		state.Reason = aws.ToString(insight.InsightStatus.Reason)
+	}
+
+	return state
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+func TestReconcileInsights(t *testing.T) {
+	insight := func(id string, category ekstypes.Category, status ekstypes.InsightStatusValue) *eks.DescribeInsightOutput {
+		return &eks.DescribeInsightOutput{
+			Insight: &ekstypes.Insight{
+				Id:                aws.String(id),
+				Name:              aws.String(id),
+				Category:          category,
+				KubernetesVersion: aws.String("1.31"),
+				InsightStatus: &ekstypes.InsightStatus{
+					Status: status,
+					Reason: aws.String("reason"),
+				},
+				Recommendation: aws.String("recommendation"),
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		insights        *ekscontrolplanev1.InsightsStatus
+		expect          func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectRefreshed bool
+		expectedFailing []ekscontrolplanev1.InsightState
+		expectedPassing *bool
+		expectedReason  string
+	}{
+		{
+			name: "insights refreshed recently",
+			insights: &ekscontrolplanev1.InsightsStatus{
+				LastRefreshTime: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+		{
+			name: "no failing insights",
+			insights: &ekscontrolplanev1.InsightsStatus{
+				LastRefreshTime: &metav1.Time{Time: time.Now().Add(-2 * insightsRefreshPeriod)},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListInsights(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListInsightsInput{})).Return(&eks.ListInsightsOutput{}, nil)
+			},
+			expectRefreshed: true,
+			expectedFailing: []ekscontrolplanev1.InsightState{},
+			expectedPassing: aws.Bool(true),
+		},
+		{
+			name: "upgrade readiness insight in error",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListInsights(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListInsightsInput{})).Return(&eks.ListInsightsOutput{
+					Insights:  []ekstypes.InsightSummary{{Id: aws.String("b")}},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.ListInsights(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListInsightsInput{})).Return(&eks.ListInsightsOutput{
+					Insights: []ekstypes.InsightSummary{{Id: aws.String("a")}},
+				}, nil)
+				m.DescribeInsight(gomock.Any(), &eks.DescribeInsightInput{ClusterName: aws.String("cluster"), Id: aws.String("b")}).
+					Return(insight("b", ekstypes.CategoryUpgradeReadiness, ekstypes.InsightStatusValueError), nil)
+				m.DescribeInsight(gomock.Any(), &eks.DescribeInsightInput{ClusterName: aws.String("cluster"), Id: aws.String("a")}).
+					Return(insight("a", ekstypes.Category("MISCONFIGURATION"), ekstypes.InsightStatusValueError), nil)
+			},
+			expectRefreshed: true,
+			expectedFailing: []ekscontrolplanev1.InsightState{
+				{
+					ID:                "a",
+					Name:              "a",
+					Category:          string(ekstypes.Category("MISCONFIGURATION")),
+					KubernetesVersion: "1.31",
+					Status:            string(ekstypes.InsightStatusValueError),
+					Reason:            "reason",
+					Recommendation:    "recommendation",
+				},
+				{
+					ID:                "b",
+					Name:              "b",
+					Category:          string(ekstypes.CategoryUpgradeReadiness),
+					KubernetesVersion: "1.31",
+					Status:            string(ekstypes.InsightStatusValueError),
+					Reason:            "reason",
+					Recommendation:    "recommendation",
+				},
+			},
+			expectedPassing: aws.Bool(false),
+			expectedReason:  ekscontrolplanev1.EKSUpgradeInsightsFailingReason,
+		},
+		{
+			name: "upgrade readiness insight in warning",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListInsights(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListInsightsInput{})).Return(&eks.ListInsightsOutput{
+					Insights: []ekstypes.InsightSummary{{Id: aws.String("a")}},
+				}, nil)
+				m.DescribeInsight(gomock.Any(), gomock.Any()).
+					Return(insight("a", ekstypes.CategoryUpgradeReadiness, ekstypes.InsightStatusValueWarning), nil)
+			},
+			expectRefreshed: true,
+			expectedFailing: []ekscontrolplanev1.InsightState{
+				{
+					ID:                "a",
+					Name:              "a",
+					Category:          string(ekstypes.CategoryUpgradeReadiness),
+					KubernetesVersion: "1.31",
+					Status:            string(ekstypes.InsightStatusValueWarning),
+					Reason:            "reason",
+					Recommendation:    "recommendation",
+				},
+			},
+			expectedPassing: aws.Bool(true),
+		},
+		{
+			name: "refresh failure is not fatal",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListInsights(gomock.Any(), gomock.Any()).Return(nil, errors.New("access denied"))
+			},
+			expectedPassing: aws.Bool(false),
+			expectedReason:  ekscontrolplanev1.EKSUpgradeInsightsRefreshFailedReason,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "cluster",
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						EKSClusterName: "cluster",
+					},
+					Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+						Insights: tc.insights,
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(eksMock.EXPECT())
+			s := NewService(scope)
+			s.EKSClient = eksMock
+
+			s.reconcileInsights(context.TODO())
+
+			if tc.expectRefreshed {
+				insights := scope.ControlPlane.Status.Insights
+				g.Expect(insights).NotTo(BeNil())
+				g.Expect(insights.LastRefreshTime.Time).To(BeTemporally("~", time.Now(), time.Minute))
+				g.Expect(insights.Failing).To(Equal(tc.expectedFailing))
+			} else {
+				g.Expect(scope.ControlPlane.Status.Insights).To(Equal(tc.insights))
+			}
+
+			condition := v1beta1conditions.Get(scope.ControlPlane, ekscontrolplanev1.EKSUpgradeInsightsPassingCondition)
+			if tc.expectedPassing == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(v1beta1conditions.IsTrue(scope.ControlPlane, ekscontrolplanev1.EKSUpgradeInsightsPassingCondition)).To(Equal(*tc.expectedPassing))
+			g.Expect(condition.Reason).To(Equal(tc.expectedReason))
+		})
+	}
+}
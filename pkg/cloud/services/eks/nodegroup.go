@@ -342,6 +342,12 @@ func (s *NodegroupService) reconcileNodegroupVersion(ctx context.Context, ng *ek
 
 	eksClusterName := s.scope.KubernetesClusterName()
 	if (specVersion != nil && ngVersion.LessThan(specVersion)) || (specAMI != nil && *specAMI != ngAMI) || (statusLaunchTemplateVersion != nil && *statusLaunchTemplateVersion != *ngLaunchTemplateVersion) {
+		// Nodegroups are rolled out once the control plane and its addons have been upgraded
+		if upgrade := s.scope.ControlPlane.Status.Upgrade; upgrade.InProgress() {
+			s.scope.Info("EKS control plane upgrade in progress, deferring nodegroup version update", "phase", upgrade.Phase, "version", upgrade.Version)
+			return nil
+		}
+
 		input := &eks.UpdateNodegroupVersionInput{
 			ClusterName:   aws.String(eksClusterName),
 			NodegroupName: aws.String(s.scope.NodegroupName()),
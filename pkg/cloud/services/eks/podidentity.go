@@ -0,0 +1,331 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	eksiam "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
+	ekspkg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+func (s *Service) reconcilePodIdentityAssociations(ctx context.Context) error {
+	managedAssociations, err := s.getManagedPodIdentityAssociations(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list existing pod identity associations")
+	}
+
+	for _, association := range s.scope.ControlPlane.Spec.PodIdentityAssociations {
+		key := podIdentityAssociationKey(association.ServiceAccountNamespace, association.ServiceAccountName)
+
+		roleARN, err := s.reconcilePodIdentityRole(ctx, association)
+		if err != nil {
+			return errors.Wrapf(err, "failed to reconcile IAM role for pod identity association %s", key)
+		}
+
+		if existing, exists := managedAssociations[key]; exists {
+			if aws.ToString(existing.RoleArn) != roleARN {
+				if err := s.updatePodIdentityAssociation(ctx, existing, roleARN); err != nil {
+					return errors.Wrapf(err, "failed to update pod identity association %s", key)
+				}
+				if err := s.deletePreviousPodIdentityRole(ctx, existing); err != nil {
+					return errors.Wrapf(err, "failed to delete previous IAM role of pod identity association %s", key)
+				}
+			}
+			delete(managedAssociations, key)
+		} else {
+			if err := s.createPodIdentityAssociation(ctx, association, roleARN); err != nil {
+				return errors.Wrapf(err, "failed to create pod identity association %s", key)
+			}
+		}
+	}
+
+	for key, association := range managedAssociations {
+		if err := s.deletePodIdentityAssociation(ctx, association); err != nil {
+			return errors.Wrapf(err, "failed to delete pod identity association %s", key)
+		}
+	}
+
+	record.Event(s.scope.ControlPlane, "SuccessfulReconcilePodIdentityAssociations", "Reconciled pod identity associations")
+	return nil
+}
+
+// getManagedPodIdentityAssociations returns the pod identity associations created by CAPA for the cluster,
+// keyed by service account. The associations owned by EKS addons are reconciled with the addons.
+func (s *Service) getManagedPodIdentityAssociations(ctx context.Context) (map[string]*ekstypes.PodIdentityAssociation, error) {
+	existingAssociations := make(map[string]*ekstypes.PodIdentityAssociation)
+	var nextToken *string
+
+	clusterName := s.scope.KubernetesClusterName()
+	managedTag := infrav1.ClusterAWSCloudProviderTagKey(s.scope.Name())
+
+	for {
+		input := &eks.ListPodIdentityAssociationsInput{
+			ClusterName: &clusterName,
+			NextToken:   nextToken,
+		}
+
+		output, err := s.EKSClient.ListPodIdentityAssociations(ctx, input)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list pod identity associations")
+		}
+
+		for _, summary := range output.Associations {
+			if summary.OwnerArn != nil {
+				continue
+			}
+
+			describeOutput, err := s.EKSClient.DescribePodIdentityAssociation(ctx, &eks.DescribePodIdentityAssociationInput{
+				ClusterName:   &clusterName,
+				AssociationId: summary.AssociationId,
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to describe pod identity association %s", aws.ToString(summary.AssociationId))
+			}
+
+			if _, managed := describeOutput.Association.Tags[managedTag]; managed {
+				key := podIdentityAssociationKey(aws.ToString(summary.Namespace), aws.ToString(summary.ServiceAccount))
+				existingAssociations[key] = describeOutput.Association
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		nextToken = output.NextToken
+	}
+
+	return existingAssociations, nil
+}
+
+func (s *Service) createPodIdentityAssociation(ctx context.Context, association ekscontrolplanev1.PodIdentityAssociation, roleARN string) error {
+	clusterName := s.scope.KubernetesClusterName()
+
+	additionalTags := s.scope.AdditionalTags()
+	additionalTags[infrav1.ClusterAWSCloudProviderTagKey(s.scope.Name())] = string(infrav1.ResourceLifecycleOwned)
+	tags := make(map[string]string)
+	for k, v := range additionalTags {
+		tags[k] = v
+	}
+
+	if _, err := s.EKSClient.CreatePodIdentityAssociation(ctx, &eks.CreatePodIdentityAssociationInput{
+		ClusterName:    &clusterName,
+		Namespace:      &association.ServiceAccountNamespace,
+		ServiceAccount: &association.ServiceAccountName,
+		RoleArn:        &roleARN,
+		Tags:           tags,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to create pod identity association for role %s", roleARN)
+	}
+
+	return nil
+}
+
+func (s *Service) updatePodIdentityAssociation(ctx context.Context, association *ekstypes.PodIdentityAssociation, roleARN string) error {
+	clusterName := s.scope.KubernetesClusterName()
+
+	if _, err := s.EKSClient.UpdatePodIdentityAssociation(ctx, &eks.UpdatePodIdentityAssociationInput{
+		ClusterName:   &clusterName,
+		AssociationId: association.AssociationId,
+		RoleArn:       &roleARN,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to update pod identity association %s", aws.ToString(association.AssociationId))
+	}
+
+	return nil
+}
+
+func (s *Service) deletePodIdentityAssociation(ctx context.Context, association *ekstypes.PodIdentityAssociation) error {
+	clusterName := s.scope.KubernetesClusterName()
+
+	if _, err := s.EKSClient.DeletePodIdentityAssociation(ctx, &eks.DeletePodIdentityAssociationInput{
+		ClusterName:   &clusterName,
+		AssociationId: association.AssociationId,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to delete pod identity association %s", aws.ToString(association.AssociationId))
+	}
+
+	return s.deletePreviousPodIdentityRole(ctx, association)
+}
+
+// deletePreviousPodIdentityRole deletes the IAM role an association used before it was updated
+// or deleted, if that role was created by CAPA for the association.
+func (s *Service) deletePreviousPodIdentityRole(ctx context.Context, association *ekstypes.PodIdentityAssociation) error {
+	roleName, err := podIdentityRoleName(s.scope.KubernetesClusterName(), aws.ToString(association.Namespace), aws.ToString(association.ServiceAccount))
+	if err != nil {
+		return err
+	}
+
+	return s.deletePodIdentityRole(ctx, roleName, aws.ToString(association.RoleArn))
+}
+
+// reconcilePodIdentityRole returns the ARN of the IAM role of the association, creating
+// and updating the role when it is managed by CAPA.
+func (s *Service) reconcilePodIdentityRole(ctx context.Context, association ekscontrolplanev1.PodIdentityAssociation) (string, error) {
+	if association.Role == nil {
+		return association.RoleARN, nil
+	}
+
+	roleName, err := podIdentityRoleName(s.scope.KubernetesClusterName(), association.ServiceAccountNamespace, association.ServiceAccountName)
+	if err != nil {
+		return "", err
+	}
+
+	trustRelationship, err := podIdentityTrustRelationship(association.Role)
+	if err != nil {
+		return "", err
+	}
+
+	role, err := s.GetIAMRole(ctx, roleName)
+	if err != nil {
+		if !isNotFound(err) {
+			return "", err
+		}
+
+		// If the disable IAM flag is used then the role must exist
+		if !s.scope.EnableIAM() {
+			return "", fmt.Errorf("getting role %s: %w", roleName, ErrPodIdentityRoleNotFound)
+		}
+
+		role, err = s.CreateRole(ctx, roleName, s.scope.Name(), trustRelationship, s.scope.AdditionalTags(), s.scope.ControlPlane.Spec.RolePath, s.scope.ControlPlane.Spec.RolePermissionsBoundary)
+		if err != nil {
+			record.Warnf(s.scope.ControlPlane, "FailedIAMRoleCreation", "Failed to create pod identity IAM role %q: %v", roleName, err)
+			return "", fmt.Errorf("creating role %s: %w", roleName, err)
+		}
+		record.Eventf(s.scope.ControlPlane, "SuccessfulIAMRoleCreation", "Created pod identity IAM role %q", roleName)
+	}
+
+	if s.IsUnmanaged(role, s.scope.Name()) {
+		s.scope.Debug("Skipping, pod identity role policy assignment as role is unmanaged", "role", roleName)
+		return aws.ToString(role.Arn), nil
+	}
+
+	if _, err := s.EnsureTagsAndPolicy(ctx, role, s.scope.Name(), trustRelationship, s.scope.AdditionalTags()); err != nil {
+		return "", errors.Wrapf(err, "error ensuring tags and policy document are set on pod identity role %s", roleName)
+	}
+
+	if _, err := s.EnsurePoliciesAttached(ctx, role, association.Role.PolicyARNs); err != nil {
+		return "", errors.Wrapf(err, "error ensuring policies are attached: %v", association.Role.PolicyARNs)
+	}
+
+	return aws.ToString(role.Arn), nil
+}
+
+// deletePodIdentityAssociations deletes the pod identity associations created by CAPA together
+// with the IAM roles created for them. It runs before the cluster is deleted, as the associations
+// can't be listed anymore once EKS has deleted them along with the cluster.
+func (s *Service) deletePodIdentityAssociations(ctx context.Context) error {
+	eksClusterName := s.scope.KubernetesClusterName()
+	if eksClusterName == "" {
+		return nil
+	}
+
+	cluster, err := s.describeEKSCluster(ctx, eksClusterName)
+	if err != nil {
+		return errors.Wrap(err, "unable to describe eks cluster")
+	}
+	if cluster == nil {
+		s.scope.Trace("eks cluster does not exist, skipping pod identity association deletion")
+		return nil
+	}
+
+	managedAssociations, err := s.getManagedPodIdentityAssociations(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list existing pod identity associations")
+	}
+
+	for key, association := range managedAssociations {
+		if err := s.deletePodIdentityAssociation(ctx, association); err != nil {
+			return errors.Wrapf(err, "failed to delete pod identity association %s", key)
+		}
+	}
+
+	return nil
+}
+
+// deletePodIdentityRole deletes a pod identity IAM role if it is managed by CAPA and, when
+// roleARN is set, if it is the role with that ARN.
+func (s *Service) deletePodIdentityRole(ctx context.Context, roleName, roleARN string) error {
+	if !s.scope.EnableIAM() {
+		s.scope.Debug("EKS IAM disabled, skipping deleting pod identity IAM role", "role", roleName)
+		return nil
+	}
+
+	role, err := s.GetIAMRole(ctx, roleName)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+
+		return errors.Wrapf(err, "getting pod identity iam role %s", roleName)
+	}
+
+	if s.IsUnmanaged(role, s.scope.Name()) || (roleARN != "" && aws.ToString(role.Arn) != roleARN) {
+		s.scope.Debug("Skipping, pod identity iam role deletion as role is unmanaged", "role", roleName)
+		return nil
+	}
+
+	if err := s.DeleteRole(ctx, roleName); err != nil {
+		record.Eventf(s.scope.ControlPlane, "FailedIAMRoleDeletion", "Failed to delete pod identity IAM role %q: %v", roleName, err)
+		return err
+	}
+
+	record.Eventf(s.scope.ControlPlane, "SuccessfulIAMRoleDeletion", "Deleted pod identity IAM role %q", roleName)
+	return nil
+}
+
+func podIdentityAssociationKey(namespace, serviceAccount string) string {
+	return fmt.Sprintf("%s/%s", namespace, serviceAccount)
+}
+
+func podIdentityRoleName(clusterName, namespace, serviceAccount string) (string, error) {
+	roleName, err := ekspkg.GenerateEKSName(
+		"pod-identity",
+		fmt.Sprintf("%s-%s-%s", clusterName, namespace, serviceAccount),
+		maxIAMRoleNameLength,
+	)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate IAM role name")
+	}
+
+	return roleName, nil
+}
+
+func podIdentityTrustRelationship(role *ekscontrolplanev1.PodIdentityRole) (*iamv1.PolicyDocument, error) {
+	if role.TrustPolicy == "" {
+		return eksiam.PodIdentityTrustRelationship(), nil
+	}
+
+	trustRelationship := &iamv1.PolicyDocument{}
+	if err := json.Unmarshal([]byte(role.TrustPolicy), trustRelationship); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal trust policy")
+	}
+
+	return trustRelationship, nil
+}
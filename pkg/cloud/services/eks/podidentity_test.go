@@ -0,0 +1,502 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/smithy-go"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const (
+	podIdentityRoleARN       = "arn:aws:iam::123456789012:role/app"
+	secondPodIdentityRoleARN = "arn:aws:iam::123456789012:role/second-app"
+	podIdentityPolicyARN     = "arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"
+	managedPodIdentityRole   = "test-cluster-default-app_pod-identity"
+	podIdentityTrustPolicy   = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["pods.eks.amazonaws.com"]},"Action":["sts:AssumeRole","sts:TagSession"]}]}`
+)
+
+func TestReconcilePodIdentityAssociations(t *testing.T) {
+	managedTags := map[string]string{
+		"kubernetes.io/cluster/test-cluster": "owned",
+	}
+
+	tests := []struct {
+		name         string
+		associations []ekscontrolplanev1.PodIdentityAssociation
+		enableIAM    bool
+		expect       func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder)
+		expectError  bool
+	}{
+		{
+			name:         "no pod identity associations",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).Return(&eks.ListPodIdentityAssociationsOutput{}, nil)
+			},
+			expectError: false,
+		},
+		{
+			name:         "delete the last association and its managed role",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{},
+			enableIAM:    true,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).Return(&eks.ListPodIdentityAssociationsOutput{
+					Associations: []ekstypes.PodIdentityAssociationSummary{
+						{AssociationId: aws.String("a-1"), Namespace: aws.String("default"), ServiceAccount: aws.String("app")},
+					},
+				}, nil)
+
+				m.DescribePodIdentityAssociation(gomock.Any(), &eks.DescribePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-1"),
+				}).Return(&eks.DescribePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String("a-1"),
+						Namespace:      aws.String("default"),
+						ServiceAccount: aws.String("app"),
+						RoleArn:        aws.String(podIdentityRoleARN),
+						Tags:           managedTags,
+					},
+				}, nil)
+
+				m.DeletePodIdentityAssociation(gomock.Any(), &eks.DeletePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-1"),
+				}).Return(&eks.DeletePodIdentityAssociationOutput{}, nil)
+
+				i.GetRole(gomock.Any(), &iam.GetRoleInput{
+					RoleName: aws.String(managedPodIdentityRole),
+				}).Return(&iam.GetRoleOutput{
+					Role: &iamtypes.Role{
+						Arn:      aws.String(podIdentityRoleARN),
+						RoleName: aws.String(managedPodIdentityRole),
+						Tags: []iamtypes.Tag{
+							{Key: aws.String("kubernetes.io/cluster/test-cluster"), Value: aws.String("owned")},
+						},
+					},
+				}, nil)
+				i.ListAttachedRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+				i.DeleteRole(gomock.Any(), &iam.DeleteRoleInput{
+					RoleName: aws.String(managedPodIdentityRole),
+				}).Return(&iam.DeleteRoleOutput{}, nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "fail when an existing association can't be described",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "default",
+					ServiceAccountName:      "app",
+					RoleARN:                 podIdentityRoleARN,
+				},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).Return(&eks.ListPodIdentityAssociationsOutput{
+					Associations: []ekstypes.PodIdentityAssociationSummary{
+						{AssociationId: aws.String("a-1"), Namespace: aws.String("default"), ServiceAccount: aws.String("app")},
+					},
+				}, nil)
+
+				m.DescribePodIdentityAssociation(gomock.Any(), gomock.Any()).Return(nil, &smithy.GenericAPIError{Code: "ServerException"})
+			},
+			expectError: true,
+		},
+		{
+			name: "create new association with role arn",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "default",
+					ServiceAccountName:      "app",
+					RoleARN:                 podIdentityRoleARN,
+				},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).Return(&eks.ListPodIdentityAssociationsOutput{}, nil)
+
+				m.CreatePodIdentityAssociation(gomock.Any(), &eks.CreatePodIdentityAssociationInput{
+					ClusterName:    aws.String(clusterName),
+					Namespace:      aws.String("default"),
+					ServiceAccount: aws.String("app"),
+					RoleArn:        aws.String(podIdentityRoleARN),
+					Tags:           managedTags,
+				}).Return(&eks.CreatePodIdentityAssociationOutput{}, nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "update role of existing association",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "default",
+					ServiceAccountName:      "app",
+					RoleARN:                 secondPodIdentityRoleARN,
+				},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).Return(&eks.ListPodIdentityAssociationsOutput{
+					Associations: []ekstypes.PodIdentityAssociationSummary{
+						{AssociationId: aws.String("a-1"), Namespace: aws.String("default"), ServiceAccount: aws.String("app")},
+					},
+				}, nil)
+
+				m.DescribePodIdentityAssociation(gomock.Any(), &eks.DescribePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-1"),
+				}).Return(&eks.DescribePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String("a-1"),
+						Namespace:      aws.String("default"),
+						ServiceAccount: aws.String("app"),
+						RoleArn:        aws.String(podIdentityRoleARN),
+						Tags:           managedTags,
+					},
+				}, nil)
+
+				m.UpdatePodIdentityAssociation(gomock.Any(), &eks.UpdatePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-1"),
+					RoleArn:       aws.String(secondPodIdentityRoleARN),
+				}).Return(&eks.UpdatePodIdentityAssociationOutput{}, nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "delete managed associations that are no longer desired",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "default",
+					ServiceAccountName:      "app",
+					RoleARN:                 podIdentityRoleARN,
+				},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).Return(&eks.ListPodIdentityAssociationsOutput{
+					Associations: []ekstypes.PodIdentityAssociationSummary{
+						{AssociationId: aws.String("a-1"), Namespace: aws.String("default"), ServiceAccount: aws.String("app")},
+						{AssociationId: aws.String("a-2"), Namespace: aws.String("default"), ServiceAccount: aws.String("removed")},
+						{AssociationId: aws.String("a-3"), Namespace: aws.String("default"), ServiceAccount: aws.String("unmanaged")},
+						{AssociationId: aws.String("a-4"), Namespace: aws.String("kube-system"), ServiceAccount: aws.String("addon"), OwnerArn: aws.String("arn:addon")},
+					},
+				}, nil)
+
+				m.DescribePodIdentityAssociation(gomock.Any(), &eks.DescribePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-1"),
+				}).Return(&eks.DescribePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String("a-1"),
+						Namespace:      aws.String("default"),
+						ServiceAccount: aws.String("app"),
+						RoleArn:        aws.String(podIdentityRoleARN),
+						Tags:           managedTags,
+					},
+				}, nil)
+
+				m.DescribePodIdentityAssociation(gomock.Any(), &eks.DescribePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-2"),
+				}).Return(&eks.DescribePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String("a-2"),
+						Namespace:      aws.String("default"),
+						ServiceAccount: aws.String("removed"),
+						RoleArn:        aws.String(secondPodIdentityRoleARN),
+						Tags:           managedTags,
+					},
+				}, nil)
+
+				m.DescribePodIdentityAssociation(gomock.Any(), &eks.DescribePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-3"),
+				}).Return(&eks.DescribePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String("a-3"),
+						Namespace:      aws.String("default"),
+						ServiceAccount: aws.String("unmanaged"),
+						RoleArn:        aws.String(secondPodIdentityRoleARN),
+					},
+				}, nil)
+
+				m.DeletePodIdentityAssociation(gomock.Any(), &eks.DeletePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-2"),
+				}).Return(&eks.DeletePodIdentityAssociationOutput{}, nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "create new association with managed role",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "default",
+					ServiceAccountName:      "app",
+					Role: &ekscontrolplanev1.PodIdentityRole{
+						PolicyARNs: []string{podIdentityPolicyARN},
+					},
+				},
+			},
+			enableIAM: true,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				i.GetRole(gomock.Any(), &iam.GetRoleInput{
+					RoleName: aws.String(managedPodIdentityRole),
+				}).Return(nil, &smithy.GenericAPIError{Code: "NoSuchEntity"})
+
+				i.CreateRole(gomock.Any(), gomock.Any()).Return(&iam.CreateRoleOutput{
+					Role: &iamtypes.Role{
+						Arn:                      aws.String(podIdentityRoleARN),
+						RoleName:                 aws.String(managedPodIdentityRole),
+						AssumeRolePolicyDocument: aws.String(podIdentityTrustPolicy),
+						Tags: []iamtypes.Tag{
+							{Key: aws.String("kubernetes.io/cluster/test-cluster"), Value: aws.String("owned")},
+						},
+					},
+				}, nil)
+
+				i.ListAttachedRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+				i.GetPolicy(gomock.Any(), gomock.Any()).Return(&iam.GetPolicyOutput{}, nil)
+				i.AttachRolePolicy(gomock.Any(), &iam.AttachRolePolicyInput{
+					RoleName:  aws.String(managedPodIdentityRole),
+					PolicyArn: aws.String(podIdentityPolicyARN),
+				}).Return(&iam.AttachRolePolicyOutput{}, nil)
+
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).Return(&eks.ListPodIdentityAssociationsOutput{}, nil)
+
+				m.CreatePodIdentityAssociation(gomock.Any(), &eks.CreatePodIdentityAssociationInput{
+					ClusterName:    aws.String(clusterName),
+					Namespace:      aws.String("default"),
+					ServiceAccount: aws.String("app"),
+					RoleArn:        aws.String(podIdentityRoleARN),
+					Tags:           managedTags,
+				}).Return(&eks.CreatePodIdentityAssociationOutput{}, nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "managed role not found with IAM disabled",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "default",
+					ServiceAccountName:      "app",
+					Role:                    &ekscontrolplanev1.PodIdentityRole{},
+				},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).Return(&eks.ListPodIdentityAssociationsOutput{}, nil)
+
+				i.GetRole(gomock.Any(), gomock.Any()).Return(nil, &smithy.GenericAPIError{Code: "NoSuchEntity"})
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      clusterName,
+				},
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName:          clusterName,
+					PodIdentityAssociations: tc.associations,
+				},
+			}
+
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				ControlPlane: controlPlane,
+				EnableIAM:    tc.enableIAM,
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(eksMock.EXPECT(), iamMock.EXPECT())
+			s := NewService(scope)
+			s.EKSClient = eksMock
+			s.IAMClient = iamMock
+
+			err = s.reconcilePodIdentityAssociations(context.TODO())
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
+
+func TestDeletePodIdentityAssociations(t *testing.T) {
+	managedTags := map[string]string{
+		"kubernetes.io/cluster/test-cluster": "owned",
+	}
+
+	tests := []struct {
+		name   string
+		expect func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder)
+	}{
+		{
+			name: "cluster does not exist",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.DescribeCluster(gomock.Any(), &eks.DescribeClusterInput{Name: aws.String(clusterName)}).Return(nil, &ekstypes.ResourceNotFoundException{})
+			},
+		},
+		{
+			name: "delete managed associations and their managed roles",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.DescribeCluster(gomock.Any(), &eks.DescribeClusterInput{Name: aws.String(clusterName)}).Return(&eks.DescribeClusterOutput{
+					Cluster: &ekstypes.Cluster{Name: aws.String(clusterName)},
+				}, nil)
+
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).Return(&eks.ListPodIdentityAssociationsOutput{
+					Associations: []ekstypes.PodIdentityAssociationSummary{
+						{AssociationId: aws.String("a-1"), Namespace: aws.String("default"), ServiceAccount: aws.String("app")},
+						{AssociationId: aws.String("a-2"), Namespace: aws.String("default"), ServiceAccount: aws.String("unmanaged")},
+					},
+				}, nil)
+
+				m.DescribePodIdentityAssociation(gomock.Any(), &eks.DescribePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-1"),
+				}).Return(&eks.DescribePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String("a-1"),
+						Namespace:      aws.String("default"),
+						ServiceAccount: aws.String("app"),
+						RoleArn:        aws.String(podIdentityRoleARN),
+						Tags:           managedTags,
+					},
+				}, nil)
+
+				m.DescribePodIdentityAssociation(gomock.Any(), &eks.DescribePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-2"),
+				}).Return(&eks.DescribePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String("a-2"),
+						Namespace:      aws.String("default"),
+						ServiceAccount: aws.String("unmanaged"),
+						RoleArn:        aws.String(secondPodIdentityRoleARN),
+					},
+				}, nil)
+
+				m.DeletePodIdentityAssociation(gomock.Any(), &eks.DeletePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-1"),
+				}).Return(&eks.DeletePodIdentityAssociationOutput{}, nil)
+
+				i.GetRole(gomock.Any(), &iam.GetRoleInput{
+					RoleName: aws.String(managedPodIdentityRole),
+				}).Return(&iam.GetRoleOutput{
+					Role: &iamtypes.Role{
+						Arn:      aws.String(podIdentityRoleARN),
+						RoleName: aws.String(managedPodIdentityRole),
+						Tags: []iamtypes.Tag{
+							{Key: aws.String("kubernetes.io/cluster/test-cluster"), Value: aws.String("owned")},
+						},
+					},
+				}, nil)
+				i.ListAttachedRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+				i.DeleteRole(gomock.Any(), &iam.DeleteRoleInput{
+					RoleName: aws.String(managedPodIdentityRole),
+				}).Return(&iam.DeleteRoleOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						EKSClusterName: clusterName,
+					},
+				},
+				EnableIAM: true,
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(eksMock.EXPECT(), iamMock.EXPECT())
+			s := NewService(scope)
+			s.EKSClient = eksMock
+			s.IAMClient = iamMock
+
+			g.Expect(s.deletePodIdentityAssociations(context.TODO())).To(Succeed())
+		})
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/pkg/errors"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+// upgradePreflightChecks runs the checks that must pass before the control plane is
+// upgraded to the given version. It returns the reasons the upgrade is blocked, if any.
+func (s *Service) upgradePreflightChecks(ctx context.Context, nextVersion string) ([]string, error) {
+	failures := []string{}
+
+	versionsOutput, err := s.EKSClient.DescribeClusterVersions(ctx, &eks.DescribeClusterVersionsInput{
+		ClusterVersions: []string{nextVersion},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe EKS cluster versions")
+	}
+
+	supported := false
+	for _, v := range versionsOutput.ClusterVersions {
+		if aws.ToString(v.ClusterVersion) == nextVersion && v.VersionStatus != ekstypes.VersionStatusUnsupported {
+			supported = true
+		}
+	}
+	if !supported {
+		failures = append(failures, fmt.Sprintf("Kubernetes version %s is not supported by EKS", nextVersion))
+	}
+
+	if err := s.refreshInsights(ctx); err != nil {
+		return nil, err
+	}
+	if s.scope.ControlPlane.GetAnnotations()[ekscontrolplanev1.SkipUpgradeInsightsCheckAnnotation] == "true" {
+		s.scope.Info("Ignoring EKS upgrade readiness insights", "annotation", ekscontrolplanev1.SkipUpgradeInsightsCheckAnnotation)
+		return failures, nil
+	}
+	for _, insight := range s.scope.ControlPlane.Status.Insights.Failing {
+		if isBlockingUpgradeInsight(insight, nextVersion) {
+			failures = append(failures, fmt.Sprintf("upgrade insight %q failed: %s", insight.Name, insight.Reason))
+		}
+	}
+
+	return failures, nil
+}
+
+// upgradeBlockedRecently returns whether the pre-flight checks of the upgrade to the given
+// version failed against insights that are not yet due for a refresh. A blocked upgrade is
+// then re-checked once the insights refresh period elapsed instead of on every reconciliation,
+// unless the insights check is being skipped.
+func (s *Service) upgradeBlockedRecently(previous *ekscontrolplanev1.UpgradeStatus, nextVersion string) bool {
+	if previous == nil || previous.Phase != ekscontrolplanev1.UpgradePhasePreflight || previous.Version != nextVersion {
+		return false
+	}
+	if v1beta1conditions.GetReason(s.scope.ControlPlane, ekscontrolplanev1.EKSUpgradePreflightChecksPassedCondition) != ekscontrolplanev1.EKSUpgradePreflightChecksFailedReason {
+		return false
+	}
+	if s.scope.ControlPlane.GetAnnotations()[ekscontrolplanev1.SkipUpgradeInsightsCheckAnnotation] == "true" {
+		return false
+	}
+
+	insights := s.scope.ControlPlane.Status.Insights
+	return insights != nil && insights.LastRefreshTime != nil && time.Since(insights.LastRefreshTime.Time) < insightsRefreshPeriod
+}
+
+// completeAddonsUpgrade moves an upgrade whose addons have been reconciled on to the
+// next upgrade step, or marks it completed once the control plane has reached the
+// version in the spec.
+func (s *Service) completeAddonsUpgrade() error {
+	upgrade := s.scope.ControlPlane.Status.Upgrade
+	if upgrade == nil || upgrade.Phase != ekscontrolplanev1.UpgradePhaseAddonsUpgrading {
+		return nil
+	}
+
+	if s.scope.ControlPlane.Spec.Version != nil && s.scope.ControlPlane.Status.Version != nil {
+		specVersion, err := parseEKSVersion(*s.scope.ControlPlane.Spec.Version)
+		if err != nil {
+			return fmt.Errorf("parsing EKS version from spec: %w", err)
+		}
+		currentVersion, err := parseEKSVersion(*s.scope.ControlPlane.Status.Version)
+		if err != nil {
+			return fmt.Errorf("parsing EKS version from status: %w", err)
+		}
+
+		if currentVersion.LessThan(specVersion) {
+			upgrade.Phase = ekscontrolplanev1.UpgradePhasePreflight
+			return nil
+		}
+	}
+
+	upgrade.Phase = ekscontrolplanev1.UpgradePhaseCompleted
+	record.Eventf(s.scope.ControlPlane, "SuccessfulUpgradeEKSControlPlane", "Upgraded EKS control plane %s and addons to version %s", s.scope.KubernetesClusterName(), upgrade.Version)
+
+	return nil
+}
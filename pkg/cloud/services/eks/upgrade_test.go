@@ -0,0 +1,369 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+func TestReconcileClusterVersionUpgradePhases(t *testing.T) {
+	supportedVersion := &eks.DescribeClusterVersionsOutput{
+		ClusterVersions: []ekstypes.ClusterVersionInformation{
+			{
+				ClusterVersion: aws.String("1.31"),
+				VersionStatus:  ekstypes.VersionStatusStandardSupport,
+			},
+		},
+	}
+
+	deprecatedAPIsInsights := &eks.ListInsightsOutput{
+		Insights: []ekstypes.InsightSummary{
+			{
+				Id:       aws.String("insight-1"),
+				Category: ekstypes.CategoryUpgradeReadiness,
+			},
+		},
+	}
+	deprecatedAPIsInsight := &eks.DescribeInsightOutput{
+		Insight: &ekstypes.Insight{
+			Id:                aws.String("insight-1"),
+			Name:              aws.String("Deprecated APIs removed in Kubernetes v1.31"),
+			Category:          ekstypes.CategoryUpgradeReadiness,
+			KubernetesVersion: aws.String("1.31"),
+			InsightStatus: &ekstypes.InsightStatus{
+				Status: ekstypes.InsightStatusValueError,
+				Reason: aws.String("Deprecated API usage detected within last 30 days"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		clusterVersion      string
+		annotations         map[string]string
+		upgrade             *ekscontrolplanev1.UpgradeStatus
+		insightsRefreshTime time.Time
+		preflightFailed     bool
+		skipPreflightChecks bool
+		expect              func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectedUpgrade     *ekscontrolplanev1.UpgradeStatus
+		expectPreflightFail bool
+	}{
+		{
+			name:           "preflight checks pass and the control plane upgrade is started",
+			clusterVersion: "1.30",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeClusterVersions(gomock.Any(), &eks.DescribeClusterVersionsInput{
+					ClusterVersions: []string{"1.31"},
+				}).Return(supportedVersion, nil)
+				m.ListInsights(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListInsightsInput{})).
+					DoAndReturn(func(_ context.Context, input *eks.ListInsightsInput, _ ...func(*eks.Options)) (*eks.ListInsightsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.Filter.Statuses).To(ConsistOf(ekstypes.InsightStatusValueError, ekstypes.InsightStatusValueWarning))
+						return &eks.ListInsightsOutput{}, nil
+					})
+				m.UpdateClusterVersion(gomock.Any(), gomock.AssignableToTypeOf(&eks.UpdateClusterVersionInput{})).Return(&eks.UpdateClusterVersionOutput{}, nil)
+				m.WaitUntilClusterUpdating(gomock.Any(), gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}), gomock.Any()).Return(nil)
+			},
+			expectedUpgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhaseControlPlaneUpgrading,
+				Version: "1.31",
+			},
+		},
+		{
+			name:           "unsupported version blocks the upgrade",
+			clusterVersion: "1.30",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeClusterVersions(gomock.Any(), gomock.Any()).Return(&eks.DescribeClusterVersionsOutput{}, nil)
+				m.ListInsights(gomock.Any(), gomock.Any()).Return(&eks.ListInsightsOutput{}, nil)
+			},
+			expectedUpgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhasePreflight,
+				Version: "1.31",
+			},
+			expectPreflightFail: true,
+		},
+		{
+			name:           "upgrade insight in error blocks the upgrade",
+			clusterVersion: "1.30",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeClusterVersions(gomock.Any(), gomock.Any()).Return(supportedVersion, nil)
+				m.ListInsights(gomock.Any(), gomock.Any()).Return(deprecatedAPIsInsights, nil)
+				m.DescribeInsight(gomock.Any(), gomock.Any()).Return(deprecatedAPIsInsight, nil)
+			},
+			expectedUpgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhasePreflight,
+				Version: "1.31",
+			},
+			expectPreflightFail: true,
+		},
+		{
+			name:           "blocked upgrade is not checked again before the insights refresh",
+			clusterVersion: "1.30",
+			upgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhasePreflight,
+				Version: "1.31",
+			},
+			insightsRefreshTime: time.Now().Add(-insightsRefreshPeriod / 2),
+			preflightFailed:     true,
+			expect:              func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectedUpgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhasePreflight,
+				Version: "1.31",
+			},
+			expectPreflightFail: true,
+		},
+		{
+			name:           "blocked upgrade is checked again after the insights refresh period",
+			clusterVersion: "1.30",
+			upgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhasePreflight,
+				Version: "1.31",
+			},
+			insightsRefreshTime: time.Now().Add(-2 * insightsRefreshPeriod),
+			preflightFailed:     true,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeClusterVersions(gomock.Any(), gomock.Any()).Return(supportedVersion, nil)
+				m.ListInsights(gomock.Any(), gomock.Any()).Return(&eks.ListInsightsOutput{}, nil)
+				m.UpdateClusterVersion(gomock.Any(), gomock.AssignableToTypeOf(&eks.UpdateClusterVersionInput{})).Return(&eks.UpdateClusterVersionOutput{}, nil)
+				m.WaitUntilClusterUpdating(gomock.Any(), gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}), gomock.Any()).Return(nil)
+			},
+			expectedUpgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhaseControlPlaneUpgrading,
+				Version: "1.31",
+			},
+		},
+		{
+			name:           "upgrade insight in error is overridden by annotation",
+			clusterVersion: "1.30",
+			annotations: map[string]string{
+				ekscontrolplanev1.SkipUpgradeInsightsCheckAnnotation: "true",
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeClusterVersions(gomock.Any(), gomock.Any()).Return(supportedVersion, nil)
+				m.ListInsights(gomock.Any(), gomock.Any()).Return(deprecatedAPIsInsights, nil)
+				m.DescribeInsight(gomock.Any(), gomock.Any()).Return(deprecatedAPIsInsight, nil)
+				m.UpdateClusterVersion(gomock.Any(), gomock.AssignableToTypeOf(&eks.UpdateClusterVersionInput{})).Return(&eks.UpdateClusterVersionOutput{}, nil)
+				m.WaitUntilClusterUpdating(gomock.Any(), gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}), gomock.Any()).Return(nil)
+			},
+			expectedUpgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhaseControlPlaneUpgrading,
+				Version: "1.31",
+			},
+		},
+		{
+			name:                "preflight checks are skipped",
+			clusterVersion:      "1.30",
+			skipPreflightChecks: true,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateClusterVersion(gomock.Any(), gomock.AssignableToTypeOf(&eks.UpdateClusterVersionInput{})).Return(&eks.UpdateClusterVersionOutput{}, nil)
+				m.WaitUntilClusterUpdating(gomock.Any(), gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}), gomock.Any()).Return(nil)
+			},
+			expectedUpgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhaseControlPlaneUpgrading,
+				Version: "1.31",
+			},
+		},
+		{
+			name:           "upgraded control plane moves on to the addons",
+			clusterVersion: "1.31",
+			upgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhaseControlPlaneUpgrading,
+				Version: "1.31",
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectedUpgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhaseAddonsUpgrading,
+				Version: "1.31",
+			},
+		},
+		{
+			name:           "next upgrade step waits for the addons",
+			clusterVersion: "1.31",
+			upgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhaseAddonsUpgrading,
+				Version: "1.31",
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectedUpgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhaseAddonsUpgrading,
+				Version: "1.31",
+			},
+		},
+		{
+			name:           "completed upgrade is left alone",
+			clusterVersion: "1.32",
+			upgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhaseCompleted,
+				Version: "1.32",
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectedUpgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhaseCompleted,
+				Version: "1.32",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "default",
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: tc.annotations,
+					},
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						Version:                    aws.String("1.32"),
+						SkipUpgradePreflightChecks: tc.skipPreflightChecks,
+					},
+					Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+						Upgrade: tc.upgrade,
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+			if !tc.insightsRefreshTime.IsZero() {
+				scope.ControlPlane.Status.Insights = &ekscontrolplanev1.InsightsStatus{
+					LastRefreshTime: &metav1.Time{Time: tc.insightsRefreshTime},
+				}
+			}
+			if tc.preflightFailed {
+				v1beta1conditions.MarkFalse(scope.ControlPlane, ekscontrolplanev1.EKSUpgradePreflightChecksPassedCondition, ekscontrolplanev1.EKSUpgradePreflightChecksFailedReason, clusterv1beta1.ConditionSeverityWarning, "")
+			}
+
+			tc.expect(eksMock.EXPECT())
+			s := NewService(scope)
+			s.EKSClient = eksMock
+
+			err = s.reconcileClusterVersion(context.TODO(), &ekstypes.Cluster{
+				Name:    aws.String("default"),
+				Version: aws.String(tc.clusterVersion),
+			})
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.ControlPlane.Status.Upgrade).To(Equal(tc.expectedUpgrade))
+			g.Expect(v1beta1conditions.IsFalse(scope.ControlPlane, ekscontrolplanev1.EKSUpgradePreflightChecksPassedCondition)).To(Equal(tc.expectPreflightFail))
+		})
+	}
+}
+
+func TestCompleteAddonsUpgrade(t *testing.T) {
+	tests := []struct {
+		name          string
+		statusVersion string
+		upgrade       *ekscontrolplanev1.UpgradeStatus
+		expectedPhase ekscontrolplanev1.UpgradePhase
+	}{
+		{
+			name:          "upgrade completed",
+			statusVersion: "1.32",
+			upgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhaseAddonsUpgrading,
+				Version: "1.32",
+			},
+			expectedPhase: ekscontrolplanev1.UpgradePhaseCompleted,
+		},
+		{
+			name:          "next upgrade step",
+			statusVersion: "1.31",
+			upgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhaseAddonsUpgrading,
+				Version: "1.31",
+			},
+			expectedPhase: ekscontrolplanev1.UpgradePhasePreflight,
+		},
+		{
+			name:          "control plane still upgrading",
+			statusVersion: "1.31",
+			upgrade: &ekscontrolplanev1.UpgradeStatus{
+				Phase:   ekscontrolplanev1.UpgradePhaseControlPlaneUpgrading,
+				Version: "1.32",
+			},
+			expectedPhase: ekscontrolplanev1.UpgradePhaseControlPlaneUpgrading,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "default",
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						Version: aws.String("1.32.0"),
+					},
+					Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+						Version: aws.String(tc.statusVersion),
+						Upgrade: tc.upgrade,
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			s := NewService(scope)
+			g.Expect(s.completeAddonsUpgrade()).To(Succeed())
+			g.Expect(scope.ControlPlane.Status.Upgrade.Phase).To(Equal(tc.expectedPhase))
+		})
+	}
+}
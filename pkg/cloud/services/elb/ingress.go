@@ -0,0 +1,699 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/hash"
+)
+
+// ingressTargetGroupPrefix is the target group name prefix used when creating the target groups of the
+// ingress load balancer.
+const ingressTargetGroupPrefix = "ingress-"
+
+const (
+	ruleConditionFieldHostHeader  = "host-header"
+	ruleConditionFieldPathPattern = "path-pattern"
+)
+
+// IngressLBName returns the user-defined name of the ingress load balancer, or a generated default
+// if the user has not defined the name.
+func IngressLBName(s scope.ELBScope) (string, error) {
+	if lbSpec := s.IngressLoadBalancer(); lbSpec != nil && lbSpec.Name != nil {
+		return *lbSpec.Name, nil
+	}
+	return generateIngressLBName(fmt.Sprintf("%s-%s", s.Namespace(), s.Name()))
+}
+
+// generateIngressLBName generates the name of the ingress load balancer by concatenating the cluster name
+// to the "-ingress" suffix, or by computing a hash for clusters with names above 32 characters.
+//
+// WARNING If this function's output is changed, a controller using the new function will fail to find
+// the ingress load balancer of an existing cluster.
+func generateIngressLBName(clusterName string) (string, error) {
+	name := fmt.Sprintf("%s-%s", strings.ReplaceAll(clusterName, ".", "-"), infrav1.IngressRoleTagValue)
+	if len(name) <= 32 {
+		return name, nil
+	}
+
+	// hashSize = 32 - length of "ingress" - length of "-" = 24
+	shortName, err := hash.Base36TruncatedHash(clusterName, 24)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to create ingress load balancer name")
+	}
+	return fmt.Sprintf("%s-%s", shortName, infrav1.IngressRoleTagValue), nil
+}
+
+// ingressTargetGroupName returns the name of the target group the ingress load balancer forwards
+// requests to for the given port and protocol. Rules forwarding to the same port and protocol share it.
+func ingressTargetGroupName(lbName string, tg infrav1.IngressTargetGroupSpec) (string, error) {
+	// hashSize = 32 - length of "ingress-" = 24
+	name, err := hash.Base36TruncatedHash(fmt.Sprintf("%s-%s-%d", lbName, ingressTargetGroupProtocol(tg), tg.Port), 24)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to create ingress target group name")
+	}
+	return ingressTargetGroupPrefix + name, nil
+}
+
+func ingressTargetGroupProtocol(tg infrav1.IngressTargetGroupSpec) infrav1.ELBProtocol {
+	if tg.Protocol == "" {
+		return infrav1.ELBProtocolHTTPS
+	}
+	return tg.Protocol
+}
+
+// getIngressLBSpec returns the desired state of the ingress load balancer.
+func (s *Service) getIngressLBSpec(ctx context.Context, name string, lbSpec *infrav1.IngressLoadBalancerSpec) (*infrav1.LoadBalancer, error) {
+	scheme := infrav1.ELBSchemeInternetFacing
+	if lbSpec.Scheme != nil {
+		scheme = *lbSpec.Scheme
+	}
+
+	securityGroupIDs := append([]string{}, lbSpec.AdditionalSecurityGroups...)
+	securityGroupIDs = append(securityGroupIDs, s.scope.SecurityGroups()[infrav1.SecurityGroupIngressLB].ID)
+
+	res := &infrav1.LoadBalancer{
+		Name:             name,
+		Scheme:           scheme,
+		LoadBalancerType: infrav1.LoadBalancerTypeALB,
+		SecurityGroupIDs: securityGroupIDs,
+		Tags: infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.scope.Name(),
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Name:        aws.String(name),
+			Role:        aws.String(infrav1.IngressRoleTagValue),
+			Additional:  s.scope.AdditionalTags(),
+		}),
+	}
+
+	var err error
+	res.SubnetIDs, res.AvailabilityZones, err = s.getLoadBalancerSubnets(ctx, lbSpec.Subnets, scheme)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ingressAWSLoadBalancerSpec returns the load balancer spec used to create and describe the ingress load balancer.
+func ingressAWSLoadBalancerSpec(name string, lbSpec *infrav1.IngressLoadBalancerSpec) *infrav1.AWSLoadBalancerSpec {
+	res := &infrav1.AWSLoadBalancerSpec{
+		Name:             aws.String(name),
+		LoadBalancerType: infrav1.LoadBalancerTypeALB,
+	}
+	if lbSpec != nil {
+		res.Scheme = lbSpec.Scheme
+		res.Subnets = lbSpec.Subnets
+	}
+	return res
+}
+
+// getOrCreateIngressLB gets the ingress load balancer, or creates it if it does not exist.
+// It returns a function that reconciles the load balancer.
+func (s *Service) getOrCreateIngressLB(ctx context.Context, lbSpec *infrav1.IngressLoadBalancerSpec) (lbReconciler, error) {
+	name, err := IngressLBName(s.scope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get ingress load balancer name")
+	}
+
+	desiredLB, err := s.getIngressLBSpec(ctx, name, lbSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	awsLBSpec := ingressAWSLoadBalancerSpec(name, lbSpec)
+	lb, err := s.describeLB(ctx, name, awsLBSpec)
+	switch {
+	case IsNotFound(err):
+		lb, err = s.createLB(ctx, desiredLB, awsLBSpec)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create ingress load balancer")
+		}
+		s.scope.Debug("Created ingress load balancer", "ingress-lb-name", lb.Name)
+	case err != nil:
+		return nil, err
+	}
+
+	return func() error {
+		return s.reconcileIngressLB(ctx, lb, desiredLB, lbSpec)
+	}, nil
+}
+
+func (s *Service) reconcileIngressLB(ctx context.Context, lb *infrav1.LoadBalancer, desiredLB *infrav1.LoadBalancer, lbSpec *infrav1.IngressLoadBalancerSpec) error {
+	s.scope.Debug("Waiting for ingress load balancer to become active", "ingress-lb-name", lb.Name)
+	waitStart := time.Now()
+	if err := s.ELBV2Client.WaitUntilLoadBalancerAvailable(ctx, &elbv2.DescribeLoadBalancersInput{LoadBalancerArns: []string{lb.ARN}}, s.scope.MaxWaitDuration()); err != nil {
+		s.scope.Error(err, "failed to wait for ingress load balancer to become available", "time", time.Since(waitStart))
+		return err
+	}
+
+	lb.LoadBalancerType = infrav1.LoadBalancerTypeALB
+	if lb.IsManaged(s.scope.Name()) {
+		if err := s.reconcileIngressListeners(ctx, lb, lbSpec, desiredLB.Tags); err != nil {
+			return errors.Wrapf(err, "failed to reconcile listeners of ingress load balancer %q", lb.Name)
+		}
+
+		if err := s.reconcileV2LBTags(ctx, lb, desiredLB.Tags); err != nil {
+			return errors.Wrapf(err, "failed to reconcile tags for ingress load balancer %q", lb.Name)
+		}
+
+		if !sets.New(lb.SubnetIDs...).Equal(sets.New(desiredLB.SubnetIDs...)) {
+			if _, err := s.ELBV2Client.SetSubnets(ctx, &elbv2.SetSubnetsInput{
+				LoadBalancerArn: aws.String(lb.ARN),
+				Subnets:         desiredLB.SubnetIDs,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to set subnets for ingress load balancer %q", lb.Name)
+			}
+			lb.SubnetIDs = desiredLB.SubnetIDs
+			lb.AvailabilityZones = desiredLB.AvailabilityZones
+		}
+
+		if !sets.New(lb.SecurityGroupIDs...).Equal(sets.New(desiredLB.SecurityGroupIDs...)) {
+			if _, err := s.ELBV2Client.SetSecurityGroups(ctx, &elbv2.SetSecurityGroupsInput{
+				LoadBalancerArn: aws.String(lb.ARN),
+				SecurityGroups:  desiredLB.SecurityGroupIDs,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to apply security groups to ingress load balancer %q", lb.Name)
+			}
+			lb.SecurityGroupIDs = desiredLB.SecurityGroupIDs
+		}
+
+		if err := s.reconcileIngressWebACL(ctx, lb, lbSpec.WebACLARN); err != nil {
+			return err
+		}
+	} else {
+		s.scope.Trace("Unmanaged ingress load balancer, skipping load balancer configuration", "ingress-lb", lb)
+	}
+
+	s.scope.Network().IngressLoadBalancer = lb.DeepCopy()
+	return nil
+}
+
+// reconcileIngressListeners reconciles the target groups, the HTTPS listeners and their rules of the
+// ingress load balancer, and removes the ones no longer in the spec.
+func (s *Service) reconcileIngressListeners(ctx context.Context, lb *infrav1.LoadBalancer, lbSpec *infrav1.IngressLoadBalancerSpec, tags map[string]string) error {
+	// The target groups are only associated with the load balancer while rules forward to them,
+	// so they have to be gathered before the rules are changed.
+	existingTargetGroups, err := s.ELBV2Client.DescribeTargetGroups(ctx, &elbv2.DescribeTargetGroupsInput{
+		LoadBalancerArn: aws.String(lb.ARN),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to describe target groups")
+	}
+
+	targetGroupARNs := map[string]string{}
+	for _, ln := range lbSpec.Listeners {
+		for _, rule := range ln.Rules {
+			name, err := ingressTargetGroupName(lb.Name, rule.TargetGroup)
+			if err != nil {
+				return err
+			}
+			if _, ok := targetGroupARNs[name]; ok {
+				continue
+			}
+			group, err := s.getOrCreateIngressTargetGroup(ctx, name, rule.TargetGroup, tags)
+			if err != nil {
+				return err
+			}
+			targetGroupARNs[name] = aws.ToString(group.TargetGroupArn)
+		}
+	}
+
+	existingListeners, err := s.ELBV2Client.DescribeListeners(ctx, &elbv2.DescribeListenersInput{
+		LoadBalancerArn: aws.String(lb.ARN),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to describe listeners")
+	}
+
+	desiredPorts := sets.New[int32]()
+	for _, ln := range lbSpec.Listeners {
+		desiredPorts.Insert(int32(ln.Port)) //#nosec G115
+		var listener *elbv2types.Listener
+		for i := range existingListeners.Listeners {
+			if aws.ToInt32(existingListeners.Listeners[i].Port) == int32(ln.Port) { //#nosec G115
+				listener = &existingListeners.Listeners[i]
+				break
+			}
+		}
+
+		if listener == nil {
+			listener, err = s.createIngressListener(ctx, lb.ARN, ln, tags)
+			if err != nil {
+				return err
+			}
+		} else if err := s.reconcileIngressListenerTLS(ctx, listener, ln); err != nil {
+			return err
+		}
+
+		if err := s.reconcileIngressRules(ctx, lb.Name, aws.ToString(listener.ListenerArn), ln, targetGroupARNs, tags); err != nil {
+			return err
+		}
+	}
+
+	// Deleting a listener also deletes its rules.
+	for _, l := range existingListeners.Listeners {
+		if desiredPorts.Has(aws.ToInt32(l.Port)) {
+			continue
+		}
+		s.scope.Debug("deleting ingress listener", "arn", aws.ToString(l.ListenerArn), "port", aws.ToInt32(l.Port))
+		if _, err := s.ELBV2Client.DeleteListener(ctx, &elbv2.DeleteListenerInput{ListenerArn: l.ListenerArn}); err != nil {
+			return errors.Wrapf(err, "failed to delete ingress listener %q", aws.ToString(l.ListenerArn))
+		}
+	}
+
+	for _, group := range existingTargetGroups.TargetGroups {
+		if _, ok := targetGroupARNs[aws.ToString(group.TargetGroupName)]; ok {
+			continue
+		}
+		if !strings.HasPrefix(aws.ToString(group.TargetGroupName), ingressTargetGroupPrefix) {
+			continue
+		}
+		s.scope.Debug("deleting ingress target group", "name", aws.ToString(group.TargetGroupName))
+		if _, err := s.ELBV2Client.DeleteTargetGroup(ctx, &elbv2.DeleteTargetGroupInput{TargetGroupArn: group.TargetGroupArn}); err != nil {
+			return errors.Wrapf(err, "failed to delete ingress target group %q", aws.ToString(group.TargetGroupName))
+		}
+	}
+
+	return nil
+}
+
+// getOrCreateIngressTargetGroup returns the target group with the given name, creating it when it does
+// not exist, and reconciles its attributes.
+func (s *Service) getOrCreateIngressTargetGroup(ctx context.Context, name string, tg infrav1.IngressTargetGroupSpec, tags map[string]string) (*elbv2types.TargetGroup, error) {
+	attributes := getTargetGroupAttributes(tg.Attributes)
+
+	out, err := s.ELBV2Client.DescribeTargetGroups(ctx, &elbv2.DescribeTargetGroupsInput{
+		Names: []string{name},
+	})
+	smithyErr := awserrors.ParseSmithyError(err)
+	switch {
+	case smithyErr != nil && smithyErr.ErrorCode() == (&elbv2types.TargetGroupNotFoundException{}).ErrorCode():
+	case err != nil:
+		return nil, errors.Wrapf(err, "failed to describe target group %q", name)
+	case len(out.TargetGroups) > 0:
+		group := &out.TargetGroups[0]
+		if len(attributes) > 0 {
+			if err := s.reconcileTargetGroupAttributes(ctx, group, attributes); err != nil {
+				return nil, err
+			}
+		}
+		return group, nil
+	}
+
+	protocol := ingressTargetGroupProtocol(tg)
+	healthCheck := s.getAdditionalTargetGroupHealthCheck(infrav1.AdditionalListenerSpec{
+		Port:        tg.Port,
+		Protocol:    protocol,
+		HealthCheck: tg.HealthCheck,
+	})
+	group, err := s.createTargetGroup(ctx, infrav1.Listener{
+		Protocol: protocol,
+		Port:     tg.Port,
+		TargetGroup: infrav1.TargetGroupSpec{
+			Name:        name,
+			Port:        tg.Port,
+			Protocol:    protocol,
+			VpcID:       s.scope.VPC().ID,
+			HealthCheck: healthCheck,
+			IPType:      s.getTargetGroupIPAddressType(),
+		},
+	}, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(attributes) > 0 {
+		input := &elbv2.ModifyTargetGroupAttributesInput{TargetGroupArn: group.TargetGroupArn}
+		for _, key := range sets.List(sets.KeySet(attributes)) {
+			input.Attributes = append(input.Attributes, elbv2types.TargetGroupAttribute{
+				Key:   aws.String(key),
+				Value: aws.String(attributes[key]),
+			})
+		}
+		if _, err := s.ELBV2Client.ModifyTargetGroupAttributes(ctx, input); err != nil {
+			return nil, errors.Wrapf(err, "failed to modify attributes of target group %q", name)
+		}
+	}
+	return group, nil
+}
+
+// createIngressListener creates an HTTPS listener answering the requests not matching any of its rules
+// with a 404 status code.
+func (s *Service) createIngressListener(ctx context.Context, lbARN string, ln infrav1.IngressListenerSpec, tags map[string]string) (*elbv2types.Listener, error) {
+	input := &elbv2.CreateListenerInput{
+		LoadBalancerArn: aws.String(lbARN),
+		Port:            aws.Int32(int32(ln.Port)), //#nosec G115
+		Protocol:        elbv2types.ProtocolEnumHttps,
+		Certificates:    []elbv2types.Certificate{{CertificateArn: aws.String(ln.CertificateARN)}},
+		SslPolicy:       ln.SSLPolicy,
+		DefaultActions: []elbv2types.Action{
+			{
+				Type: elbv2types.ActionTypeEnumFixedResponse,
+				FixedResponseConfig: &elbv2types.FixedResponseActionConfig{
+					StatusCode:  aws.String("404"),
+					ContentType: aws.String("text/plain"),
+				},
+			},
+		},
+		Tags: converters.MapToV2Tags(tags),
+	}
+	s.scope.Debug("creating ingress listener", "port", ln.Port)
+	out, err := s.ELBV2Client.CreateListener(ctx, input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create ingress listener on port %d", ln.Port)
+	}
+	if len(out.Listeners) != 1 {
+		return nil, errors.Errorf("expected 1 ingress listener to be created, got %d", len(out.Listeners))
+	}
+	return &out.Listeners[0], nil
+}
+
+// reconcileIngressListenerTLS updates the certificate and security policy of an existing ingress listener.
+func (s *Service) reconcileIngressListenerTLS(ctx context.Context, listener *elbv2types.Listener, ln infrav1.IngressListenerSpec) error {
+	var certificateARN string
+	if len(listener.Certificates) > 0 {
+		certificateARN = aws.ToString(listener.Certificates[0].CertificateArn)
+	}
+	if certificateARN == ln.CertificateARN && (ln.SSLPolicy == nil || aws.ToString(listener.SslPolicy) == *ln.SSLPolicy) {
+		return nil
+	}
+
+	s.scope.Debug("updating ingress listener", "arn", aws.ToString(listener.ListenerArn), "certificate", ln.CertificateARN)
+	if _, err := s.ELBV2Client.ModifyListener(ctx, &elbv2.ModifyListenerInput{
+		ListenerArn:  listener.ListenerArn,
+		Certificates: []elbv2types.Certificate{{CertificateArn: aws.String(ln.CertificateARN)}},
+		SslPolicy:    ln.SSLPolicy,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to update ingress listener %q", aws.ToString(listener.ListenerArn))
+	}
+	return nil
+}
+
+// reconcileIngressRules creates, updates and deletes the rules of an ingress listener, matching them by priority.
+func (s *Service) reconcileIngressRules(ctx context.Context, lbName, listenerARN string, ln infrav1.IngressListenerSpec, targetGroupARNs map[string]string, tags map[string]string) error {
+	out, err := s.ELBV2Client.DescribeRules(ctx, &elbv2.DescribeRulesInput{
+		ListenerArn: aws.String(listenerARN),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe rules of ingress listener %q", listenerARN)
+	}
+
+	existingRules := map[string]elbv2types.Rule{}
+	for _, rule := range out.Rules {
+		if aws.ToBool(rule.IsDefault) {
+			continue
+		}
+		existingRules[aws.ToString(rule.Priority)] = rule
+	}
+
+	for _, rule := range ln.Rules {
+		name, err := ingressTargetGroupName(lbName, rule.TargetGroup)
+		if err != nil {
+			return err
+		}
+		actions := []elbv2types.Action{
+			{
+				Type:           elbv2types.ActionTypeEnumForward,
+				TargetGroupArn: aws.String(targetGroupARNs[name]),
+			},
+		}
+		conditions := ingressRuleConditions(rule)
+
+		priority := strconv.Itoa(int(rule.Priority))
+		existing, ok := existingRules[priority]
+		delete(existingRules, priority)
+		switch {
+		case !ok:
+			s.scope.Debug("creating ingress listener rule", "listener", listenerARN, "priority", priority)
+			if _, err := s.ELBV2Client.CreateRule(ctx, &elbv2.CreateRuleInput{
+				ListenerArn: aws.String(listenerARN),
+				Priority:    aws.Int32(rule.Priority),
+				Actions:     actions,
+				Conditions:  conditions,
+				Tags:        converters.MapToV2Tags(tags),
+			}); err != nil {
+				return errors.Wrapf(err, "failed to create rule with priority %s on ingress listener %q", priority, listenerARN)
+			}
+		case !isSDKRuleEqualToIngressRule(existing, rule, targetGroupARNs[name]):
+			s.scope.Debug("updating ingress listener rule", "listener", listenerARN, "priority", priority)
+			if _, err := s.ELBV2Client.ModifyRule(ctx, &elbv2.ModifyRuleInput{
+				RuleArn:    existing.RuleArn,
+				Actions:    actions,
+				Conditions: conditions,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to update rule with priority %s on ingress listener %q", priority, listenerARN)
+			}
+		}
+	}
+
+	for priority, rule := range existingRules {
+		s.scope.Debug("deleting ingress listener rule", "listener", listenerARN, "priority", priority)
+		if _, err := s.ELBV2Client.DeleteRule(ctx, &elbv2.DeleteRuleInput{RuleArn: rule.RuleArn}); err != nil {
+			return errors.Wrapf(err, "failed to delete rule with priority %s on ingress listener %q", priority, listenerARN)
+		}
+	}
+
+	return nil
+}
+
+func ingressRuleConditions(rule infrav1.IngressListenerRule) []elbv2types.RuleCondition {
+	var conditions []elbv2types.RuleCondition
+	if len(rule.HostHeaders) > 0 {
+		conditions = append(conditions, elbv2types.RuleCondition{
+			Field:            aws.String(ruleConditionFieldHostHeader),
+			HostHeaderConfig: &elbv2types.HostHeaderConditionConfig{Values: rule.HostHeaders},
+		})
+	}
+	if len(rule.PathPatterns) > 0 {
+		conditions = append(conditions, elbv2types.RuleCondition{
+			Field:             aws.String(ruleConditionFieldPathPattern),
+			PathPatternConfig: &elbv2types.PathPatternConditionConfig{Values: rule.PathPatterns},
+		})
+	}
+	return conditions
+}
+
+// isSDKRuleEqualToIngressRule checks if a given AWS SDK listener rule matches an ingress rule spec.
+func isSDKRuleEqualToIngressRule(rule elbv2types.Rule, spec infrav1.IngressListenerRule, targetGroupARN string) bool {
+	if len(rule.Actions) != 1 || rule.Actions[0].Type != elbv2types.ActionTypeEnumForward ||
+		aws.ToString(rule.Actions[0].TargetGroupArn) != targetGroupARN {
+		return false
+	}
+
+	var hostHeaders, pathPatterns []string
+	for _, c := range rule.Conditions {
+		switch aws.ToString(c.Field) {
+		case ruleConditionFieldHostHeader:
+			hostHeaders = c.Values
+			if c.HostHeaderConfig != nil {
+				hostHeaders = c.HostHeaderConfig.Values
+			}
+		case ruleConditionFieldPathPattern:
+			pathPatterns = c.Values
+			if c.PathPatternConfig != nil {
+				pathPatterns = c.PathPatternConfig.Values
+			}
+		default:
+			return false
+		}
+	}
+	return slices.Equal(hostHeaders, spec.HostHeaders) && slices.Equal(pathPatterns, spec.PathPatterns)
+}
+
+// reconcileIngressWebACL associates the web ACL of the spec with the ingress load balancer, replacing
+// or removing the web ACL currently associated with it.
+func (s *Service) reconcileIngressWebACL(ctx context.Context, lb *infrav1.LoadBalancer, webACLARN *string) error {
+	out, err := s.WAFV2Client.GetWebACLForResource(ctx, &wafv2.GetWebACLForResourceInput{
+		ResourceArn: aws.String(lb.ARN),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get web ACL of ingress load balancer %q", lb.Name)
+	}
+
+	var current string
+	if out.WebACL != nil {
+		current = aws.ToString(out.WebACL.ARN)
+	}
+	if current == aws.ToString(webACLARN) {
+		return nil
+	}
+
+	if current != "" {
+		s.scope.Debug("disassociating web ACL from ingress load balancer", "web-acl", current)
+		if _, err := s.WAFV2Client.DisassociateWebACL(ctx, &wafv2.DisassociateWebACLInput{
+			ResourceArn: aws.String(lb.ARN),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to disassociate web ACL %q from ingress load balancer %q", current, lb.Name)
+		}
+	}
+
+	if webACLARN != nil {
+		s.scope.Debug("associating web ACL with ingress load balancer", "web-acl", *webACLARN)
+		if _, err := s.WAFV2Client.AssociateWebACL(ctx, &wafv2.AssociateWebACLInput{
+			ResourceArn: aws.String(lb.ARN),
+			WebACLArn:   webACLARN,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to associate web ACL %q with ingress load balancer %q", *webACLARN, lb.Name)
+		}
+	}
+	return nil
+}
+
+// deleteIngressLB deletes the ingress load balancer with its listeners and target groups.
+// The web ACL association is removed along with the load balancer.
+func (s *Service) deleteIngressLB(ctx context.Context) error {
+	var name string
+	switch {
+	case s.scope.IngressLoadBalancer() != nil:
+		var err error
+		if name, err = IngressLBName(s.scope); err != nil {
+			return errors.Wrap(err, "failed to get ingress load balancer name")
+		}
+	case s.scope.Network().IngressLoadBalancer != nil:
+		name = s.scope.Network().IngressLoadBalancer.Name
+	default:
+		return nil
+	}
+
+	lb, err := s.describeLB(ctx, name, nil)
+	if IsNotFound(err) {
+		s.scope.Network().IngressLoadBalancer = nil
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if lb.IsUnmanaged(s.scope.Name()) {
+		s.scope.Debug("Found unmanaged ingress load balancer, skipping deletion", "ingress-lb-name", lb.Name)
+		s.scope.Network().IngressLoadBalancer = nil
+		return nil
+	}
+
+	s.scope.Debug("deleting ingress load balancer", "name", name)
+	if err := s.deleteLB(ctx, lb.ARN); err != nil {
+		return err
+	}
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (done bool, err error) {
+		_, err = s.describeLB(ctx, name, nil)
+		done = IsNotFound(err)
+		return done, nil
+	}); err != nil {
+		return errors.Wrapf(err, "failed to wait for %q ingress load balancer deletion", name)
+	}
+
+	s.scope.Network().IngressLoadBalancer = nil
+	s.scope.Info("Deleted ingress load balancer", "name", name)
+	return nil
+}
+
+// RegisterInstanceWithIngressLB registers an instance with the target groups of the ingress load balancer
+// it is not registered with yet.
+func (s *Service) RegisterInstanceWithIngressLB(ctx context.Context, i *infrav1.Instance) error {
+	lb := s.scope.Network().IngressLoadBalancer
+	if lb == nil || lb.ARN == "" {
+		// The instance is registered once the load balancer was reconciled.
+		return nil
+	}
+
+	targetGroups, err := s.ELBV2Client.DescribeTargetGroups(ctx, &elbv2.DescribeTargetGroupsInput{
+		LoadBalancerArn: aws.String(lb.ARN),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error describing target groups of ingress load balancer %q", lb.Name)
+	}
+
+	for _, tg := range targetGroups.TargetGroups {
+		registered, err := s.isInstanceRegisteredWithTargetGroup(ctx, i, tg)
+		if err != nil {
+			return err
+		}
+		if registered {
+			continue
+		}
+		if _, err := s.ELBV2Client.RegisterTargets(ctx, &elbv2.RegisterTargetsInput{
+			TargetGroupArn: tg.TargetGroupArn,
+			Targets:        []elbv2types.TargetDescription{{Id: aws.String(i.ID), Port: tg.Port}},
+		}); err != nil {
+			return errors.Wrapf(err, "failed to register instance with target group %q", aws.ToString(tg.TargetGroupName))
+		}
+	}
+	return nil
+}
+
+// DeregisterInstanceFromIngressLB de-registers an instance from the target groups of the ingress load balancer.
+func (s *Service) DeregisterInstanceFromIngressLB(ctx context.Context, i *infrav1.Instance) error {
+	lb := s.scope.Network().IngressLoadBalancer
+	if lb == nil || lb.ARN == "" {
+		return nil
+	}
+
+	targetGroups, err := s.ELBV2Client.DescribeTargetGroups(ctx, &elbv2.DescribeTargetGroupsInput{
+		LoadBalancerArn: aws.String(lb.ARN),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error describing target groups of ingress load balancer %q", lb.Name)
+	}
+
+	for _, tg := range targetGroups.TargetGroups {
+		registered, err := s.isInstanceRegisteredWithTargetGroup(ctx, i, tg)
+		if err != nil {
+			return err
+		}
+		if !registered {
+			continue
+		}
+		if err := s.DeregisterInstanceFromAPIServerLB(ctx, aws.ToString(tg.TargetGroupArn), i); err != nil {
+			return errors.Wrapf(err, "failed to deregister instance from target group %q", aws.ToString(tg.TargetGroupName))
+		}
+	}
+	return nil
+}
+
+func (s *Service) isInstanceRegisteredWithTargetGroup(ctx context.Context, i *infrav1.Instance, tg elbv2types.TargetGroup) (bool, error) {
+	health, err := s.ELBV2Client.DescribeTargetHealth(ctx, &elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: tg.TargetGroupArn,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "error describing health of target group %q", aws.ToString(tg.TargetGroupName))
+	}
+	for _, desc := range health.TargetHealthDescriptions {
+		if desc.Target != nil && aws.ToString(desc.Target.Id) == i.ID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
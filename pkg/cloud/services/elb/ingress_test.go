@@ -0,0 +1,335 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	wafv2types "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const (
+	ingressClusterName = "bar"
+	ingressLBName      = "foo-bar-ingress"
+	ingressLBArn       = "arn::ingress"
+	ingressListenerArn = "arn::listener-443"
+	ingressTGArn       = "arn::ingress-target-group"
+	ingressCertARN     = "arn:aws:acm:us-east-1:123456789012:certificate/abc"
+)
+
+func ingressLoadBalancerSpec() *infrav1.IngressLoadBalancerSpec {
+	return &infrav1.IngressLoadBalancerSpec{
+		Listeners: []infrav1.IngressListenerSpec{
+			{
+				Port:           443,
+				CertificateARN: ingressCertARN,
+				Rules: []infrav1.IngressListenerRule{
+					{
+						Priority:     1,
+						HostHeaders:  []string{"webhooks.example.com"},
+						PathPatterns: []string{"/validate/*"},
+						TargetGroup:  infrav1.IngressTargetGroupSpec{Port: 9443, Protocol: infrav1.ELBProtocolHTTPS},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newIngressTestScope(t *testing.T, lbSpec *infrav1.IngressLoadBalancerSpec) *scope.ClusterScope {
+	t.Helper()
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: ingressClusterName},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: ingressClusterName},
+			Spec: infrav1.AWSClusterSpec{
+				IngressLoadBalancer: lbSpec,
+				NetworkSpec: infrav1.NetworkSpec{
+					VPC: infrav1.VPCSpec{ID: "vpc-id"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return clusterScope
+}
+
+func TestGenerateIngressLBName(t *testing.T) {
+	g := NewWithT(t)
+
+	name, err := generateIngressLBName("foo-bar.baz")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(name).To(Equal("foo-bar-baz-ingress"))
+
+	name, err = generateIngressLBName("a-namespace-with-a-long-name-my-cluster")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(name).To(HaveLen(32))
+	g.Expect(name).To(HaveSuffix("-ingress"))
+}
+
+func TestReconcileIngressListeners(t *testing.T) {
+	tgName, err := ingressTargetGroupName(ingressLBName, infrav1.IngressTargetGroupSpec{Port: 9443, Protocol: infrav1.ELBProtocolHTTPS})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		elbV2APIMocks func(m *mocks.MockELBV2APIMockRecorder)
+	}{
+		{
+			name: "creates the target groups, listeners and rules",
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeTargetGroups(gomock.Any(), &elbv2.DescribeTargetGroupsInput{LoadBalancerArn: aws.String(ingressLBArn)}).
+					Return(&elbv2.DescribeTargetGroupsOutput{}, nil)
+				m.DescribeTargetGroups(gomock.Any(), &elbv2.DescribeTargetGroupsInput{Names: []string{tgName}}).
+					Return(nil, &elbv2types.TargetGroupNotFoundException{})
+				m.CreateTargetGroup(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *elbv2.CreateTargetGroupInput, _ ...func(*elbv2.Options)) (*elbv2.CreateTargetGroupOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.ToString(input.Name)).To(Equal(tgName))
+						g.Expect(aws.ToInt32(input.Port)).To(Equal(int32(9443)))
+						g.Expect(input.Protocol).To(Equal(elbv2types.ProtocolEnumHttps))
+						g.Expect(aws.ToString(input.HealthCheckPort)).To(Equal("9443"))
+						return &elbv2.CreateTargetGroupOutput{
+							TargetGroups: []elbv2types.TargetGroup{{TargetGroupArn: aws.String(ingressTGArn), TargetGroupName: aws.String(tgName)}},
+						}, nil
+					})
+				m.DescribeListeners(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeListenersOutput{}, nil)
+				m.CreateListener(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *elbv2.CreateListenerInput, _ ...func(*elbv2.Options)) (*elbv2.CreateListenerOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.Protocol).To(Equal(elbv2types.ProtocolEnumHttps))
+						g.Expect(aws.ToString(input.Certificates[0].CertificateArn)).To(Equal(ingressCertARN))
+						g.Expect(input.DefaultActions[0].Type).To(Equal(elbv2types.ActionTypeEnumFixedResponse))
+						return &elbv2.CreateListenerOutput{
+							Listeners: []elbv2types.Listener{{ListenerArn: aws.String(ingressListenerArn), Port: aws.Int32(443)}},
+						}, nil
+					})
+				m.DescribeRules(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeRulesOutput{
+					Rules: []elbv2types.Rule{{IsDefault: aws.Bool(true), Priority: aws.String("default")}},
+				}, nil)
+				m.CreateRule(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *elbv2.CreateRuleInput, _ ...func(*elbv2.Options)) (*elbv2.CreateRuleOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.ToInt32(input.Priority)).To(Equal(int32(1)))
+						g.Expect(aws.ToString(input.Actions[0].TargetGroupArn)).To(Equal(ingressTGArn))
+						g.Expect(input.Conditions).To(HaveLen(2))
+						return &elbv2.CreateRuleOutput{}, nil
+					})
+			},
+		},
+		{
+			name: "updates drifted listeners and rules and removes the ones no longer in the spec",
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeTargetGroups(gomock.Any(), &elbv2.DescribeTargetGroupsInput{LoadBalancerArn: aws.String(ingressLBArn)}).
+					Return(&elbv2.DescribeTargetGroupsOutput{
+						TargetGroups: []elbv2types.TargetGroup{
+							{TargetGroupArn: aws.String(ingressTGArn), TargetGroupName: aws.String(tgName)},
+							{TargetGroupArn: aws.String("arn::stale"), TargetGroupName: aws.String("ingress-stale")},
+						},
+					}, nil)
+				m.DescribeTargetGroups(gomock.Any(), &elbv2.DescribeTargetGroupsInput{Names: []string{tgName}}).
+					Return(&elbv2.DescribeTargetGroupsOutput{
+						TargetGroups: []elbv2types.TargetGroup{{TargetGroupArn: aws.String(ingressTGArn), TargetGroupName: aws.String(tgName)}},
+					}, nil)
+				m.DescribeListeners(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []elbv2types.Listener{
+						{
+							ListenerArn:  aws.String(ingressListenerArn),
+							Port:         aws.Int32(443),
+							Certificates: []elbv2types.Certificate{{CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/old")}},
+						},
+						{ListenerArn: aws.String("arn::listener-8443"), Port: aws.Int32(8443)},
+					},
+				}, nil)
+				m.ModifyListener(gomock.Any(), &elbv2.ModifyListenerInput{
+					ListenerArn:  aws.String(ingressListenerArn),
+					Certificates: []elbv2types.Certificate{{CertificateArn: aws.String(ingressCertARN)}},
+				}).Return(&elbv2.ModifyListenerOutput{}, nil)
+				m.DescribeRules(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeRulesOutput{
+					Rules: []elbv2types.Rule{
+						{IsDefault: aws.Bool(true), Priority: aws.String("default")},
+						{
+							RuleArn:  aws.String("arn::rule-1"),
+							Priority: aws.String("1"),
+							Actions:  []elbv2types.Action{{Type: elbv2types.ActionTypeEnumForward, TargetGroupArn: aws.String(ingressTGArn)}},
+							Conditions: []elbv2types.RuleCondition{
+								{Field: aws.String(ruleConditionFieldHostHeader), HostHeaderConfig: &elbv2types.HostHeaderConditionConfig{Values: []string{"webhooks.example.com"}}},
+							},
+						},
+						{RuleArn: aws.String("arn::rule-2"), Priority: aws.String("2")},
+					},
+				}, nil)
+				m.ModifyRule(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *elbv2.ModifyRuleInput, _ ...func(*elbv2.Options)) (*elbv2.ModifyRuleOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.ToString(input.RuleArn)).To(Equal("arn::rule-1"))
+						g.Expect(input.Conditions).To(HaveLen(2))
+						return &elbv2.ModifyRuleOutput{}, nil
+					})
+				m.DeleteRule(gomock.Any(), &elbv2.DeleteRuleInput{RuleArn: aws.String("arn::rule-2")}).Return(&elbv2.DeleteRuleOutput{}, nil)
+				m.DeleteListener(gomock.Any(), &elbv2.DeleteListenerInput{ListenerArn: aws.String("arn::listener-8443")}).Return(&elbv2.DeleteListenerOutput{}, nil)
+				m.DeleteTargetGroup(gomock.Any(), &elbv2.DeleteTargetGroupInput{TargetGroupArn: aws.String("arn::stale")}).Return(&elbv2.DeleteTargetGroupOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			elbV2APIMocks := mocks.NewMockELBV2API(mockCtrl)
+			tc.elbV2APIMocks(elbV2APIMocks.EXPECT())
+
+			lbSpec := ingressLoadBalancerSpec()
+			s := &Service{
+				scope:       newIngressTestScope(t, lbSpec),
+				ELBV2Client: elbV2APIMocks,
+			}
+
+			lb := &infrav1.LoadBalancer{ARN: ingressLBArn, Name: ingressLBName}
+			g.Expect(s.reconcileIngressListeners(context.TODO(), lb, lbSpec, map[string]string{"test": "tag"})).To(Succeed())
+		})
+	}
+}
+
+func TestReconcileIngressWebACL(t *testing.T) {
+	const (
+		currentACL = "arn:aws:wafv2:us-east-1:123456789012:regional/webacl/current/1"
+		desiredACL = "arn:aws:wafv2:us-east-1:123456789012:regional/webacl/desired/2"
+	)
+
+	tests := []struct {
+		name          string
+		current       string
+		desired       *string
+		wafV2APIMocks func(m *mocks.MockWAFV2APIMockRecorder)
+	}{
+		{
+			name:    "associates the web ACL",
+			desired: aws.String(desiredACL),
+			wafV2APIMocks: func(m *mocks.MockWAFV2APIMockRecorder) {
+				m.AssociateWebACL(gomock.Any(), &wafv2.AssociateWebACLInput{
+					ResourceArn: aws.String(ingressLBArn),
+					WebACLArn:   aws.String(desiredACL),
+				}).Return(&wafv2.AssociateWebACLOutput{}, nil)
+			},
+		},
+		{
+			name:    "replaces a different web ACL",
+			current: currentACL,
+			desired: aws.String(desiredACL),
+			wafV2APIMocks: func(m *mocks.MockWAFV2APIMockRecorder) {
+				m.DisassociateWebACL(gomock.Any(), gomock.Any()).Return(&wafv2.DisassociateWebACLOutput{}, nil)
+				m.AssociateWebACL(gomock.Any(), gomock.Any()).Return(&wafv2.AssociateWebACLOutput{}, nil)
+			},
+		},
+		{
+			name:    "disassociates the web ACL removed from the spec",
+			current: currentACL,
+			wafV2APIMocks: func(m *mocks.MockWAFV2APIMockRecorder) {
+				m.DisassociateWebACL(gomock.Any(), &wafv2.DisassociateWebACLInput{
+					ResourceArn: aws.String(ingressLBArn),
+				}).Return(&wafv2.DisassociateWebACLOutput{}, nil)
+			},
+		},
+		{
+			name:          "does nothing when the web ACL is associated",
+			current:       desiredACL,
+			desired:       aws.String(desiredACL),
+			wafV2APIMocks: func(m *mocks.MockWAFV2APIMockRecorder) {},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			wafV2APIMocks := mocks.NewMockWAFV2API(mockCtrl)
+
+			out := &wafv2.GetWebACLForResourceOutput{}
+			if tc.current != "" {
+				out.WebACL = &wafv2types.WebACL{ARN: aws.String(tc.current)}
+			}
+			wafV2APIMocks.EXPECT().GetWebACLForResource(gomock.Any(), gomock.Any()).Return(out, nil)
+			tc.wafV2APIMocks(wafV2APIMocks.EXPECT())
+
+			s := &Service{
+				scope:       newIngressTestScope(t, ingressLoadBalancerSpec()),
+				WAFV2Client: wafV2APIMocks,
+			}
+			g.Expect(s.reconcileIngressWebACL(context.TODO(), &infrav1.LoadBalancer{ARN: ingressLBArn, Name: ingressLBName}, tc.desired)).To(Succeed())
+		})
+	}
+}
+
+func TestRegisterInstanceWithIngressLB(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	elbV2APIMocks := mocks.NewMockELBV2API(mockCtrl)
+
+	clusterScope := newIngressTestScope(t, ingressLoadBalancerSpec())
+	clusterScope.Network().IngressLoadBalancer = &infrav1.LoadBalancer{ARN: ingressLBArn, Name: ingressLBName}
+
+	elbV2APIMocks.EXPECT().DescribeTargetGroups(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{
+		TargetGroups: []elbv2types.TargetGroup{
+			{TargetGroupArn: aws.String("arn::registered"), TargetGroupName: aws.String("ingress-registered"), Port: aws.Int32(9443)},
+			{TargetGroupArn: aws.String(ingressTGArn), TargetGroupName: aws.String("ingress-new"), Port: aws.Int32(8443)},
+		},
+	}, nil)
+	elbV2APIMocks.EXPECT().DescribeTargetHealth(gomock.Any(), &elbv2.DescribeTargetHealthInput{TargetGroupArn: aws.String("arn::registered")}).
+		Return(&elbv2.DescribeTargetHealthOutput{
+			TargetHealthDescriptions: []elbv2types.TargetHealthDescription{{Target: &elbv2types.TargetDescription{Id: aws.String("i-123")}}},
+		}, nil)
+	elbV2APIMocks.EXPECT().DescribeTargetHealth(gomock.Any(), &elbv2.DescribeTargetHealthInput{TargetGroupArn: aws.String(ingressTGArn)}).
+		Return(&elbv2.DescribeTargetHealthOutput{}, nil)
+	elbV2APIMocks.EXPECT().RegisterTargets(gomock.Any(), &elbv2.RegisterTargetsInput{
+		TargetGroupArn: aws.String(ingressTGArn),
+		Targets:        []elbv2types.TargetDescription{{Id: aws.String("i-123"), Port: aws.Int32(8443)}},
+	}).Return(&elbv2.RegisterTargetsOutput{}, nil)
+
+	s := &Service{
+		scope:       clusterScope,
+		ELBV2Client: elbV2APIMocks,
+	}
+	g.Expect(s.RegisterInstanceWithIngressLB(context.TODO(), &infrav1.Instance{ID: "i-123"})).To(Succeed())
+}
@@ -19,6 +19,7 @@ package elb
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -66,6 +67,9 @@ const apiServerTargetGroupPrefix = "apiserver-target-"
 // listeners.
 const additionalTargetGroupPrefix = "additional-listener-"
 
+// tlsTargetGroupPrefix is the target group name prefix used when creating the target group for the TLS listener.
+const tlsTargetGroupPrefix = "apiserver-tls-target-"
+
 // cantAttachSGToNLBRegions is a set of regions that do not support Security Groups in NLBs.
 var cantAttachSGToNLBRegions = sets.New("us-iso-east-1", "us-iso-west-1", "us-isob-east-1")
 
@@ -85,6 +89,10 @@ func (s *Service) ReconcileLoadbalancers(ctx context.Context) error {
 	// the reconcile phase. This is useful when creating multiple load
 	// balancers, as they can take several minutes to become available.
 
+	// A change of the control plane load balancer type migrates the cluster to a new load balancer
+	// created alongside the current one.
+	s.startLoadBalancerMigration()
+
 	for _, lbSpec := range s.scope.ControlPlaneLoadBalancers() {
 		if lbSpec == nil {
 			continue
@@ -109,6 +117,17 @@ func (s *Service) ReconcileLoadbalancers(ctx context.Context) error {
 		}
 	}
 
+	if ingressLBSpec := s.scope.IngressLoadBalancer(); ingressLBSpec != nil {
+		reconciler, err := s.getOrCreateIngressLB(ctx, ingressLBSpec)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			lbReconcilers = append(lbReconcilers, reconciler)
+		}
+	} else if err := s.deleteIngressLB(ctx); err != nil {
+		errs = append(errs, errors.Wrap(err, "failed to delete ingress load balancer"))
+	}
+
 	// Reconcile all load balancers
 	for _, reconciler := range lbReconcilers {
 		if err := reconciler(); err != nil {
@@ -116,6 +135,12 @@ func (s *Service) ReconcileLoadbalancers(ctx context.Context) error {
 		}
 	}
 
+	if len(errs) == 0 {
+		if err := s.reconcileLoadBalancerMigration(ctx); err != nil {
+			errs = append(errs, errors.Wrap(err, "failed to reconcile control plane load balancer migration"))
+		}
+	}
+
 	return kerrors.NewAggregate(errs)
 }
 
@@ -135,7 +160,7 @@ func (s *Service) getOrCreateV2LB(ctx context.Context, lbSpec *infrav1.AWSLoadBa
 	}
 	lb, err := s.describeLB(ctx, name, lbSpec)
 	switch {
-	case IsNotFound(err) && s.scope.ControlPlaneEndpoint().IsValid():
+	case IsNotFound(err) && s.scope.ControlPlaneEndpoint().IsValid() && s.scope.ControlPlaneLoadBalancerMigration() == nil:
 		// if elb is not found and owner cluster ControlPlaneEndpoint is already populated, then we should not recreate the elb.
 		return nil, errors.Wrapf(err, "no loadbalancer exists for the AWSCluster %s, the cluster has become unrecoverable and should be deleted manually", s.scope.InfraClusterName())
 	case IsNotFound(err):
@@ -217,7 +242,7 @@ func (s *Service) reconcileV2LB(ctx context.Context, lb *infrav1.LoadBalancer, d
 		s.scope.Trace("Unmanaged control plane load balancer, skipping load balancer configuration", "api-server-elb", lb)
 	}
 
-	if s.scope.ControlPlaneLoadBalancers()[1] != nil && lb.Name == *s.scope.ControlPlaneLoadBalancers()[1].Name {
+	if s.isSecondaryControlPlaneLB(lb) {
 		lb.DeepCopyInto(&s.scope.Network().SecondaryAPIServerELB)
 	} else {
 		lb.DeepCopyInto(&s.scope.Network().APIServerELB)
@@ -226,6 +251,18 @@ func (s *Service) reconcileV2LB(ctx context.Context, lb *infrav1.LoadBalancer, d
 	return nil
 }
 
+// isSecondaryControlPlaneLB returns true if the load balancer is the secondary control plane load balancer.
+// The name of the secondary load balancer is generated when it takes the place of the new load balancer
+// of a control plane load balancer migration.
+func (s *Service) isSecondaryControlPlaneLB(lb *infrav1.LoadBalancer) bool {
+	lbSpecs := s.scope.ControlPlaneLoadBalancers()
+	if len(lbSpecs) < 2 || lbSpecs[1] == nil {
+		return false
+	}
+	name, err := LBName(s.scope, lbSpecs[1])
+	return err == nil && lb.Name == name
+}
+
 // getAPITargetGroupHealthCheck creates the health check for the Kube apiserver target group,
 // limiting the customization for the health check probe counters (skipping standarized/reserved
 // fields: Protocol, Port or Path). To customize the health check protocol, use HealthCheckProtocol instead.
@@ -306,6 +343,73 @@ func (s *Service) getAdditionalTargetGroupHealthCheck(ln infrav1.AdditionalListe
 	return healthCheck
 }
 
+// getTLSListener returns the listener terminating TLS with the certificate of the TLS listener spec.
+// It forwards the traffic to its own API server target group, which re-encrypts the traffic to the API server.
+func (s *Service) getTLSListener(lbSpec *infrav1.AWSLoadBalancerSpec) infrav1.Listener {
+	protocol := infrav1.ELBProtocolTLS
+	healthCheck := s.getAPITargetGroupHealthCheck(lbSpec)
+	if lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeALB {
+		// Application load balancers only support HTTP and HTTPS, for the listeners as well as for the health checks.
+		protocol = infrav1.ELBProtocolHTTPS
+		if hcProtocol := aws.ToString(healthCheck.Protocol); hcProtocol != infrav1.ELBProtocolHTTP.String() && hcProtocol != infrav1.ELBProtocolHTTPS.String() {
+			healthCheck.Protocol = aws.String(infrav1.ELBProtocolHTTPS.String())
+			healthCheck.Path = aws.String(infrav1.DefaultAPIServerHealthCheckPath)
+		}
+	}
+
+	return infrav1.Listener{
+		Protocol:       protocol,
+		Port:           lbSpec.TLSListener.Port,
+		CertificateARN: lbSpec.TLSListener.CertificateARN,
+		SSLPolicy:      aws.ToString(lbSpec.TLSListener.SSLPolicy),
+		TargetGroup: infrav1.TargetGroupSpec{
+			Name:        names.SimpleNameGenerator.GenerateName(tlsTargetGroupPrefix),
+			Port:        infrav1.DefaultAPIServerPort,
+			Protocol:    protocol,
+			VpcID:       s.scope.VPC().ID,
+			HealthCheck: healthCheck,
+			IPType:      s.getAPITargetGroupIPType(lbSpec),
+			Attributes:  getTargetGroupAttributes(lbSpec.TargetGroupAttributes),
+		},
+	}
+}
+
+// getTargetGroupAttributes returns the target group attributes set in the spec, by attribute key.
+func getTargetGroupAttributes(attrs *infrav1.TargetGroupAttributes) map[string]string {
+	if attrs == nil {
+		return nil
+	}
+
+	res := make(map[string]string)
+	if attrs.DeregistrationDelaySeconds != nil {
+		res[infrav1.TargetGroupAttributeDeregistrationDelayTimeoutSeconds] = strconv.FormatInt(*attrs.DeregistrationDelaySeconds, 10)
+	}
+	if attrs.ConnectionTermination != nil {
+		res[infrav1.TargetGroupAttributeDeregistrationDelayConnectionTermination] = strconv.FormatBool(*attrs.ConnectionTermination)
+	}
+	if attrs.UnhealthyConnectionTermination != nil {
+		res[infrav1.TargetGroupAttributeEnableConnectionTermination] = strconv.FormatBool(*attrs.UnhealthyConnectionTermination)
+	}
+	if attrs.UnhealthyDrainingIntervalSeconds != nil {
+		res[infrav1.TargetGroupAttributeUnhealthyDrainingIntervalSeconds] = strconv.FormatInt(*attrs.UnhealthyDrainingIntervalSeconds, 10)
+	}
+	if attrs.ProxyProtocolV2 != nil {
+		res[infrav1.TargetGroupAttributeEnableProxyProtocolV2] = strconv.FormatBool(*attrs.ProxyProtocolV2)
+	}
+	if attrs.Stickiness != nil {
+		res[infrav1.TargetGroupAttributeStickinessEnabled] = strconv.FormatBool(attrs.Stickiness.Enabled)
+		res[infrav1.TargetGroupAttributeStickinessType] = string(attrs.Stickiness.Type)
+		if attrs.Stickiness.CookieDurationSeconds != nil {
+			res[infrav1.TargetGroupAttributeStickinessLBCookieDurationSeconds] = strconv.FormatInt(*attrs.Stickiness.CookieDurationSeconds, 10)
+		}
+	}
+
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
+
 // getAPITargetGroupIPType determines the IP address type for the API server target group.
 // It examines the control plane subnets to determine if they have IPv4 and/or IPv6 addresses,
 // and can be overridden by the load balancer spec.
@@ -402,6 +506,9 @@ func (s *Service) getAPIServerLBSpec(ctx context.Context, elbName string, lbSpec
 		},
 		SecurityGroupIDs: securityGroupIDs,
 	}
+	if lbSpec != nil {
+		res.ELBListeners[0].TargetGroup.Attributes = getTargetGroupAttributes(lbSpec.TargetGroupAttributes)
+	}
 
 	if lbSpec != nil {
 		for _, listener := range lbSpec.AdditionalListeners {
@@ -423,9 +530,14 @@ func (s *Service) getAPIServerLBSpec(ctx context.Context, elbName string, lbSpec
 					VpcID:       s.scope.VPC().ID,
 					HealthCheck: lnHealthCheck,
 					IPType:      s.getAdditionalTargetGroupIPType(listener),
+					Attributes:  getTargetGroupAttributes(listener.TargetGroupAttributes),
 				},
 			})
 		}
+
+		if lbSpec.TLSListener != nil {
+			res.ELBListeners = append(res.ELBListeners, s.getTLSListener(lbSpec))
+		}
 	}
 
 	if lbSpec != nil && lbSpec.LoadBalancerType != infrav1.LoadBalancerTypeNLB {
@@ -435,6 +547,17 @@ func (s *Service) getAPIServerLBSpec(ctx context.Context, elbName string, lbSpec
 	if lbSpec != nil {
 		isCrossZoneLB := lbSpec.CrossZoneLoadBalancing
 		res.ELBAttributes[infrav1.LoadBalancerAttributeEnableLoadBalancingCrossZone] = aws.String(strconv.FormatBool(isCrossZoneLB))
+		res.ELBAttributes[infrav1.LoadBalancerAttributeDeletionProtectionEnabled] = aws.String(strconv.FormatBool(lbSpec.DeletionProtection))
+	}
+
+	// Access logs are always set on network and application load balancers, so that removing them
+	// from the spec disables their delivery.
+	if lbSpec != nil && (lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeNLB || lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeALB) {
+		res.ELBAttributes[infrav1.LoadBalancerAttributeAccessLogsS3Enabled] = aws.String(strconv.FormatBool(lbSpec.AccessLogs != nil))
+		if lbSpec.AccessLogs != nil {
+			res.ELBAttributes[infrav1.LoadBalancerAttributeAccessLogsS3Bucket] = aws.String(lbSpec.AccessLogs.Bucket)
+			res.ELBAttributes[infrav1.LoadBalancerAttributeAccessLogsS3Prefix] = aws.String(lbSpec.AccessLogs.Prefix)
+		}
 	}
 
 	res.Tags = infrav1.Build(infrav1.BuildParams{
@@ -445,45 +568,64 @@ func (s *Service) getAPIServerLBSpec(ctx context.Context, elbName string, lbSpec
 		Additional:  s.scope.AdditionalTags(),
 	})
 
+	var subnetIDs []string
+	if lbSpec != nil {
+		subnetIDs = lbSpec.Subnets
+	}
+	var err error
+	res.SubnetIDs, res.AvailabilityZones, err = s.getLoadBalancerSubnets(ctx, subnetIDs, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// getLoadBalancerSubnets returns the subnets of a load balancer and their availability zones.
+// When no subnets are specified, one subnet of the cluster is selected in every availability zone.
+func (s *Service) getLoadBalancerSubnets(ctx context.Context, specSubnets []string, scheme infrav1.ELBScheme) ([]string, []string, error) {
+	var subnetIDs, availabilityZones []string
+
 	// If subnet IDs have been specified for this load balancer
-	if lbSpec != nil && len(lbSpec.Subnets) > 0 {
+	if len(specSubnets) > 0 {
 		// This set of subnets may not match the subnets specified on the Cluster, so we may not have already discovered them
 		// We need to call out to AWS to describe them just in case
 		input := &ec2.DescribeSubnetsInput{
-			SubnetIds: lbSpec.Subnets,
+			SubnetIds: specSubnets,
 		}
 		out, err := s.EC2Client.DescribeSubnets(ctx, input)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		for _, sn := range out.Subnets {
-			res.AvailabilityZones = append(res.AvailabilityZones, *sn.AvailabilityZone)
-			res.SubnetIDs = append(res.SubnetIDs, *sn.SubnetId)
+			availabilityZones = append(availabilityZones, *sn.AvailabilityZone)
+			subnetIDs = append(subnetIDs, *sn.SubnetId)
 		}
-	} else {
-		// The load balancer APIs require us to only attach one subnet for each AZ.
-		subnets := s.scope.Subnets().FilterPrivate().FilterNonCni()
+		return subnetIDs, availabilityZones, nil
+	}
 
-		// public-only setup has no private subnets
-		if scheme == infrav1.ELBSchemeInternetFacing || len(subnets) == 0 {
-			subnets = s.scope.Subnets().FilterPublic().FilterNonCni()
-		}
+	// The load balancer APIs require us to only attach one subnet for each AZ.
+	subnets := s.scope.Subnets().FilterPrivate().FilterNonCni()
 
-	subnetLoop:
-		for _, sn := range subnets {
-			for _, az := range res.AvailabilityZones {
-				if sn.AvailabilityZone == az {
-					// If we already attached another subnet in the same AZ, there is no need to
-					// add this subnet to the list of the ELB's subnets.
-					continue subnetLoop
-				}
+	// public-only setup has no private subnets
+	if scheme == infrav1.ELBSchemeInternetFacing || len(subnets) == 0 {
+		subnets = s.scope.Subnets().FilterPublic().FilterNonCni()
+	}
+
+subnetLoop:
+	for _, sn := range subnets {
+		for _, az := range availabilityZones {
+			if sn.AvailabilityZone == az {
+				// If we already attached another subnet in the same AZ, there is no need to
+				// add this subnet to the list of the ELB's subnets.
+				continue subnetLoop
 			}
-			res.AvailabilityZones = append(res.AvailabilityZones, sn.AvailabilityZone)
-			res.SubnetIDs = append(res.SubnetIDs, sn.GetResourceID())
 		}
+		availabilityZones = append(availabilityZones, sn.AvailabilityZone)
+		subnetIDs = append(subnetIDs, sn.GetResourceID())
 	}
 
-	return res, nil
+	return subnetIDs, availabilityZones, nil
 }
 
 func (s *Service) createLB(ctx context.Context, spec *infrav1.LoadBalancer, lbSpec *infrav1.AWSLoadBalancerSpec) (*infrav1.LoadBalancer, error) {
@@ -556,6 +698,7 @@ func (s *Service) createLB(ctx context.Context, spec *infrav1.LoadBalancer, lbSp
 	res := spec.DeepCopy()
 	s.scope.Debug("applying load balancer DNS to result", "dns", dnsName)
 	res.DNSName = dnsName
+	res.CanonicalHostedZoneID = aws.ToString(out.LoadBalancers[0].CanonicalHostedZoneId)
 	res.ARN = arn
 	return res, nil
 }
@@ -822,6 +965,10 @@ func (s *Service) deleteAWSCloudProviderELBs(ctx context.Context) error {
 func (s *Service) DeleteLoadbalancers(ctx context.Context) error {
 	s.scope.Debug("Deleting load balancers")
 
+	if err := s.deleteIngressLB(ctx); err != nil {
+		return errors.Wrap(err, "failed to delete ingress load balancer")
+	}
+
 	if err := s.deleteAPIServerELB(ctx); err != nil {
 		return errors.Wrap(err, "failed to delete control plane load balancer")
 	}
@@ -841,7 +988,7 @@ func (s *Service) deleteExistingNLBs(ctx context.Context) error {
 	errs := make([]error, 0)
 
 	for _, lbSpec := range s.scope.ControlPlaneLoadBalancers() {
-		if lbSpec == nil {
+		if lbSpec == nil || lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeClassic {
 			continue
 		}
 		errs = append(errs, s.deleteExistingNLB(ctx, lbSpec))
@@ -872,6 +1019,17 @@ func (s *Service) deleteExistingNLB(ctx context.Context, lbSpec *infrav1.AWSLoad
 		s.scope.Debug("Found unmanaged load balancer for apiserver, skipping deletion", "api-server-elb-name", lb.Name)
 		return nil
 	}
+
+	if aws.ToString(lb.ELBAttributes[infrav1.LoadBalancerAttributeDeletionProtectionEnabled]) == "true" {
+		s.scope.Debug("disabling load balancer deletion protection", "name", name)
+		if err := s.configureLBAttributes(ctx, lb.ARN, map[string]*string{
+			infrav1.LoadBalancerAttributeDeletionProtectionEnabled: aws.String("false"),
+		}); err != nil {
+			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.LoadBalancerReadyCondition, "DeletingFailed", clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+			return err
+		}
+	}
+
 	s.scope.Debug("deleting load balancer", "name", name)
 	if err := s.deleteLB(ctx, lb.ARN); err != nil {
 		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.LoadBalancerReadyCondition, "DeletingFailed", clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
@@ -1357,11 +1515,11 @@ func (s *Service) configureAttributes(ctx context.Context, name string, attribut
 }
 
 func (s *Service) configureLBAttributes(ctx context.Context, arn string, attributes map[string]*string) error {
-	attrs := make([]elbv2types.LoadBalancerAttribute, 0)
-	for k, v := range attributes {
+	attrs := make([]elbv2types.LoadBalancerAttribute, 0, len(attributes))
+	for _, k := range sets.List(sets.KeySet(attributes)) {
 		attrs = append(attrs, elbv2types.LoadBalancerAttribute{
 			Key:   aws.String(k),
-			Value: v,
+			Value: attributes[k],
 		})
 	}
 	s.scope.Debug("adding attributes to load balancer", "attrs", attrs)
@@ -1773,12 +1931,29 @@ func (s *Service) reconcileTargetGroupsAndListeners(ctx context.Context, lbARN s
 				)
 			}
 
+			// The attributes set in the spec take precedence over the defaults.
+			for _, key := range sets.List(sets.KeySet(tgSpec.Attributes)) {
+				targetGroupAttributeInput.Attributes = slices.DeleteFunc(targetGroupAttributeInput.Attributes, func(attr elbv2types.TargetGroupAttribute) bool {
+					return aws.ToString(attr.Key) == key
+				})
+				targetGroupAttributeInput.Attributes = append(targetGroupAttributeInput.Attributes,
+					elbv2types.TargetGroupAttribute{
+						Key:   aws.String(key),
+						Value: aws.String(tgSpec.Attributes[key]),
+					},
+				)
+			}
+
 			if len(targetGroupAttributeInput.Attributes) > 0 {
 				s.scope.Debug("configuring target group attributes", "attributes", targetGroupAttributeInput)
 				if _, err := s.ELBV2Client.ModifyTargetGroupAttributes(ctx, targetGroupAttributeInput); err != nil {
 					return nil, nil, errors.Wrapf(err, "failed to modify target group attribute")
 				}
 			}
+		} else if len(tgSpec.Attributes) > 0 {
+			if err := s.reconcileTargetGroupAttributes(ctx, group, tgSpec.Attributes); err != nil {
+				return nil, nil, err
+			}
 		}
 
 		var listener *elbv2types.Listener
@@ -1795,12 +1970,109 @@ func (s *Service) reconcileTargetGroupsAndListeners(ctx context.Context, lbARN s
 				return nil, nil, err
 			}
 			createdListeners = append(createdListeners, listener)
+		} else if err := s.reconcileListenerTLS(ctx, listener, ln); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if lbSpec.TLSListener == nil {
+		if err := s.deleteTLSListener(ctx, existingTargetGroups.TargetGroups, existingListeners); err != nil {
+			return nil, nil, err
 		}
 	}
 
 	return createdTargetGroups, createdListeners, nil
 }
 
+// reconcileTargetGroupAttributes updates the attributes of an existing target group that drifted from the spec.
+func (s *Service) reconcileTargetGroupAttributes(ctx context.Context, group *elbv2types.TargetGroup, desired map[string]string) error {
+	out, err := s.ELBV2Client.DescribeTargetGroupAttributes(ctx, &elbv2.DescribeTargetGroupAttributesInput{
+		TargetGroupArn: group.TargetGroupArn,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe attributes of target group %q", aws.ToString(group.TargetGroupName))
+	}
+
+	current := make(map[string]string, len(out.Attributes))
+	for _, attr := range out.Attributes {
+		current[aws.ToString(attr.Key)] = aws.ToString(attr.Value)
+	}
+
+	input := &elbv2.ModifyTargetGroupAttributesInput{TargetGroupArn: group.TargetGroupArn}
+	for _, key := range sets.List(sets.KeySet(desired)) {
+		if value, ok := current[key]; !ok || value != desired[key] {
+			input.Attributes = append(input.Attributes, elbv2types.TargetGroupAttribute{
+				Key:   aws.String(key),
+				Value: aws.String(desired[key]),
+			})
+		}
+	}
+	if len(input.Attributes) == 0 {
+		return nil
+	}
+
+	s.scope.Debug("updating target group attributes", "group", aws.ToString(group.TargetGroupName), "attributes", input.Attributes)
+	if _, err := s.ELBV2Client.ModifyTargetGroupAttributes(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to modify attributes of target group %q", aws.ToString(group.TargetGroupName))
+	}
+	return nil
+}
+
+// reconcileListenerTLS updates the port, certificate and security policy of an existing TLS listener.
+func (s *Service) reconcileListenerTLS(ctx context.Context, listener *elbv2types.Listener, ln infrav1.Listener) error {
+	if ln.CertificateARN == "" {
+		return nil
+	}
+
+	var certificateARN string
+	if len(listener.Certificates) > 0 {
+		certificateARN = aws.ToString(listener.Certificates[0].CertificateArn)
+	}
+	if int64(aws.ToInt32(listener.Port)) == ln.Port && certificateARN == ln.CertificateARN &&
+		(ln.SSLPolicy == "" || aws.ToString(listener.SslPolicy) == ln.SSLPolicy) {
+		return nil
+	}
+
+	input := &elbv2.ModifyListenerInput{
+		ListenerArn:  listener.ListenerArn,
+		Port:         aws.Int32(int32(ln.Port)), //#nosec G115
+		Certificates: []elbv2types.Certificate{{CertificateArn: aws.String(ln.CertificateARN)}},
+	}
+	if ln.SSLPolicy != "" {
+		input.SslPolicy = aws.String(ln.SSLPolicy)
+	}
+	s.scope.Debug("updating TLS listener", "arn", aws.ToString(listener.ListenerArn), "port", ln.Port, "certificate", ln.CertificateARN)
+	if _, err := s.ELBV2Client.ModifyListener(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to update TLS listener %q", aws.ToString(listener.ListenerArn))
+	}
+	return nil
+}
+
+// deleteTLSListener deletes the TLS listener and its target group, when they are no longer in the spec.
+func (s *Service) deleteTLSListener(ctx context.Context, targetGroups []elbv2types.TargetGroup, listeners *elbv2.DescribeListenersOutput) error {
+	for _, group := range targetGroups {
+		if !strings.HasPrefix(aws.ToString(group.TargetGroupName), tlsTargetGroupPrefix) {
+			continue
+		}
+		if listeners != nil {
+			for _, l := range listeners.Listeners {
+				if len(l.DefaultActions) == 0 || aws.ToString(l.DefaultActions[0].TargetGroupArn) != aws.ToString(group.TargetGroupArn) {
+					continue
+				}
+				s.scope.Debug("deleting TLS listener", "arn", aws.ToString(l.ListenerArn))
+				if _, err := s.ELBV2Client.DeleteListener(ctx, &elbv2.DeleteListenerInput{ListenerArn: l.ListenerArn}); err != nil {
+					return errors.Wrapf(err, "failed to delete TLS listener %q", aws.ToString(l.ListenerArn))
+				}
+			}
+		}
+		s.scope.Debug("deleting TLS listener target group", "name", aws.ToString(group.TargetGroupName))
+		if _, err := s.ELBV2Client.DeleteTargetGroup(ctx, &elbv2.DeleteTargetGroupInput{TargetGroupArn: group.TargetGroupArn}); err != nil {
+			return errors.Wrapf(err, "failed to delete TLS listener target group %q", aws.ToString(group.TargetGroupName))
+		}
+	}
+	return nil
+}
+
 // createListener creates a single Listener.
 func (s *Service) createListener(ctx context.Context, ln infrav1.Listener, group *elbv2types.TargetGroup, lbARN string, tags map[string]string) (*elbv2types.Listener, error) {
 	listenerInput := &elbv2.CreateListenerInput{
@@ -1815,6 +2087,12 @@ func (s *Service) createListener(ctx context.Context, ln infrav1.Listener, group
 		Protocol:        elbProtocolToSDKProtocol(ln.Protocol),
 		Tags:            converters.MapToV2Tags(tags),
 	}
+	if ln.CertificateARN != "" {
+		listenerInput.Certificates = []elbv2types.Certificate{{CertificateArn: aws.String(ln.CertificateARN)}}
+	}
+	if ln.SSLPolicy != "" {
+		listenerInput.SslPolicy = aws.String(ln.SSLPolicy)
+	}
 	// Create ClassicELBListeners
 	listener, err := s.ELBV2Client.CreateListener(ctx, listenerInput)
 	if err != nil {
@@ -1898,13 +2176,14 @@ func (s *Service) getHealthCheckTarget() string {
 
 func fromSDKTypeToClassicELB(v *elbtypes.LoadBalancerDescription, attrs *elbtypes.LoadBalancerAttributes, tags []elbtypes.Tag) *infrav1.LoadBalancer {
 	res := &infrav1.LoadBalancer{
-		Name:             aws.ToString(v.LoadBalancerName),
-		Scheme:           infrav1.ELBScheme(*v.Scheme),
-		SubnetIDs:        v.Subnets,
-		SecurityGroupIDs: v.SecurityGroups,
-		DNSName:          aws.ToString(v.DNSName),
-		Tags:             converters.ELBTagsToMap(tags),
-		LoadBalancerType: infrav1.LoadBalancerTypeClassic,
+		Name:                  aws.ToString(v.LoadBalancerName),
+		Scheme:                infrav1.ELBScheme(*v.Scheme),
+		SubnetIDs:             v.Subnets,
+		SecurityGroupIDs:      v.SecurityGroups,
+		DNSName:               aws.ToString(v.DNSName),
+		CanonicalHostedZoneID: aws.ToString(v.CanonicalHostedZoneNameID),
+		Tags:                  converters.ELBTagsToMap(tags),
+		LoadBalancerType:      infrav1.LoadBalancerTypeClassic,
 		// Classic Load Balancers only support IPv4.
 		LoadBalancerIPAddressType: infrav1.LoadBalancerIPAddressTypeIPv4,
 	}
@@ -1933,6 +2212,7 @@ func fromSDKTypeToLB(v elbv2types.LoadBalancer, attrs []elbv2types.LoadBalancerA
 		SecurityGroupIDs:          v.SecurityGroups,
 		AvailabilityZones:         availabilityZones,
 		DNSName:                   aws.ToString(v.DNSName),
+		CanonicalHostedZoneID:     aws.ToString(v.CanonicalHostedZoneId),
 		Tags:                      converters.V2TagsToMap(tags),
 		LoadBalancerIPAddressType: infrav1.LoadBalancerIPAddressType(v.IpAddressType),
 	}
@@ -1999,6 +2279,10 @@ func isSDKTargetGroupEqualToTargetGroup(elbTG *elbv2types.TargetGroup, spec *inf
 		if !strings.HasPrefix(spec.Name, additionalTargetGroupPrefix) {
 			return false
 		}
+	case strings.HasPrefix(*elbTG.TargetGroupName, tlsTargetGroupPrefix):
+		if !strings.HasPrefix(spec.Name, tlsTargetGroupPrefix) {
+			return false
+		}
 	default:
 		// Not created by CAPA
 		return false
@@ -416,6 +416,41 @@ func TestGetAPIServerV2ELBSpecControlPlaneLoadBalancer(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "target group attributes are set on the API server and additional listeners",
+			lb: &infrav1.AWSLoadBalancerSpec{
+				LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+				TargetGroupAttributes: &infrav1.TargetGroupAttributes{
+					DeregistrationDelaySeconds: aws.Int64(30),
+				},
+				AdditionalListeners: []infrav1.AdditionalListenerSpec{
+					{
+						Port:     2379,
+						Protocol: infrav1.ELBProtocolTCP,
+						TargetGroupAttributes: &infrav1.TargetGroupAttributes{
+							ProxyProtocolV2: aws.Bool(true),
+							Stickiness: &infrav1.TargetGroupStickiness{
+								Enabled: true,
+								Type:    infrav1.TargetGroupStickinessTypeSourceIP,
+							},
+						},
+					},
+				},
+			},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.ELBListeners).To(HaveLen(2))
+				g.Expect(res.ELBListeners[0].TargetGroup.Attributes).To(Equal(map[string]string{
+					infrav1.TargetGroupAttributeDeregistrationDelayTimeoutSeconds: "30",
+				}))
+				g.Expect(res.ELBListeners[1].TargetGroup.Attributes).To(Equal(map[string]string{
+					infrav1.TargetGroupAttributeEnableProxyProtocolV2: "true",
+					infrav1.TargetGroupAttributeStickinessEnabled:     "true",
+					infrav1.TargetGroupAttributeStickinessType:        "source_ip",
+				}))
+			},
+		},
 		{
 			name: "A base listener is set up for NLB, with additional listeners",
 			lb: &infrav1.AWSLoadBalancerSpec{
@@ -435,6 +470,83 @@ func TestGetAPIServerV2ELBSpecControlPlaneLoadBalancer(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "NLB with access logs and deletion protection",
+			lb: &infrav1.AWSLoadBalancerSpec{
+				LoadBalancerType:   infrav1.LoadBalancerTypeNLB,
+				DeletionProtection: true,
+				AccessLogs: &infrav1.LoadBalancerAccessLogs{
+					Bucket: "access-logs",
+					Prefix: "apiserver",
+				},
+			},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.ELBAttributes).To(HaveKeyWithValue(infrav1.LoadBalancerAttributeDeletionProtectionEnabled, aws.String("true")))
+				g.Expect(res.ELBAttributes).To(HaveKeyWithValue(infrav1.LoadBalancerAttributeAccessLogsS3Enabled, aws.String("true")))
+				g.Expect(res.ELBAttributes).To(HaveKeyWithValue(infrav1.LoadBalancerAttributeAccessLogsS3Bucket, aws.String("access-logs")))
+				g.Expect(res.ELBAttributes).To(HaveKeyWithValue(infrav1.LoadBalancerAttributeAccessLogsS3Prefix, aws.String("apiserver")))
+			},
+		},
+		{
+			name: "NLB without access logs disables them",
+			lb: &infrav1.AWSLoadBalancerSpec{
+				LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+			},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.ELBAttributes).To(HaveKeyWithValue(infrav1.LoadBalancerAttributeDeletionProtectionEnabled, aws.String("false")))
+				g.Expect(res.ELBAttributes).To(HaveKeyWithValue(infrav1.LoadBalancerAttributeAccessLogsS3Enabled, aws.String("false")))
+				g.Expect(res.ELBAttributes).NotTo(HaveKey(infrav1.LoadBalancerAttributeAccessLogsS3Bucket))
+			},
+		},
+		{
+			name: "NLB with a TLS listener",
+			lb: &infrav1.AWSLoadBalancerSpec{
+				LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+				TLSListener: &infrav1.TLSListenerSpec{
+					Port:           443,
+					CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+					SSLPolicy:      aws.String("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+				},
+			},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.ELBListeners).To(HaveLen(2))
+				ln := res.ELBListeners[1]
+				g.Expect(ln.Protocol).To(Equal(infrav1.ELBProtocolTLS))
+				g.Expect(ln.Port).To(Equal(int64(443)))
+				g.Expect(ln.CertificateARN).To(Equal("arn:aws:acm:us-east-1:123456789012:certificate/abc"))
+				g.Expect(ln.SSLPolicy).To(Equal("ELBSecurityPolicy-TLS13-1-2-2021-06"))
+				g.Expect(ln.TargetGroup.Name).To(HavePrefix(tlsTargetGroupPrefix))
+				g.Expect(ln.TargetGroup.Port).To(Equal(int64(infrav1.DefaultAPIServerPort)))
+				g.Expect(ln.TargetGroup.Protocol).To(Equal(infrav1.ELBProtocolTLS))
+			},
+		},
+		{
+			name: "ALB with a TLS listener uses HTTPS",
+			lb: &infrav1.AWSLoadBalancerSpec{
+				LoadBalancerType: infrav1.LoadBalancerTypeALB,
+				TLSListener: &infrav1.TLSListenerSpec{
+					Port:           443,
+					CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+				},
+			},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.ELBListeners).To(HaveLen(2))
+				ln := res.ELBListeners[1]
+				g.Expect(ln.Protocol).To(Equal(infrav1.ELBProtocolHTTPS))
+				g.Expect(ln.SSLPolicy).To(BeEmpty())
+				g.Expect(ln.TargetGroup.Protocol).To(Equal(infrav1.ELBProtocolHTTPS))
+				g.Expect(ln.TargetGroup.HealthCheck.Protocol).To(Equal(aws.String(infrav1.ELBProtocolHTTPS.String())))
+				g.Expect(ln.TargetGroup.HealthCheck.Path).To(Equal(aws.String(infrav1.DefaultAPIServerHealthCheckPath)))
+			},
+		},
 	}
 
 	ctx := context.TODO()
@@ -2206,6 +2318,267 @@ func TestReconcileTargetGroupsAndListeners(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "updates the certificate of an existing TLS listener",
+			spec: func(spec infrav1.LoadBalancer) infrav1.LoadBalancer {
+				spec.ELBListeners = []infrav1.Listener{
+					{
+						Protocol:       infrav1.ELBProtocolTLS,
+						Port:           443,
+						CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/new",
+						SSLPolicy:      "ELBSecurityPolicy-TLS13-1-2-2021-06",
+						TargetGroup: infrav1.TargetGroupSpec{
+							Name:     tlsTargetGroupPrefix + "abcde",
+							Port:     infrav1.DefaultAPIServerPort,
+							Protocol: infrav1.ELBProtocolTLS,
+							VpcID:    vpcID,
+						},
+					},
+				}
+				return spec
+			},
+			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
+				acl.Spec.ControlPlaneLoadBalancer.TLSListener = &infrav1.TLSListenerSpec{
+					Port:           443,
+					CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/new",
+					SSLPolicy:      aws.String("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+				}
+				return acl
+			},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeTargetGroups(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []elbv2types.TargetGroup{
+						{
+							TargetGroupArn:  aws.String(tgArn),
+							TargetGroupName: aws.String(tlsTargetGroupPrefix + "fghij"),
+							Port:            aws.Int32(infrav1.DefaultAPIServerPort),
+							Protocol:        elbv2types.ProtocolEnumTls,
+						},
+					},
+				}, nil)
+				m.DescribeListeners(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []elbv2types.Listener{
+						{
+							ListenerArn:    aws.String("listener::arn"),
+							Port:           aws.Int32(443),
+							Protocol:       elbv2types.ProtocolEnumTls,
+							Certificates:   []elbv2types.Certificate{{CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/old")}},
+							SslPolicy:      aws.String("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+							DefaultActions: []elbv2types.Action{{TargetGroupArn: aws.String(tgArn), Type: elbv2types.ActionTypeEnumForward}},
+						},
+					},
+				}, nil)
+				m.ModifyListener(gomock.Any(), gomock.Eq(&elbv2.ModifyListenerInput{
+					ListenerArn:  aws.String("listener::arn"),
+					Port:         aws.Int32(443),
+					Certificates: []elbv2types.Certificate{{CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/new")}},
+					SslPolicy:    aws.String("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+				})).Return(&elbv2.ModifyListenerOutput{}, nil)
+			},
+			check: func(t *testing.T, tgs []*elbv2types.TargetGroup, listeners []*elbv2types.Listener, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if len(tgs) != 0 || len(listeners) != 0 {
+					t.Fatalf("did not expect target groups or listeners to be created")
+				}
+			},
+		},
+		{
+			name: "target group attributes of the spec override the defaults",
+			spec: func(spec infrav1.LoadBalancer) infrav1.LoadBalancer {
+				spec.ELBListeners[0].TargetGroup.Attributes = map[string]string{
+					infrav1.TargetGroupAttributeDeregistrationDelayTimeoutSeconds: "30",
+					infrav1.TargetGroupAttributeUnhealthyDrainingIntervalSeconds:  "60",
+				}
+				return spec
+			},
+			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
+				return acl
+			},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeTargetGroups(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []elbv2types.TargetGroup{},
+				}, nil)
+				m.CreateTargetGroup(gomock.Any(), gomock.Any()).Return(&elbv2.CreateTargetGroupOutput{
+					TargetGroups: []elbv2types.TargetGroup{
+						{
+							TargetGroupArn:  aws.String(tgArn),
+							TargetGroupName: aws.String("name"),
+							VpcId:           aws.String(vpcID),
+						},
+					},
+				}, nil)
+				m.ModifyTargetGroupAttributes(gomock.Any(), gomock.Eq(&elbv2.ModifyTargetGroupAttributesInput{
+					TargetGroupArn: aws.String(tgArn),
+					Attributes: []elbv2types.TargetGroupAttribute{
+						{
+							Key:   aws.String(infrav1.TargetGroupAttributeEnableConnectionTermination),
+							Value: aws.String("false"),
+						},
+						{
+							Key:   aws.String(infrav1.TargetGroupAttributeEnablePreserveClientIP),
+							Value: aws.String("false"),
+						},
+						{
+							Key:   aws.String(infrav1.TargetGroupAttributeDeregistrationDelayTimeoutSeconds),
+							Value: aws.String("30"),
+						},
+						{
+							Key:   aws.String(infrav1.TargetGroupAttributeUnhealthyDrainingIntervalSeconds),
+							Value: aws.String("60"),
+						},
+					},
+				})).Return(nil, nil)
+				m.DescribeListeners(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []elbv2types.Listener{
+						{
+							ListenerArn:    aws.String("listener::arn"),
+							Port:           aws.Int32(infrav1.DefaultAPIServerPort),
+							Protocol:       elbv2types.ProtocolEnumTcp,
+							DefaultActions: []elbv2types.Action{{TargetGroupArn: aws.String(tgArn), Type: elbv2types.ActionTypeEnumForward}},
+						},
+					},
+				}, nil)
+			},
+			check: func(t *testing.T, tgs []*elbv2types.TargetGroup, listeners []*elbv2types.Listener, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if len(tgs) != 1 {
+					t.Fatalf("expected the target group to be created")
+				}
+			},
+		},
+		{
+			name: "updates the drifted target group attributes of an existing target group",
+			spec: func(spec infrav1.LoadBalancer) infrav1.LoadBalancer {
+				spec.ELBListeners[0].TargetGroup.Name = apiServerTargetGroupPrefix + "abcde"
+				spec.ELBListeners[0].TargetGroup.Attributes = map[string]string{
+					infrav1.TargetGroupAttributeDeregistrationDelayTimeoutSeconds:        "30",
+					infrav1.TargetGroupAttributeDeregistrationDelayConnectionTermination: "true",
+				}
+				return spec
+			},
+			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
+				return acl
+			},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeTargetGroups(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []elbv2types.TargetGroup{
+						{
+							TargetGroupArn:  aws.String(tgArn),
+							TargetGroupName: aws.String(apiServerTargetGroupPrefix + "fghij"),
+							Port:            aws.Int32(infrav1.DefaultAPIServerPort),
+							Protocol:        elbv2types.ProtocolEnumTcp,
+						},
+					},
+				}, nil)
+				m.DescribeListeners(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []elbv2types.Listener{
+						{
+							ListenerArn:    aws.String("listener::arn"),
+							Port:           aws.Int32(infrav1.DefaultAPIServerPort),
+							Protocol:       elbv2types.ProtocolEnumTcp,
+							DefaultActions: []elbv2types.Action{{TargetGroupArn: aws.String(tgArn), Type: elbv2types.ActionTypeEnumForward}},
+						},
+					},
+				}, nil)
+				m.DescribeTargetGroupAttributes(gomock.Any(), gomock.Eq(&elbv2.DescribeTargetGroupAttributesInput{
+					TargetGroupArn: aws.String(tgArn),
+				})).Return(&elbv2.DescribeTargetGroupAttributesOutput{
+					Attributes: []elbv2types.TargetGroupAttribute{
+						{
+							Key:   aws.String(infrav1.TargetGroupAttributeDeregistrationDelayTimeoutSeconds),
+							Value: aws.String("300"),
+						},
+						{
+							Key:   aws.String(infrav1.TargetGroupAttributeDeregistrationDelayConnectionTermination),
+							Value: aws.String("true"),
+						},
+					},
+				}, nil)
+				m.ModifyTargetGroupAttributes(gomock.Any(), gomock.Eq(&elbv2.ModifyTargetGroupAttributesInput{
+					TargetGroupArn: aws.String(tgArn),
+					Attributes: []elbv2types.TargetGroupAttribute{
+						{
+							Key:   aws.String(infrav1.TargetGroupAttributeDeregistrationDelayTimeoutSeconds),
+							Value: aws.String("30"),
+						},
+					},
+				})).Return(&elbv2.ModifyTargetGroupAttributesOutput{}, nil)
+			},
+			check: func(t *testing.T, tgs []*elbv2types.TargetGroup, listeners []*elbv2types.Listener, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if len(tgs) != 0 || len(listeners) != 0 {
+					t.Fatalf("did not expect target groups or listeners to be created")
+				}
+			},
+		},
+		{
+			name: "deletes the TLS listener once it is removed from the spec",
+			spec: func(spec infrav1.LoadBalancer) infrav1.LoadBalancer {
+				spec.ELBListeners[0].TargetGroup.Name = apiServerTargetGroupPrefix + "abcde"
+				return spec
+			},
+			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
+				return acl
+			},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeTargetGroups(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []elbv2types.TargetGroup{
+						{
+							TargetGroupArn:  aws.String(tgArn),
+							TargetGroupName: aws.String(apiServerTargetGroupPrefix + "fghij"),
+							Port:            aws.Int32(infrav1.DefaultAPIServerPort),
+							Protocol:        elbv2types.ProtocolEnumTcp,
+						},
+						{
+							TargetGroupArn:  aws.String("arn::tls-target-group"),
+							TargetGroupName: aws.String(tlsTargetGroupPrefix + "fghij"),
+							Port:            aws.Int32(infrav1.DefaultAPIServerPort),
+							Protocol:        elbv2types.ProtocolEnumTls,
+						},
+					},
+				}, nil)
+				m.DescribeListeners(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []elbv2types.Listener{
+						{
+							ListenerArn:    aws.String("listener::arn"),
+							Port:           aws.Int32(infrav1.DefaultAPIServerPort),
+							Protocol:       elbv2types.ProtocolEnumTcp,
+							DefaultActions: []elbv2types.Action{{TargetGroupArn: aws.String(tgArn), Type: elbv2types.ActionTypeEnumForward}},
+						},
+						{
+							ListenerArn:    aws.String("tls-listener::arn"),
+							Port:           aws.Int32(443),
+							Protocol:       elbv2types.ProtocolEnumTls,
+							DefaultActions: []elbv2types.Action{{TargetGroupArn: aws.String("arn::tls-target-group"), Type: elbv2types.ActionTypeEnumForward}},
+						},
+					},
+				}, nil)
+				m.DeleteListener(gomock.Any(), gomock.Eq(&elbv2.DeleteListenerInput{
+					ListenerArn: aws.String("tls-listener::arn"),
+				})).Return(&elbv2.DeleteListenerOutput{}, nil)
+				m.DeleteTargetGroup(gomock.Any(), gomock.Eq(&elbv2.DeleteTargetGroupInput{
+					TargetGroupArn: aws.String("arn::tls-target-group"),
+				})).Return(&elbv2.DeleteTargetGroupOutput{}, nil)
+			},
+			check: func(t *testing.T, tgs []*elbv2types.TargetGroup, listeners []*elbv2types.Listener, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if len(tgs) != 0 || len(listeners) != 0 {
+					t.Fatalf("did not expect target groups or listeners to be created")
+				}
+			},
+		},
 	}
 
 	ctx := context.TODO()
@@ -2424,6 +2797,14 @@ func TestReconcileV2LB(t *testing.T) {
 				m.ModifyLoadBalancerAttributes(gomock.Any(), &elbv2.ModifyLoadBalancerAttributesInput{
 					LoadBalancerArn: aws.String(elbArn),
 					Attributes: []elbv2types.LoadBalancerAttribute{
+						{
+							Key:   aws.String("access_logs.s3.enabled"),
+							Value: aws.String("false"),
+						},
+						{
+							Key:   aws.String("deletion_protection.enabled"),
+							Value: aws.String("false"),
+						},
 						{
 							Key:   aws.String("load_balancing.cross_zone.enabled"),
 							Value: aws.String("false"),
@@ -3283,6 +3664,94 @@ func TestDeleteNLB(t *testing.T) {
 				m.DeleteTargetGroup(gomock.Any(), &elbv2.DeleteTargetGroupInput{TargetGroupArn: aws.String(tgArn)}).Return(&elbv2.DeleteTargetGroupOutput{}, nil)
 				// delete the load balancer
 
+				m.DeleteLoadBalancer(gomock.Any(), &elbv2.DeleteLoadBalancerInput{LoadBalancerArn: aws.String(elbArn)}).Return(
+					&elbv2.DeleteLoadBalancerOutput{}, nil)
+
+				m.DescribeLoadBalancers(gomock.Any(), &elbv2.DescribeLoadBalancersInput{Names: []string{elbName}}).Return(
+					&elbv2.DescribeLoadBalancersOutput{
+						LoadBalancers: []elbv2types.LoadBalancer{},
+					},
+					nil,
+				)
+			},
+		},
+		{
+			name: "if control plane NLB is managed and has deletion protection enabled, disable it before deleting the NLB",
+			elbv2ApiMock: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeLoadBalancers(gomock.Any(), &elbv2.DescribeLoadBalancersInput{Names: []string{elbName}}).Return(
+					&elbv2.DescribeLoadBalancersOutput{
+						LoadBalancers: []elbv2types.LoadBalancer{
+							{
+								LoadBalancerArn:  aws.String(elbArn),
+								LoadBalancerName: aws.String(elbName),
+								Scheme:           SchemeToSDKScheme(infrav1.ELBSchemeInternetFacing),
+							},
+						},
+					},
+					nil,
+				)
+
+				m.DescribeLoadBalancerAttributes(gomock.Any(), &elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: aws.String(elbArn)}).Return(
+					&elbv2.DescribeLoadBalancerAttributesOutput{
+						Attributes: []elbv2types.LoadBalancerAttribute{
+							{
+								Key:   aws.String("load_balancing.cross_zone.enabled"),
+								Value: aws.String("false"),
+							},
+							{
+								Key:   aws.String("deletion_protection.enabled"),
+								Value: aws.String("true"),
+							},
+						},
+					},
+					nil,
+				)
+
+				m.DescribeTags(gomock.Any(), &elbv2.DescribeTagsInput{ResourceArns: []string{elbArn}}).Return(
+					&elbv2.DescribeTagsOutput{
+						TagDescriptions: []elbv2types.TagDescription{
+							{
+								ResourceArn: aws.String(elbArn),
+								Tags: []elbv2types.Tag{{
+									Key:   aws.String(infrav1.ClusterTagKey(clusterName)),
+									Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+								}},
+							},
+						},
+					},
+					nil,
+				)
+
+				m.ModifyLoadBalancerAttributes(gomock.Any(), &elbv2.ModifyLoadBalancerAttributesInput{
+					LoadBalancerArn: aws.String(elbArn),
+					Attributes: []elbv2types.LoadBalancerAttribute{
+						{
+							Key:   aws.String("deletion_protection.enabled"),
+							Value: aws.String("false"),
+						},
+					},
+				}).Return(&elbv2.ModifyLoadBalancerAttributesOutput{}, nil)
+
+				// delete listeners
+				m.DescribeListeners(gomock.Any(), &elbv2.DescribeListenersInput{LoadBalancerArn: aws.String(elbArn)}).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []elbv2types.Listener{
+						{
+							ListenerArn: aws.String("listener::arn"),
+						},
+					},
+				}, nil)
+				m.DeleteListener(gomock.Any(), &elbv2.DeleteListenerInput{ListenerArn: aws.String("listener::arn")}).Return(&elbv2.DeleteListenerOutput{}, nil)
+				// delete target groups
+				m.DescribeTargetGroups(gomock.Any(), &elbv2.DescribeTargetGroupsInput{LoadBalancerArn: aws.String(elbArn)}).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []elbv2types.TargetGroup{
+						{
+							TargetGroupArn: aws.String(tgArn),
+						},
+					},
+				}, nil)
+				m.DeleteTargetGroup(gomock.Any(), &elbv2.DeleteTargetGroupInput{TargetGroupArn: aws.String(tgArn)}).Return(&elbv2.DeleteTargetGroupOutput{}, nil)
+				// delete the load balancer
+
 				m.DeleteLoadBalancer(gomock.Any(), &elbv2.DeleteLoadBalancerInput{LoadBalancerArn: aws.String(elbArn)}).Return(
 					&elbv2.DeleteLoadBalancerOutput{}, nil)
 
@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+// defaultLoadBalancerMigrationDrainPeriod is how long the source load balancer of a migration
+// is kept after the cutover, unless overridden with the drain period annotation.
+const defaultLoadBalancerMigrationDrainPeriod = 5 * time.Minute
+
+// startLoadBalancerMigration records the classic load balancer serving the control plane as the source
+// of a migration when the type of the control plane load balancer was changed to a v2 load balancer type.
+// Until the cutover, the source keeps being reconciled as the primary control plane load balancer and the
+// new load balancer is reconciled as the secondary one, see ClusterScope.ControlPlaneLoadBalancers.
+// The source stays in place until the control plane endpoint was cut over and the drain period elapsed.
+func (s *Service) startLoadBalancerMigration() {
+	if s.scope.ControlPlaneLoadBalancerMigration() != nil {
+		return
+	}
+
+	lbSpec := s.scope.ControlPlaneLoadBalancer()
+	if lbSpec == nil {
+		return
+	}
+	switch lbSpec.LoadBalancerType {
+	case infrav1.LoadBalancerTypeNLB, infrav1.LoadBalancerTypeALB, infrav1.LoadBalancerTypeELB:
+	default:
+		return
+	}
+
+	current := s.scope.Network().APIServerELB
+	if current.DNSName == "" || !isClassicLoadBalancer(&current) {
+		return
+	}
+
+	s.scope.Info("Migrating control plane load balancer", "source", current.Name, "type", lbSpec.LoadBalancerType)
+	record.Eventf(s.scope.InfraCluster(), "MigratingControlPlaneLoadBalancer", "Migrating control plane load balancer %q to type %s", current.Name, lbSpec.LoadBalancerType)
+	s.scope.SetControlPlaneLoadBalancerMigration(&infrav1.LoadBalancerMigrationStatus{
+		Phase:  infrav1.LoadBalancerMigrationPhaseProvisioning,
+		Source: *current.DeepCopy(),
+	})
+	v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.ControlPlaneLoadBalancerMigrationCondition, infrav1.LoadBalancerMigrationProvisioningReason, clusterv1beta1.ConditionSeverityInfo, "")
+}
+
+// reconcileLoadBalancerMigration moves an in-progress migration of the control plane load balancer
+// through its phases. It expects the new load balancer to have been reconciled into the network status,
+// as the secondary load balancer until the cutover and as the primary one afterwards.
+func (s *Service) reconcileLoadBalancerMigration(ctx context.Context) error {
+	migration := s.scope.ControlPlaneLoadBalancerMigration()
+	if migration == nil {
+		return nil
+	}
+
+	network := s.scope.Network()
+	target := network.SecondaryAPIServerELB
+	if migration.IsCutOver() {
+		target = network.APIServerELB
+	}
+	switch migration.Phase {
+	case infrav1.LoadBalancerMigrationPhaseProvisioning:
+		ready, err := s.isLoadBalancerMigrationTargetReady(ctx, migration)
+		if err != nil {
+			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.ControlPlaneLoadBalancerMigrationCondition, infrav1.LoadBalancerMigrationFailedReason, clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+			return err
+		}
+		if !ready {
+			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.ControlPlaneLoadBalancerMigrationCondition, infrav1.LoadBalancerMigrationProvisioningReason, clusterv1beta1.ConditionSeverityInfo,
+				"Waiting for the control plane instances to become healthy in load balancer %q", target.Name)
+			return nil
+		}
+		s.scope.Info("Control plane load balancer migration is ready for cutover", "target", target.Name)
+		migration.Phase = infrav1.LoadBalancerMigrationPhaseWaitingForCutover
+		fallthrough
+
+	case infrav1.LoadBalancerMigrationPhaseWaitingForCutover:
+		if s.scope.InfraCluster().GetAnnotations()[infrav1.ControlPlaneLoadBalancerCutoverAnnotation] != "true" {
+			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.ControlPlaneLoadBalancerMigrationCondition, infrav1.LoadBalancerMigrationWaitingForCutoverReason, clusterv1beta1.ConditionSeverityInfo,
+				"Set the %s annotation to switch the control plane endpoint to load balancer %q", infrav1.ControlPlaneLoadBalancerCutoverAnnotation, target.Name)
+			return nil
+		}
+		s.scope.Info("Cutting over control plane endpoint", "source", migration.Source.Name, "target", target.Name)
+		record.Eventf(s.scope.InfraCluster(), "CutOverControlPlaneLoadBalancer", "Switched control plane endpoint from load balancer %q to %q", migration.Source.Name, target.Name)
+		migration.Phase = infrav1.LoadBalancerMigrationPhaseDraining
+		migration.CutoverTime = &metav1.Time{Time: time.Now()}
+		// The new load balancer becomes the primary control plane load balancer, which switches the
+		// control plane endpoint to it.
+		network.APIServerELB.DeepCopyInto(&migration.Source)
+		target.DeepCopyInto(&network.APIServerELB)
+		network.SecondaryAPIServerELB = infrav1.LoadBalancer{}
+		fallthrough
+
+	case infrav1.LoadBalancerMigrationPhaseDraining:
+		drainPeriod, err := s.loadBalancerMigrationDrainPeriod()
+		if err != nil {
+			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.ControlPlaneLoadBalancerMigrationCondition, infrav1.LoadBalancerMigrationFailedReason, clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+			return err
+		}
+		if migration.CutoverTime == nil {
+			migration.CutoverTime = &metav1.Time{Time: time.Now()}
+		}
+		if remaining := time.Until(migration.CutoverTime.Add(drainPeriod)); remaining > 0 {
+			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.ControlPlaneLoadBalancerMigrationCondition, infrav1.LoadBalancerMigrationDrainingReason, clusterv1beta1.ConditionSeverityInfo,
+				"Deleting load balancer %q in %s", migration.Source.Name, remaining.Round(time.Second))
+			return nil
+		}
+		if err := s.deleteLoadBalancerMigrationSource(ctx, migration); err != nil {
+			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.ControlPlaneLoadBalancerMigrationCondition, infrav1.LoadBalancerMigrationFailedReason, clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+			return err
+		}
+		record.Eventf(s.scope.InfraCluster(), "MigratedControlPlaneLoadBalancer", "Migrated control plane load balancer from %q to %q", migration.Source.Name, target.Name)
+		s.scope.SetControlPlaneLoadBalancerMigration(nil)
+		v1beta1conditions.MarkTrue(s.scope.InfraCluster(), infrav1.ControlPlaneLoadBalancerMigrationCondition)
+		return nil
+
+	default:
+		return errors.Errorf("unknown control plane load balancer migration phase %q", migration.Phase)
+	}
+}
+
+// isLoadBalancerMigrationTargetReady returns true once every instance registered with the source
+// load balancer is a healthy target of the API server target group of the new load balancer.
+func (s *Service) isLoadBalancerMigrationTargetReady(ctx context.Context, migration *infrav1.LoadBalancerMigrationStatus) (bool, error) {
+	target := s.scope.Network().SecondaryAPIServerELB
+	if target.ARN == "" || isClassicLoadBalancer(&target) {
+		return false, nil
+	}
+
+	out, err := s.ELBClient.DescribeLoadBalancers(ctx, &elb.DescribeLoadBalancersInput{
+		LoadBalancerNames: []string{migration.Source.Name},
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to describe source load balancer %q", migration.Source.Name)
+	}
+	sourceInstances := sets.New[string]()
+	for _, lb := range out.LoadBalancerDescriptions {
+		for _, instance := range lb.Instances {
+			sourceInstances.Insert(aws.ToString(instance.InstanceId))
+		}
+	}
+
+	groups, err := s.ELBV2Client.DescribeTargetGroups(ctx, &elbv2.DescribeTargetGroupsInput{
+		LoadBalancerArn: aws.String(target.ARN),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to describe target groups of load balancer %q", target.Name)
+	}
+	healthyTargets := sets.New[string]()
+	for _, group := range groups.TargetGroups {
+		if !strings.HasPrefix(aws.ToString(group.TargetGroupName), apiServerTargetGroupPrefix) {
+			continue
+		}
+		health, err := s.ELBV2Client.DescribeTargetHealth(ctx, &elbv2.DescribeTargetHealthInput{
+			TargetGroupArn: group.TargetGroupArn,
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to describe target health of target group %q", aws.ToString(group.TargetGroupName))
+		}
+		for _, desc := range health.TargetHealthDescriptions {
+			if desc.Target != nil && desc.TargetHealth != nil && desc.TargetHealth.State == elbv2types.TargetHealthStateEnumHealthy {
+				healthyTargets.Insert(aws.ToString(desc.Target.Id))
+			}
+		}
+	}
+
+	return healthyTargets.Len() > 0 && healthyTargets.IsSuperset(sourceInstances), nil
+}
+
+// loadBalancerMigrationDrainPeriod returns how long the source load balancer is kept after the cutover.
+func (s *Service) loadBalancerMigrationDrainPeriod() (time.Duration, error) {
+	value, ok := s.scope.InfraCluster().GetAnnotations()[infrav1.ControlPlaneLoadBalancerDrainPeriodAnnotation]
+	if !ok {
+		return defaultLoadBalancerMigrationDrainPeriod, nil
+	}
+	drainPeriod, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid value for annotation %s", infrav1.ControlPlaneLoadBalancerDrainPeriodAnnotation)
+	}
+	if drainPeriod < 0 {
+		return 0, errors.Errorf("invalid value for annotation %s: the drain period must not be negative", infrav1.ControlPlaneLoadBalancerDrainPeriodAnnotation)
+	}
+	return drainPeriod, nil
+}
+
+// deleteLoadBalancerMigrationSource deletes the source load balancer of a migration, unless it isn't managed.
+func (s *Service) deleteLoadBalancerMigrationSource(ctx context.Context, migration *infrav1.LoadBalancerMigrationStatus) error {
+	if migration.Source.IsUnmanaged(s.scope.Name()) {
+		s.scope.Debug("Source load balancer of the migration is unmanaged, skipping deletion", "api-server-elb-name", migration.Source.Name)
+		return nil
+	}
+
+	s.scope.Debug("deleting source load balancer of the migration", "name", migration.Source.Name)
+	if err := s.deleteClassicELB(ctx, migration.Source.Name); err != nil && !IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete source load balancer %q", migration.Source.Name)
+	}
+	return nil
+}
+
+// isClassicLoadBalancer returns true if the load balancer status describes a classic load balancer.
+// Statuses written by older releases don't report the type, but only v2 load balancers have an ARN.
+func isClassicLoadBalancer(lb *infrav1.LoadBalancer) bool {
+	if lb.LoadBalancerType == "" {
+		return lb.ARN == ""
+	}
+	return lb.LoadBalancerType == infrav1.LoadBalancerTypeClassic
+}
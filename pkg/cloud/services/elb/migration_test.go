@@ -0,0 +1,438 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+const (
+	migrationClusterName = "bar"
+	migrationClassicName = "bar-apiserver"
+	migrationNLBArn      = "arn::nlb"
+	migrationTGArn       = "arn::target-group"
+)
+
+var migrationSource = infrav1.LoadBalancer{
+	Name:             migrationClassicName,
+	DNSName:          "classic.elb.amazonaws.com",
+	LoadBalancerType: infrav1.LoadBalancerTypeClassic,
+	Tags:             infrav1.Tags{infrav1.ClusterTagKey(migrationClusterName): string(infrav1.ResourceLifecycleOwned)},
+}
+
+var migrationTarget = infrav1.LoadBalancer{
+	Name:             "bar-nlb",
+	ARN:              migrationNLBArn,
+	DNSName:          "nlb.elb.amazonaws.com",
+	LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+}
+
+func TestStartLoadBalancerMigration(t *testing.T) {
+	tests := []struct {
+		name          string
+		lbType        infrav1.LoadBalancerType
+		apiServerELB  infrav1.LoadBalancer
+		wantMigration bool
+	}{
+		{
+			name:          "starts a migration when a classic load balancer is changed to nlb",
+			lbType:        infrav1.LoadBalancerTypeNLB,
+			apiServerELB:  migrationSource,
+			wantMigration: true,
+		},
+		{
+			name:   "starts a migration for statuses without a load balancer type",
+			lbType: infrav1.LoadBalancerTypeALB,
+			apiServerELB: infrav1.LoadBalancer{
+				Name:    migrationClassicName,
+				DNSName: "classic.elb.amazonaws.com",
+			},
+			wantMigration: true,
+		},
+		{
+			name:         "does not start a migration for a classic load balancer",
+			lbType:       infrav1.LoadBalancerTypeClassic,
+			apiServerELB: migrationSource,
+		},
+		{
+			name:   "does not start a migration before the load balancer is created",
+			lbType: infrav1.LoadBalancerTypeNLB,
+		},
+		{
+			name:   "does not start a migration when the nlb is already in place",
+			lbType: infrav1.LoadBalancerTypeNLB,
+			apiServerELB: infrav1.LoadBalancer{
+				ARN:              migrationNLBArn,
+				DNSName:          "nlb.elb.amazonaws.com",
+				LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{LoadBalancerType: tc.lbType},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{APIServerELB: tc.apiServerELB},
+				},
+			}
+			s := &Service{scope: newMigrationClusterScope(t, awsCluster)}
+
+			s.startLoadBalancerMigration()
+
+			migration := awsCluster.Status.ControlPlaneLoadBalancerMigration
+			if !tc.wantMigration {
+				g.Expect(migration).To(BeNil())
+				return
+			}
+			g.Expect(migration).ToNot(BeNil())
+			g.Expect(migration.Phase).To(Equal(infrav1.LoadBalancerMigrationPhaseProvisioning))
+			g.Expect(migration.Source).To(Equal(tc.apiServerELB))
+			g.Expect(v1beta1conditions.GetReason(awsCluster, infrav1.ControlPlaneLoadBalancerMigrationCondition)).To(Equal(infrav1.LoadBalancerMigrationProvisioningReason))
+		})
+	}
+}
+
+func TestReconcileLoadBalancerMigration(t *testing.T) {
+	longAgo := metav1.NewTime(time.Now().Add(-time.Hour))
+	recently := metav1.NewTime(time.Now().Add(-time.Minute))
+
+	tests := []struct {
+		name          string
+		annotations   map[string]string
+		migration     infrav1.LoadBalancerMigrationStatus
+		elbAPIMocks   func(m *mocks.MockELBAPIMockRecorder)
+		elbv2APIMocks func(m *mocks.MockELBV2APIMockRecorder)
+		wantPhase     infrav1.LoadBalancerMigrationPhase
+		wantReason    string
+		wantCompleted bool
+	}{
+		{
+			name:      "keeps provisioning until every source instance is healthy in the new load balancer",
+			migration: infrav1.LoadBalancerMigrationStatus{Phase: infrav1.LoadBalancerMigrationPhaseProvisioning, Source: migrationSource},
+			elbAPIMocks: func(m *mocks.MockELBAPIMockRecorder) {
+				expectSourceInstances(m, "i-1", "i-2")
+			},
+			elbv2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				expectTargetHealth(m, map[string]elbv2types.TargetHealthStateEnum{
+					"i-1": elbv2types.TargetHealthStateEnumHealthy,
+					"i-2": elbv2types.TargetHealthStateEnumInitial,
+				})
+			},
+			wantPhase:  infrav1.LoadBalancerMigrationPhaseProvisioning,
+			wantReason: infrav1.LoadBalancerMigrationProvisioningReason,
+		},
+		{
+			name:      "waits for the cutover annotation once the new load balancer is ready",
+			migration: infrav1.LoadBalancerMigrationStatus{Phase: infrav1.LoadBalancerMigrationPhaseProvisioning, Source: migrationSource},
+			elbAPIMocks: func(m *mocks.MockELBAPIMockRecorder) {
+				expectSourceInstances(m, "i-1")
+			},
+			elbv2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				expectTargetHealth(m, map[string]elbv2types.TargetHealthStateEnum{
+					"i-1": elbv2types.TargetHealthStateEnumHealthy,
+				})
+			},
+			wantPhase:  infrav1.LoadBalancerMigrationPhaseWaitingForCutover,
+			wantReason: infrav1.LoadBalancerMigrationWaitingForCutoverReason,
+		},
+		{
+			name:        "cuts over and drains the source load balancer",
+			annotations: map[string]string{infrav1.ControlPlaneLoadBalancerCutoverAnnotation: "true"},
+			migration:   infrav1.LoadBalancerMigrationStatus{Phase: infrav1.LoadBalancerMigrationPhaseWaitingForCutover, Source: migrationSource},
+			wantPhase:   infrav1.LoadBalancerMigrationPhaseDraining,
+			wantReason:  infrav1.LoadBalancerMigrationDrainingReason,
+		},
+		{
+			name:        "keeps the source load balancer for the configured drain period",
+			annotations: map[string]string{infrav1.ControlPlaneLoadBalancerDrainPeriodAnnotation: "2h"},
+			migration:   infrav1.LoadBalancerMigrationStatus{Phase: infrav1.LoadBalancerMigrationPhaseDraining, Source: migrationSource, CutoverTime: &longAgo},
+			wantPhase:   infrav1.LoadBalancerMigrationPhaseDraining,
+			wantReason:  infrav1.LoadBalancerMigrationDrainingReason,
+		},
+		{
+			name:       "keeps the source load balancer for the default drain period",
+			migration:  infrav1.LoadBalancerMigrationStatus{Phase: infrav1.LoadBalancerMigrationPhaseDraining, Source: migrationSource, CutoverTime: &recently},
+			wantPhase:  infrav1.LoadBalancerMigrationPhaseDraining,
+			wantReason: infrav1.LoadBalancerMigrationDrainingReason,
+		},
+		{
+			name:      "deletes the source load balancer after the drain period",
+			migration: infrav1.LoadBalancerMigrationStatus{Phase: infrav1.LoadBalancerMigrationPhaseDraining, Source: migrationSource, CutoverTime: &longAgo},
+			elbAPIMocks: func(m *mocks.MockELBAPIMockRecorder) {
+				m.DeleteLoadBalancer(gomock.Any(), &elb.DeleteLoadBalancerInput{LoadBalancerName: aws.String(migrationClassicName)}).
+					Return(&elb.DeleteLoadBalancerOutput{}, nil)
+			},
+			wantCompleted: true,
+		},
+		{
+			name: "does not delete an unmanaged source load balancer",
+			migration: infrav1.LoadBalancerMigrationStatus{
+				Phase:       infrav1.LoadBalancerMigrationPhaseDraining,
+				Source:      infrav1.LoadBalancer{Name: migrationClassicName, LoadBalancerType: infrav1.LoadBalancerTypeClassic},
+				CutoverTime: &longAgo,
+			},
+			wantCompleted: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			elbAPIMocks := mocks.NewMockELBAPI(mockCtrl)
+			elbv2APIMocks := mocks.NewMockELBV2API(mockCtrl)
+			if tc.elbAPIMocks != nil {
+				tc.elbAPIMocks(elbAPIMocks.EXPECT())
+			}
+			if tc.elbv2APIMocks != nil {
+				tc.elbv2APIMocks(elbv2APIMocks.EXPECT())
+			}
+
+			migration := tc.migration.DeepCopy()
+			// Until the cutover, the new load balancer is reconciled as the secondary control plane load balancer.
+			network := infrav1.NetworkStatus{APIServerELB: *migration.Source.DeepCopy(), SecondaryAPIServerELB: migrationTarget}
+			if migration.IsCutOver() {
+				network = infrav1.NetworkStatus{APIServerELB: migrationTarget}
+			}
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Annotations: tc.annotations},
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{LoadBalancerType: infrav1.LoadBalancerTypeNLB},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network:                           network,
+					ControlPlaneLoadBalancerMigration: migration,
+				},
+			}
+			s := &Service{
+				scope:       newMigrationClusterScope(t, awsCluster),
+				ELBClient:   elbAPIMocks,
+				ELBV2Client: elbv2APIMocks,
+			}
+
+			g.Expect(s.reconcileLoadBalancerMigration(context.TODO())).To(Succeed())
+
+			if tc.wantPhase == infrav1.LoadBalancerMigrationPhaseDraining || tc.wantCompleted {
+				// The cutover makes the new load balancer the primary control plane load balancer.
+				g.Expect(awsCluster.Status.Network.APIServerELB).To(Equal(migrationTarget))
+				g.Expect(awsCluster.Status.Network.SecondaryAPIServerELB).To(Equal(infrav1.LoadBalancer{}))
+			} else {
+				g.Expect(awsCluster.Status.Network.APIServerELB.Name).To(Equal(migrationClassicName))
+				g.Expect(awsCluster.Status.Network.SecondaryAPIServerELB).To(Equal(migrationTarget))
+			}
+			if tc.wantCompleted {
+				g.Expect(awsCluster.Status.ControlPlaneLoadBalancerMigration).To(BeNil())
+				g.Expect(v1beta1conditions.IsTrue(awsCluster, infrav1.ControlPlaneLoadBalancerMigrationCondition)).To(BeTrue())
+				return
+			}
+			g.Expect(awsCluster.Status.ControlPlaneLoadBalancerMigration).ToNot(BeNil())
+			g.Expect(awsCluster.Status.ControlPlaneLoadBalancerMigration.Phase).To(Equal(tc.wantPhase))
+			if tc.wantPhase == infrav1.LoadBalancerMigrationPhaseDraining {
+				g.Expect(awsCluster.Status.ControlPlaneLoadBalancerMigration.CutoverTime).ToNot(BeNil())
+			}
+			g.Expect(v1beta1conditions.GetReason(awsCluster, infrav1.ControlPlaneLoadBalancerMigrationCondition)).To(Equal(tc.wantReason))
+			g.Expect(v1beta1conditions.GetSeverity(awsCluster, infrav1.ControlPlaneLoadBalancerMigrationCondition)).To(HaveValue(Equal(clusterv1beta1.ConditionSeverityInfo)))
+		})
+	}
+}
+
+func TestControlPlaneLoadBalancersDuringMigration(t *testing.T) {
+	tests := []struct {
+		name        string
+		migration   *infrav1.LoadBalancerMigrationStatus
+		wantClassic bool
+	}{
+		{
+			name: "returns the spec without a migration",
+		},
+		{
+			name:        "reconciles the source as primary and the new load balancer as secondary until the cutover",
+			migration:   &infrav1.LoadBalancerMigrationStatus{Phase: infrav1.LoadBalancerMigrationPhaseWaitingForCutover, Source: migrationSource},
+			wantClassic: true,
+		},
+		{
+			name:      "returns the spec once the migration is cut over",
+			migration: &infrav1.LoadBalancerMigrationStatus{Phase: infrav1.LoadBalancerMigrationPhaseDraining, Source: migrationSource},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			lbSpec := &infrav1.AWSLoadBalancerSpec{
+				LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+				Scheme:           &infrav1.ELBSchemeInternetFacing,
+				IngressRules:     []infrav1.IngressRule{{Description: "test", Protocol: infrav1.SecurityGroupProtocolTCP}},
+			}
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       infrav1.AWSClusterSpec{ControlPlaneLoadBalancer: lbSpec},
+				Status:     infrav1.AWSClusterStatus{ControlPlaneLoadBalancerMigration: tc.migration},
+			}
+			s := &Service{scope: newMigrationClusterScope(t, awsCluster)}
+
+			lbSpecs := s.scope.ControlPlaneLoadBalancers()
+			g.Expect(lbSpecs).To(HaveLen(2))
+			if !tc.wantClassic {
+				g.Expect(lbSpecs[0]).To(Equal(lbSpec))
+				g.Expect(lbSpecs[1]).To(BeNil())
+				g.Expect(s.isSecondaryControlPlaneLB(&migrationTarget)).To(BeFalse())
+				return
+			}
+			// The classic load balancer only carries what identifies it, so the rules of the spec aren't applied twice.
+			g.Expect(lbSpecs[0]).To(Equal(&infrav1.AWSLoadBalancerSpec{
+				Scheme:           &infrav1.ELBSchemeInternetFacing,
+				LoadBalancerType: infrav1.LoadBalancerTypeClassic,
+			}))
+			g.Expect(lbSpecs[1]).To(Equal(lbSpec))
+
+			name, err := LBName(s.scope, lbSpec)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(s.isSecondaryControlPlaneLB(&infrav1.LoadBalancer{Name: name})).To(BeTrue())
+		})
+	}
+}
+
+func TestLoadBalancerMigrationDrainPeriod(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        time.Duration
+		wantErr     bool
+	}{
+		{
+			name: "defaults the drain period",
+			want: defaultLoadBalancerMigrationDrainPeriod,
+		},
+		{
+			name:        "uses the drain period annotation",
+			annotations: map[string]string{infrav1.ControlPlaneLoadBalancerDrainPeriodAnnotation: "30m"},
+			want:        30 * time.Minute,
+		},
+		{
+			name:        "rejects an invalid drain period",
+			annotations: map[string]string{infrav1.ControlPlaneLoadBalancerDrainPeriodAnnotation: "soon"},
+			wantErr:     true,
+		},
+		{
+			name:        "rejects a negative drain period",
+			annotations: map[string]string{infrav1.ControlPlaneLoadBalancerDrainPeriodAnnotation: "-1m"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			awsCluster := &infrav1.AWSCluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Annotations: tc.annotations}}
+			s := &Service{scope: newMigrationClusterScope(t, awsCluster)}
+
+			drainPeriod, err := s.loadBalancerMigrationDrainPeriod()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(drainPeriod).To(Equal(tc.want))
+		})
+	}
+}
+
+func newMigrationClusterScope(t *testing.T, awsCluster *infrav1.AWSCluster) *scope.ClusterScope {
+	t.Helper()
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).WithStatusSubresource(awsCluster).Build()
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      migrationClusterName,
+			},
+		},
+		AWSCluster: awsCluster,
+		Client:     client,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return clusterScope
+}
+
+func expectSourceInstances(m *mocks.MockELBAPIMockRecorder, instanceIDs ...string) {
+	instances := make([]elbtypes.Instance, 0, len(instanceIDs))
+	for _, id := range instanceIDs {
+		instances = append(instances, elbtypes.Instance{InstanceId: aws.String(id)})
+	}
+	m.DescribeLoadBalancers(gomock.Any(), &elb.DescribeLoadBalancersInput{LoadBalancerNames: []string{migrationClassicName}}).
+		Return(&elb.DescribeLoadBalancersOutput{
+			LoadBalancerDescriptions: []elbtypes.LoadBalancerDescription{{
+				LoadBalancerName: aws.String(migrationClassicName),
+				Instances:        instances,
+			}},
+		}, nil)
+}
+
+func expectTargetHealth(m *mocks.MockELBV2APIMockRecorder, targets map[string]elbv2types.TargetHealthStateEnum) {
+	m.DescribeTargetGroups(gomock.Any(), &elbv2.DescribeTargetGroupsInput{LoadBalancerArn: aws.String(migrationNLBArn)}).
+		Return(&elbv2.DescribeTargetGroupsOutput{
+			TargetGroups: []elbv2types.TargetGroup{
+				{TargetGroupArn: aws.String(migrationTGArn), TargetGroupName: aws.String(apiServerTargetGroupPrefix + "abc")},
+				{TargetGroupArn: aws.String("arn::other"), TargetGroupName: aws.String(additionalTargetGroupPrefix + "abc")},
+			},
+		}, nil)
+
+	descriptions := make([]elbv2types.TargetHealthDescription, 0, len(targets))
+	for id, state := range targets {
+		descriptions = append(descriptions, elbv2types.TargetHealthDescription{
+			Target:       &elbv2types.TargetDescription{Id: aws.String(id)},
+			TargetHealth: &elbv2types.TargetHealth{State: state},
+		})
+	}
+	m.DescribeTargetHealth(gomock.Any(), &elbv2.DescribeTargetHealthInput{TargetGroupArn: aws.String(migrationTGArn)}).
+		Return(&elbv2.DescribeTargetHealthOutput{TargetHealthDescriptions: descriptions}, nil)
+}
@@ -19,6 +19,7 @@ package gc
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -58,35 +59,54 @@ func (s *Service) ReconcileDelete(ctx context.Context) error {
 		return nil
 	}
 
+	if s.isDryRun() {
+		report, err := s.Preview(ctx)
+		if err != nil {
+			return fmt.Errorf("previewing garbage collection: %w", err)
+		}
+		s.recordReport(report)
+
+		// The previewed resources would otherwise be left behind once the finalizer is removed, and
+		// could block the deletion of the VPC. Block the deletion until the dry-run is turned off.
+		return fmt.Errorf("garbage collection is in dry-run mode (%s): remove the annotation %s or set it to false to delete the cluster",
+			report.Summary(), infrav1.ExternalResourceGCDryRunAnnotation)
+	}
+
 	return s.deleteResources(ctx)
 }
 
 func (s *Service) deleteResources(ctx context.Context) error {
 	s.scope.Info("deleting aws resources created by tenant cluster", "cluster", s.scope.InfraClusterName())
 
-	resources, err := s.collectFuncs.Execute(ctx)
-	if err != nil {
-		return fmt.Errorf("collecting resources: %w", err)
-	}
-
+	collectFuncs := s.collectFuncs
 	cleanupFuncs := s.cleanupFuncs
 
 	if val, found := annotations.Get(s.scope.InfraCluster(), infrav1.ExternalResourceGCTasksAnnotation); found {
-		var gcTaskToFunc = map[infrav1.GCTask]ResourceCleanupFunc{
-			infrav1.GCTaskLoadBalancer:  s.deleteLoadBalancers,
-			infrav1.GCTaskTargetGroup:   s.deleteTargetGroups,
-			infrav1.GCTaskSecurityGroup: s.deleteSecurityGroups,
-		}
+		gcTasks := s.gcTasks()
 
+		collectFuncs = slices.Clone(s.collectFuncs)
 		cleanupFuncs = ResourceCleanupFuncs{}
 
 		tasks := strings.Split(val, ",")
 
 		for _, task := range tasks {
-			cleanupFuncs = append(cleanupFuncs, gcTaskToFunc[infrav1.GCTask(task)])
+			funcs, ok := gcTasks[infrav1.GCTask(task)]
+			if !ok {
+				s.scope.Info("skipping unsupported garbage collection task", "task", task)
+				continue
+			}
+			cleanupFuncs = append(cleanupFuncs, funcs.cleanup)
+			if s.alternativeGCStrategy && funcs.collect != nil {
+				collectFuncs = append(collectFuncs, funcs.collect)
+			}
 		}
 	}
 
+	resources, err := collectFuncs.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("collecting resources: %w", err)
+	}
+
 	if deleteErr := cleanupFuncs.Execute(ctx, resources); deleteErr != nil {
 		return fmt.Errorf("deleting resources: %w", deleteErr)
 	}
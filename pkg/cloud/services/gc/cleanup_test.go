@@ -19,13 +19,18 @@ package gc
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
 	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	rgapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
 	rgapitypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/aws/smithy-go"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -40,6 +45,8 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	capicache "sigs.k8s.io/cluster-api/util/cache"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
 func TestReconcileDelete(t *testing.T) {
@@ -877,6 +884,360 @@ func TestReconcileDelete(t *testing.T) {
 	}
 }
 
+func TestReconcileDeleteWorkloadResources(t *testing.T) {
+	workloadResources := []rgapitypes.ResourceTagMapping{
+		{
+			ResourceARN: aws.String("arn:aws:elasticloadbalancing:eu-west-2:1234567890:loadbalancer/aec24434cd2ce4630bd14a955413ee37"),
+		},
+		{
+			ResourceARN: aws.String("arn:aws:ec2:eu-west-2:1234567890:volume/vol-1234"),
+		},
+		{
+			ResourceARN: aws.String("arn:aws:ec2:eu-west-2::snapshot/snap-1234"),
+		},
+		{
+			ResourceARN: aws.String("arn:aws:ec2:eu-west-2:1234567890:network-interface/eni-1234"),
+		},
+		{
+			ResourceARN: aws.String("arn:aws:ec2:eu-west-2:1234567890:network-interface/eni-5678"),
+		},
+		{
+			ResourceARN: aws.String("arn:aws:ec2:eu-west-2:1234567890:elastic-ip/eipalloc-1234"),
+		},
+		{
+			ResourceARN: aws.String("arn:aws:elasticfilesystem:eu-west-2:1234567890:access-point/fsap-1234"),
+		},
+	}
+	ownedTag := ec2types.Tag{Key: aws.String("kubernetes.io/cluster/cluster1"), Value: aws.String("owned")}
+
+	testCases := []struct {
+		name                  string
+		tasks                 string
+		alternativeGCStrategy bool
+		rgAPIMocks            func(m *mocks.MockResourceGroupsTaggingAPIAPIMockRecorder)
+		elbMocks              func(m *mocks.MockELBAPIMockRecorder)
+		elbv2Mocks            func(m *mocks.MockELBV2APIMockRecorder)
+		ec2Mocks              func(m *mocks.MockEC2APIMockRecorder)
+		efsMocks              func(m *mocks.MockEFSAPIMockRecorder)
+		expectErr             bool
+	}{
+		{
+			name:  "deletes workload resources of the requested tasks only",
+			tasks: "volume,snapshot,network-interface,elastic-ip,efs-access-point",
+			rgAPIMocks: func(m *mocks.MockResourceGroupsTaggingAPIAPIMockRecorder) {
+				m.GetResources(gomock.Any(), gomock.Any()).Return(&rgapi.GetResourcesOutput{
+					ResourceTagMappingList: workloadResources,
+				}, nil)
+			},
+			elbMocks:   func(m *mocks.MockELBAPIMockRecorder) {},
+			elbv2Mocks: func(m *mocks.MockELBV2APIMockRecorder) {},
+			ec2Mocks: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DeleteVolume(gomock.Any(), &ec2.DeleteVolumeInput{VolumeId: aws.String("vol-1234")}).Return(&ec2.DeleteVolumeOutput{}, nil)
+				m.DeleteSnapshot(gomock.Any(), &ec2.DeleteSnapshotInput{SnapshotId: aws.String("snap-1234")}).Return(&ec2.DeleteSnapshotOutput{}, nil)
+				m.DescribeNetworkInterfaces(gomock.Any(), &ec2.DescribeNetworkInterfacesInput{
+					Filters: []ec2types.Filter{
+						{
+							Name:   aws.String("network-interface-id"),
+							Values: []string{"eni-1234", "eni-5678"},
+						},
+						{
+							Name:   aws.String("status"),
+							Values: []string{"available"},
+						},
+					},
+				}, gomock.Any()).Return(&ec2.DescribeNetworkInterfacesOutput{
+					NetworkInterfaces: []ec2types.NetworkInterface{
+						{NetworkInterfaceId: aws.String("eni-1234")},
+					},
+				}, nil)
+				m.DeleteNetworkInterface(gomock.Any(), &ec2.DeleteNetworkInterfaceInput{NetworkInterfaceId: aws.String("eni-1234")}).Return(&ec2.DeleteNetworkInterfaceOutput{}, nil)
+				m.ReleaseAddress(gomock.Any(), &ec2.ReleaseAddressInput{AllocationId: aws.String("eipalloc-1234")}).Return(&ec2.ReleaseAddressOutput{}, nil)
+			},
+			efsMocks: func(m *mocks.MockEFSAPIMockRecorder) {
+				m.DeleteAccessPoint(gomock.Any(), &efs.DeleteAccessPointInput{AccessPointId: aws.String("fsap-1234")}).Return(&efs.DeleteAccessPointOutput{}, nil)
+			},
+		},
+		{
+			name:  "ignores resources already deleted",
+			tasks: "volume,efs-access-point",
+			rgAPIMocks: func(m *mocks.MockResourceGroupsTaggingAPIAPIMockRecorder) {
+				m.GetResources(gomock.Any(), gomock.Any()).Return(&rgapi.GetResourcesOutput{
+					ResourceTagMappingList: workloadResources,
+				}, nil)
+			},
+			elbMocks:   func(m *mocks.MockELBAPIMockRecorder) {},
+			elbv2Mocks: func(m *mocks.MockELBV2APIMockRecorder) {},
+			ec2Mocks: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DeleteVolume(gomock.Any(), gomock.Any()).Return(nil, &smithy.GenericAPIError{Code: "InvalidVolume.NotFound"})
+			},
+			efsMocks: func(m *mocks.MockEFSAPIMockRecorder) {
+				m.DeleteAccessPoint(gomock.Any(), gomock.Any()).Return(nil, &efstypes.AccessPointNotFound{})
+			},
+		},
+		{
+			name:  "fails when a volume is still in use",
+			tasks: "volume",
+			rgAPIMocks: func(m *mocks.MockResourceGroupsTaggingAPIAPIMockRecorder) {
+				m.GetResources(gomock.Any(), gomock.Any()).Return(&rgapi.GetResourcesOutput{
+					ResourceTagMappingList: workloadResources,
+				}, nil)
+			},
+			elbMocks:   func(m *mocks.MockELBAPIMockRecorder) {},
+			elbv2Mocks: func(m *mocks.MockELBV2APIMockRecorder) {},
+			ec2Mocks: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DeleteVolume(gomock.Any(), gomock.Any()).Return(nil, &smithy.GenericAPIError{Code: "VolumeInUse"})
+			},
+			efsMocks:  func(m *mocks.MockEFSAPIMockRecorder) {},
+			expectErr: true,
+		},
+		{
+			name:                  "alternative strategy collects the resources of the requested tasks",
+			tasks:                 "security-group,volume,efs-access-point",
+			alternativeGCStrategy: true,
+			rgAPIMocks:            func(m *mocks.MockResourceGroupsTaggingAPIAPIMockRecorder) {},
+			elbMocks: func(m *mocks.MockELBAPIMockRecorder) {
+				m.DescribeLoadBalancersPages(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			elbv2Mocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeLoadBalancersPages(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.DescribeTargetGroups(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{}, nil)
+			},
+			ec2Mocks: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeSecurityGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+				m.DescribeVolumes(gomock.Any(), &ec2.DescribeVolumesInput{
+					Filters: []ec2types.Filter{
+						{
+							Name:   aws.String("tag:kubernetes.io/cluster/cluster1"),
+							Values: []string{"owned"},
+						},
+					},
+				}, gomock.Any()).Return(&ec2.DescribeVolumesOutput{
+					Volumes: []ec2types.Volume{
+						{VolumeId: aws.String("vol-1234"), Tags: []ec2types.Tag{ownedTag}},
+					},
+				}, nil)
+				m.DeleteVolume(gomock.Any(), &ec2.DeleteVolumeInput{VolumeId: aws.String("vol-1234")}).Return(&ec2.DeleteVolumeOutput{}, nil)
+			},
+			efsMocks: func(m *mocks.MockEFSAPIMockRecorder) {
+				m.DescribeAccessPoints(gomock.Any(), gomock.Any(), gomock.Any()).Return(&efs.DescribeAccessPointsOutput{
+					AccessPoints: []efstypes.AccessPointDescription{
+						{AccessPointId: aws.String("fsap-1234"), Tags: []efstypes.Tag{{Key: ownedTag.Key, Value: ownedTag.Value}}},
+						{AccessPointId: aws.String("fsap-other")},
+					},
+				}, nil)
+				m.DeleteAccessPoint(gomock.Any(), &efs.DeleteAccessPointInput{AccessPointId: aws.String("fsap-1234")}).Return(&efs.DeleteAccessPointOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			rgapiMock := mocks.NewMockResourceGroupsTaggingAPIAPI(mockCtrl)
+			elbapiMock := mocks.NewMockELBAPI(mockCtrl)
+			elbv2Mock := mocks.NewMockELBV2API(mockCtrl)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			efsMock := mocks.NewMockEFSAPI(mockCtrl)
+
+			tc.rgAPIMocks(rgapiMock.EXPECT())
+			tc.elbMocks(elbapiMock.EXPECT())
+			tc.elbv2Mocks(elbv2Mock.EXPECT())
+			tc.ec2Mocks(ec2Mock.EXPECT())
+			tc.efsMocks(efsMock.EXPECT())
+
+			opts := []ServiceOption{
+				withELBClient(elbapiMock),
+				withELBv2Client(elbv2Mock),
+				withResourceTaggingClient(rgapiMock),
+				withEC2Client(ec2Mock),
+				withEFSClient(efsMock),
+				WithGCStrategy(tc.alternativeGCStrategy),
+			}
+			wkSvc := NewService(createUnManageScope(t, "", tc.tasks), opts...)
+			err := wkSvc.ReconcileDelete(context.TODO())
+
+			if tc.expectErr {
+				g.Expect(err).NotTo(BeNil())
+				return
+			}
+
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
+
+func TestPreview(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rgapiMock := mocks.NewMockResourceGroupsTaggingAPIAPI(mockCtrl)
+	elbapiMock := mocks.NewMockELBAPI(mockCtrl)
+	elbv2Mock := mocks.NewMockELBV2API(mockCtrl)
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+	efsMock := mocks.NewMockEFSAPI(mockCtrl)
+
+	// No delete API is expected to be called.
+	rgapiMock.EXPECT().GetResources(gomock.Any(), gomock.Any()).Return(&rgapi.GetResourcesOutput{
+		ResourceTagMappingList: []rgapitypes.ResourceTagMapping{
+			{
+				ResourceARN: aws.String("arn:aws:elasticloadbalancing:eu-west-2:1234567890:loadbalancer/net/a123/123"),
+				Tags: []rgapitypes.Tag{
+					{
+						Key:   aws.String(serviceNameTag),
+						Value: aws.String("default/svc1"),
+					},
+				},
+			},
+			{
+				ResourceARN: aws.String("arn:aws:elasticloadbalancing:eu-west-2:1234567890:loadbalancer/net/b456/456"),
+			},
+			{
+				ResourceARN: aws.String("arn:aws:ec2:eu-west-2:1234567890:security-group/sg-123456"),
+			},
+			{
+				ResourceARN: aws.String("arn:aws:ec2:eu-west-2:1234567890:volume/vol-1234"),
+			},
+			{
+				ResourceARN: aws.String("arn:aws:ec2:eu-west-2:1234567890:network-interface/eni-1234"),
+			},
+		},
+	}, nil).Times(2)
+	ec2Mock.EXPECT().DescribeNetworkInterfaces(gomock.Any(), gomock.Any(), gomock.Any()).Return(&ec2.DescribeNetworkInterfacesOutput{
+		NetworkInterfaces: []ec2types.NetworkInterface{
+			{NetworkInterfaceId: aws.String("eni-1234")},
+		},
+	}, nil).Times(2)
+
+	clusterScope := createUnManageScope(t, "", "load-balancer,security-group,volume,network-interface")
+	clusterScope.AWSCluster.Annotations[infrav1.ExternalResourceGCDryRunAnnotation] = "true"
+	wkSvc := NewService(clusterScope,
+		withELBClient(elbapiMock),
+		withELBv2Client(elbv2Mock),
+		withResourceTaggingClient(rgapiMock),
+		withEC2Client(ec2Mock),
+		withEFSClient(efsMock),
+		WithGCStrategy(false),
+	)
+
+	report, err := wkSvc.Preview(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(report.Resources).To(ConsistOf(
+		ReportedResource{Type: "loadbalancer", ARN: "arn:aws:elasticloadbalancing:eu-west-2:1234567890:loadbalancer/net/a123/123"},
+		ReportedResource{Type: "security-group", ARN: "arn:aws:ec2:eu-west-2:1234567890:security-group/sg-123456"},
+		ReportedResource{Type: "volume", ARN: "arn:aws:ec2:eu-west-2:1234567890:volume/vol-1234"},
+		ReportedResource{Type: "network-interface", ARN: "arn:aws:ec2:eu-west-2:1234567890:network-interface/eni-1234"},
+	))
+	g.Expect(report.Summary()).To(Equal("would delete 1 loadbalancer, 1 network-interface, 1 security-group, 1 volume"))
+
+	// With the dry-run annotation, the deletion only reports the resources and is blocked so that
+	// they are not left behind.
+	err = wkSvc.ReconcileDelete(context.TODO())
+	g.Expect(err).To(MatchError(ContainSubstring("dry-run")))
+	condition := v1beta1conditions.Get(clusterScope.AWSCluster, infrav1.ExternalResourceGCDryRunCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Message).To(Equal(report.Summary()))
+}
+
+func TestReconcileDeleteDryRun(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rgapiMock := mocks.NewMockResourceGroupsTaggingAPIAPI(mockCtrl)
+	elbapiMock := mocks.NewMockELBAPI(mockCtrl)
+	elbv2Mock := mocks.NewMockELBV2API(mockCtrl)
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+	efsMock := mocks.NewMockEFSAPI(mockCtrl)
+
+	rgapiMock.EXPECT().GetResources(gomock.Any(), gomock.Any()).Return(&rgapi.GetResourcesOutput{
+		ResourceTagMappingList: []rgapitypes.ResourceTagMapping{
+			{
+				ResourceARN: aws.String("arn:aws:elasticloadbalancing:eu-west-2:1234567890:loadbalancer/net/a123/123"),
+				Tags: []rgapitypes.Tag{
+					{
+						Key:   aws.String(serviceNameTag),
+						Value: aws.String("default/svc1"),
+					},
+				},
+			},
+		},
+	}, nil).Times(2)
+
+	clusterScope := createManageScope(t, "true", "")
+	clusterScope.ControlPlane.Annotations[infrav1.ExternalResourceGCDryRunAnnotation] = "true"
+	wkSvc := NewService(clusterScope,
+		withELBClient(elbapiMock),
+		withELBv2Client(elbv2Mock),
+		withResourceTaggingClient(rgapiMock),
+		withEC2Client(ec2Mock),
+		withEFSClient(efsMock),
+		WithGCStrategy(false),
+	)
+
+	// The deletion is blocked while the dry-run annotation is set, and nothing is deleted.
+	err := wkSvc.ReconcileDelete(context.TODO())
+	g.Expect(err).To(MatchError(ContainSubstring(infrav1.ExternalResourceGCDryRunAnnotation)))
+	condition := v1beta1conditions.Get(clusterScope.ControlPlane, infrav1.ExternalResourceGCDryRunCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Message).To(Equal("would delete 1 loadbalancer"))
+
+	// Once the dry-run is turned off, the resources are deleted.
+	clusterScope.ControlPlane.Annotations[infrav1.ExternalResourceGCDryRunAnnotation] = "false"
+	elbv2Mock.EXPECT().DeleteLoadBalancer(gomock.Any(), &elbv2.DeleteLoadBalancerInput{
+		LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:eu-west-2:1234567890:loadbalancer/net/a123/123"),
+	}).Return(&elbv2.DeleteLoadBalancerOutput{}, nil)
+	g.Expect(wkSvc.ReconcileDelete(context.TODO())).To(Succeed())
+}
+
+func TestReconcileDryRun(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rgapiMock := mocks.NewMockResourceGroupsTaggingAPIAPI(mockCtrl)
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	clusterScope := createUnManageScope(t, "", "load-balancer")
+	clusterScope.AWSCluster.Annotations[infrav1.ExternalResourceGCDryRunAnnotation] = "true"
+	dryRunPreviews := capicache.New[dryRunPreviewEntry](time.Hour)
+	reconcileDryRun := func() error {
+		wkSvc := NewService(clusterScope,
+			withELBClient(mocks.NewMockELBAPI(mockCtrl)),
+			withELBv2Client(mocks.NewMockELBV2API(mockCtrl)),
+			withResourceTaggingClient(rgapiMock),
+			withEC2Client(ec2Mock),
+			withEFSClient(mocks.NewMockEFSAPI(mockCtrl)),
+			withDryRunPreviews(dryRunPreviews),
+			WithGCStrategy(false),
+		)
+		return wkSvc.ReconcileDryRun(context.TODO())
+	}
+
+	// The resources are collected on the first reconcile only.
+	rgapiMock.EXPECT().GetResources(gomock.Any(), gomock.Any()).Return(&rgapi.GetResourcesOutput{}, nil).Times(1)
+	g.Expect(reconcileDryRun()).To(Succeed())
+	g.Expect(reconcileDryRun()).To(Succeed())
+	g.Expect(v1beta1conditions.IsTrue(clusterScope.AWSCluster, infrav1.ExternalResourceGCDryRunCondition)).To(BeTrue())
+
+	// A change of the garbage collection tasks refreshes the report.
+	clusterScope.AWSCluster.Annotations[infrav1.ExternalResourceGCTasksAnnotation] = "load-balancer,volume"
+	rgapiMock.EXPECT().GetResources(gomock.Any(), gomock.Any()).Return(&rgapi.GetResourcesOutput{}, nil).Times(1)
+	g.Expect(reconcileDryRun()).To(Succeed())
+	g.Expect(reconcileDryRun()).To(Succeed())
+
+	// Turning the dry-run off and on again refreshes the report.
+	clusterScope.AWSCluster.Annotations[infrav1.ExternalResourceGCDryRunAnnotation] = "false"
+	g.Expect(reconcileDryRun()).To(Succeed())
+	g.Expect(v1beta1conditions.Has(clusterScope.AWSCluster, infrav1.ExternalResourceGCDryRunCondition)).To(BeFalse())
+	clusterScope.AWSCluster.Annotations[infrav1.ExternalResourceGCDryRunAnnotation] = "true"
+	rgapiMock.EXPECT().GetResources(gomock.Any(), gomock.Any()).Return(&rgapi.GetResourcesOutput{}, nil).Times(1)
+	g.Expect(reconcileDryRun()).To(Succeed())
+}
+
 func createManageScope(t *testing.T, gcAnnotationValue, gcTasksAnnotationValue string) *scope.ManagedControlPlaneScope {
 	t.Helper()
 	g := NewWithT(t)
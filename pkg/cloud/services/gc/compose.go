@@ -33,6 +33,14 @@ const (
 	sgService         = "ec2"
 	sgResourcePrefix  = "security-group/"
 
+	ec2Service                     = "ec2"
+	volumeResourcePrefix           = "volume/"
+	snapshotResourcePrefix         = "snapshot/"
+	networkInterfaceResourcePrefix = "network-interface/"
+	elasticIPResourcePrefix        = "elastic-ip/"
+	efsService                     = "elasticfilesystem"
+	efsAccessPointResourcePrefix   = "access-point/"
+
 	// maxDescribeTagsRequest is the maximum number of resources for the DescribeTags API call
 	// see: https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_DescribeTags.html.
 	maxDescribeTagsRequest = 20
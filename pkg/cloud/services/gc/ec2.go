@@ -25,6 +25,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
 	filter "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
 )
@@ -35,6 +36,9 @@ func (s *Service) deleteSecurityGroups(ctx context.Context, resources []*AWSReso
 			s.scope.Debug("Resource not a security group for deletion", "arn", resource.ARN.String())
 			continue
 		}
+		if s.skipDelete(resource) {
+			continue
+		}
 
 		groupID := strings.ReplaceAll(resource.ARN.Resource, "security-group/", "")
 		if err := s.deleteSecurityGroup(ctx, groupID); err != nil {
@@ -101,3 +105,221 @@ func (s *Service) getProviderOwnedSecurityGroups(ctx context.Context) ([]*AWSRes
 
 	return resources, nil
 }
+
+func (s *Service) deleteVolumes(ctx context.Context, resources []*AWSResource) error {
+	for _, resource := range resources {
+		if !s.isMatchingResource(resource, ec2Service, "volume") || s.skipDelete(resource) {
+			continue
+		}
+
+		volumeID := strings.TrimPrefix(resource.ARN.Resource, volumeResourcePrefix)
+		s.scope.Debug("Deleting volume", "volume_id", volumeID)
+		if _, err := s.ec2Client.DeleteVolume(ctx, &ec2.DeleteVolumeInput{VolumeId: aws.String(volumeID)}); err != nil {
+			if code, _ := awserrors.Code(err); code == awserrors.VolumeNotFound {
+				continue
+			}
+			return fmt.Errorf("deleting volume %q with ID %s: %w", resource.ARN, volumeID, err)
+		}
+	}
+	s.scope.Debug("Finished processing resources for volume deletion")
+
+	return nil
+}
+
+func (s *Service) deleteSnapshots(ctx context.Context, resources []*AWSResource) error {
+	for _, resource := range resources {
+		if !s.isMatchingResource(resource, ec2Service, "snapshot") || s.skipDelete(resource) {
+			continue
+		}
+
+		snapshotID := strings.TrimPrefix(resource.ARN.Resource, snapshotResourcePrefix)
+		s.scope.Debug("Deleting snapshot", "snapshot_id", snapshotID)
+		if _, err := s.ec2Client.DeleteSnapshot(ctx, &ec2.DeleteSnapshotInput{SnapshotId: aws.String(snapshotID)}); err != nil {
+			if code, _ := awserrors.Code(err); code == awserrors.SnapshotNotFound {
+				continue
+			}
+			return fmt.Errorf("deleting snapshot %q with ID %s: %w", resource.ARN, snapshotID, err)
+		}
+	}
+	s.scope.Debug("Finished processing resources for snapshot deletion")
+
+	return nil
+}
+
+// deleteNetworkInterfaces deletes the network interfaces that aren't attached anymore. Attached network
+// interfaces are left alone as they are usually deleted along with the instance they are attached to.
+func (s *Service) deleteNetworkInterfaces(ctx context.Context, resources []*AWSResource) error {
+	interfaces := map[string]*AWSResource{}
+	var interfaceIDs []string
+	for _, resource := range resources {
+		if !s.isMatchingResource(resource, ec2Service, "network-interface") {
+			continue
+		}
+		interfaceID := strings.TrimPrefix(resource.ARN.Resource, networkInterfaceResourcePrefix)
+		interfaces[interfaceID] = resource
+		interfaceIDs = append(interfaceIDs, interfaceID)
+	}
+	if len(interfaceIDs) == 0 {
+		return nil
+	}
+
+	input := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("network-interface-id"),
+				Values: interfaceIDs,
+			},
+			{
+				Name:   aws.String("status"),
+				Values: []string{string(types.NetworkInterfaceStatusAvailable)},
+			},
+		},
+	}
+
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(s.ec2Client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("describing network interfaces: %w", err)
+		}
+		for _, eni := range page.NetworkInterfaces {
+			if resource, ok := interfaces[aws.ToString(eni.NetworkInterfaceId)]; ok && s.skipDelete(resource) {
+				continue
+			}
+			s.scope.Debug("Deleting network interface", "network_interface_id", aws.ToString(eni.NetworkInterfaceId))
+			if _, err := s.ec2Client.DeleteNetworkInterface(ctx, &ec2.DeleteNetworkInterfaceInput{NetworkInterfaceId: eni.NetworkInterfaceId}); err != nil {
+				if code, _ := awserrors.Code(err); code == awserrors.NetworkInterfaceNotFound {
+					continue
+				}
+				return fmt.Errorf("deleting network interface with ID %s: %w", aws.ToString(eni.NetworkInterfaceId), err)
+			}
+		}
+	}
+	s.scope.Debug("Finished processing resources for network interface deletion")
+
+	return nil
+}
+
+func (s *Service) deleteElasticIPs(ctx context.Context, resources []*AWSResource) error {
+	for _, resource := range resources {
+		if !s.isMatchingResource(resource, ec2Service, "elastic-ip") || s.skipDelete(resource) {
+			continue
+		}
+
+		allocationID := strings.TrimPrefix(resource.ARN.Resource, elasticIPResourcePrefix)
+		s.scope.Debug("Releasing elastic IP", "allocation_id", allocationID)
+		if _, err := s.ec2Client.ReleaseAddress(ctx, &ec2.ReleaseAddressInput{AllocationId: aws.String(allocationID)}); err != nil {
+			if code, _ := awserrors.Code(err); code == awserrors.AllocationIDNotFound {
+				continue
+			}
+			return fmt.Errorf("releasing elastic IP %q with allocation ID %s: %w", resource.ARN, allocationID, err)
+		}
+	}
+	s.scope.Debug("Finished processing resources for elastic IP deletion")
+
+	return nil
+}
+
+// getProviderOwnedVolumes gets the EBS volumes created for this cluster, filtering by tag: kubernetes.io/cluster/<cluster-name>:owned.
+func (s *Service) getProviderOwnedVolumes(ctx context.Context) ([]*AWSResource, error) {
+	input := &ec2.DescribeVolumesInput{
+		Filters: []types.Filter{
+			filter.EC2.ProviderOwned(s.scope.KubernetesClusterName()),
+		},
+	}
+
+	var resources []*AWSResource
+	paginator := ec2.NewDescribeVolumesPaginator(s.ec2Client, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get next page of volumes: %w", err)
+		}
+		for _, volume := range page.Volumes {
+			resources = s.appendEC2Resource(resources, volumeResourcePrefix+aws.ToString(volume.VolumeId), volume.Tags)
+		}
+	}
+
+	return resources, nil
+}
+
+// getProviderOwnedSnapshots gets the EBS snapshots created for this cluster, filtering by tag: kubernetes.io/cluster/<cluster-name>:owned.
+func (s *Service) getProviderOwnedSnapshots(ctx context.Context) ([]*AWSResource, error) {
+	input := &ec2.DescribeSnapshotsInput{
+		OwnerIds: []string{"self"},
+		Filters: []types.Filter{
+			filter.EC2.ProviderOwned(s.scope.KubernetesClusterName()),
+		},
+	}
+
+	var resources []*AWSResource
+	paginator := ec2.NewDescribeSnapshotsPaginator(s.ec2Client, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get next page of snapshots: %w", err)
+		}
+		for _, snapshot := range page.Snapshots {
+			resources = s.appendEC2Resource(resources, snapshotResourcePrefix+aws.ToString(snapshot.SnapshotId), snapshot.Tags)
+		}
+	}
+
+	return resources, nil
+}
+
+// getProviderOwnedNetworkInterfaces gets the network interfaces created for this cluster, filtering by tag: kubernetes.io/cluster/<cluster-name>:owned.
+func (s *Service) getProviderOwnedNetworkInterfaces(ctx context.Context) ([]*AWSResource, error) {
+	input := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []types.Filter{
+			filter.EC2.ProviderOwned(s.scope.KubernetesClusterName()),
+		},
+	}
+
+	var resources []*AWSResource
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(s.ec2Client, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get next page of network interfaces: %w", err)
+		}
+		for _, eni := range page.NetworkInterfaces {
+			resources = s.appendEC2Resource(resources, networkInterfaceResourcePrefix+aws.ToString(eni.NetworkInterfaceId), eni.TagSet)
+		}
+	}
+
+	return resources, nil
+}
+
+// getProviderOwnedElasticIPs gets the elastic IPs allocated for this cluster, filtering by tag: kubernetes.io/cluster/<cluster-name>:owned.
+func (s *Service) getProviderOwnedElasticIPs(ctx context.Context) ([]*AWSResource, error) {
+	input := &ec2.DescribeAddressesInput{
+		Filters: []types.Filter{
+			filter.EC2.ProviderOwned(s.scope.KubernetesClusterName()),
+		},
+	}
+
+	out, err := s.ec2Client.DescribeAddresses(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe elastic IPs: %w", err)
+	}
+
+	var resources []*AWSResource
+	for _, address := range out.Addresses {
+		resources = s.appendEC2Resource(resources, elasticIPResourcePrefix+aws.ToString(address.AllocationId), address.Tags)
+	}
+
+	return resources, nil
+}
+
+func (s *Service) appendEC2Resource(resources []*AWSResource, resourceName string, tags []types.Tag) []*AWSResource {
+	arn := composeFakeArn(ec2Service, resourceName)
+	resource, err := composeAWSResource(arn, converters.TagsToMap(tags))
+	if err != nil {
+		s.scope.Error(err, "error compose aws ec2 resource: %v", "name", arn)
+		return resources
+	}
+	return append(resources, resource)
+}
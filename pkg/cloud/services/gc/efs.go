@@ -0,0 +1,95 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// EFSAPI defines the EFS API interface used by the garbage collector.
+type EFSAPI interface {
+	DeleteAccessPoint(ctx context.Context, params *efs.DeleteAccessPointInput, optFns ...func(*efs.Options)) (*efs.DeleteAccessPointOutput, error)
+	DescribeAccessPoints(ctx context.Context, params *efs.DescribeAccessPointsInput, optFns ...func(*efs.Options)) (*efs.DescribeAccessPointsOutput, error)
+}
+
+var _ EFSAPI = &efs.Client{}
+
+func (s *Service) deleteEFSAccessPoints(ctx context.Context, resources []*AWSResource) error {
+	for _, resource := range resources {
+		if !s.isMatchingResource(resource, efsService, "access-point") || s.skipDelete(resource) {
+			continue
+		}
+
+		accessPointID := strings.TrimPrefix(resource.ARN.Resource, efsAccessPointResourcePrefix)
+		s.scope.Debug("Deleting EFS access point", "access_point_id", accessPointID)
+		if _, err := s.efsClient.DeleteAccessPoint(ctx, &efs.DeleteAccessPointInput{AccessPointId: aws.String(accessPointID)}); err != nil {
+			var notFound *efstypes.AccessPointNotFound
+			if errors.As(err, &notFound) {
+				continue
+			}
+			return fmt.Errorf("deleting EFS access point %q with ID %s: %w", resource.ARN, accessPointID, err)
+		}
+	}
+	s.scope.Debug("Finished processing resources for EFS access point deletion")
+
+	return nil
+}
+
+// getProviderOwnedEFSAccessPoints gets the EFS access points created for this cluster. The EFS API doesn't
+// support filtering by tag, so the access points are filtered by tag: kubernetes.io/cluster/<cluster-name>:owned
+// after being listed.
+func (s *Service) getProviderOwnedEFSAccessPoints(ctx context.Context) ([]*AWSResource, error) {
+	ownedTag := infrav1.ClusterAWSCloudProviderTagKey(s.scope.KubernetesClusterName())
+
+	var resources []*AWSResource
+	paginator := efs.NewDescribeAccessPointsPaginator(s.efsClient, &efs.DescribeAccessPointsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get next page of EFS access points: %w", err)
+		}
+		for _, accessPoint := range page.AccessPoints {
+			tags := infrav1.Tags{}
+			for _, tag := range accessPoint.Tags {
+				tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+			}
+			if tags[ownedTag] != string(infrav1.ResourceLifecycleOwned) {
+				continue
+			}
+
+			arn := composeFakeArn(efsService, efsAccessPointResourcePrefix+aws.ToString(accessPoint.AccessPointId))
+			resource, err := composeAWSResource(arn, tags)
+			if err != nil {
+				s.scope.Error(err, "error compose aws EFS access point resource: %v", "name", arn)
+				continue
+			}
+			resources = append(resources, resource)
+		}
+	}
+
+	return resources, nil
+}
@@ -38,16 +38,25 @@ func (s *Service) deleteLoadBalancers(ctx context.Context, resources []*AWSResou
 
 		switch {
 		case strings.HasPrefix(resource.ARN.Resource, "loadbalancer/app/"):
+			if s.skipDelete(resource) {
+				continue
+			}
 			s.scope.Debug("Deleting ALB for Service", "arn", resource.ARN.String())
 			if err := s.deleteLoadBalancerV2(ctx, resource.ARN.String()); err != nil {
 				return fmt.Errorf("deleting ALB: %w", err)
 			}
 		case strings.HasPrefix(resource.ARN.Resource, "loadbalancer/net/"):
+			if s.skipDelete(resource) {
+				continue
+			}
 			s.scope.Debug("Deleting NLB for Service", "arn", resource.ARN.String())
 			if err := s.deleteLoadBalancerV2(ctx, resource.ARN.String()); err != nil {
 				return fmt.Errorf("deleting NLB: %w", err)
 			}
 		case strings.HasPrefix(resource.ARN.Resource, "loadbalancer/"):
+			if s.skipDelete(resource) {
+				continue
+			}
 			name := strings.ReplaceAll(resource.ARN.Resource, "loadbalancer/", "")
 			s.scope.Debug("Deleting classic ELB for Service", "arn", resource.ARN.String(), "name", name)
 			if err := s.deleteLoadBalancer(ctx, name); err != nil {
@@ -69,6 +78,9 @@ func (s *Service) deleteTargetGroups(ctx context.Context, resources []*AWSResour
 			s.scope.Trace("Resource not a target group for deletion", "arn", resource.ARN.String())
 			continue
 		}
+		if s.skipDelete(resource) {
+			continue
+		}
 
 		if err := s.deleteTargetGroup(ctx, resource.ARN.String()); err != nil {
 			return fmt.Errorf("deleting target group %q: %w", resource.ARN, err)
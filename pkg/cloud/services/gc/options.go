@@ -19,6 +19,7 @@ package gc
 import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/common"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/elb"
+	capicache "sigs.k8s.io/cluster-api/util/cache"
 )
 
 // ServiceOption is an option for creating the service.
@@ -52,6 +53,20 @@ func withEC2Client(client common.EC2API) ServiceOption {
 	}
 }
 
+// withEFSClient is an option for specifying a AWS EFS Client.
+func withEFSClient(client EFSAPI) ServiceOption {
+	return func(s *Service) {
+		s.efsClient = client
+	}
+}
+
+// withDryRunPreviews is an option for specifying the cache of the recorded dry-run reports.
+func withDryRunPreviews(dryRunPreviews capicache.Cache[dryRunPreviewEntry]) ServiceOption {
+	return func(s *Service) {
+		s.dryRunPreviews = dryRunPreviews
+	}
+}
+
 // WithGCStrategy is an option for specifying using the alternative GC strategy.
 func WithGCStrategy(alternativeGCStrategy bool) ServiceOption {
 	if alternativeGCStrategy {
@@ -0,0 +1,194 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	capicache "sigs.k8s.io/cluster-api/util/cache"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+// dryRunPreviewPeriod is how often the report of the garbage collection dry-run is refreshed.
+const dryRunPreviewPeriod = 10 * time.Minute
+
+// dryRunPreviewEntry records that a dry-run report was recorded on an infra cluster.
+type dryRunPreviewEntry struct {
+	key string
+}
+
+// Key returns the cache key of a dryRunPreviewEntry.
+func (e dryRunPreviewEntry) Key() string {
+	return e.key
+}
+
+// dryRunPreviews is shared by the services of all the infra clusters, as a service only lives for a reconcile.
+var dryRunPreviews = capicache.New[dryRunPreviewEntry](dryRunPreviewPeriod)
+
+// Report lists the resources that the garbage collection would delete.
+type Report struct {
+	Resources []ReportedResource `json:"resources"`
+}
+
+// ReportedResource is a resource that the garbage collection would delete.
+type ReportedResource struct {
+	// Type is the type of the resource, e.g. loadbalancer or volume.
+	Type string `json:"type"`
+	// ARN is the ARN of the resource. The partition, region and account of the ARN are
+	// fake when the resource was collected by the alternative GC strategy.
+	ARN string `json:"arn"`
+}
+
+// Counts returns the number of resources that would be deleted per resource type.
+func (r *Report) Counts() map[string]int {
+	counts := map[string]int{}
+	for _, resource := range r.Resources {
+		counts[resource.Type]++
+	}
+
+	return counts
+}
+
+// Summary returns a human readable summary of the number of resources that would be deleted.
+func (r *Report) Summary() string {
+	if len(r.Resources) == 0 {
+		return "no resources to delete"
+	}
+
+	counts := r.Counts()
+	types := make([]string, 0, len(counts))
+	for resourceType := range counts {
+		types = append(types, resourceType)
+	}
+	sort.Strings(types)
+
+	parts := make([]string, 0, len(types))
+	for _, resourceType := range types {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[resourceType], resourceType))
+	}
+
+	return "would delete " + strings.Join(parts, ", ")
+}
+
+// Preview runs the garbage collection without deleting anything and reports the resources that
+// would be deleted. The resources are collected and filtered exactly as they would be on deletion.
+func (s *Service) Preview(ctx context.Context) (*Report, error) {
+	s.report = &Report{}
+	defer func() {
+		s.report = nil
+	}()
+
+	if err := s.deleteResources(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.report, nil
+}
+
+// ReconcileDryRun reports the resources that the garbage collection would delete as a condition on the
+// infra cluster when the dry-run annotation is set, so that they can be reviewed before the cluster is deleted.
+func (s *Service) ReconcileDryRun(ctx context.Context) error {
+	if !s.isDryRun() {
+		v1beta1conditions.Delete(s.scope.InfraCluster(), infrav1.ExternalResourceGCDryRunCondition)
+		return nil
+	}
+
+	// Collecting the resources goes through the resource tagging API and describes EC2 resources, so a
+	// recorded report is only refreshed periodically or when the garbage collection tasks change.
+	key := s.dryRunPreviewKey()
+	if _, ok := s.dryRunPreviews.Has(key); ok && v1beta1conditions.IsTrue(s.scope.InfraCluster(), infrav1.ExternalResourceGCDryRunCondition) {
+		return nil
+	}
+
+	report, err := s.Preview(ctx)
+	if err != nil {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.ExternalResourceGCDryRunCondition, infrav1.ExternalResourceGCDryRunFailedReason, clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+		return fmt.Errorf("previewing garbage collection: %w", err)
+	}
+	s.recordReport(report)
+	s.dryRunPreviews.Add(dryRunPreviewEntry{key: key})
+
+	return nil
+}
+
+// dryRunPreviewKey identifies the infra cluster and the garbage collection tasks a dry-run report was
+// recorded for.
+func (s *Service) dryRunPreviewKey() string {
+	tasks, _ := annotations.Get(s.scope.InfraCluster(), infrav1.ExternalResourceGCTasksAnnotation)
+	return fmt.Sprintf("%s/%s", s.scope.InfraCluster().GetUID(), tasks)
+}
+
+func (s *Service) isDryRun() bool {
+	val, found := annotations.Get(s.scope.InfraCluster(), infrav1.ExternalResourceGCDryRunAnnotation)
+	if !found {
+		return false
+	}
+	dryRun, err := strconv.ParseBool(val)
+	if err != nil {
+		s.scope.Info("ignoring invalid value of annotation", "annotation", infrav1.ExternalResourceGCDryRunAnnotation, "value", val)
+		return false
+	}
+
+	return dryRun
+}
+
+// recordReport sets the dry-run condition and, when the report changed since it was last recorded,
+// emits an event per resource that would be deleted.
+func (s *Service) recordReport(report *Report) {
+	summary := report.Summary()
+	previous := v1beta1conditions.Get(s.scope.InfraCluster(), infrav1.ExternalResourceGCDryRunCondition)
+	condition := v1beta1conditions.TrueCondition(infrav1.ExternalResourceGCDryRunCondition)
+	condition.Message = summary
+	v1beta1conditions.Set(s.scope.InfraCluster(), condition)
+	if previous != nil && previous.Status == corev1.ConditionTrue && previous.Message == summary {
+		return
+	}
+
+	s.scope.Info("garbage collection dry-run", "cluster", s.scope.InfraClusterName(), "summary", summary)
+	record.Eventf(s.scope.InfraCluster(), "ExternalResourceGCDryRun", "Garbage collection %s", summary)
+	for _, resource := range report.Resources {
+		record.Eventf(s.scope.InfraCluster(), "ExternalResourceGCDryRun", "Garbage collection would delete %s %s", resource.Type, resource.ARN)
+	}
+}
+
+// skipDelete records the resource in the report and returns true when running a dry-run, in which
+// case the resource must not be deleted.
+func (s *Service) skipDelete(resource *AWSResource) bool {
+	if s.report == nil {
+		return false
+	}
+
+	resourceType, _, _ := strings.Cut(resource.ARN.Resource, "/")
+	s.report.Resources = append(s.report.Resources, ReportedResource{
+		Type: resourceType,
+		ARN:  resource.ARN.String(),
+	})
+	s.scope.Debug("Skipping deletion of resource in dry-run", "arn", resource.ARN.String())
+
+	return true
+}
@@ -22,10 +22,12 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/common"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/elb"
+	capicache "sigs.k8s.io/cluster-api/util/cache"
 )
 
 // Service is used to perform operations against a tenant/workload/child cluster.
@@ -35,8 +37,13 @@ type Service struct {
 	elbv2Client           elb.ELBV2API
 	resourceTaggingClient elb.ResourceGroupsTaggingAPIAPI
 	ec2Client             common.EC2API
+	efsClient             EFSAPI
 	cleanupFuncs          ResourceCleanupFuncs
 	collectFuncs          ResourceCollectFuncs
+	alternativeGCStrategy bool
+	// report is set while running a dry-run, see Preview.
+	report         *Report
+	dryRunPreviews capicache.Cache[dryRunPreviewEntry]
 }
 
 // NewService creates a new Service.
@@ -52,9 +59,11 @@ func NewService(clusterScope cloud.ClusterScoper, opts ...ServiceOption) *Servic
 		resourceTaggingClient: &elb.ResourceGroupsTaggingAPIClient{
 			Client: scope.NewResourgeTaggingClient(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
 		},
-		cleanupFuncs: ResourceCleanupFuncs{},
-		collectFuncs: ResourceCollectFuncs{},
-		ec2Client:    scope.NewEC2Client(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
+		cleanupFuncs:   ResourceCleanupFuncs{},
+		collectFuncs:   ResourceCollectFuncs{},
+		ec2Client:      scope.NewEC2Client(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
+		efsClient:      scope.NewEFSClient(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
+		dryRunPreviews: dryRunPreviews,
 	}
 	addDefaultCleanupFuncs(svc)
 
@@ -80,6 +89,7 @@ func addDefaultCollectFuncs(s *Service) {
 }
 
 func addAlternativeCollectFuncs(s *Service) {
+	s.alternativeGCStrategy = true
 	s.collectFuncs = []ResourceCollectFunc{
 		s.getProviderOwnedLoadBalancers,
 		s.getProviderOwnedLoadBalancersV2,
@@ -88,6 +98,27 @@ func addAlternativeCollectFuncs(s *Service) {
 	}
 }
 
+// gcTaskFuncs are the functions to collect and clean up the resources of a GC task. The collect
+// function is only used by the alternative GC strategy and is nil for the resources that are
+// always collected by it.
+type gcTaskFuncs struct {
+	cleanup ResourceCleanupFunc
+	collect ResourceCollectFunc
+}
+
+func (s *Service) gcTasks() map[infrav1.GCTask]gcTaskFuncs {
+	return map[infrav1.GCTask]gcTaskFuncs{
+		infrav1.GCTaskLoadBalancer:     {cleanup: s.deleteLoadBalancers},
+		infrav1.GCTaskTargetGroup:      {cleanup: s.deleteTargetGroups},
+		infrav1.GCTaskSecurityGroup:    {cleanup: s.deleteSecurityGroups},
+		infrav1.GCTaskVolume:           {cleanup: s.deleteVolumes, collect: s.getProviderOwnedVolumes},
+		infrav1.GCTaskSnapshot:         {cleanup: s.deleteSnapshots, collect: s.getProviderOwnedSnapshots},
+		infrav1.GCTaskNetworkInterface: {cleanup: s.deleteNetworkInterfaces, collect: s.getProviderOwnedNetworkInterfaces},
+		infrav1.GCTaskElasticIP:        {cleanup: s.deleteElasticIPs, collect: s.getProviderOwnedElasticIPs},
+		infrav1.GCTaskEFSAccessPoint:   {cleanup: s.deleteEFSAccessPoints, collect: s.getProviderOwnedEFSAccessPoints},
+	}
+}
+
 // AWSResource represents a resource in AWS.
 type AWSResource struct {
 	ARN  *arn.ARN
@@ -51,6 +51,26 @@ func (m *MockSQSAPI) EXPECT() *MockSQSAPIMockRecorder {
 	return m.recorder
 }
 
+// ChangeMessageVisibility mocks base method.
+func (m *MockSQSAPI) ChangeMessageVisibility(arg0 context.Context, arg1 *sqs.ChangeMessageVisibilityInput, arg2 ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangeMessageVisibility", varargs...)
+	ret0, _ := ret[0].(*sqs.ChangeMessageVisibilityOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeMessageVisibility indicates an expected call of ChangeMessageVisibility.
+func (mr *MockSQSAPIMockRecorder) ChangeMessageVisibility(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMessageVisibility", reflect.TypeOf((*MockSQSAPI)(nil).ChangeMessageVisibility), varargs...)
+}
+
 // CreateQueue mocks base method.
 func (m *MockSQSAPI) CreateQueue(arg0 context.Context, arg1 *sqs.CreateQueueInput, arg2 ...func(*sqs.Options)) (*sqs.CreateQueueOutput, error) {
 	m.ctrl.T.Helper()
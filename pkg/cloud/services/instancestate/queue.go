@@ -20,6 +20,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -31,26 +33,98 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 )
 
+const (
+	// DeadLetterQueueMaxReceiveCount is the number of times a message is received from the queue
+	// without being deleted before it is moved to the dead-letter queue.
+	DeadLetterQueueMaxReceiveCount = 5
+
+	// deadLetterQueueRetentionPeriod keeps the messages of the dead-letter queue for the maximum period
+	// allowed by SQS, in seconds, so that they can be inspected.
+	deadLetterQueueRetentionPeriod = "1209600"
+)
+
 func (s *Service) reconcileSQSQueue(ctx context.Context) error {
+	dlqURL, err := s.createQueue(ctx, GenerateDeadLetterQueueName(s.scope.Name()), map[string]string{
+		string(sqstypes.QueueAttributeNameMessageRetentionPeriod): deadLetterQueueRetentionPeriod,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to create new dead-letter queue")
+	}
+	resp, err := s.SQSClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameQueueArn},
+		QueueUrl:       aws.String(dlqURL),
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to get dead-letter queue attributes")
+	}
+	dlqArn, ok := resp.Attributes[string(sqstypes.QueueAttributeNameQueueArn)]
+	if !ok {
+		return errors.New("unable to get ARN of the dead-letter queue")
+	}
+
+	redrivePolicy, err := json.Marshal(redrivePolicy{
+		DeadLetterTargetArn: dlqArn,
+		MaxReceiveCount:     DeadLetterQueueMaxReceiveCount,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to JSON marshal redrive policy")
+	}
 	attrs := make(map[string]string)
 	attrs[string(sqstypes.QueueAttributeNameReceiveMessageWaitTimeSeconds)] = "20"
+	attrs[string(sqstypes.QueueAttributeNameRedrivePolicy)] = string(redrivePolicy)
 
-	_, err := s.SQSClient.CreateQueue(ctx, &sqs.CreateQueueInput{
+	_, err = s.SQSClient.CreateQueue(ctx, &sqs.CreateQueueInput{
 		QueueName:  aws.String(GenerateQueueName(s.scope.Name())),
 		Attributes: attrs,
 	})
+	if err == nil {
+		return nil
+	}
+	if !queueNameExistsError(err) {
+		return errors.Wrap(err, "unable to create new queue")
+	}
 
-	smithyErr := awserrors.ParseSmithyError(err)
-	if smithyErr != nil {
-		if smithyErr.ErrorCode() == (&sqstypes.QueueNameExists{}).ErrorCode() {
-			return nil
-		}
+	// The queue exists with different attributes, e.g. it was created before dead-letter queues were
+	// introduced, update them in place.
+	urlResp, err := s.SQSClient.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String(GenerateQueueName(s.scope.Name()))})
+	if err != nil {
+		return errors.Wrap(err, "unable to get queue URL")
 	}
-	return errors.Wrap(err, "unable to create new queue")
+	_, err = s.SQSClient.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
+		QueueUrl:   urlResp.QueueUrl,
+		Attributes: attrs,
+	})
+	return errors.Wrap(err, "unable to update queue attributes")
+}
+
+// createQueue creates a queue if it doesn't exist and returns its URL.
+func (s *Service) createQueue(ctx context.Context, name string, attrs map[string]string) (string, error) {
+	resp, err := s.SQSClient.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName:  aws.String(name),
+		Attributes: attrs,
+	})
+	if err == nil {
+		return aws.ToString(resp.QueueUrl), nil
+	}
+	if !queueNameExistsError(err) {
+		return "", err
+	}
+	urlResp, err := s.SQSClient.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String(name)})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(urlResp.QueueUrl), nil
 }
 
 func (s *Service) deleteSQSQueue(ctx context.Context) error {
-	resp, err := s.SQSClient.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String(GenerateQueueName(s.scope.Name()))})
+	if err := s.deleteQueue(ctx, GenerateQueueName(s.scope.Name())); err != nil {
+		return err
+	}
+	return s.deleteQueue(ctx, GenerateDeadLetterQueueName(s.scope.Name()))
+}
+
+func (s *Service) deleteQueue(ctx context.Context, name string) error {
+	resp, err := s.SQSClient.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String(name)})
 	if err != nil {
 		if queueNotFoundError(err) {
 			return nil
@@ -65,23 +139,24 @@ func (s *Service) deleteSQSQueue(ctx context.Context) error {
 	return errors.Wrap(err, "unable to delete queue")
 }
 
-func (s *Service) createPolicyForRule(ctx context.Context, input *createPolicyForRuleInput) error {
+func (s *Service) createPolicyForRules(ctx context.Context, input *createPolicyForRulesInput) error {
 	attrs := make(map[string]string)
 	policy := iamv1.PolicyDocument{
-		Version: iamv1.CurrentVersion,
-		ID:      input.QueueArn,
-		Statement: iamv1.Statements{
-			iamv1.StatementEntry{
-				Sid:       fmt.Sprintf("CAPAEvents_%s_%s", s.getEC2RuleName(), GenerateQueueName(s.scope.Name())),
-				Effect:    iamv1.EffectAllow,
-				Principal: iamv1.Principals{iamv1.PrincipalService: iamv1.PrincipalID{"events.amazonaws.com"}},
-				Action:    iamv1.Actions{"sqs:SendMessage"},
-				Resource:  iamv1.Resources{input.QueueArn},
-				Condition: iamv1.Conditions{
-					"ArnEquals": map[string]string{"aws:SourceArn": input.RuleArn},
-				},
+		Version:   iamv1.CurrentVersion,
+		ID:        input.QueueArn,
+		Statement: make(iamv1.Statements, 0, len(input.RuleArns)),
+	}
+	for _, ruleName := range slices.Sorted(maps.Keys(input.RuleArns)) {
+		policy.Statement = append(policy.Statement, iamv1.StatementEntry{
+			Sid:       fmt.Sprintf("CAPAEvents_%s_%s", ruleName, GenerateQueueName(s.scope.Name())),
+			Effect:    iamv1.EffectAllow,
+			Principal: iamv1.Principals{iamv1.PrincipalService: iamv1.PrincipalID{"events.amazonaws.com"}},
+			Action:    iamv1.Actions{"sqs:SendMessage"},
+			Resource:  iamv1.Resources{input.QueueArn},
+			Condition: iamv1.Conditions{
+				"ArnEquals": map[string]string{"aws:SourceArn": input.RuleArns[ruleName]},
 			},
-		},
+		})
 	}
 	policyData, err := json.Marshal(policy)
 	if err != nil {
@@ -103,6 +178,20 @@ func GenerateQueueName(clusterName string) string {
 	return fmt.Sprintf("%s-queue", adjusted)
 }
 
+// GenerateDeadLetterQueueName will generate the name of the dead-letter queue of a cluster's queue.
+func GenerateDeadLetterQueueName(clusterName string) string {
+	adjusted := strings.ReplaceAll(clusterName, ".", "-")
+	return fmt.Sprintf("%s-dlq", adjusted)
+}
+
+func queueNameExistsError(err error) bool {
+	smithyErr := awserrors.ParseSmithyError(err)
+	if smithyErr == nil {
+		return false
+	}
+	return smithyErr.ErrorCode() == (&sqstypes.QueueNameExists{}).ErrorCode()
+}
+
 func queueNotFoundError(err error) bool {
 	smithyErr := awserrors.ParseSmithyError(err)
 	if smithyErr == nil {
@@ -111,8 +200,14 @@ func queueNotFoundError(err error) bool {
 	return smithyErr.ErrorCode() == (&sqstypes.QueueDoesNotExist{}).ErrorCode()
 }
 
-type createPolicyForRuleInput struct {
+type createPolicyForRulesInput struct {
 	QueueArn string
 	QueueURL string
-	RuleArn  string
+	// RuleArns maps the name of the rules to their ARN.
+	RuleArns map[string]string
+}
+
+type redrivePolicy struct {
+	DeadLetterTargetArn string `json:"deadLetterTargetArn"`
+	MaxReceiveCount     int    `json:"maxReceiveCount"`
 }
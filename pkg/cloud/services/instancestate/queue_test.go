@@ -38,43 +38,96 @@ func TestReconcileSQSQueue(t *testing.T) {
 
 	ctx := context.TODO()
 
+	queueAttrs := map[string]string{
+		string(sqstypes.QueueAttributeNameReceiveMessageWaitTimeSeconds): "20",
+		string(sqstypes.QueueAttributeNameRedrivePolicy):                 `{"deadLetterTargetArn":"test-cluster-dlq-arn","maxReceiveCount":5}`,
+	}
+	expectDeadLetterQueue := func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+		m.CreateQueue(ctx, &sqs.CreateQueueInput{
+			QueueName: aws.String("test-cluster-dlq"),
+			Attributes: map[string]string{
+				string(sqstypes.QueueAttributeNameMessageRetentionPeriod): "1209600",
+			},
+		}).Return(&sqs.CreateQueueOutput{QueueUrl: aws.String("test-cluster-dlq-url")}, nil)
+		m.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+			AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameQueueArn},
+			QueueUrl:       aws.String("test-cluster-dlq-url"),
+		}).Return(&sqs.GetQueueAttributesOutput{Attributes: map[string]string{
+			string(sqstypes.QueueAttributeNameQueueArn): "test-cluster-dlq-arn",
+		}}, nil)
+	}
+
 	testCases := []struct {
 		name      string
 		expect    func(m *mock_sqsiface.MockSQSAPIMockRecorder)
 		expectErr bool
 	}{
 		{
-			name: "successfully creates an SQS queue",
+			name: "successfully creates an SQS queue and its dead-letter queue",
 			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
-				attrs := make(map[string]string)
-				attrs[string(sqstypes.QueueAttributeNameReceiveMessageWaitTimeSeconds)] = "20"
+				expectDeadLetterQueue(m)
 				m.CreateQueue(ctx, &sqs.CreateQueueInput{
 					QueueName:  aws.String("test-cluster-queue"),
-					Attributes: attrs,
+					Attributes: queueAttrs,
 				}).Return(nil, nil)
 			},
 			expectErr: false,
 		},
 		{
-			name: "does not error if queue already exists",
+			name: "reuses the dead-letter queue if it already exists",
 			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
-				attrs := make(map[string]string)
-				attrs[string(sqstypes.QueueAttributeNameReceiveMessageWaitTimeSeconds)] = "20"
+				m.CreateQueue(ctx, gomock.Eq(&sqs.CreateQueueInput{
+					QueueName: aws.String("test-cluster-dlq"),
+					Attributes: map[string]string{
+						string(sqstypes.QueueAttributeNameMessageRetentionPeriod): "1209600",
+					},
+				})).Return(nil, &sqstypes.QueueNameExists{})
+				m.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
+					QueueName: aws.String("test-cluster-dlq"),
+				}).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-dlq-url")}, nil)
+				m.GetQueueAttributes(ctx, gomock.Any()).Return(&sqs.GetQueueAttributesOutput{Attributes: map[string]string{
+					string(sqstypes.QueueAttributeNameQueueArn): "test-cluster-dlq-arn",
+				}}, nil)
 				m.CreateQueue(ctx, &sqs.CreateQueueInput{
 					QueueName:  aws.String("test-cluster-queue"),
-					Attributes: attrs,
+					Attributes: queueAttrs,
+				}).Return(nil, nil)
+			},
+			expectErr: false,
+		},
+		{
+			name: "updates the attributes of a queue created without a dead-letter queue",
+			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				expectDeadLetterQueue(m)
+				m.CreateQueue(ctx, &sqs.CreateQueueInput{
+					QueueName:  aws.String("test-cluster-queue"),
+					Attributes: queueAttrs,
 				}).Return(nil, &sqstypes.QueueNameExists{})
+				m.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
+					QueueName: aws.String("test-cluster-queue"),
+				}).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-queue-url")}, nil)
+				m.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
+					QueueUrl:   aws.String("test-cluster-queue-url"),
+					Attributes: queueAttrs,
+				}).Return(nil, nil)
 			},
 			expectErr: false,
 		},
+		{
+			name: "errors when the dead-letter queue has no ARN",
+			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.CreateQueue(ctx, gomock.Any()).Return(&sqs.CreateQueueOutput{QueueUrl: aws.String("test-cluster-dlq-url")}, nil)
+				m.GetQueueAttributes(ctx, gomock.Any()).Return(&sqs.GetQueueAttributesOutput{}, nil)
+			},
+			expectErr: true,
+		},
 		{
 			name: "errors when unexpected error occurs",
 			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
-				attrs := make(map[string]string)
-				attrs[string(sqstypes.QueueAttributeNameReceiveMessageWaitTimeSeconds)] = "20"
+				expectDeadLetterQueue(m)
 				m.CreateQueue(ctx, &sqs.CreateQueueInput{
 					QueueName:  aws.String("test-cluster-queue"),
-					Attributes: attrs,
+					Attributes: queueAttrs,
 				}).Return(nil, errors.New("some error"))
 			},
 			expectErr: true,
@@ -109,13 +162,22 @@ func TestDeleteSQSQueue(t *testing.T) {
 
 	ctx := context.TODO()
 
+	expectDeleteDeadLetterQueue := func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+		m.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
+			QueueName: aws.String("test-cluster-dlq"),
+		}).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-dlq-url")}, nil)
+		m.DeleteQueue(ctx, &sqs.DeleteQueueInput{
+			QueueUrl: aws.String("test-cluster-dlq-url"),
+		}).Return(nil, nil)
+	}
+
 	testCases := []struct {
 		name      string
 		expect    func(m *mock_sqsiface.MockSQSAPIMockRecorder)
 		expectErr bool
 	}{
 		{
-			name: "deletes queue successfully",
+			name: "deletes queue and dead-letter queue successfully",
 			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
 				m.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
 					QueueName: aws.String("test-cluster-queue"),
@@ -123,6 +185,7 @@ func TestDeleteSQSQueue(t *testing.T) {
 				m.DeleteQueue(ctx, &sqs.DeleteQueueInput{
 					QueueUrl: aws.String("test-cluster-queue-url"),
 				}).Return(nil, nil)
+				expectDeleteDeadLetterQueue(m)
 			},
 			expectErr: false,
 		},
@@ -132,6 +195,7 @@ func TestDeleteSQSQueue(t *testing.T) {
 				m.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
 					QueueName: aws.String("test-cluster-queue"),
 				}).Return(nil, &sqstypes.QueueDoesNotExist{})
+				expectDeleteDeadLetterQueue(m)
 			},
 			expectErr: false,
 		},
@@ -153,6 +217,7 @@ func TestDeleteSQSQueue(t *testing.T) {
 				m.DeleteQueue(ctx, &sqs.DeleteQueueInput{
 					QueueUrl: aws.String("test-cluster-queue-url"),
 				}).Return(nil, &sqstypes.QueueDoesNotExist{})
+				expectDeleteDeadLetterQueue(m)
 			},
 			expectErr: false,
 		},
@@ -192,7 +257,7 @@ func TestDeleteSQSQueue(t *testing.T) {
 	}
 }
 
-func TestCreatePolicyForRule(t *testing.T) {
+func TestCreatePolicyForRules(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 
@@ -200,16 +265,19 @@ func TestCreatePolicyForRule(t *testing.T) {
 
 	testCases := []struct {
 		name      string
-		input     *createPolicyForRuleInput
+		input     *createPolicyForRulesInput
 		expect    func(m *mock_sqsiface.MockSQSAPIMockRecorder)
 		expectErr bool
 	}{
 		{
-			name: "creates a policy for a given rule",
-			input: &createPolicyForRuleInput{
+			name: "creates a policy for the given rules",
+			input: &createPolicyForRulesInput{
 				QueueArn: "test-cluster-queue-arn",
 				QueueURL: "test-cluster-queue-url",
-				RuleArn:  "test-cluster-rule-arn",
+				RuleArns: map[string]string{
+					"test-cluster-ec2-rule":              "test-cluster-rule-arn",
+					"test-cluster-ec2-interruption-rule": "test-cluster-interruption-rule-arn",
+				},
 			},
 			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
 				buffer := new(bytes.Buffer)
@@ -236,7 +304,7 @@ func TestCreatePolicyForRule(t *testing.T) {
 			s := NewService(clusterScope)
 			s.SQSClient = sqsMock
 
-			err = s.createPolicyForRule(ctx, tc.input)
+			err = s.createPolicyForRules(ctx, tc.input)
 
 			if tc.expectErr {
 				g.Expect(err).NotTo(BeNil())
@@ -274,6 +342,26 @@ func TestGenerateQueueName(t *testing.T) {
 const expectedPolicyJSON = `{
   "Version": "2012-10-17",
   "Statement": [
+    {
+      "Sid": "CAPAEvents_test-cluster-ec2-interruption-rule_test-cluster-queue",
+      "Principal": {
+        "Service": [
+          "events.amazonaws.com"
+        ]
+      },
+      "Effect": "Allow",
+      "Action": [
+        "sqs:SendMessage"
+      ],
+      "Resource": [
+        "test-cluster-queue-arn"
+      ],
+      "Condition": {
+        "ArnEquals": {
+          "aws:SourceArn": "test-cluster-interruption-rule-arn"
+        }
+      }
+    },
     {
       "Sid": "CAPAEvents_test-cluster-ec2-rule_test-cluster-queue",
       "Principal": {
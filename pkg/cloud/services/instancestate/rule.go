@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
@@ -32,36 +33,75 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 )
 
-// Ec2StateChangeNotification defines the EC2 instance's state change notification.
-const Ec2StateChangeNotification = "EC2 Instance State-change Notification"
+const (
+	// Ec2StateChangeNotification defines the EC2 instance's state change notification.
+	Ec2StateChangeNotification = "EC2 Instance State-change Notification"
+	// Ec2SpotInstanceInterruptionWarning defines the notification sent two minutes before a Spot instance is interrupted.
+	Ec2SpotInstanceInterruptionWarning = "EC2 Spot Instance Interruption Warning"
+	// Ec2InstanceRebalanceRecommendation defines the notification sent when a Spot instance is at an elevated risk of interruption.
+	Ec2InstanceRebalanceRecommendation = "EC2 Instance Rebalance Recommendation"
+	// AWSHealthEvent defines the AWS Health notification, which reports the scheduled maintenance of EC2 instances.
+	AWSHealthEvent = "AWS Health Event"
+)
 
-// reconcileRules creates rules and attaches the queue as a target.
-func (s Service) reconcileRules(ctx context.Context) error {
-	var ruleNotFound bool
-	ruleResp, err := s.EventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
-	})
-	if err != nil {
-		if resourceNotFoundError(err) {
-			ruleNotFound = true
-		} else {
-			return errors.Wrapf(err, "unable to describe rule %s", s.getEC2RuleName())
-		}
-	}
+// eventRule is an EventBridge rule that sends EC2 events to the queue of the cluster.
+type eventRule struct {
+	name    string
+	pattern eventPattern
+	// tracksInstances is set when the rule only matches the instances added with AddInstanceToEventPattern.
+	// Such a rule is disabled as long as it doesn't track any instance.
+	tracksInstances bool
+}
 
-	if ruleNotFound {
-		err = s.createRule(ctx)
-		if err != nil {
-			return errors.Wrap(err, "unable to create rule")
-		}
-		// fetch newly created rule
-		ruleResp, err = s.EventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
-			Name: aws.String(s.getEC2RuleName()),
-		})
+// eventRules returns the rules of the cluster.
+func (s Service) eventRules() []eventRule {
+	return []eventRule{
+		{
+			name: s.getEC2RuleName(),
+			pattern: eventPattern{
+				Source:     []string{"aws.ec2"},
+				DetailType: []string{Ec2StateChangeNotification},
+				EventDetail: &eventDetail{
+					States: []infrav1.InstanceState{infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated},
+				},
+			},
+			tracksInstances: true,
+		},
+		{
+			name: s.getEC2InterruptionRuleName(),
+			pattern: eventPattern{
+				Source:      []string{"aws.ec2"},
+				DetailType:  []string{Ec2SpotInstanceInterruptionWarning, Ec2InstanceRebalanceRecommendation},
+				EventDetail: &eventDetail{},
+			},
+			tracksInstances: true,
+		},
+		{
+			// AWS Health events list the affected instances in an array of entities, so the rule matches the
+			// scheduled EC2 maintenance of the whole account and the events of unknown instances are ignored.
+			name: s.getEC2MaintenanceRuleName(),
+			pattern: eventPattern{
+				Source:     []string{"aws.health"},
+				DetailType: []string{AWSHealthEvent},
+				EventDetail: &eventDetail{
+					Service:           []string{"EC2"},
+					EventTypeCategory: []string{"scheduledChange"},
+				},
+			},
+		},
+	}
+}
 
+// reconcileRules creates rules and attaches the queue as a target.
+func (s Service) reconcileRules(ctx context.Context) error {
+	rules := s.eventRules()
+	ruleArns := make(map[string]string, len(rules))
+	for _, rule := range rules {
+		ruleArn, err := s.reconcileRule(ctx, rule)
 		if err != nil {
-			return errors.Wrapf(err, "unable to describe new rule %s", s.getEC2RuleName())
+			return err
 		}
+		ruleArns[rule.name] = ruleArn
 	}
 
 	queueURLResp, err := s.SQSClient.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
@@ -85,106 +125,157 @@ func (s Service) reconcileRules(ctx context.Context) error {
 		return errors.New("queue ARN not exist in queue attributes response")
 	}
 
-	targetsResp, err := s.EventBridgeClient.ListTargetsByRule(ctx, &eventbridge.ListTargetsByRuleInput{
-		Rule: aws.String(s.getEC2RuleName()),
-	})
-	if err != nil {
-		return errors.Wrapf(err, "unable to list targets for rule %s", s.getEC2RuleName())
+	for _, rule := range rules {
+		if err := s.reconcileRuleTarget(ctx, rule.name, queueArn); err != nil {
+			return err
+		}
 	}
 
-	targetFound := false
-	for _, target := range targetsResp.Targets {
-		// check if queue is already added as a target
-		if *target.Id == GenerateQueueName(s.scope.Name()) && *target.Arn == queueArn {
-			targetFound = true
+	// add a policy for the rules so the rules are authorized to emit messages to the queue. The policy is
+	// replaced when it doesn't authorize every rule, for example when the rules of an existing cluster change.
+	policy := queueAttrs.Attributes[string(sqstypes.QueueAttributeNamePolicy)]
+	for _, ruleArn := range ruleArns {
+		if !strings.Contains(policy, ruleArn) {
+			return s.createPolicyForRules(ctx, &createPolicyForRulesInput{
+				QueueArn: queueArn,
+				QueueURL: *queueURLResp.QueueUrl,
+				RuleArns: ruleArns,
+			})
 		}
 	}
 
-	if !targetFound {
-		_, err = s.EventBridgeClient.PutTargets(ctx, &eventbridge.PutTargetsInput{
-			Rule: ruleResp.Name,
-			Targets: []eventbridgetypes.Target{{
-				Arn: aws.String(queueArn),
-				Id:  aws.String(GenerateQueueName(s.scope.Name())),
-			}},
-		})
+	return nil
+}
 
-		if err != nil {
-			return errors.Wrapf(err, "unable to add SQS target %s to rule %s", GenerateQueueName(s.scope.Name()), s.getEC2RuleName())
+// reconcileRule creates a rule if it doesn't exist and returns its ARN.
+func (s Service) reconcileRule(ctx context.Context, rule eventRule) (string, error) {
+	var ruleNotFound bool
+	ruleResp, err := s.EventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+		Name: aws.String(rule.name),
+	})
+	if err != nil {
+		if resourceNotFoundError(err) {
+			ruleNotFound = true
+		} else {
+			return "", errors.Wrapf(err, "unable to describe rule %s", rule.name)
 		}
 	}
 
-	if _, ok := queueAttrs.Attributes[string(sqstypes.QueueAttributeNamePolicy)]; !ok {
-		// add a policy for the rule so the rule is authorized to emit messages to the queue
-		err = s.createPolicyForRule(ctx, &createPolicyForRuleInput{
-			QueueArn: queueArn,
-			QueueURL: *queueURLResp.QueueUrl,
-			RuleArn:  *ruleResp.Arn,
+	if ruleNotFound {
+		err = s.createRule(ctx, rule)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to create rule")
+		}
+		// fetch newly created rule
+		ruleResp, err = s.EventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+			Name: aws.String(rule.name),
 		})
+
 		if err != nil {
-			return err
+			return "", errors.Wrapf(err, "unable to describe new rule %s", rule.name)
 		}
 	}
 
-	return nil
+	return aws.ToString(ruleResp.Arn), nil
 }
 
-func (s Service) createRule(ctx context.Context) error {
-	eventPattern := eventPattern{
-		Source:     []string{"aws.ec2"},
-		DetailType: []string{Ec2StateChangeNotification},
-		EventDetail: &eventDetail{
-			States: []infrav1.InstanceState{infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated},
-		},
+// reconcileRuleTarget adds the queue as a target of a rule if it isn't already.
+func (s Service) reconcileRuleTarget(ctx context.Context, ruleName, queueArn string) error {
+	targetsResp, err := s.EventBridgeClient.ListTargetsByRule(ctx, &eventbridge.ListTargetsByRuleInput{
+		Rule: aws.String(ruleName),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "unable to list targets for rule %s", ruleName)
 	}
-	data, err := json.Marshal(eventPattern)
+
+	for _, target := range targetsResp.Targets {
+		// check if queue is already added as a target
+		if *target.Id == GenerateQueueName(s.scope.Name()) && *target.Arn == queueArn {
+			return nil
+		}
+	}
+
+	_, err = s.EventBridgeClient.PutTargets(ctx, &eventbridge.PutTargetsInput{
+		Rule: aws.String(ruleName),
+		Targets: []eventbridgetypes.Target{{
+			Arn: aws.String(queueArn),
+			Id:  aws.String(GenerateQueueName(s.scope.Name())),
+		}},
+	})
+
+	return errors.Wrapf(err, "unable to add SQS target %s to rule %s", GenerateQueueName(s.scope.Name()), ruleName)
+}
+
+func (s Service) createRule(ctx context.Context, rule eventRule) error {
+	data, err := json.Marshal(rule.pattern)
 	if err != nil {
 		return err
 	}
-	// create in disabled state so the rule doesn't pick up all EC2 instances. As machines get created,
-	// the rule will get updated to track those machines
+	// create a rule that tracks instances in disabled state so the rule doesn't pick up all EC2 instances.
+	// As machines get created, the rule will get updated to track those machines
+	state := eventbridgetypes.RuleStateEnabled
+	if rule.tracksInstances {
+		state = eventbridgetypes.RuleStateDisabled
+	}
 	_, err = s.EventBridgeClient.PutRule(ctx, &eventbridge.PutRuleInput{
-		Name:         aws.String(s.getEC2RuleName()),
+		Name:         aws.String(rule.name),
 		EventPattern: aws.String(string(data)),
-		State:        eventbridgetypes.RuleStateDisabled,
+		State:        state,
 	})
 
 	return err
 }
 
 func (s Service) deleteRules(ctx context.Context) error {
-	_, err := s.EventBridgeClient.RemoveTargets(ctx, &eventbridge.RemoveTargetsInput{
-		Rule: aws.String(s.getEC2RuleName()),
-		Ids:  []string{GenerateQueueName(s.scope.Name())},
-	})
-	if err != nil && !resourceNotFoundError(err) {
-		return errors.Wrapf(err, "unable to remove target %s for rule %s", GenerateQueueName(s.scope.Name()), s.getEC2RuleName())
+	for _, rule := range s.eventRules() {
+		_, err := s.EventBridgeClient.RemoveTargets(ctx, &eventbridge.RemoveTargetsInput{
+			Rule: aws.String(rule.name),
+			Ids:  []string{GenerateQueueName(s.scope.Name())},
+		})
+		if err != nil && !resourceNotFoundError(err) {
+			return errors.Wrapf(err, "unable to remove target %s for rule %s", GenerateQueueName(s.scope.Name()), rule.name)
+		}
+		_, err = s.EventBridgeClient.DeleteRule(ctx, &eventbridge.DeleteRuleInput{
+			Name: aws.String(rule.name),
+		})
+		if err != nil && !resourceNotFoundError(err) {
+			return err
+		}
 	}
-	_, err = s.EventBridgeClient.DeleteRule(ctx, &eventbridge.DeleteRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
-	})
 
-	if err != nil && resourceNotFoundError(err) {
-		return nil
+	return nil
+}
+
+// AddInstanceToEventPattern will add an instance to the event patterns of the rules that track instances.
+func (s Service) AddInstanceToEventPattern(ctx context.Context, instanceID string) error {
+	for _, rule := range s.eventRules() {
+		if !rule.tracksInstances {
+			continue
+		}
+		if err := s.addInstanceToRule(ctx, rule, instanceID); err != nil {
+			return err
+		}
 	}
 
-	return err
+	return nil
 }
 
-// AddInstanceToEventPattern will add an instance to an event pattern.
-func (s Service) AddInstanceToEventPattern(ctx context.Context, instanceID string) error {
+func (s Service) addInstanceToRule(ctx context.Context, rule eventRule, instanceID string) error {
 	ruleResp, err := s.EventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
+		Name: aws.String(rule.name),
 	})
 	if err != nil {
-		return errors.Wrapf(err, "unable to describe rule %s", s.getEC2RuleName())
+		return errors.Wrapf(err, "unable to describe rule %s", rule.name)
 	}
 	e := eventPattern{}
 	err = json.Unmarshal([]byte(*ruleResp.EventPattern), &e)
 	if err != nil {
 		return err
 	}
-	e.DetailType = []string{Ec2StateChangeNotification}
+	e.DetailType = rule.pattern.DetailType
+	if e.EventDetail == nil {
+		e.EventDetail = &eventDetail{}
+	}
 
 	for _, r := range e.EventDetail.InstanceIDs {
 		if r == instanceID {
@@ -199,28 +290,36 @@ func (s Service) AddInstanceToEventPattern(ctx context.Context, instanceID strin
 		return err
 	}
 	_, err = s.EventBridgeClient.PutRule(ctx, &eventbridge.PutRuleInput{
-		Name:         aws.String(s.getEC2RuleName()),
+		Name:         aws.String(rule.name),
 		EventPattern: aws.String(string(eventData)),
 		State:        eventbridgetypes.RuleStateEnabled,
 	})
 	return err
 }
 
-// RemoveInstanceFromEventPattern attempts a best effort update to the event rule to remove the instance.
+// RemoveInstanceFromEventPattern attempts a best effort update to the event rules to remove the instance.
 // Any errors encountered won't be blocking.
 func (s Service) RemoveInstanceFromEventPattern(ctx context.Context, instanceID string) {
+	for _, rule := range s.eventRules() {
+		if rule.tracksInstances {
+			s.removeInstanceFromRule(ctx, rule, instanceID)
+		}
+	}
+}
+
+func (s Service) removeInstanceFromRule(ctx context.Context, rule eventRule, instanceID string) {
 	ruleResp, err := s.EventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
+		Name: aws.String(rule.name),
 	})
 	if err != nil {
 		return
 	}
 	e := eventPattern{}
 	err = json.Unmarshal([]byte(*ruleResp.EventPattern), &e)
-	if err != nil {
+	if err != nil || e.EventDetail == nil {
 		return
 	}
-	e.DetailType = []string{Ec2StateChangeNotification}
+	e.DetailType = rule.pattern.DetailType
 
 	found := false
 	for i, r := range e.EventDetail.InstanceIDs {
@@ -237,7 +336,7 @@ func (s Service) RemoveInstanceFromEventPattern(ctx context.Context, instanceID
 			return
 		}
 		input := &eventbridge.PutRuleInput{
-			Name:         aws.String(s.getEC2RuleName()),
+			Name:         aws.String(rule.name),
 			EventPattern: aws.String(string(eventData)),
 			State:        eventbridgetypes.RuleStateEnabled,
 		}
@@ -253,6 +352,14 @@ func (s Service) getEC2RuleName() string {
 	return fmt.Sprintf("%s-ec2-rule", s.scope.Name())
 }
 
+func (s Service) getEC2InterruptionRuleName() string {
+	return fmt.Sprintf("%s-ec2-interruption-rule", s.scope.Name())
+}
+
+func (s Service) getEC2MaintenanceRuleName() string {
+	return fmt.Sprintf("%s-ec2-maintenance-rule", s.scope.Name())
+}
+
 func resourceNotFoundError(err error) bool {
 	smithyErr := awserrors.ParseSmithyError(err)
 	if smithyErr == nil {
@@ -268,6 +375,8 @@ type eventPattern struct {
 }
 
 type eventDetail struct {
-	InstanceIDs []string                `json:"instance-id,omitempty"`
-	States      []infrav1.InstanceState `json:"state,omitempty"`
+	InstanceIDs       []string                `json:"instance-id,omitempty"`
+	States            []infrav1.InstanceState `json:"state,omitempty"`
+	Service           []string                `json:"service,omitempty"`
+	EventTypeCategory []string                `json:"eventTypeCategory,omitempty"`
 }
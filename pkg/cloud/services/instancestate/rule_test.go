@@ -39,8 +39,26 @@ func TestReconcileRules(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	ruleName := "test-cluster-ec2-rule"
+	interruptionRuleName := "test-cluster-ec2-interruption-rule"
+	maintenanceRuleName := "test-cluster-ec2-maintenance-rule"
+	ruleNames := []string{ruleName, interruptionRuleName, maintenanceRuleName}
 	ctx := context.TODO()
 
+	marshal := func(e *eventPattern) string {
+		data, err := json.Marshal(e)
+		if err != nil {
+			t.Fatalf("got an unexpected error: %v", err)
+		}
+		return string(data)
+	}
+	describeRules := func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+		for _, name := range ruleNames {
+			m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
+				Name: aws.String(name),
+			})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(name), Arn: aws.String(name + "-arn")}, nil)
+		}
+	}
+
 	testCases := []struct {
 		name                        string
 		eventBridgeExpect           func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder)
@@ -49,47 +67,65 @@ func TestReconcileRules(t *testing.T) {
 		expectErr                   bool
 	}{
 		{
-			name: "successfully creates missing rule and target",
+			name: "successfully creates missing rules and targets",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
-				m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
-					Name: aws.String(ruleName),
-				})).Return(nil, &eventbridgetypes.ResourceNotFoundException{})
-				e := &eventPattern{
-					Source:     []string{"aws.ec2"},
-					DetailType: []string{Ec2StateChangeNotification},
-					EventDetail: &eventDetail{
-						States: []infrav1.InstanceState{infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated},
-					},
-				}
-				data, err := json.Marshal(e)
-				if err != nil {
-					t.Fatalf("got an unexpected error: %v", err)
+				for _, name := range ruleNames {
+					m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
+						Name: aws.String(name),
+					})).Return(nil, &eventbridgetypes.ResourceNotFoundException{})
 				}
 				m.PutRule(ctx, gomock.Eq(&eventbridge.PutRuleInput{
-					Name:         aws.String(ruleName),
-					State:        eventbridgetypes.RuleStateDisabled,
-					EventPattern: aws.String(string(data)),
+					Name:  aws.String(ruleName),
+					State: eventbridgetypes.RuleStateDisabled,
+					EventPattern: aws.String(marshal(&eventPattern{
+						Source:     []string{"aws.ec2"},
+						DetailType: []string{Ec2StateChangeNotification},
+						EventDetail: &eventDetail{
+							States: []infrav1.InstanceState{infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated},
+						},
+					})),
+				}))
+				m.PutRule(ctx, gomock.Eq(&eventbridge.PutRuleInput{
+					Name:  aws.String(interruptionRuleName),
+					State: eventbridgetypes.RuleStateDisabled,
+					EventPattern: aws.String(marshal(&eventPattern{
+						Source:      []string{"aws.ec2"},
+						DetailType:  []string{Ec2SpotInstanceInterruptionWarning, Ec2InstanceRebalanceRecommendation},
+						EventDetail: &eventDetail{},
+					})),
+				}))
+				m.PutRule(ctx, gomock.Eq(&eventbridge.PutRuleInput{
+					Name:  aws.String(maintenanceRuleName),
+					State: eventbridgetypes.RuleStateEnabled,
+					EventPattern: aws.String(marshal(&eventPattern{
+						Source:     []string{"aws.health"},
+						DetailType: []string{AWSHealthEvent},
+						EventDetail: &eventDetail{
+							Service:           []string{"EC2"},
+							EventTypeCategory: []string{"scheduledChange"},
+						},
+					})),
 				}))
 			},
 			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
-				m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
-					Name: aws.String(ruleName),
-				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(ruleName), Arn: aws.String("rule-arn")}, nil)
-				m.ListTargetsByRule(ctx, &eventbridge.ListTargetsByRuleInput{
-					Rule: aws.String(ruleName),
-				}).Return(&eventbridge.ListTargetsByRuleOutput{
-					Targets: []eventbridgetypes.Target{{
-						Id:  aws.String("another-queue"),
-						Arn: aws.String("another-queue-arn"),
-					}},
-				}, nil)
-				m.PutTargets(ctx, gomock.Eq(&eventbridge.PutTargetsInput{
-					Rule: aws.String(ruleName),
-					Targets: []eventbridgetypes.Target{{
-						Arn: aws.String("test-cluster-queue-arn"),
-						Id:  aws.String("test-cluster-queue"),
-					}},
-				}))
+				describeRules(m)
+				for _, name := range ruleNames {
+					m.ListTargetsByRule(ctx, &eventbridge.ListTargetsByRuleInput{
+						Rule: aws.String(name),
+					}).Return(&eventbridge.ListTargetsByRuleOutput{
+						Targets: []eventbridgetypes.Target{{
+							Id:  aws.String("another-queue"),
+							Arn: aws.String("another-queue-arn"),
+						}},
+					}, nil)
+					m.PutTargets(ctx, gomock.Eq(&eventbridge.PutTargetsInput{
+						Rule: aws.String(name),
+						Targets: []eventbridgetypes.Target{{
+							Arn: aws.String("test-cluster-queue-arn"),
+							Id:  aws.String("test-cluster-queue"),
+						}},
+					}))
+				}
 			},
 			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
 				m.GetQueueUrl(ctx, gomock.Eq(&sqs.GetQueueUrlInput{
@@ -106,34 +142,47 @@ func TestReconcileRules(t *testing.T) {
 			expectErr: false,
 		},
 		{
-			name: "skips creating target and queue policy if they already exist",
-			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
-				m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
-					Name: aws.String(ruleName),
-				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(ruleName), Arn: aws.String("rule-arn")}, nil)
+			name:              "skips creating targets and queue policy if they already exist",
+			eventBridgeExpect: describeRules,
+			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
 				m.ListTargetsByRule(ctx, gomock.AssignableToTypeOf(&eventbridge.ListTargetsByRuleInput{})).Return(&eventbridge.ListTargetsByRuleOutput{
 					Targets: []eventbridgetypes.Target{{
 						Id:  aws.String("test-cluster-queue"),
 						Arn: aws.String("test-cluster-queue-arn"),
 					}},
-				}, nil)
+				}, nil).Times(len(ruleNames))
 			},
-			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {},
 			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
 				m.GetQueueUrl(ctx, gomock.AssignableToTypeOf(&sqs.GetQueueUrlInput{})).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-queue-url")}, nil)
 				attrs := make(map[string]string)
 				attrs[string(sqstypes.QueueAttributeNameQueueArn)] = "test-cluster-queue-arn"
-				attrs[string(sqstypes.QueueAttributeNamePolicy)] = "some policy"
+				attrs[string(sqstypes.QueueAttributeNamePolicy)] = "policy for test-cluster-ec2-rule-arn, test-cluster-ec2-interruption-rule-arn and test-cluster-ec2-maintenance-rule-arn"
 				m.GetQueueAttributes(ctx, gomock.AssignableToTypeOf(&sqs.GetQueueAttributesInput{})).Return(&sqs.GetQueueAttributesOutput{Attributes: attrs}, nil)
 			},
 		},
 		{
-			name: "returns error if GetQueueAttributes doesn't have queue ARN",
-			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
-				m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
-					Name: aws.String(ruleName),
-				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(ruleName), Arn: aws.String("rule-arn")}, nil)
+			name:              "replaces the queue policy if it doesn't authorize every rule",
+			eventBridgeExpect: describeRules,
+			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.ListTargetsByRule(ctx, gomock.AssignableToTypeOf(&eventbridge.ListTargetsByRuleInput{})).Return(&eventbridge.ListTargetsByRuleOutput{
+					Targets: []eventbridgetypes.Target{{
+						Id:  aws.String("test-cluster-queue"),
+						Arn: aws.String("test-cluster-queue-arn"),
+					}},
+				}, nil).Times(len(ruleNames))
+			},
+			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(ctx, gomock.AssignableToTypeOf(&sqs.GetQueueUrlInput{})).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-queue-url")}, nil)
+				attrs := make(map[string]string)
+				attrs[string(sqstypes.QueueAttributeNameQueueArn)] = "test-cluster-queue-arn"
+				attrs[string(sqstypes.QueueAttributeNamePolicy)] = "policy for test-cluster-ec2-rule-arn"
+				m.GetQueueAttributes(ctx, gomock.AssignableToTypeOf(&sqs.GetQueueAttributesInput{})).Return(&sqs.GetQueueAttributesOutput{Attributes: attrs}, nil)
+				m.SetQueueAttributes(ctx, gomock.AssignableToTypeOf(&sqs.SetQueueAttributesInput{})).Return(nil, nil)
 			},
+		},
+		{
+			name:                        "returns error if GetQueueAttributes doesn't have queue ARN",
+			eventBridgeExpect:           describeRules,
 			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {},
 			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
 				m.GetQueueUrl(ctx, gomock.AssignableToTypeOf(&sqs.GetQueueUrlInput{})).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-queue-url")}, nil)
@@ -185,6 +234,7 @@ func TestDeleteRules(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	ctx := context.TODO()
+	ruleNames := []string{"test-cluster-ec2-rule", "test-cluster-ec2-interruption-rule", "test-cluster-ec2-maintenance-rule"}
 
 	testCases := []struct {
 		name              string
@@ -192,26 +242,34 @@ func TestDeleteRules(t *testing.T) {
 		expectErr         bool
 	}{
 		{
-			name: "removes target and ec2 rule successfully when they both exist",
+			name: "removes targets and rules successfully when they exist",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
-				m.RemoveTargets(ctx, gomock.Eq(&eventbridge.RemoveTargetsInput{
-					Rule: aws.String("test-cluster-ec2-rule"),
-					Ids:  []string{"test-cluster-queue"},
-				})).Return(nil, nil)
-				m.DeleteRule(ctx, gomock.Eq(&eventbridge.DeleteRuleInput{
-					Name: aws.String("test-cluster-ec2-rule"),
-				})).Return(nil, nil)
+				for _, name := range ruleNames {
+					m.RemoveTargets(ctx, gomock.Eq(&eventbridge.RemoveTargetsInput{
+						Rule: aws.String(name),
+						Ids:  []string{"test-cluster-queue"},
+					})).Return(nil, nil)
+					m.DeleteRule(ctx, gomock.Eq(&eventbridge.DeleteRuleInput{
+						Name: aws.String(name),
+					})).Return(nil, nil)
+				}
 			},
 			expectErr: false,
 		},
 		{
-			name: "continues to remove rule when target doesn't exist",
+			name: "continues to remove rules when targets and rules don't exist",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
 				m.RemoveTargets(ctx, gomock.AssignableToTypeOf(&eventbridge.RemoveTargetsInput{})).
-					Return(nil, &eventbridgetypes.ResourceNotFoundException{})
+					Return(nil, &eventbridgetypes.ResourceNotFoundException{}).Times(len(ruleNames))
 				m.DeleteRule(ctx, gomock.Eq(&eventbridge.DeleteRuleInput{
 					Name: aws.String("test-cluster-ec2-rule"),
 				})).Return(nil, nil)
+				m.DeleteRule(ctx, gomock.Eq(&eventbridge.DeleteRuleInput{
+					Name: aws.String("test-cluster-ec2-interruption-rule"),
+				})).Return(nil, &eventbridgetypes.ResourceNotFoundException{})
+				m.DeleteRule(ctx, gomock.Eq(&eventbridge.DeleteRuleInput{
+					Name: aws.String("test-cluster-ec2-maintenance-rule"),
+				})).Return(nil, nil)
 			},
 			expectErr: false,
 		},
@@ -256,17 +314,21 @@ func TestDeleteRules(t *testing.T) {
 func TestAddInstanceToRule(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
-	pattern := eventPattern{
-		DetailType: []string{Ec2StateChangeNotification},
-		Source:     []string{"aws.ec2"},
-		EventDetail: &eventDetail{
-			InstanceIDs: []string{"instance-a"},
-		},
-	}
-	patternData, err := json.Marshal(pattern)
-	if err != nil {
-		t.Fatalf("got an unexpected error: %v", err)
+	pattern := func(detailType []string, instanceIDs ...string) string {
+		data, err := json.Marshal(eventPattern{
+			DetailType: detailType,
+			Source:     []string{"aws.ec2"},
+			EventDetail: &eventDetail{
+				InstanceIDs: instanceIDs,
+			},
+		})
+		if err != nil {
+			t.Fatalf("got an unexpected error: %v", err)
+		}
+		return string(data)
 	}
+	stateChange := []string{Ec2StateChangeNotification}
+	interruption := []string{Ec2SpotInstanceInterruptionWarning, Ec2InstanceRebalanceRecommendation}
 
 	ctx := context.TODO()
 
@@ -277,22 +339,26 @@ func TestAddInstanceToRule(t *testing.T) {
 		expectErr         bool
 	}{
 		{
-			name: "adds instance to event pattern when it doesn't exist",
+			name: "adds instance to event patterns when it doesn't exist",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
 				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
 					Name: aws.String("test-cluster-ec2-rule"),
 				}).Return(&eventbridge.DescribeRuleOutput{
-					EventPattern: aws.String(string(patternData)),
+					EventPattern: aws.String(pattern(stateChange, "instance-a")),
 				}, nil)
-				expectedPattern := pattern
-				expectedPattern.EventDetail.InstanceIDs = append(expectedPattern.EventDetail.InstanceIDs, "instance-b")
-				expectedData, err := json.Marshal(expectedPattern)
-				if err != nil {
-					t.Fatalf("got an unexpected error: %v", err)
-				}
 				m.PutRule(ctx, &eventbridge.PutRuleInput{
 					Name:         aws.String("test-cluster-ec2-rule"),
-					EventPattern: aws.String(string(expectedData)),
+					EventPattern: aws.String(pattern(stateChange, "instance-a", "instance-b")),
+					State:        eventbridgetypes.RuleStateEnabled,
+				}).Return(nil, nil)
+				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+					Name: aws.String("test-cluster-ec2-interruption-rule"),
+				}).Return(&eventbridge.DescribeRuleOutput{
+					EventPattern: aws.String(pattern(interruption, "instance-a")),
+				}, nil)
+				m.PutRule(ctx, &eventbridge.PutRuleInput{
+					Name:         aws.String("test-cluster-ec2-interruption-rule"),
+					EventPattern: aws.String(pattern(interruption, "instance-a", "instance-b")),
 					State:        eventbridgetypes.RuleStateEnabled,
 				}).Return(nil, nil)
 			},
@@ -300,17 +366,37 @@ func TestAddInstanceToRule(t *testing.T) {
 			expectErr:     false,
 		},
 		{
-			name: "does nothing if instance is already tracked in event pattern",
+			name: "does nothing if instance is already tracked in event patterns",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
 				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
 					Name: aws.String("test-cluster-ec2-rule"),
 				}).Return(&eventbridge.DescribeRuleOutput{
-					EventPattern: aws.String(string(patternData)),
+					EventPattern: aws.String(pattern(stateChange, "instance-a")),
+				}, nil)
+				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+					Name: aws.String("test-cluster-ec2-interruption-rule"),
+				}).Return(&eventbridge.DescribeRuleOutput{
+					EventPattern: aws.String(pattern(interruption, "instance-a")),
 				}, nil)
 			},
 			newInstanceID: "instance-a",
 			expectErr:     false,
 		},
+		{
+			name: "returns error if the interruption rule doesn't exist yet",
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+					Name: aws.String("test-cluster-ec2-rule"),
+				}).Return(&eventbridge.DescribeRuleOutput{
+					EventPattern: aws.String(pattern(stateChange, "instance-a")),
+				}, nil)
+				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+					Name: aws.String("test-cluster-ec2-interruption-rule"),
+				}).Return(nil, &eventbridgetypes.ResourceNotFoundException{})
+			},
+			newInstanceID: "instance-a",
+			expectErr:     true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -337,16 +423,31 @@ func TestAddInstanceToRule(t *testing.T) {
 func TestRemoveInstanceStateFromEventPattern(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
-	pattern := eventPattern{
-		DetailType: []string{Ec2StateChangeNotification},
-		Source:     []string{"aws.ec2"},
-		EventDetail: &eventDetail{
-			InstanceIDs: []string{"instance-a", "instance-b", "instance-c"},
-		},
+	pattern := func(instanceIDs ...string) string {
+		data, err := json.Marshal(eventPattern{
+			DetailType: []string{Ec2StateChangeNotification},
+			Source:     []string{"aws.ec2"},
+			EventDetail: &eventDetail{
+				InstanceIDs: instanceIDs,
+			},
+		})
+		if err != nil {
+			t.Fatalf("got an unexpected error: %v", err)
+		}
+		return string(data)
 	}
-	patternData, err := json.Marshal(pattern)
-	if err != nil {
-		t.Fatalf("got an unexpected error: %v", err)
+	interruptionPattern := func(instanceIDs ...string) string {
+		data, err := json.Marshal(eventPattern{
+			DetailType: []string{Ec2SpotInstanceInterruptionWarning, Ec2InstanceRebalanceRecommendation},
+			Source:     []string{"aws.ec2"},
+			EventDetail: &eventDetail{
+				InstanceIDs: instanceIDs,
+			},
+		})
+		if err != nil {
+			t.Fatalf("got an unexpected error: %v", err)
+		}
+		return string(data)
 	}
 
 	ctx := context.TODO()
@@ -357,51 +458,52 @@ func TestRemoveInstanceStateFromEventPattern(t *testing.T) {
 		instanceID        string
 	}{
 		{
-			name: "remove instance from instance IDs and disables rule when no instances are tracked",
+			name: "remove instance from instance IDs and disables rules when no instances are tracked",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
-				singleInstanceEventPattern := pattern
-				singleInstanceEventPattern.EventDetail.InstanceIDs = []string{"instance-a"}
-				patternData, err := json.Marshal(pattern)
-				if err != nil {
-					t.Fatalf("got an unexpected error: %v", err)
-				}
 				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
 					Name: aws.String("test-cluster-ec2-rule"),
 				}).Return(&eventbridge.DescribeRuleOutput{
-					EventPattern: aws.String(string(patternData)),
+					EventPattern: aws.String(pattern("instance-a")),
 				}, nil)
-				expectedPattern := pattern
-				expectedPattern.EventDetail.InstanceIDs = []string{}
-				expectedData, err := json.Marshal(expectedPattern)
-				if err != nil {
-					t.Fatalf("got an unexpected error: %v", err)
-				}
-
 				m.PutRule(ctx, &eventbridge.PutRuleInput{
 					Name:         aws.String("test-cluster-ec2-rule"),
-					EventPattern: aws.String(string(expectedData)),
+					EventPattern: aws.String(pattern()),
+					State:        eventbridgetypes.RuleStateDisabled,
+				}).Return(nil, nil)
+				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+					Name: aws.String("test-cluster-ec2-interruption-rule"),
+				}).Return(&eventbridge.DescribeRuleOutput{
+					EventPattern: aws.String(interruptionPattern("instance-a")),
+				}, nil)
+				m.PutRule(ctx, &eventbridge.PutRuleInput{
+					Name:         aws.String("test-cluster-ec2-interruption-rule"),
+					EventPattern: aws.String(interruptionPattern()),
 					State:        eventbridgetypes.RuleStateDisabled,
 				}).Return(nil, nil)
 			},
 			instanceID: "instance-a",
 		},
 		{
-			name: "remove instance from instance IDs and rule remains enabled when other instances are tracked",
+			name: "remove instance from instance IDs and rules remain enabled when other instances are tracked",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
 				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
 					Name: aws.String("test-cluster-ec2-rule"),
 				}).Return(&eventbridge.DescribeRuleOutput{
-					EventPattern: aws.String(string(patternData)),
+					EventPattern: aws.String(pattern("instance-a", "instance-b", "instance-c")),
 				}, nil)
-				expectedPattern := pattern
-				expectedPattern.EventDetail.InstanceIDs = []string{"instance-a", "instance-c"}
-				expectedData, err := json.Marshal(expectedPattern)
-				if err != nil {
-					t.Fatalf("got an unexpected error: %v", err)
-				}
 				m.PutRule(ctx, &eventbridge.PutRuleInput{
 					Name:         aws.String("test-cluster-ec2-rule"),
-					EventPattern: aws.String(string(expectedData)),
+					EventPattern: aws.String(pattern("instance-a", "instance-c")),
+					State:        eventbridgetypes.RuleStateEnabled,
+				}).Return(nil, nil)
+				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+					Name: aws.String("test-cluster-ec2-interruption-rule"),
+				}).Return(&eventbridge.DescribeRuleOutput{
+					EventPattern: aws.String(interruptionPattern("instance-a", "instance-b", "instance-c")),
+				}, nil)
+				m.PutRule(ctx, &eventbridge.PutRuleInput{
+					Name:         aws.String("test-cluster-ec2-interruption-rule"),
+					EventPattern: aws.String(interruptionPattern("instance-a", "instance-c")),
 					State:        eventbridgetypes.RuleStateEnabled,
 				}).Return(nil, nil)
 			},
@@ -413,11 +515,35 @@ func TestRemoveInstanceStateFromEventPattern(t *testing.T) {
 				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
 					Name: aws.String("test-cluster-ec2-rule"),
 				}).Return(&eventbridge.DescribeRuleOutput{
-					EventPattern: aws.String(string(patternData)),
+					EventPattern: aws.String(pattern("instance-a", "instance-b", "instance-c")),
+				}, nil)
+				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+					Name: aws.String("test-cluster-ec2-interruption-rule"),
+				}).Return(&eventbridge.DescribeRuleOutput{
+					EventPattern: aws.String(interruptionPattern("instance-a", "instance-b", "instance-c")),
 				}, nil)
 			},
 			instanceID: "instance-d",
 		},
+		{
+			name: "continues with the other rules when a rule can't be described",
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+					Name: aws.String("test-cluster-ec2-rule"),
+				}).Return(&eventbridge.DescribeRuleOutput{
+					EventPattern: aws.String(pattern("instance-a", "instance-b")),
+				}, nil)
+				m.PutRule(ctx, &eventbridge.PutRuleInput{
+					Name:         aws.String("test-cluster-ec2-rule"),
+					EventPattern: aws.String(pattern("instance-a")),
+					State:        eventbridgetypes.RuleStateEnabled,
+				}).Return(nil, nil)
+				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+					Name: aws.String("test-cluster-ec2-interruption-rule"),
+				}).Return(nil, &eventbridgetypes.ResourceNotFoundException{})
+			},
+			instanceID: "instance-b",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -60,6 +60,15 @@ type ASGInterface interface {
 	CreateLifecycleHook(ctx context.Context, asgName string, hook *expinfrav1.AWSLifecycleHook) error
 	UpdateLifecycleHook(ctx context.Context, asgName string, hook *expinfrav1.AWSLifecycleHook) error
 	DeleteLifecycleHook(ctx context.Context, asgName string, hook *expinfrav1.AWSLifecycleHook) error
+	DescribeWarmPool(ctx context.Context, asgName string) (*expinfrav1.WarmPoolStatus, error)
+	PutWarmPool(ctx context.Context, asgName string, warmPool *expinfrav1.WarmPool) error
+	DeleteWarmPool(ctx context.Context, asgName string) error
+	DescribeScalingPolicies(ctx context.Context, asgName string) (map[string]*expinfrav1.ScalingPolicy, error)
+	PutScalingPolicy(ctx context.Context, asgName string, policy *expinfrav1.ScalingPolicy) (string, error)
+	DeleteScalingPolicy(ctx context.Context, asgName string, policyName string) error
+	DescribeScheduledActions(ctx context.Context, asgName string) ([]*expinfrav1.ScheduledAction, error)
+	PutScheduledAction(ctx context.Context, asgName string, action *expinfrav1.ScheduledAction) error
+	DeleteScheduledAction(ctx context.Context, asgName string, actionName string) error
 }
 
 // EC2Interface encapsulates the methods exposed to the machine
@@ -103,6 +112,15 @@ type EC2Interface interface {
 	DescribeDedicatedHost(ctx context.Context, hostID string) (*infrav1.DedicatedHostInfo, error)
 }
 
+// FleetInterface encapsulates the methods exposed to the AWSFleetMachinePool controller.
+type FleetInterface interface {
+	GetFleet(scope *scope.FleetMachinePoolScope) (*expinfrav1.EC2Fleet, error)
+	CreateFleet(scope *scope.FleetMachinePoolScope) (string, error)
+	FleetNeedsUpdate(scope *scope.FleetMachinePoolScope, existing *expinfrav1.EC2Fleet) (bool, error)
+	UpdateFleet(scope *scope.FleetMachinePoolScope, fleetID string) error
+	DeleteFleet(fleetID string) error
+}
+
 // MachinePoolReconcileInterface encapsulates high-level reconciliation functions regarding EC2 reconciliation. It is
 // separate from EC2Interface so that we can mock AWS requests separately. For example, by not mocking the
 // ReconcileLaunchTemplate function, but mocking EC2Interface, we can test which EC2 API operations would have been called.
@@ -130,6 +148,8 @@ type ELBInterface interface {
 	DeregisterInstanceFromAPIServerLB(ctx context.Context, targetGroupArn string, i *infrav1.Instance) error
 	RegisterInstanceWithAPIServerELB(ctx context.Context, i *infrav1.Instance) error
 	RegisterInstanceWithAPIServerLB(ctx context.Context, i *infrav1.Instance, lb *infrav1.AWSLoadBalancerSpec) error
+	RegisterInstanceWithIngressLB(ctx context.Context, i *infrav1.Instance) error
+	DeregisterInstanceFromIngressLB(ctx context.Context, i *infrav1.Instance) error
 }
 
 // NetworkInterface encapsulates the methods exposed to the cluster
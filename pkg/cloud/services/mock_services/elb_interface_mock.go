@@ -93,6 +93,20 @@ func (mr *MockELBInterfaceMockRecorder) DeregisterInstanceFromAPIServerLB(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterInstanceFromAPIServerLB", reflect.TypeOf((*MockELBInterface)(nil).DeregisterInstanceFromAPIServerLB), arg0, arg1, arg2)
 }
 
+// DeregisterInstanceFromIngressLB mocks base method.
+func (m *MockELBInterface) DeregisterInstanceFromIngressLB(arg0 context.Context, arg1 *v1beta2.Instance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterInstanceFromIngressLB", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterInstanceFromIngressLB indicates an expected call of DeregisterInstanceFromIngressLB.
+func (mr *MockELBInterfaceMockRecorder) DeregisterInstanceFromIngressLB(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterInstanceFromIngressLB", reflect.TypeOf((*MockELBInterface)(nil).DeregisterInstanceFromIngressLB), arg0, arg1)
+}
+
 // IsInstanceRegisteredWithAPIServerELB mocks base method.
 func (m *MockELBInterface) IsInstanceRegisteredWithAPIServerELB(arg0 context.Context, arg1 *v1beta2.Instance) (bool, error) {
 	m.ctrl.T.Helper()
@@ -165,3 +179,17 @@ func (mr *MockELBInterfaceMockRecorder) RegisterInstanceWithAPIServerLB(arg0, ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterInstanceWithAPIServerLB", reflect.TypeOf((*MockELBInterface)(nil).RegisterInstanceWithAPIServerLB), arg0, arg1, arg2)
 }
+
+// RegisterInstanceWithIngressLB mocks base method.
+func (m *MockELBInterface) RegisterInstanceWithIngressLB(arg0 context.Context, arg1 *v1beta2.Instance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterInstanceWithIngressLB", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterInstanceWithIngressLB indicates an expected call of RegisterInstanceWithIngressLB.
+func (mr *MockELBInterfaceMockRecorder) RegisterInstanceWithIngressLB(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterInstanceWithIngressLB", reflect.TypeOf((*MockELBInterface)(nil).RegisterInstanceWithIngressLB), arg0, arg1)
+}
@@ -0,0 +1,249 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+const (
+	defaultFlowLogsMaxAggregationInterval = 600
+	flowLogsDeliveryFailed                = "FAILED"
+)
+
+// reconcileFlowLogs publishes the flow logs of the VPC to the configured destination.
+// Flow logs cannot be modified, so the flow logs owned by the cluster that don't match
+// the configuration are replaced. If the VPC is unmanaged, this is a no-op.
+// For more information, see: https://docs.aws.amazon.com/vpc/latest/userguide/flow-logs.html
+func (s *Service) reconcileFlowLogs() error {
+	// If the VPC is unmanaged or not yet populated, return early.
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) || s.scope.VPC().ID == "" {
+		return nil
+	}
+
+	spec := s.scope.VPC().FlowLogs
+	if spec == nil && !v1beta1conditions.Has(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition) {
+		return nil
+	}
+
+	flowLogs, err := s.describeFlowLogs()
+	if err != nil {
+		return err
+	}
+
+	var current *types.FlowLog
+	stale := []string{}
+	for i := range flowLogs {
+		if current == nil && spec != nil && flowLogMatches(spec, flowLogs[i]) {
+			current = &flowLogs[i]
+			continue
+		}
+		stale = append(stale, aws.ToString(flowLogs[i].FlowLogId))
+	}
+
+	if err := s.deleteFlowLogsByID(stale); err != nil {
+		return err
+	}
+
+	if spec == nil {
+		v1beta1conditions.Delete(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition)
+		return nil
+	}
+
+	if current == nil {
+		if err := s.createFlowLogs(spec); err != nil {
+			return err
+		}
+		v1beta1conditions.MarkTrue(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition)
+		return nil
+	}
+
+	if aws.ToString(current.DeliverLogsStatus) == flowLogsDeliveryFailed {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition, infrav1.VpcFlowLogsDeliveryFailedReason, clusterv1beta1.ConditionSeverityWarning,
+			"Failed to publish flow logs %q: %s", aws.ToString(current.FlowLogId), aws.ToString(current.DeliverLogsErrorMessage))
+		return nil
+	}
+	v1beta1conditions.MarkTrue(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition)
+
+	return nil
+}
+
+// deleteFlowLogs deletes the flow logs owned by the cluster in the VPC.
+func (s *Service) deleteFlowLogs() error {
+	// If the VPC is unmanaged or not yet populated, return early.
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) || s.scope.VPC().ID == "" {
+		return nil
+	}
+
+	flowLogs, err := s.describeFlowLogs()
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(flowLogs))
+	for _, fl := range flowLogs {
+		ids = append(ids, aws.ToString(fl.FlowLogId))
+	}
+
+	return s.deleteFlowLogsByID(ids)
+}
+
+// describeFlowLogs returns the flow logs owned by the cluster in the VPC.
+func (s *Service) describeFlowLogs() ([]types.FlowLog, error) {
+	input := &ec2.DescribeFlowLogsInput{
+		Filter: []types.Filter{
+			filter.EC2.FlowLogResource(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+		},
+	}
+
+	flowLogs := []types.FlowLog{}
+	paginator := ec2.NewDescribeFlowLogsPaginator(s.EC2Client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			record.Eventf(s.scope.InfraCluster(), "FailedDescribeFlowLogs", "Failed to describe flow logs of vpc %q: %v", s.scope.VPC().ID, err)
+			return nil, errors.Wrapf(err, "failed to describe flow logs of vpc %q", s.scope.VPC().ID)
+		}
+		flowLogs = append(flowLogs, page.FlowLogs...)
+	}
+
+	return flowLogs, nil
+}
+
+func (s *Service) createFlowLogs(spec *infrav1.VPCFlowLogs) error {
+	out, err := s.EC2Client.CreateFlowLogs(context.TODO(), &ec2.CreateFlowLogsInput{
+		ResourceIds:              []string{s.scope.VPC().ID},
+		ResourceType:             types.FlowLogsResourceTypeVpc,
+		LogDestinationType:       flowLogsDestinationType(spec),
+		LogDestination:           aws.String(spec.DestinationARN),
+		DeliverLogsPermissionArn: spec.DeliverLogsPermissionARN,
+		TrafficType:              flowLogsTrafficType(spec),
+		LogFormat:                spec.LogFormat,
+		MaxAggregationInterval:   aws.Int32(flowLogsMaxAggregationInterval(spec)),
+		TagSpecifications: []types.TagSpecification{
+			tags.BuildParamsToTagSpecification(types.ResourceTypeVpcFlowLog, s.getFlowLogTagParams()),
+		},
+	})
+	if err == nil && len(out.Unsuccessful) > 0 {
+		err = unsuccessfulItemError(out.Unsuccessful[0])
+	}
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateFlowLogs", "Failed to create flow logs of vpc %q: %v", s.scope.VPC().ID, err)
+		return errors.Wrapf(err, "failed to create flow logs of vpc %q", s.scope.VPC().ID)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateFlowLogs", "Created flow logs %v of vpc %q", out.FlowLogIds, s.scope.VPC().ID)
+	s.scope.Info("Created flow logs", "flow-log-ids", out.FlowLogIds, "vpc-id", s.scope.VPC().ID, "destination", spec.DestinationARN)
+
+	return nil
+}
+
+func (s *Service) deleteFlowLogsByID(ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	out, err := s.EC2Client.DeleteFlowLogs(context.TODO(), &ec2.DeleteFlowLogsInput{
+		FlowLogIds: ids,
+	})
+	if err == nil && len(out.Unsuccessful) > 0 {
+		err = unsuccessfulItemError(out.Unsuccessful[0])
+	}
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteFlowLogs", "Failed to delete flow logs %v: %v", ids, err)
+		return errors.Wrapf(err, "failed to delete flow logs %v", ids)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteFlowLogs", "Deleted flow logs %v", ids)
+	s.scope.Info("Deleted flow logs", "flow-log-ids", ids, "vpc-id", s.scope.VPC().ID)
+
+	return nil
+}
+
+func (s *Service) getFlowLogTagParams() infrav1.BuildParams {
+	name := fmt.Sprintf("%s-vpc-flow-log", s.scope.Name())
+
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+// flowLogMatches returns true if a flow log publishes the flow logs as configured.
+func flowLogMatches(spec *infrav1.VPCFlowLogs, fl types.FlowLog) bool {
+	if fl.LogDestinationType != flowLogsDestinationType(spec) ||
+		trimLogGroupARN(aws.ToString(fl.LogDestination)) != trimLogGroupARN(spec.DestinationARN) ||
+		fl.TrafficType != flowLogsTrafficType(spec) ||
+		aws.ToString(fl.DeliverLogsPermissionArn) != aws.ToString(spec.DeliverLogsPermissionARN) ||
+		aws.ToInt32(fl.MaxAggregationInterval) != flowLogsMaxAggregationInterval(spec) {
+		return false
+	}
+
+	// AWS reports the default log format when none is set.
+	return spec.LogFormat == nil || aws.ToString(fl.LogFormat) == *spec.LogFormat
+}
+
+// trimLogGroupARN removes the optional ":*" suffix of the ARN of a CloudWatch Logs log group.
+func trimLogGroupARN(arn string) string {
+	return strings.TrimSuffix(arn, ":*")
+}
+
+func flowLogsDestinationType(spec *infrav1.VPCFlowLogs) types.LogDestinationType {
+	if spec.DestinationType == "" {
+		return types.LogDestinationType(infrav1.FlowLogsDestinationTypeCloudWatchLogs)
+	}
+	return types.LogDestinationType(spec.DestinationType)
+}
+
+func flowLogsTrafficType(spec *infrav1.VPCFlowLogs) types.TrafficType {
+	if spec.TrafficType == "" {
+		return types.TrafficType(infrav1.FlowLogsTrafficTypeAll)
+	}
+	return types.TrafficType(spec.TrafficType)
+}
+
+func flowLogsMaxAggregationInterval(spec *infrav1.VPCFlowLogs) int32 {
+	if spec.MaxAggregationInterval == 0 {
+		return defaultFlowLogsMaxAggregationInterval
+	}
+	return spec.MaxAggregationInterval
+}
+
+func unsuccessfulItemError(item types.UnsuccessfulItem) error {
+	if item.Error == nil {
+		return errors.Errorf("operation failed for resource %q", aws.ToString(item.ResourceId))
+	}
+	return errors.Errorf("%s: %s", aws.ToString(item.Error.Code), aws.ToString(item.Error.Message))
+}
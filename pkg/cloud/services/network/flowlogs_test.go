@@ -0,0 +1,236 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+func TestReconcileFlowLogs(t *testing.T) {
+	cloudWatchFlowLogs := &infrav1.VPCFlowLogs{
+		DestinationType:          infrav1.FlowLogsDestinationTypeCloudWatchLogs,
+		DestinationARN:           "arn:aws:logs:us-east-1:123456789012:log-group:flow-logs",
+		DeliverLogsPermissionARN: aws.String("arn:aws:iam::123456789012:role/flow-logs"),
+		TrafficType:              infrav1.FlowLogsTrafficTypeAll,
+		MaxAggregationInterval:   600,
+	}
+	cloudWatchFlowLog := types.FlowLog{
+		FlowLogId:                aws.String("fl-1"),
+		LogDestinationType:       types.LogDestinationTypeCloudWatchLogs,
+		LogDestination:           aws.String("arn:aws:logs:us-east-1:123456789012:log-group:flow-logs:*"),
+		DeliverLogsPermissionArn: aws.String("arn:aws:iam::123456789012:role/flow-logs"),
+		TrafficType:              types.TrafficTypeAll,
+		MaxAggregationInterval:   aws.Int32(600),
+		LogFormat:                aws.String("${version} ${account-id}"),
+	}
+	describeFlowLogsInput := &ec2.DescribeFlowLogsInput{
+		Filter: []types.Filter{
+			{Name: aws.String("resource-id"), Values: []string{"vpc-flow-logs"}},
+			{Name: aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Values: []string{"owned"}},
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		flowLogs        *infrav1.VPCFlowLogs
+		hasCondition    bool
+		expect          func(m *mocks.MockEC2APIMockRecorder)
+		wantErr         string
+		wantCondition   corev1.ConditionStatus
+		wantReason      string
+		wantNoCondition bool
+	}{
+		{
+			name:            "does nothing when flow logs were never configured",
+			expect:          func(m *mocks.MockEC2APIMockRecorder) {},
+			wantNoCondition: true,
+		},
+		{
+			name:     "creates the flow logs",
+			flowLogs: cloudWatchFlowLogs,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(context.TODO(), gomock.Eq(describeFlowLogsInput), gomock.Any()).
+					Return(&ec2.DescribeFlowLogsOutput{}, nil)
+				m.CreateFlowLogs(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateFlowLogsInput, _ ...func(*ec2.Options)) (*ec2.CreateFlowLogsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.ResourceIds).To(Equal([]string{"vpc-flow-logs"}))
+						g.Expect(input.ResourceType).To(Equal(types.FlowLogsResourceTypeVpc))
+						g.Expect(input.LogDestinationType).To(Equal(types.LogDestinationTypeCloudWatchLogs))
+						g.Expect(input.LogDestination).To(Equal(aws.String(cloudWatchFlowLogs.DestinationARN)))
+						g.Expect(input.DeliverLogsPermissionArn).To(Equal(cloudWatchFlowLogs.DeliverLogsPermissionARN))
+						g.Expect(input.TrafficType).To(Equal(types.TrafficTypeAll))
+						g.Expect(input.MaxAggregationInterval).To(Equal(aws.Int32(600)))
+						g.Expect(input.TagSpecifications).To(HaveLen(1))
+						g.Expect(input.TagSpecifications[0].ResourceType).To(Equal(types.ResourceTypeVpcFlowLog))
+						return &ec2.CreateFlowLogsOutput{FlowLogIds: []string{"fl-1"}}, nil
+					})
+			},
+			wantCondition: corev1.ConditionTrue,
+		},
+		{
+			name:     "keeps the flow logs matching the configuration",
+			flowLogs: cloudWatchFlowLogs,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(context.TODO(), gomock.Eq(describeFlowLogsInput), gomock.Any()).
+					Return(&ec2.DescribeFlowLogsOutput{FlowLogs: []types.FlowLog{cloudWatchFlowLog}}, nil)
+			},
+			wantCondition: corev1.ConditionTrue,
+		},
+		{
+			name: "replaces the flow logs not matching the configuration",
+			flowLogs: &infrav1.VPCFlowLogs{
+				DestinationType: infrav1.FlowLogsDestinationTypeS3,
+				DestinationARN:  "arn:aws:s3:::flow-logs/test-cluster",
+				TrafficType:     infrav1.FlowLogsTrafficTypeReject,
+				LogFormat:       aws.String("${version} ${srcaddr} ${dstaddr}"),
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(context.TODO(), gomock.Eq(describeFlowLogsInput), gomock.Any()).
+					Return(&ec2.DescribeFlowLogsOutput{FlowLogs: []types.FlowLog{cloudWatchFlowLog}}, nil)
+				m.DeleteFlowLogs(context.TODO(), gomock.Eq(&ec2.DeleteFlowLogsInput{FlowLogIds: []string{"fl-1"}})).
+					Return(&ec2.DeleteFlowLogsOutput{}, nil)
+				m.CreateFlowLogs(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateFlowLogsInput, _ ...func(*ec2.Options)) (*ec2.CreateFlowLogsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.LogDestinationType).To(Equal(types.LogDestinationTypeS3))
+						g.Expect(input.LogDestination).To(Equal(aws.String("arn:aws:s3:::flow-logs/test-cluster")))
+						g.Expect(input.DeliverLogsPermissionArn).To(BeNil())
+						g.Expect(input.TrafficType).To(Equal(types.TrafficTypeReject))
+						g.Expect(input.LogFormat).To(Equal(aws.String("${version} ${srcaddr} ${dstaddr}")))
+						g.Expect(input.MaxAggregationInterval).To(Equal(aws.Int32(600)))
+						return &ec2.CreateFlowLogsOutput{FlowLogIds: []string{"fl-2"}}, nil
+					})
+			},
+			wantCondition: corev1.ConditionTrue,
+		},
+		{
+			name:     "reports the flow logs failing to be published",
+			flowLogs: cloudWatchFlowLogs,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				failed := cloudWatchFlowLog
+				failed.DeliverLogsStatus = aws.String("FAILED")
+				failed.DeliverLogsErrorMessage = aws.String("Access error")
+				m.DescribeFlowLogs(context.TODO(), gomock.Eq(describeFlowLogsInput), gomock.Any()).
+					Return(&ec2.DescribeFlowLogsOutput{FlowLogs: []types.FlowLog{failed}}, nil)
+			},
+			wantCondition: corev1.ConditionFalse,
+			wantReason:    infrav1.VpcFlowLogsDeliveryFailedReason,
+		},
+		{
+			name:     "returns the error of a flow log that cannot be created",
+			flowLogs: cloudWatchFlowLogs,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(context.TODO(), gomock.Eq(describeFlowLogsInput), gomock.Any()).
+					Return(&ec2.DescribeFlowLogsOutput{}, nil)
+				m.CreateFlowLogs(context.TODO(), gomock.Any()).
+					Return(&ec2.CreateFlowLogsOutput{Unsuccessful: []types.UnsuccessfulItem{{
+						ResourceId: aws.String("vpc-flow-logs"),
+						Error: &types.UnsuccessfulItemError{
+							Code:    aws.String("AccessDenied"),
+							Message: aws.String("Access Denied for LogDestination"),
+						},
+					}}}, nil)
+			},
+			wantErr: "AccessDenied: Access Denied for LogDestination",
+		},
+		{
+			name:         "deletes the flow logs once removed from the configuration",
+			hasCondition: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(context.TODO(), gomock.Eq(describeFlowLogsInput), gomock.Any()).
+					Return(&ec2.DescribeFlowLogsOutput{FlowLogs: []types.FlowLog{cloudWatchFlowLog}}, nil)
+				m.DeleteFlowLogs(context.TODO(), gomock.Eq(&ec2.DeleteFlowLogsInput{FlowLogIds: []string{"fl-1"}})).
+					Return(&ec2.DeleteFlowLogsOutput{}, nil)
+			},
+			wantNoCondition: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{
+								ID:       "vpc-flow-logs",
+								Tags:     infrav1.Tags{infrav1.ClusterTagKey("test-cluster"): "owned"},
+								FlowLogs: tc.flowLogs,
+							},
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.hasCondition {
+				v1beta1conditions.MarkTrue(scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileFlowLogs()
+			if tc.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+
+			condition := v1beta1conditions.Get(scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition)
+			if tc.wantNoCondition {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tc.wantCondition))
+			g.Expect(condition.Reason).To(Equal(tc.wantReason))
+		})
+	}
+}
@@ -79,6 +79,12 @@ func (s *Service) ReconcileNetwork() (err error) {
 	}
 	v1beta1conditions.MarkTrue(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition)
 
+	// Transit Gateway attachment.
+	if err := s.reconcileTransitGatewayAttachment(); err != nil {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, infrav1.TransitGatewayAttachmentReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), "%s", err.Error())
+		return err
+	}
+
 	// Routing tables.
 	if err := s.reconcileRouteTables(); err != nil {
 		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.RouteTablesReadyCondition, infrav1.RouteTableReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), "%s", err.Error())
@@ -93,6 +99,12 @@ func (s *Service) ReconcileNetwork() (err error) {
 	}
 	v1beta1conditions.MarkTrue(s.scope.InfraCluster(), infrav1.VpcEndpointsReadyCondition)
 
+	// VPC Flow Logs.
+	if err := s.reconcileFlowLogs(); err != nil {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition, infrav1.VpcFlowLogsReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), "%s", err.Error())
+		return err
+	}
+
 	s.scope.Debug("Reconcile network completed successfully")
 	return nil
 }
@@ -131,6 +143,22 @@ func (s *Service) DeleteNetwork() (err error) {
 	}
 	v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcEndpointsReadyCondition, clusterv1beta1.DeletedReason, clusterv1beta1.ConditionSeverityInfo, "")
 
+	// VPC Flow Logs.
+	if v1beta1conditions.Has(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition) {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition, clusterv1beta1.DeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
+		if err := s.scope.PatchObject(); err != nil {
+			return err
+		}
+	}
+
+	if err := s.deleteFlowLogs(); err != nil {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition, "DeletingFailed", clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+		return err
+	}
+	if v1beta1conditions.Has(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition) {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition, clusterv1beta1.DeletedReason, clusterv1beta1.ConditionSeverityInfo, "")
+	}
+
 	// Routing tables.
 	v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.RouteTablesReadyCondition, clusterv1beta1.DeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
 	if err := s.scope.PatchObject(); err != nil {
@@ -143,6 +171,22 @@ func (s *Service) DeleteNetwork() (err error) {
 	}
 	v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.RouteTablesReadyCondition, clusterv1beta1.DeletedReason, clusterv1beta1.ConditionSeverityInfo, "")
 
+	// Transit Gateway attachments, they must be deleted before their subnets.
+	if v1beta1conditions.Has(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition) {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, clusterv1beta1.DeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
+		if err := s.scope.PatchObject(); err != nil {
+			return err
+		}
+	}
+
+	if err := s.deleteTransitGatewayAttachments(); err != nil {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, "DeletingFailed", clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+		return err
+	}
+	if v1beta1conditions.Has(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition) {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, clusterv1beta1.DeletedReason, clusterv1beta1.ConditionSeverityInfo, "")
+	}
+
 	// NAT Gateways.
 	v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition, clusterv1beta1.DeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
 	if err := s.scope.PatchObject(); err != nil {
@@ -84,6 +84,11 @@ func (s *Service) reconcileRouteTables() error {
 				}
 			}
 
+			// Routes to the transit gateway are added once the attachment is available, after the table was created.
+			if err := s.reconcileTransitGatewayRoutes(routes, rt); err != nil {
+				return err
+			}
+
 			// Make sure tags are up-to-date.
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 				buildParams := s.getRouteTableTagParams(aws.ToString(rt.RouteTableId), sn.IsPublic, sn.AvailabilityZone)
@@ -441,8 +446,15 @@ func (s *Service) getRoutesToPrivateSubnet(sn *infrav1.SubnetSpec) (routes []*ec
 }
 
 func (s *Service) getRoutesForSubnet(sn *infrav1.SubnetSpec) ([]*ec2.CreateRouteInput, error) {
+	var routes []*ec2.CreateRouteInput
+	var err error
 	if sn.IsPublic {
-		return s.getRoutesToPublicSubnet(sn)
+		routes, err = s.getRoutesToPublicSubnet(sn)
+	} else {
+		routes, err = s.getRoutesToPrivateSubnet(sn)
+	}
+	if err != nil {
+		return routes, err
 	}
-	return s.getRoutesToPrivateSubnet(sn)
+	return append(routes, s.getTransitGatewayRoutes(sn)...), nil
 }
@@ -800,3 +800,46 @@ func (s *Service) getSubnetTagParams(unmanagedVPC bool, id string, public bool,
 		Additional: additionalTags,
 	}
 }
+
+// getZonalSubnetIDs returns the resource ids of the subnets of a resource spanning availability zones,
+// such as a transit gateway attachment or an interface VPC endpoint, which accept at most one subnet per zone.
+// The ids are looked up in the network subnets. If no ids are given, it defaults to a private subnet
+// in each availability zone, preferring the subnets that aren't dedicated to the CNI.
+func (s *Service) getZonalSubnetIDs(ids []string, resource string) ([]string, error) {
+	subnets := s.scope.Subnets()
+
+	subnetIDs := []string{}
+	zones := make(map[string]string)
+	if len(ids) > 0 {
+		for _, id := range ids {
+			sn := subnets.FindByIDOrResourceID(id)
+			if sn == nil || sn.GetResourceID() == "" {
+				return nil, errors.Errorf("subnet %q of the %s not found in the network subnets", id, resource)
+			}
+			if sn.IsEdge() {
+				return nil, errors.Errorf("subnet %q in zone %q cannot be used by the %s", id, sn.AvailabilityZone, resource)
+			}
+			if other, ok := zones[sn.AvailabilityZone]; ok {
+				return nil, errors.Errorf("subnets %q and %q of the %s are in the same availability zone %q", other, id, resource, sn.AvailabilityZone)
+			}
+			zones[sn.AvailabilityZone] = id
+			subnetIDs = append(subnetIDs, sn.GetResourceID())
+		}
+		return subnetIDs, nil
+	}
+
+	private := subnets.FilterPrivate()
+	for _, candidates := range []infrav1.Subnets{private.FilterNonCni(), private} {
+		for _, sn := range candidates {
+			if _, ok := zones[sn.AvailabilityZone]; ok || sn.IsEdge() || sn.GetResourceID() == "" {
+				continue
+			}
+			zones[sn.AvailabilityZone] = sn.ID
+			subnetIDs = append(subnetIDs, sn.GetResourceID())
+		}
+	}
+	if len(subnetIDs) == 0 {
+		return nil, errors.Errorf("no private subnets available for the %s", resource)
+	}
+	return subnetIDs, nil
+}
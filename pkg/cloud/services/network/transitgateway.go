@@ -0,0 +1,486 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+// activeTransitGatewayAttachmentStates are the states of an attachment that isn't being, or hasn't been, deleted.
+var activeTransitGatewayAttachmentStates = []types.TransitGatewayAttachmentState{
+	types.TransitGatewayAttachmentStateInitiating,
+	types.TransitGatewayAttachmentStateInitiatingRequest,
+	types.TransitGatewayAttachmentStatePendingAcceptance,
+	types.TransitGatewayAttachmentStatePending,
+	types.TransitGatewayAttachmentStateAvailable,
+	types.TransitGatewayAttachmentStateModifying,
+}
+
+func (s *Service) reconcileTransitGatewayAttachment() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping transit gateway attachment reconcile in unmanaged mode")
+		return nil
+	}
+
+	spec := s.scope.VPC().TransitGateway
+	if spec == nil {
+		if s.scope.Network().TransitGatewayAttachment == nil {
+			return nil
+		}
+
+		// The transit gateway configuration was removed, detach the VPC.
+		if err := s.deleteTransitGatewayRoutes(s.scope.Network().TransitGatewayAttachment.TransitGatewayID); err != nil {
+			return err
+		}
+		if err := s.deleteTransitGatewayAttachments(); err != nil {
+			return err
+		}
+		v1beta1conditions.Delete(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition)
+		return nil
+	}
+
+	s.scope.Debug("Reconciling transit gateway attachment", "transit-gateway-id", spec.ID)
+
+	if err := s.describeTransitGateway(spec); err != nil {
+		return err
+	}
+
+	subnetIDs, err := s.getZonalSubnetIDs(spec.Subnets, "transit gateway attachment")
+	if err != nil {
+		return err
+	}
+
+	attachment, err := s.describeTransitGatewayAttachment(spec.ID)
+	switch {
+	case awserrors.IsNotFound(err):
+		attachment, err = s.createTransitGatewayAttachment(spec, subnetIDs)
+		if err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	case converters.TagsToMap(attachment.Tags).HasOwned(s.scope.Name()):
+		if err := s.updateTransitGatewayAttachment(spec, attachment, subnetIDs); err != nil {
+			return err
+		}
+	default:
+		s.scope.Debug("Transit gateway attachment isn't owned by the cluster, skipping update", "transit-gateway-attachment-id", aws.ToString(attachment.TransitGatewayAttachmentId))
+	}
+
+	attachment, err = s.waitForTransitGatewayAttachment(attachment)
+	if err != nil {
+		return err
+	}
+
+	s.scope.Network().TransitGatewayAttachment = &infrav1.TransitGatewayAttachmentStatus{
+		ID:               aws.ToString(attachment.TransitGatewayAttachmentId),
+		TransitGatewayID: aws.ToString(attachment.TransitGatewayId),
+		State:            infrav1.TransitGatewayAttachmentState(attachment.State),
+		SubnetIDs:        attachment.SubnetIds,
+	}
+
+	switch attachment.State {
+	case types.TransitGatewayAttachmentStateAvailable:
+		v1beta1conditions.MarkTrue(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition)
+	case types.TransitGatewayAttachmentStatePendingAcceptance:
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, infrav1.TransitGatewayAttachmentPendingAcceptanceReason, clusterv1beta1.ConditionSeverityWarning,
+			"Transit gateway attachment %q must be accepted by the owner of transit gateway %q", aws.ToString(attachment.TransitGatewayAttachmentId), spec.ID)
+	default:
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, infrav1.TransitGatewayAttachmentPendingReason, clusterv1beta1.ConditionSeverityInfo,
+			"Transit gateway attachment %q is %s", aws.ToString(attachment.TransitGatewayAttachmentId), attachment.State)
+	}
+
+	return nil
+}
+
+// describeTransitGateway makes sure the transit gateway exists and is visible to the account of the cluster,
+// which isn't the case for a transit gateway owned by another account until it is shared through AWS RAM.
+func (s *Service) describeTransitGateway(spec *infrav1.TransitGatewaySpec) error {
+	input := &ec2.DescribeTransitGatewaysInput{
+		TransitGatewayIds: []string{spec.ID},
+	}
+	if spec.OwnerID != nil {
+		input.Filters = []types.Filter{
+			{Name: aws.String("owner-id"), Values: []string{*spec.OwnerID}},
+		}
+	}
+
+	out, err := s.EC2Client.DescribeTransitGateways(context.TODO(), input)
+	if err != nil {
+		code, _ := awserrors.Code(err)
+		if code != awserrors.TransitGatewayNotFound {
+			record.Warnf(s.scope.InfraCluster(), "FailedDescribeTransitGateway", "Failed to describe transit gateway %q: %v", spec.ID, err)
+			return errors.Wrapf(err, "failed to describe transit gateway %q", spec.ID)
+		}
+	}
+
+	if out == nil || len(out.TransitGateways) == 0 {
+		if spec.OwnerID != nil {
+			return errors.Errorf("transit gateway %q owned by account %q not found, make sure it is shared with the account of the cluster", spec.ID, *spec.OwnerID)
+		}
+		return errors.Errorf("transit gateway %q not found", spec.ID)
+	}
+
+	return nil
+}
+
+func (s *Service) describeTransitGatewayAttachment(transitGatewayID string) (*types.TransitGatewayVpcAttachment, error) {
+	out, err := s.EC2Client.DescribeTransitGatewayVpcAttachments(context.TODO(), &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		Filters: []types.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.TransitGateway(transitGatewayID),
+			filter.EC2.TransitGatewayAttachmentStates(activeTransitGatewayAttachmentStates...),
+		},
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeTransitGatewayAttachment", "Failed to describe transit gateway attachments in vpc %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe transit gateway attachments in vpc %q", s.scope.VPC().ID)
+	}
+
+	if len(out.TransitGatewayVpcAttachments) == 0 {
+		return nil, awserrors.NewNotFound(fmt.Sprintf("no transit gateway attachment found for transit gateway %q in vpc %q", transitGatewayID, s.scope.VPC().ID))
+	}
+
+	return &out.TransitGatewayVpcAttachments[0], nil
+}
+
+func (s *Service) createTransitGatewayAttachment(spec *infrav1.TransitGatewaySpec, subnetIDs []string) (*types.TransitGatewayVpcAttachment, error) {
+	out, err := s.EC2Client.CreateTransitGatewayVpcAttachment(context.TODO(), &ec2.CreateTransitGatewayVpcAttachmentInput{
+		TransitGatewayId: aws.String(spec.ID),
+		VpcId:            aws.String(s.scope.VPC().ID),
+		SubnetIds:        subnetIDs,
+		Options: &types.CreateTransitGatewayVpcAttachmentRequestOptions{
+			ApplianceModeSupport: applianceModeSupportValue(spec.ApplianceModeSupport),
+		},
+		TagSpecifications: []types.TagSpecification{
+			tags.BuildParamsToTagSpecification(types.ResourceTypeTransitGatewayAttachment, s.getTransitGatewayAttachmentTagParams(services.TemporaryResourceID)),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateTransitGatewayAttachment", "Failed to attach VPC %q to transit gateway %q: %v", s.scope.VPC().ID, spec.ID, err)
+		return nil, errors.Wrapf(err, "failed to attach vpc %q to transit gateway %q", s.scope.VPC().ID, spec.ID)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateTransitGatewayAttachment", "Created transit gateway attachment %q for transit gateway %q", aws.ToString(out.TransitGatewayVpcAttachment.TransitGatewayAttachmentId), spec.ID)
+	s.scope.Info("Created transit gateway attachment", "transit-gateway-attachment-id", aws.ToString(out.TransitGatewayVpcAttachment.TransitGatewayAttachmentId), "transit-gateway-id", spec.ID, "vpc-id", s.scope.VPC().ID)
+
+	return out.TransitGatewayVpcAttachment, nil
+}
+
+// updateTransitGatewayAttachment updates the subnets and options of an available attachment to match the spec.
+func (s *Service) updateTransitGatewayAttachment(spec *infrav1.TransitGatewaySpec, attachment *types.TransitGatewayVpcAttachment, subnetIDs []string) error {
+	if attachment.State != types.TransitGatewayAttachmentStateAvailable {
+		// The attachment can only be modified once available, it'll be updated in a later reconciliation.
+		return nil
+	}
+
+	input := &ec2.ModifyTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: attachment.TransitGatewayAttachmentId,
+	}
+	for _, id := range subnetIDs {
+		if !slices.Contains(attachment.SubnetIds, id) {
+			input.AddSubnetIds = append(input.AddSubnetIds, id)
+		}
+	}
+	for _, id := range attachment.SubnetIds {
+		if !slices.Contains(subnetIDs, id) {
+			input.RemoveSubnetIds = append(input.RemoveSubnetIds, id)
+		}
+	}
+	applianceModeSupport := applianceModeSupportValue(spec.ApplianceModeSupport)
+	if attachment.Options == nil || attachment.Options.ApplianceModeSupport != applianceModeSupport {
+		input.Options = &types.ModifyTransitGatewayVpcAttachmentRequestOptions{
+			ApplianceModeSupport: applianceModeSupport,
+		}
+	}
+
+	if len(input.AddSubnetIds) > 0 || len(input.RemoveSubnetIds) > 0 || input.Options != nil {
+		out, err := s.EC2Client.ModifyTransitGatewayVpcAttachment(context.TODO(), input)
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedModifyTransitGatewayAttachment", "Failed to modify transit gateway attachment %q: %v", aws.ToString(attachment.TransitGatewayAttachmentId), err)
+			return errors.Wrapf(err, "failed to modify transit gateway attachment %q", aws.ToString(attachment.TransitGatewayAttachmentId))
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulModifyTransitGatewayAttachment", "Modified transit gateway attachment %q", aws.ToString(attachment.TransitGatewayAttachmentId))
+		*attachment = *out.TransitGatewayVpcAttachment
+	}
+
+	// Make sure tags are up-to-date.
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		buildParams := s.getTransitGatewayAttachmentTagParams(aws.ToString(attachment.TransitGatewayAttachmentId))
+		tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
+		if err := tagsBuilder.Ensure(converters.TagsToMap(attachment.Tags)); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, awserrors.TransitGatewayAttachmentNotFound); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedTagTransitGatewayAttachment", "Failed to tag managed transit gateway attachment %q: %v", aws.ToString(attachment.TransitGatewayAttachmentId), err)
+		return errors.Wrapf(err, "failed to tag transit gateway attachment %q", aws.ToString(attachment.TransitGatewayAttachmentId))
+	}
+
+	return nil
+}
+
+// waitForTransitGatewayAttachment waits for the attachment to settle. It returns as soon as the attachment
+// waits to be accepted, which may take an arbitrary amount of time.
+func (s *Service) waitForTransitGatewayAttachment(attachment *types.TransitGatewayVpcAttachment) (*types.TransitGatewayVpcAttachment, error) {
+	settled := func(state types.TransitGatewayAttachmentState) bool {
+		return state == types.TransitGatewayAttachmentStateAvailable || state == types.TransitGatewayAttachmentStatePendingAcceptance
+	}
+	if settled(attachment.State) {
+		return attachment, nil
+	}
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		out, err := s.EC2Client.DescribeTransitGatewayVpcAttachments(context.TODO(), &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+			TransitGatewayAttachmentIds: []string{aws.ToString(attachment.TransitGatewayAttachmentId)},
+		})
+		if err != nil {
+			return false, err
+		}
+		if len(out.TransitGatewayVpcAttachments) == 0 {
+			return false, errors.Errorf("transit gateway attachment %q not found", aws.ToString(attachment.TransitGatewayAttachmentId))
+		}
+		attachment = &out.TransitGatewayVpcAttachments[0]
+		return settled(attachment.State), nil
+	}, awserrors.TransitGatewayAttachmentNotFound); err != nil {
+		return nil, errors.Wrapf(err, "failed to wait for transit gateway attachment %q to be available", aws.ToString(attachment.TransitGatewayAttachmentId))
+	}
+
+	return attachment, nil
+}
+
+// deleteTransitGatewayAttachments deletes the transit gateway attachments owned by the cluster in the VPC,
+// and waits for them to be deleted so that their subnets can be deleted afterwards.
+func (s *Service) deleteTransitGatewayAttachments() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping transit gateway attachment deletion in unmanaged mode")
+		return nil
+	}
+
+	out, err := s.EC2Client.DescribeTransitGatewayVpcAttachments(context.TODO(), &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		Filters: []types.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			filter.EC2.TransitGatewayAttachmentStates(append(activeTransitGatewayAttachmentStates, types.TransitGatewayAttachmentStateDeleting)...),
+		},
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeTransitGatewayAttachment", "Failed to describe transit gateway attachments in vpc %q: %v", s.scope.VPC().ID, err)
+		return errors.Wrapf(err, "failed to describe transit gateway attachments in vpc %q", s.scope.VPC().ID)
+	}
+
+	for _, attachment := range out.TransitGatewayVpcAttachments {
+		id := aws.ToString(attachment.TransitGatewayAttachmentId)
+		if attachment.State != types.TransitGatewayAttachmentStateDeleting {
+			if _, err := s.EC2Client.DeleteTransitGatewayVpcAttachment(context.TODO(), &ec2.DeleteTransitGatewayVpcAttachmentInput{
+				TransitGatewayAttachmentId: attachment.TransitGatewayAttachmentId,
+			}); err != nil {
+				code, _ := awserrors.Code(err)
+				if code == awserrors.TransitGatewayAttachmentNotFound {
+					continue
+				}
+				record.Warnf(s.scope.InfraCluster(), "FailedDeleteTransitGatewayAttachment", "Failed to delete transit gateway attachment %q: %v", id, err)
+				return errors.Wrapf(err, "failed to delete transit gateway attachment %q", id)
+			}
+			record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteTransitGatewayAttachment", "Deleted transit gateway attachment %q", id)
+			s.scope.Info("Deleted transit gateway attachment", "transit-gateway-attachment-id", id, "vpc-id", s.scope.VPC().ID)
+		}
+
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			out, err := s.EC2Client.DescribeTransitGatewayVpcAttachments(context.TODO(), &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+				TransitGatewayAttachmentIds: []string{id},
+			})
+			if err != nil {
+				code, _ := awserrors.Code(err)
+				return code == awserrors.TransitGatewayAttachmentNotFound, nil
+			}
+			return len(out.TransitGatewayVpcAttachments) == 0 || out.TransitGatewayVpcAttachments[0].State == types.TransitGatewayAttachmentStateDeleted, nil
+		}); err != nil {
+			return errors.Wrapf(err, "failed to wait for transit gateway attachment %q to be deleted", id)
+		}
+	}
+
+	s.scope.Network().TransitGatewayAttachment = nil
+	return nil
+}
+
+// getTransitGatewayRoutes returns the routes to the transit gateway for the route table of a subnet.
+// The routes are only returned once the attachment is available, as they can't be created before.
+func (s *Service) getTransitGatewayRoutes(sn *infrav1.SubnetSpec) []*ec2.CreateRouteInput {
+	spec := s.scope.VPC().TransitGateway
+	if spec == nil || sn.IsEdge() || !s.scope.Network().TransitGatewayAttachment.IsAvailable() {
+		return nil
+	}
+
+	routes := []*ec2.CreateRouteInput{}
+	for i := range spec.Routes {
+		route := spec.Routes[i]
+		if !route.AppliesTo(sn.IsPublic) {
+			continue
+		}
+		routes = append(routes, &ec2.CreateRouteInput{
+			DestinationCidrBlock:    route.DestinationCIDRBlock,
+			DestinationPrefixListId: route.DestinationPrefixListID,
+			TransitGatewayId:        aws.String(spec.ID),
+		})
+	}
+	return routes
+}
+
+// reconcileTransitGatewayRoutes creates the routes to the transit gateway missing from an existing route table,
+// and deletes the routes to the transit gateway that were removed from the spec.
+func (s *Service) reconcileTransitGatewayRoutes(routes []*ec2.CreateRouteInput, rt types.RouteTable) error {
+	spec := s.scope.VPC().TransitGateway
+	if spec == nil {
+		return nil
+	}
+
+	for _, route := range routes {
+		if route.TransitGatewayId == nil {
+			continue
+		}
+
+		idx := slices.IndexFunc(rt.Routes, func(current types.Route) bool {
+			return sameRouteDestination(current, route.DestinationCidrBlock, route.DestinationPrefixListId)
+		})
+		if idx >= 0 && aws.ToString(rt.Routes[idx].TransitGatewayId) == spec.ID {
+			continue
+		}
+
+		var err error
+		if idx >= 0 {
+			// A route to the same destination exists with another target, the spec takes precedence.
+			_, err = s.EC2Client.ReplaceRoute(context.TODO(), &ec2.ReplaceRouteInput{
+				RouteTableId:            rt.RouteTableId,
+				DestinationCidrBlock:    route.DestinationCidrBlock,
+				DestinationPrefixListId: route.DestinationPrefixListId,
+				TransitGatewayId:        route.TransitGatewayId,
+			})
+		} else {
+			_, err = s.EC2Client.CreateRoute(context.TODO(), &ec2.CreateRouteInput{
+				RouteTableId:            rt.RouteTableId,
+				DestinationCidrBlock:    route.DestinationCidrBlock,
+				DestinationPrefixListId: route.DestinationPrefixListId,
+				TransitGatewayId:        route.TransitGatewayId,
+			})
+		}
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateRoute", "Failed to create route %s for RouteTable %q: %v", route, aws.ToString(rt.RouteTableId), err)
+			return errors.Wrapf(err, "failed to create route in route table %q: %v", aws.ToString(rt.RouteTableId), route)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateRoute", "Created route %s for RouteTable %q", route, aws.ToString(rt.RouteTableId))
+	}
+
+	for _, current := range rt.Routes {
+		if aws.ToString(current.TransitGatewayId) != spec.ID {
+			continue
+		}
+		desired := slices.ContainsFunc(routes, func(route *ec2.CreateRouteInput) bool {
+			return route.TransitGatewayId != nil && sameRouteDestination(current, route.DestinationCidrBlock, route.DestinationPrefixListId)
+		})
+		if desired {
+			continue
+		}
+		if err := s.deleteRoute(rt, current); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteTransitGatewayRoutes deletes the routes to the transit gateway from the route tables of the cluster.
+func (s *Service) deleteTransitGatewayRoutes(transitGatewayID string) error {
+	rts, err := s.describeVpcRouteTables()
+	if err != nil {
+		return err
+	}
+
+	for _, rt := range rts {
+		for _, route := range rt.Routes {
+			if aws.ToString(route.TransitGatewayId) != transitGatewayID {
+				continue
+			}
+			if err := s.deleteRoute(rt, route); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Service) deleteRoute(rt types.RouteTable, route types.Route) error {
+	if _, err := s.EC2Client.DeleteRoute(context.TODO(), &ec2.DeleteRouteInput{
+		RouteTableId:            rt.RouteTableId,
+		DestinationCidrBlock:    route.DestinationCidrBlock,
+		DestinationPrefixListId: route.DestinationPrefixListId,
+	}); err != nil {
+		code, _ := awserrors.Code(err)
+		if code == awserrors.RouteNotFound {
+			return nil
+		}
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteRoute", "Failed to delete route from RouteTable %q: %v", aws.ToString(rt.RouteTableId), err)
+		return errors.Wrapf(err, "failed to delete route from route table %q", aws.ToString(rt.RouteTableId))
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteRoute", "Deleted route to %s from RouteTable %q",
+		aws.ToString(route.DestinationCidrBlock)+aws.ToString(route.DestinationPrefixListId), aws.ToString(rt.RouteTableId))
+	return nil
+}
+
+func sameRouteDestination(route types.Route, cidrBlock, prefixListID *string) bool {
+	if cidrBlock != nil {
+		return aws.ToString(route.DestinationCidrBlock) == *cidrBlock
+	}
+	return prefixListID != nil && aws.ToString(route.DestinationPrefixListId) == *prefixListID
+}
+
+func applianceModeSupportValue(enabled bool) types.ApplianceModeSupportValue {
+	if enabled {
+		return types.ApplianceModeSupportValueEnable
+	}
+	return types.ApplianceModeSupportValueDisable
+}
+
+func (s *Service) getTransitGatewayAttachmentTagParams(id string) infrav1.BuildParams {
+	name := fmt.Sprintf("%s-tgw-attachment", s.scope.Name())
+
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  id,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
@@ -0,0 +1,377 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+func transitGatewayTestSubnets() infrav1.Subnets {
+	return infrav1.Subnets{
+		{ID: "private-a", ResourceID: "subnet-private-a", AvailabilityZone: "us-east-1a"},
+		{ID: "private-a-2", ResourceID: "subnet-private-a-2", AvailabilityZone: "us-east-1a"},
+		{ID: "private-b", ResourceID: "subnet-private-b", AvailabilityZone: "us-east-1b"},
+		{ID: "public-a", ResourceID: "subnet-public-a", AvailabilityZone: "us-east-1a", IsPublic: true},
+	}
+}
+
+func transitGatewayTestScope(t *testing.T, network infrav1.NetworkSpec, status infrav1.NetworkStatus) *scope.ClusterScope {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: infrav1.AWSClusterSpec{
+				NetworkSpec: network,
+			},
+			Status: infrav1.AWSClusterStatus{
+				Network: status,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return scope
+}
+
+func TestReconcileTransitGatewayAttachment(t *testing.T) {
+	ownedTags := []types.Tag{
+		{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+	}
+
+	testCases := []struct {
+		name            string
+		transitGateway  *infrav1.TransitGatewaySpec
+		status          infrav1.NetworkStatus
+		expect          func(m *mocks.MockEC2APIMockRecorder)
+		wantErr         string
+		wantStatus      *infrav1.TransitGatewayAttachmentStatus
+		wantCondition   corev1.ConditionStatus
+		wantReason      string
+		wantNoCondition bool
+	}{
+		{
+			name:           "creates the attachment in a private subnet of each availability zone",
+			transitGateway: &infrav1.TransitGatewaySpec{ID: "tgw-1"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGateways(context.TODO(), gomock.Eq(&ec2.DescribeTransitGatewaysInput{
+					TransitGatewayIds: []string{"tgw-1"},
+				})).Return(&ec2.DescribeTransitGatewaysOutput{TransitGateways: []types.TransitGateway{{TransitGatewayId: aws.String("tgw-1")}}}, nil)
+				m.DescribeTransitGatewayVpcAttachments(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{}, nil)
+				m.CreateTransitGatewayVpcAttachment(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateTransitGatewayVpcAttachmentInput, _ ...func(*ec2.Options)) (*ec2.CreateTransitGatewayVpcAttachmentOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.TransitGatewayId).To(Equal(aws.String("tgw-1")))
+						g.Expect(input.VpcId).To(Equal(aws.String("vpc-tgw")))
+						g.Expect(input.SubnetIds).To(Equal([]string{"subnet-private-a", "subnet-private-b"}))
+						g.Expect(input.Options.ApplianceModeSupport).To(Equal(types.ApplianceModeSupportValueDisable))
+						g.Expect(input.TagSpecifications).To(HaveLen(1))
+						g.Expect(input.TagSpecifications[0].ResourceType).To(Equal(types.ResourceTypeTransitGatewayAttachment))
+						return &ec2.CreateTransitGatewayVpcAttachmentOutput{TransitGatewayVpcAttachment: &types.TransitGatewayVpcAttachment{
+							TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+							TransitGatewayId:           aws.String("tgw-1"),
+							SubnetIds:                  input.SubnetIds,
+							State:                      types.TransitGatewayAttachmentStatePending,
+						}}, nil
+					})
+				m.DescribeTransitGatewayVpcAttachments(context.TODO(), gomock.Eq(&ec2.DescribeTransitGatewayVpcAttachmentsInput{
+					TransitGatewayAttachmentIds: []string{"tgw-attach-1"},
+				})).Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+					TransitGatewayId:           aws.String("tgw-1"),
+					SubnetIds:                  []string{"subnet-private-a", "subnet-private-b"},
+					State:                      types.TransitGatewayAttachmentStateAvailable,
+				}}}, nil)
+			},
+			wantStatus: &infrav1.TransitGatewayAttachmentStatus{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-1",
+				State:            infrav1.TransitGatewayAttachmentStateAvailable,
+				SubnetIDs:        []string{"subnet-private-a", "subnet-private-b"},
+			},
+			wantCondition: corev1.ConditionTrue,
+		},
+		{
+			name: "updates the subnets and options of an existing attachment",
+			transitGateway: &infrav1.TransitGatewaySpec{
+				ID:                   "tgw-1",
+				Subnets:              []string{"private-a-2", "private-b"},
+				ApplianceModeSupport: true,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGateways(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeTransitGatewaysOutput{TransitGateways: []types.TransitGateway{{TransitGatewayId: aws.String("tgw-1")}}}, nil)
+				m.DescribeTransitGatewayVpcAttachments(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						TransitGatewayId:           aws.String("tgw-1"),
+						SubnetIds:                  []string{"subnet-private-a", "subnet-private-b"},
+						State:                      types.TransitGatewayAttachmentStateAvailable,
+						Options:                    &types.TransitGatewayVpcAttachmentOptions{ApplianceModeSupport: types.ApplianceModeSupportValueDisable},
+						Tags:                       ownedTags,
+					}}}, nil)
+				m.ModifyTransitGatewayVpcAttachment(context.TODO(), gomock.Eq(&ec2.ModifyTransitGatewayVpcAttachmentInput{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+					AddSubnetIds:               []string{"subnet-private-a-2"},
+					RemoveSubnetIds:            []string{"subnet-private-a"},
+					Options: &types.ModifyTransitGatewayVpcAttachmentRequestOptions{
+						ApplianceModeSupport: types.ApplianceModeSupportValueEnable,
+					},
+				})).Return(&ec2.ModifyTransitGatewayVpcAttachmentOutput{TransitGatewayVpcAttachment: &types.TransitGatewayVpcAttachment{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+					TransitGatewayId:           aws.String("tgw-1"),
+					SubnetIds:                  []string{"subnet-private-a-2", "subnet-private-b"},
+					State:                      types.TransitGatewayAttachmentStateModifying,
+					Tags:                       ownedTags,
+				}}, nil)
+				m.CreateTags(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateTagsInput{})).Return(nil, nil)
+				m.DescribeTransitGatewayVpcAttachments(context.TODO(), gomock.Eq(&ec2.DescribeTransitGatewayVpcAttachmentsInput{
+					TransitGatewayAttachmentIds: []string{"tgw-attach-1"},
+				})).Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+					TransitGatewayId:           aws.String("tgw-1"),
+					SubnetIds:                  []string{"subnet-private-a-2", "subnet-private-b"},
+					State:                      types.TransitGatewayAttachmentStateAvailable,
+				}}}, nil)
+			},
+			wantStatus: &infrav1.TransitGatewayAttachmentStatus{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-1",
+				State:            infrav1.TransitGatewayAttachmentStateAvailable,
+				SubnetIDs:        []string{"subnet-private-a-2", "subnet-private-b"},
+			},
+			wantCondition: corev1.ConditionTrue,
+		},
+		{
+			name:           "reports an attachment to a shared transit gateway waiting to be accepted",
+			transitGateway: &infrav1.TransitGatewaySpec{ID: "tgw-shared", OwnerID: ptr.To("111122223333")},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGateways(context.TODO(), gomock.Eq(&ec2.DescribeTransitGatewaysInput{
+					TransitGatewayIds: []string{"tgw-shared"},
+					Filters: []types.Filter{
+						{Name: aws.String("owner-id"), Values: []string{"111122223333"}},
+					},
+				})).Return(&ec2.DescribeTransitGatewaysOutput{TransitGateways: []types.TransitGateway{{TransitGatewayId: aws.String("tgw-shared")}}}, nil)
+				m.DescribeTransitGatewayVpcAttachments(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						TransitGatewayId:           aws.String("tgw-shared"),
+						SubnetIds:                  []string{"subnet-private-a", "subnet-private-b"},
+						State:                      types.TransitGatewayAttachmentStatePendingAcceptance,
+						Tags:                       ownedTags,
+					}}}, nil)
+			},
+			wantStatus: &infrav1.TransitGatewayAttachmentStatus{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-shared",
+				State:            infrav1.TransitGatewayAttachmentStatePendingAcceptance,
+				SubnetIDs:        []string{"subnet-private-a", "subnet-private-b"},
+			},
+			wantCondition: corev1.ConditionFalse,
+			wantReason:    infrav1.TransitGatewayAttachmentPendingAcceptanceReason,
+		},
+		{
+			name:           "fails when a shared transit gateway isn't visible",
+			transitGateway: &infrav1.TransitGatewaySpec{ID: "tgw-shared", OwnerID: ptr.To("111122223333")},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGateways(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeTransitGatewaysOutput{}, nil)
+			},
+			wantErr: `transit gateway "tgw-shared" owned by account "111122223333" not found`,
+		},
+		{
+			name:           "fails when two subnets are in the same availability zone",
+			transitGateway: &infrav1.TransitGatewaySpec{ID: "tgw-1", Subnets: []string{"private-a", "private-a-2"}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGateways(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeTransitGatewaysOutput{TransitGateways: []types.TransitGateway{{TransitGatewayId: aws.String("tgw-1")}}}, nil)
+			},
+			wantErr: `are in the same availability zone "us-east-1a"`,
+		},
+		{
+			name: "detaches the VPC when the transit gateway configuration is removed",
+			status: infrav1.NetworkStatus{
+				TransitGatewayAttachment: &infrav1.TransitGatewayAttachmentStatus{ID: "tgw-attach-1", TransitGatewayID: "tgw-1"},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeRouteTablesOutput{RouteTables: []types.RouteTable{{
+						RouteTableId: aws.String("rtb-1"),
+						Routes: []types.Route{
+							{DestinationCidrBlock: aws.String("0.0.0.0/0"), NatGatewayId: aws.String("nat-1")},
+							{DestinationCidrBlock: aws.String("10.100.0.0/16"), TransitGatewayId: aws.String("tgw-1")},
+						},
+					}}}, nil)
+				m.DeleteRoute(context.TODO(), gomock.Eq(&ec2.DeleteRouteInput{
+					RouteTableId:         aws.String("rtb-1"),
+					DestinationCidrBlock: aws.String("10.100.0.0/16"),
+				})).Return(&ec2.DeleteRouteOutput{}, nil)
+				m.DescribeTransitGatewayVpcAttachments(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						State:                      types.TransitGatewayAttachmentStateAvailable,
+					}}}, nil)
+				m.DeleteTransitGatewayVpcAttachment(context.TODO(), gomock.Eq(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+				})).Return(&ec2.DeleteTransitGatewayVpcAttachmentOutput{}, nil)
+				m.DescribeTransitGatewayVpcAttachments(context.TODO(), gomock.Eq(&ec2.DescribeTransitGatewayVpcAttachmentsInput{
+					TransitGatewayAttachmentIds: []string{"tgw-attach-1"},
+				})).Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+					State:                      types.TransitGatewayAttachmentStateDeleted,
+				}}}, nil)
+			},
+			wantNoCondition: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scope := transitGatewayTestScope(t, infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID:             "vpc-tgw",
+					Tags:           infrav1.Tags{infrav1.ClusterTagKey("test-cluster"): "owned"},
+					TransitGateway: tc.transitGateway,
+				},
+				Subnets: transitGatewayTestSubnets(),
+			}, tc.status)
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err := s.reconcileTransitGatewayAttachment()
+			if tc.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scope.Network().TransitGatewayAttachment).To(Equal(tc.wantStatus))
+
+			condition := v1beta1conditions.Get(scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition)
+			if tc.wantNoCondition {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tc.wantCondition))
+			g.Expect(condition.Reason).To(Equal(tc.wantReason))
+		})
+	}
+}
+
+func TestReconcileTransitGatewayRoutes(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	scope := transitGatewayTestScope(t, infrav1.NetworkSpec{
+		VPC: infrav1.VPCSpec{
+			ID:   "vpc-tgw",
+			Tags: infrav1.Tags{infrav1.ClusterTagKey("test-cluster"): "owned"},
+			TransitGateway: &infrav1.TransitGatewaySpec{
+				ID: "tgw-1",
+				Routes: []infrav1.TransitGatewayRoute{
+					{DestinationCIDRBlock: aws.String("10.100.0.0/16"), RouteTables: infrav1.TransitGatewayRouteTablesPrivate},
+					{DestinationCIDRBlock: aws.String("10.200.0.0/16"), RouteTables: infrav1.TransitGatewayRouteTablesAll},
+					{DestinationPrefixListID: aws.String("pl-1"), RouteTables: infrav1.TransitGatewayRouteTablesPrivate},
+					{DestinationCIDRBlock: aws.String("10.250.0.0/16"), RouteTables: infrav1.TransitGatewayRouteTablesPublic},
+				},
+			},
+		},
+	}, infrav1.NetworkStatus{
+		TransitGatewayAttachment: &infrav1.TransitGatewayAttachmentStatus{
+			ID:               "tgw-attach-1",
+			TransitGatewayID: "tgw-1",
+			State:            infrav1.TransitGatewayAttachmentStateAvailable,
+		},
+	})
+
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+
+	routes := s.getTransitGatewayRoutes(&infrav1.SubnetSpec{ID: "private-a"})
+	g.Expect(routes).To(HaveLen(3))
+
+	rt := types.RouteTable{
+		RouteTableId: aws.String("rtb-1"),
+		Routes: []types.Route{
+			{DestinationCidrBlock: aws.String("0.0.0.0/0"), NatGatewayId: aws.String("nat-1")},
+			// Up to date.
+			{DestinationCidrBlock: aws.String("10.100.0.0/16"), TransitGatewayId: aws.String("tgw-1")},
+			// Targets a peering connection, replaced to target the transit gateway.
+			{DestinationCidrBlock: aws.String("10.200.0.0/16"), VpcPeeringConnectionId: aws.String("pcx-1")},
+			// Removed from the spec.
+			{DestinationCidrBlock: aws.String("10.150.0.0/16"), TransitGatewayId: aws.String("tgw-1")},
+		},
+	}
+
+	ec2Mock.EXPECT().ReplaceRoute(context.TODO(), gomock.Eq(&ec2.ReplaceRouteInput{
+		RouteTableId:         aws.String("rtb-1"),
+		DestinationCidrBlock: aws.String("10.200.0.0/16"),
+		TransitGatewayId:     aws.String("tgw-1"),
+	})).Return(&ec2.ReplaceRouteOutput{}, nil)
+	ec2Mock.EXPECT().CreateRoute(context.TODO(), gomock.Eq(&ec2.CreateRouteInput{
+		RouteTableId:            aws.String("rtb-1"),
+		DestinationPrefixListId: aws.String("pl-1"),
+		TransitGatewayId:        aws.String("tgw-1"),
+	})).Return(&ec2.CreateRouteOutput{}, nil)
+	ec2Mock.EXPECT().DeleteRoute(context.TODO(), gomock.Eq(&ec2.DeleteRouteInput{
+		RouteTableId:         aws.String("rtb-1"),
+		DestinationCidrBlock: aws.String("10.150.0.0/16"),
+	})).Return(&ec2.DeleteRouteOutput{}, nil)
+
+	g.Expect(s.reconcileTransitGatewayRoutes(routes, rt)).To(Succeed())
+
+	// Routes aren't added until the attachment is available.
+	scope.Network().TransitGatewayAttachment.State = infrav1.TransitGatewayAttachmentStatePendingAcceptance
+	g.Expect(s.getTransitGatewayRoutes(&infrav1.SubnetSpec{ID: "private-a"})).To(BeEmpty())
+}
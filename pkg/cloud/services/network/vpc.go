@@ -192,97 +192,88 @@ func (s *Service) describeVPCEndpoints(filters ...types.Filter) ([]types.VpcEndp
 }
 
 // reconcileVPCEndpoints registers the AWS endpoints for the services that need to be enabled
-// in the VPC routing tables. If the VPC is unmanaged, this is a no-op.
+// in the VPC: gateway endpoints in the VPC routing tables, and interface endpoints in the subnets.
+// The endpoints owned by the cluster that are no longer needed are deleted. If the VPC is unmanaged, this is a no-op.
 // For more information, see: https://docs.aws.amazon.com/vpc/latest/privatelink/gateway-endpoints.html
+// and https://docs.aws.amazon.com/vpc/latest/privatelink/create-interface-endpoint.html
 func (s *Service) reconcileVPCEndpoints() error {
 	// If the VPC is unmanaged or not yet populated, return early.
 	if s.scope.VPC().IsUnmanaged(s.scope.Name()) || s.scope.VPC().ID == "" {
 		return nil
 	}
 
-	// Gather all services that need to be enabled.
-	services := sets.New[string]()
-	if s.scope.Bucket() != nil {
-		services.Insert(fmt.Sprintf("com.amazonaws.%s.s3", s.scope.Region()))
-	}
-	if services.Len() == 0 {
-		return nil
-	}
-
-	// Gather the current routes.
-	routeTables := sets.New[string]()
-	for _, rt := range s.scope.Subnets() {
-		if rt.RouteTableID != nil && *rt.RouteTableID != "" {
-			routeTables.Insert(*rt.RouteTableID)
-		}
-	}
-	if routeTables.Len() == 0 {
-		return nil
-	}
-
-	// Build the filters based on all the services we need to enable.
-	// A single filter with multiple values functions as an OR.
-	filters := []types.Filter{
-		{
-			Name:   aws.String("service-name"),
-			Values: services.UnsortedList(),
-		},
-	}
+	// Gather all endpoints that need to be enabled.
+	endpoints := s.getVPCEndpoints()
 
 	// Get all existing endpoints.
-	endpoints, err := s.describeVPCEndpoints(filters...)
+	existing, err := s.describeVPCEndpoints()
 	if err != nil {
 		return errors.Wrap(err, "failed to describe vpc endpoints")
 	}
 
-	// Iterate over all services and create missing endpoints.
-	for _, service := range services.UnsortedList() {
-		var existing *types.VpcEndpoint
-		for _, ep := range endpoints {
-			if aws.ToString(ep.ServiceName) == service {
-				existing = &ep
-				break
-			}
+	// Get the security groups of the interface endpoints.
+	securityGroups, err := s.describeVPCEndpointSecurityGroups()
+	if err != nil {
+		return err
+	}
+
+	// Iterate over all endpoints and create or update them.
+	inUse := sets.New[string]()
+	securityGroupIDs := map[string]string{}
+	for i := range endpoints {
+		endpoint := &endpoints[i]
+		serviceName := endpoint.ServiceName(s.scope.Region())
+		current := s.findVPCEndpoint(existing, serviceName, endpoint.EndpointType())
+		if current != nil {
+			inUse.Insert(aws.ToString(current.VpcEndpointId))
 		}
 
-		// Handle the case where the endpoint already exists.
-		// If the route tables are different, modify the endpoint.
-		if existing != nil {
-			existingRouteTables := sets.New(existing.RouteTableIds...)
-			existingRouteTables.Delete("")
-			additions := routeTables.Difference(existingRouteTables)
-			removals := existingRouteTables.Difference(routeTables)
-			if additions.Len() > 0 || removals.Len() > 0 {
-				modify := &ec2.ModifyVpcEndpointInput{
-					VpcEndpointId: existing.VpcEndpointId,
-				}
-				if additions.Len() > 0 {
-					modify.AddRouteTableIds = additions.UnsortedList()
-				}
-				if removals.Len() > 0 {
-					modify.RemoveRouteTableIds = removals.UnsortedList()
-				}
-				if _, err := s.EC2Client.ModifyVpcEndpoint(context.TODO(), modify); err != nil {
-					return errors.Wrapf(err, "failed to modify vpc endpoint for service %q", service)
-				}
+		if endpoint.EndpointType() == infrav1.VPCEndpointTypeGateway {
+			if err := s.reconcileGatewayVPCEndpoint(serviceName, current); err != nil {
+				return err
 			}
 			continue
 		}
 
-		// Create the endpoint.
-		if _, err := s.EC2Client.CreateVpcEndpoint(context.TODO(), &ec2.CreateVpcEndpointInput{
-			VpcId:         aws.String(s.scope.VPC().ID),
-			ServiceName:   aws.String(service),
-			RouteTableIds: routeTables.UnsortedList(),
-			TagSpecifications: []types.TagSpecification{
-				tags.BuildParamsToTagSpecification(types.ResourceTypeVpcEndpoint, s.getVPCEndpointTagParams()),
-			},
+		securityGroupName := s.getVPCEndpointSecurityGroupName(endpoint)
+		securityGroupID, ok := securityGroupIDs[securityGroupName]
+		if !ok {
+			var existingGroup *types.SecurityGroup
+			if group, found := securityGroups[securityGroupName]; found {
+				existingGroup = &group
+			}
+			securityGroupID, err = s.reconcileVPCEndpointSecurityGroup(securityGroupName, s.getVPCEndpointIngressRules(endpoint), existingGroup)
+			if err != nil {
+				return err
+			}
+			securityGroupIDs[securityGroupName] = securityGroupID
+		}
+
+		if err := s.reconcileInterfaceVPCEndpoint(endpoint, serviceName, securityGroupID, current); err != nil {
+			return err
+		}
+	}
+
+	// Delete the endpoints owned by the cluster that are no longer needed.
+	stale := []string{}
+	for i := range existing {
+		ep := &existing[i]
+		id := aws.ToString(ep.VpcEndpointId)
+		if inUse.Has(id) || !s.isVPCEndpointOwned(ep) || ep.State == types.StateDeleting || ep.State == types.StateDeleted {
+			continue
+		}
+		stale = append(stale, id)
+	}
+	if len(stale) > 0 {
+		if _, err := s.EC2Client.DeleteVpcEndpoints(context.TODO(), &ec2.DeleteVpcEndpointsInput{
+			VpcEndpointIds: stale,
 		}); err != nil {
-			return errors.Wrapf(err, "failed to create vpc endpoint for service %q", service)
+			return errors.Wrapf(err, "failed to delete vpc endpoints %+v", stale)
 		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteVPCEndpoints", "Deleted vpc endpoints %v", stale)
 	}
 
-	return nil
+	return s.deleteVPCEndpointSecurityGroups(securityGroups, sets.KeySet(securityGroupIDs), false)
 }
 
 func (s *Service) deleteVPCEndpoints() error {
@@ -306,17 +297,21 @@ func (s *Service) deleteVPCEndpoints() error {
 		ids = append(ids, *ep.VpcEndpointId)
 	}
 
-	if len(ids) == 0 {
-		return nil
+	if len(ids) > 0 {
+		// Iterate over all services and delete endpoints.
+		if _, err := s.EC2Client.DeleteVpcEndpoints(context.TODO(), &ec2.DeleteVpcEndpointsInput{
+			VpcEndpointIds: ids,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to delete vpc endpoints %+v", ids)
+		}
 	}
 
-	// Iterate over all services and delete endpoints.
-	if _, err := s.EC2Client.DeleteVpcEndpoints(context.TODO(), &ec2.DeleteVpcEndpointsInput{
-		VpcEndpointIds: ids,
-	}); err != nil {
-		return errors.Wrapf(err, "failed to delete vpc endpoints %+v", ids)
+	// Delete the security groups of the interface endpoints, once their network interfaces are released.
+	securityGroups, err := s.describeVPCEndpointSecurityGroups()
+	if err != nil {
+		return err
 	}
-	return nil
+	return s.deleteVPCEndpointSecurityGroups(securityGroups, sets.New[string](), true)
 }
 
 func (s *Service) ensureManagedVPCAttributes(vpc *infrav1.VPCSpec) error {
@@ -0,0 +1,467 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/utils"
+)
+
+// getVPCEndpoints returns the VPC endpoints to create in the VPC, including the s3 gateway endpoint
+// used to reach the S3 bucket of the cluster.
+func (s *Service) getVPCEndpoints() []infrav1.VPCEndpointSpec {
+	endpoints := s.scope.VPC().GetVPCEndpoints()
+	if s.scope.Bucket() == nil {
+		return endpoints
+	}
+
+	hasS3Gateway := slices.ContainsFunc(endpoints, func(e infrav1.VPCEndpointSpec) bool {
+		return e.ShortServiceName() == "s3" && e.EndpointType() == infrav1.VPCEndpointTypeGateway
+	})
+	if !hasS3Gateway {
+		endpoints = append(endpoints, infrav1.VPCEndpointSpec{Service: "s3", Type: infrav1.VPCEndpointTypeGateway})
+	}
+	return endpoints
+}
+
+// findVPCEndpoint returns the endpoint of a service and type in a list of endpoints. Interface endpoints
+// must be owned by the cluster, while existing gateway endpoints are adopted.
+func (s *Service) findVPCEndpoint(endpoints []types.VpcEndpoint, serviceName string, endpointType infrav1.VPCEndpointType) *types.VpcEndpoint {
+	for i := range endpoints {
+		ep := &endpoints[i]
+		if aws.ToString(ep.ServiceName) != serviceName || !strings.EqualFold(string(ep.VpcEndpointType), string(endpointType)) {
+			continue
+		}
+		switch ep.State {
+		case types.StateDeleting, types.StateDeleted, types.StateFailed, types.StateRejected:
+			continue
+		}
+		if endpointType == infrav1.VPCEndpointTypeInterface && !s.isVPCEndpointOwned(ep) {
+			continue
+		}
+		return ep
+	}
+	return nil
+}
+
+func (s *Service) isVPCEndpointOwned(ep *types.VpcEndpoint) bool {
+	return converters.TagsToMap(ep.Tags).HasOwned(s.scope.Name())
+}
+
+// reconcileGatewayVPCEndpoint adds a gateway endpoint to the route tables of the subnets.
+func (s *Service) reconcileGatewayVPCEndpoint(serviceName string, existing *types.VpcEndpoint) error {
+	// Gather the current routes.
+	routeTables := sets.New[string]()
+	for _, rt := range s.scope.Subnets() {
+		if rt.RouteTableID != nil && *rt.RouteTableID != "" {
+			routeTables.Insert(*rt.RouteTableID)
+		}
+	}
+	if routeTables.Len() == 0 {
+		return nil
+	}
+
+	// Handle the case where the endpoint already exists.
+	// If the route tables are different, modify the endpoint.
+	if existing != nil {
+		existingRouteTables := sets.New(existing.RouteTableIds...)
+		existingRouteTables.Delete("")
+		additions := routeTables.Difference(existingRouteTables)
+		removals := existingRouteTables.Difference(routeTables)
+		if additions.Len() > 0 || removals.Len() > 0 {
+			modify := &ec2.ModifyVpcEndpointInput{
+				VpcEndpointId: existing.VpcEndpointId,
+			}
+			if additions.Len() > 0 {
+				modify.AddRouteTableIds = additions.UnsortedList()
+			}
+			if removals.Len() > 0 {
+				modify.RemoveRouteTableIds = removals.UnsortedList()
+			}
+			if _, err := s.EC2Client.ModifyVpcEndpoint(context.TODO(), modify); err != nil {
+				return errors.Wrapf(err, "failed to modify vpc endpoint for service %q", serviceName)
+			}
+		}
+		return nil
+	}
+
+	// Create the endpoint.
+	if _, err := s.EC2Client.CreateVpcEndpoint(context.TODO(), &ec2.CreateVpcEndpointInput{
+		VpcId:           aws.String(s.scope.VPC().ID),
+		ServiceName:     aws.String(serviceName),
+		VpcEndpointType: types.VpcEndpointTypeGateway,
+		RouteTableIds:   routeTables.UnsortedList(),
+		TagSpecifications: []types.TagSpecification{
+			tags.BuildParamsToTagSpecification(types.ResourceTypeVpcEndpoint, s.getVPCEndpointTagParams()),
+		},
+	}); err != nil {
+		return errors.Wrapf(err, "failed to create vpc endpoint for service %q", serviceName)
+	}
+	return nil
+}
+
+// reconcileInterfaceVPCEndpoint creates the network interfaces of an interface endpoint in the subnets,
+// with the security group of the endpoint.
+func (s *Service) reconcileInterfaceVPCEndpoint(endpoint *infrav1.VPCEndpointSpec, serviceName, securityGroupID string, existing *types.VpcEndpoint) error {
+	subnetIDs, err := s.getZonalSubnetIDs(endpoint.Subnets, fmt.Sprintf("vpc endpoint for service %q", serviceName))
+	if err != nil {
+		return err
+	}
+	privateDNSEnabled := ptr.Deref(endpoint.PrivateDNSEnabled, true)
+
+	if existing == nil {
+		out, err := s.EC2Client.CreateVpcEndpoint(context.TODO(), &ec2.CreateVpcEndpointInput{
+			VpcId:             aws.String(s.scope.VPC().ID),
+			ServiceName:       aws.String(serviceName),
+			VpcEndpointType:   types.VpcEndpointTypeInterface,
+			SubnetIds:         subnetIDs,
+			SecurityGroupIds:  []string{securityGroupID},
+			PrivateDnsEnabled: aws.Bool(privateDNSEnabled),
+			TagSpecifications: []types.TagSpecification{
+				tags.BuildParamsToTagSpecification(types.ResourceTypeVpcEndpoint, s.getVPCEndpointTagParams()),
+			},
+		})
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateVPCEndpoint", "Failed to create vpc endpoint for service %q: %v", serviceName, err)
+			return errors.Wrapf(err, "failed to create vpc endpoint for service %q", serviceName)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateVPCEndpoint", "Created vpc endpoint %q for service %q", aws.ToString(out.VpcEndpoint.VpcEndpointId), serviceName)
+		return nil
+	}
+
+	modify := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: existing.VpcEndpointId,
+	}
+	modified := false
+
+	subnets := sets.New(subnetIDs...)
+	existingSubnets := sets.New(existing.SubnetIds...)
+	if additions := subnets.Difference(existingSubnets); additions.Len() > 0 {
+		modify.AddSubnetIds = sets.List(additions)
+		modified = true
+	}
+	if removals := existingSubnets.Difference(subnets); removals.Len() > 0 {
+		modify.RemoveSubnetIds = sets.List(removals)
+		modified = true
+	}
+
+	existingGroups := sets.New[string]()
+	for _, group := range existing.Groups {
+		existingGroups.Insert(aws.ToString(group.GroupId))
+	}
+	if !existingGroups.Has(securityGroupID) {
+		modify.AddSecurityGroupIds = []string{securityGroupID}
+		modified = true
+	}
+	if removals := existingGroups.Delete(securityGroupID); removals.Len() > 0 {
+		modify.RemoveSecurityGroupIds = sets.List(removals)
+		modified = true
+	}
+
+	if aws.ToBool(existing.PrivateDnsEnabled) != privateDNSEnabled {
+		modify.PrivateDnsEnabled = aws.Bool(privateDNSEnabled)
+		modified = true
+	}
+
+	if !modified {
+		return nil
+	}
+	if _, err := s.EC2Client.ModifyVpcEndpoint(context.TODO(), modify); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedModifyVPCEndpoint", "Failed to modify vpc endpoint %q for service %q: %v", aws.ToString(existing.VpcEndpointId), serviceName, err)
+		return errors.Wrapf(err, "failed to modify vpc endpoint for service %q", serviceName)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulModifyVPCEndpoint", "Modified vpc endpoint %q for service %q", aws.ToString(existing.VpcEndpointId), serviceName)
+	return nil
+}
+
+// getVPCEndpointSecurityGroupName returns the name of the security group of an interface endpoint.
+// The endpoints using the default ingress rules share a security group.
+func (s *Service) getVPCEndpointSecurityGroupName(endpoint *infrav1.VPCEndpointSpec) string {
+	if len(endpoint.IngressRules) == 0 {
+		return fmt.Sprintf("%s-vpc-endpoints", s.scope.Name())
+	}
+	return fmt.Sprintf("%s-vpc-endpoint-%s", s.scope.Name(), endpoint.ShortServiceName())
+}
+
+// getVPCEndpointIngressRules returns the ingress rules of the security group of an interface endpoint,
+// defaulting to HTTPS from the CIDR blocks of the VPC.
+func (s *Service) getVPCEndpointIngressRules(endpoint *infrav1.VPCEndpointSpec) infrav1.IngressRules {
+	if len(endpoint.IngressRules) > 0 {
+		return endpoint.IngressRules
+	}
+
+	vpc := s.scope.VPC()
+	cidrBlocks := []string{vpc.CidrBlock}
+	for _, block := range vpc.SecondaryCidrBlocks {
+		cidrBlocks = append(cidrBlocks, block.IPv4CidrBlock)
+	}
+	rules := infrav1.IngressRules{
+		{
+			Description: "HTTPS from the VPC",
+			Protocol:    infrav1.SecurityGroupProtocolTCP,
+			FromPort:    443,
+			ToPort:      443,
+			CidrBlocks:  cidrBlocks,
+		},
+	}
+	if vpc.IsIPv6Enabled() && vpc.IPv6.CidrBlock != "" {
+		rules = append(rules, infrav1.IngressRule{
+			Description:    "HTTPS from the VPC IPv6",
+			Protocol:       infrav1.SecurityGroupProtocolTCP,
+			FromPort:       443,
+			ToPort:         443,
+			IPv6CidrBlocks: []string{vpc.IPv6.CidrBlock},
+		})
+	}
+	return rules
+}
+
+// describeVPCEndpointSecurityGroups returns the security groups of the interface endpoints owned by the cluster, by name.
+func (s *Service) describeVPCEndpointSecurityGroups() (map[string]types.SecurityGroup, error) {
+	input := &ec2.DescribeSecurityGroupsInput{
+		Filters: []types.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			filter.EC2.ProviderRole(infrav1.VPCEndpointRoleTagValue),
+		},
+	}
+
+	groups := map[string]types.SecurityGroup{}
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(s.EC2Client, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe vpc endpoint security groups in vpc %q", s.scope.VPC().ID)
+		}
+		for _, group := range out.SecurityGroups {
+			groups[aws.ToString(group.GroupName)] = group
+		}
+	}
+
+	return groups, nil
+}
+
+// reconcileVPCEndpointSecurityGroup creates the security group of interface endpoints, and reconciles its ingress rules.
+func (s *Service) reconcileVPCEndpointSecurityGroup(name string, rules infrav1.IngressRules, existing *types.SecurityGroup) (string, error) {
+	desired := ingressPermissionsFromRules(rules)
+
+	var current map[string]types.IpPermission
+	groupID := ""
+	if existing == nil {
+		out, err := s.EC2Client.CreateSecurityGroup(context.TODO(), &ec2.CreateSecurityGroupInput{
+			VpcId:       aws.String(s.scope.VPC().ID),
+			GroupName:   aws.String(name),
+			Description: aws.String(fmt.Sprintf("Kubernetes cluster %s: %s", s.scope.Name(), infrav1.VPCEndpointRoleTagValue)),
+			TagSpecifications: []types.TagSpecification{
+				tags.BuildParamsToTagSpecification(types.ResourceTypeSecurityGroup, s.getVPCEndpointSecurityGroupTagParams(name)),
+			},
+		})
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateSecurityGroup", "Failed to create vpc endpoint SecurityGroup %q: %v", name, err)
+			return "", errors.Wrapf(err, "failed to create security group %q in vpc %q", name, s.scope.VPC().ID)
+		}
+		groupID = aws.ToString(out.GroupId)
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateSecurityGroup", "Created vpc endpoint SecurityGroup %q", groupID)
+	} else {
+		groupID = aws.ToString(existing.GroupId)
+		current = ingressPermissionsFromSDKType(existing.IpPermissions)
+	}
+
+	var authorize, revoke []types.IpPermission
+	for key, permission := range desired {
+		if _, ok := current[key]; !ok {
+			authorize = append(authorize, permission)
+		}
+	}
+	for key, permission := range current {
+		if _, ok := desired[key]; !ok {
+			revoke = append(revoke, permission)
+		}
+	}
+
+	if len(revoke) > 0 {
+		if _, err := s.EC2Client.RevokeSecurityGroupIngress(context.TODO(), &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: revoke,
+		}); awserrors.IsIgnorableSecurityGroupError(err) != nil {
+			return "", errors.Wrapf(err, "failed to revoke ingress rules of security group %q", groupID)
+		}
+	}
+	if len(authorize) > 0 {
+		if _, err := s.EC2Client.AuthorizeSecurityGroupIngress(context.TODO(), &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: authorize,
+		}); err != nil {
+			return "", errors.Wrapf(err, "failed to authorize ingress rules of security group %q", groupID)
+		}
+	}
+
+	return groupID, nil
+}
+
+// deleteVPCEndpointSecurityGroups deletes the security groups of the interface endpoints that aren't in use.
+// When wait is true, it waits for the network interfaces of the deleted endpoints to be released.
+func (s *Service) deleteVPCEndpointSecurityGroups(groups map[string]types.SecurityGroup, inUse sets.Set[string], waitForRelease bool) error {
+	for name, group := range groups {
+		if inUse.Has(name) {
+			continue
+		}
+
+		id := aws.ToString(group.GroupId)
+		deleteSecurityGroup := func() (bool, error) {
+			if _, err := s.EC2Client.DeleteSecurityGroup(context.TODO(), &ec2.DeleteSecurityGroupInput{GroupId: group.GroupId}); awserrors.IsIgnorableSecurityGroupError(err) != nil {
+				return false, err
+			}
+			return true, nil
+		}
+
+		var err error
+		if waitForRelease {
+			err = wait.WaitForWithRetryable(wait.NewBackoff(), deleteSecurityGroup, awserrors.DependencyViolation)
+		} else {
+			_, err = deleteSecurityGroup()
+			if code, _ := awserrors.Code(err); code == awserrors.DependencyViolation {
+				// The network interfaces of the deleted endpoints haven't been released yet, try again later.
+				s.scope.Debug("Security group of vpc endpoints still in use", "security-group-id", id)
+				continue
+			}
+		}
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteSecurityGroup", "Failed to delete vpc endpoint SecurityGroup %q with name %q: %v", id, name, err)
+			return errors.Wrapf(err, "failed to delete security group %q with name %q", id, name)
+		}
+
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteSecurityGroup", "Deleted vpc endpoint SecurityGroup %q", id)
+		s.scope.Info("Deleted security group", "security-group-id", id, "kind", infrav1.VPCEndpointRoleTagValue)
+	}
+
+	return nil
+}
+
+func (s *Service) getVPCEndpointSecurityGroupTagParams(name string) infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  services.TemporaryResourceID,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.VPCEndpointRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+// ingressPermissionsFromRules converts ingress rules to permissions with a single source, by key.
+func ingressPermissionsFromRules(rules infrav1.IngressRules) map[string]types.IpPermission {
+	permissions := map[string]types.IpPermission{}
+	for _, rule := range rules {
+		base := types.IpPermission{
+			IpProtocol: aws.String(string(rule.Protocol)),
+		}
+		// The ports only apply to these protocols, see: https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_IpPermission.html
+		switch rule.Protocol {
+		case infrav1.SecurityGroupProtocolTCP,
+			infrav1.SecurityGroupProtocolUDP,
+			infrav1.SecurityGroupProtocolICMP,
+			infrav1.SecurityGroupProtocolICMPv6:
+			base.FromPort = utils.ToInt32Pointer(&rule.FromPort)
+			base.ToPort = utils.ToInt32Pointer(&rule.ToPort)
+		}
+		var description *string
+		if rule.Description != "" {
+			description = aws.String(rule.Description)
+		}
+		for _, cidr := range rule.CidrBlocks {
+			permission := base
+			permission.IpRanges = []types.IpRange{{CidrIp: aws.String(cidr), Description: description}}
+			permissions[ingressPermissionKey(permission)] = permission
+		}
+		for _, cidr := range rule.IPv6CidrBlocks {
+			permission := base
+			permission.Ipv6Ranges = []types.Ipv6Range{{CidrIpv6: aws.String(cidr), Description: description}}
+			permissions[ingressPermissionKey(permission)] = permission
+		}
+		for _, id := range rule.SourceSecurityGroupIDs {
+			permission := base
+			permission.UserIdGroupPairs = []types.UserIdGroupPair{{GroupId: aws.String(id), Description: description}}
+			permissions[ingressPermissionKey(permission)] = permission
+		}
+	}
+	return permissions
+}
+
+// ingressPermissionsFromSDKType splits the permissions of a security group into permissions with a single source, by key.
+func ingressPermissionsFromSDKType(ipPermissions []types.IpPermission) map[string]types.IpPermission {
+	permissions := map[string]types.IpPermission{}
+	for _, p := range ipPermissions {
+		base := types.IpPermission{
+			IpProtocol: p.IpProtocol,
+			FromPort:   p.FromPort,
+			ToPort:     p.ToPort,
+		}
+		for _, r := range p.IpRanges {
+			permission := base
+			permission.IpRanges = []types.IpRange{{CidrIp: r.CidrIp}}
+			permissions[ingressPermissionKey(permission)] = permission
+		}
+		for _, r := range p.Ipv6Ranges {
+			permission := base
+			permission.Ipv6Ranges = []types.Ipv6Range{{CidrIpv6: r.CidrIpv6}}
+			permissions[ingressPermissionKey(permission)] = permission
+		}
+		for _, pair := range p.UserIdGroupPairs {
+			permission := base
+			permission.UserIdGroupPairs = []types.UserIdGroupPair{{GroupId: pair.GroupId}}
+			permissions[ingressPermissionKey(permission)] = permission
+		}
+	}
+	return permissions
+}
+
+// ingressPermissionKey identifies a permission with a single source, ignoring its description.
+func ingressPermissionKey(p types.IpPermission) string {
+	source := ""
+	switch {
+	case len(p.IpRanges) > 0:
+		source = aws.ToString(p.IpRanges[0].CidrIp)
+	case len(p.Ipv6Ranges) > 0:
+		source = aws.ToString(p.Ipv6Ranges[0].CidrIpv6)
+	case len(p.UserIdGroupPairs) > 0:
+		source = aws.ToString(p.UserIdGroupPairs[0].GroupId)
+	}
+	if aws.ToString(p.IpProtocol) == string(infrav1.SecurityGroupProtocolAll) {
+		return fmt.Sprintf("-1/%s", source)
+	}
+	return fmt.Sprintf("%s/%d-%d/%s", aws.ToString(p.IpProtocol), aws.ToInt32(p.FromPort), aws.ToInt32(p.ToPort), source)
+}
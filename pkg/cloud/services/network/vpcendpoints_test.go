@@ -0,0 +1,321 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func vpcEndpointsTestScope(t *testing.T, vpc infrav1.VPCSpec) *scope.ClusterScope {
+	t.Helper()
+
+	vpc.ID = "vpc-endpoints"
+	vpc.CidrBlock = "10.0.0.0/16"
+	vpc.Tags = infrav1.Tags{infrav1.ClusterTagKey("test-cluster"): "owned"}
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: infrav1.AWSClusterSpec{
+				Region: "us-east-1",
+				NetworkSpec: infrav1.NetworkSpec{
+					VPC: vpc,
+					Subnets: infrav1.Subnets{
+						{ID: "private-a", ResourceID: "subnet-private-a", AvailabilityZone: "us-east-1a", RouteTableID: aws.String("rtb-private-a")},
+						{ID: "private-b", ResourceID: "subnet-private-b", AvailabilityZone: "us-east-1b", RouteTableID: aws.String("rtb-private-b")},
+						{ID: "public-a", ResourceID: "subnet-public-a", AvailabilityZone: "us-east-1a", RouteTableID: aws.String("rtb-public-a"), IsPublic: true},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return scope
+}
+
+func TestReconcileVPCEndpoints(t *testing.T) {
+	ownedTags := []types.Tag{
+		{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+	}
+	describeEndpointsInput := &ec2.DescribeVpcEndpointsInput{
+		Filters: []types.Filter{{Name: aws.String("vpc-id"), Values: []string{"vpc-endpoints"}}},
+	}
+	describeSecurityGroupsInput := &ec2.DescribeSecurityGroupsInput{
+		Filters: []types.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{"vpc-endpoints"}},
+			{Name: aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Values: []string{"owned"}},
+			{Name: aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/role"), Values: []string{"vpc-endpoint"}},
+		},
+	}
+	httpsFromVPC := types.IpPermission{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int32(443),
+		ToPort:     aws.Int32(443),
+		IpRanges:   []types.IpRange{{CidrIp: aws.String("10.0.0.0/16")}},
+	}
+
+	testCases := []struct {
+		name    string
+		vpc     infrav1.VPCSpec
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		wantErr string
+	}{
+		{
+			name: "does nothing without endpoints",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpoints(context.TODO(), gomock.Eq(describeEndpointsInput), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{}, nil)
+				m.DescribeSecurityGroups(context.TODO(), gomock.Eq(describeSecurityGroupsInput), gomock.Any()).
+					Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+			},
+		},
+		{
+			name: "creates the endpoints of the private cluster preset",
+			vpc:  infrav1.VPCSpec{VPCEndpointsPreset: infrav1.VPCEndpointsPresetPrivateCluster},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpoints(context.TODO(), gomock.Eq(describeEndpointsInput), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{}, nil)
+				m.DescribeSecurityGroups(context.TODO(), gomock.Eq(describeSecurityGroupsInput), gomock.Any()).
+					Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+				m.CreateSecurityGroup(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateSecurityGroupInput, _ ...func(*ec2.Options)) (*ec2.CreateSecurityGroupOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.GroupName).To(Equal(aws.String("test-cluster-vpc-endpoints")))
+						g.Expect(input.VpcId).To(Equal(aws.String("vpc-endpoints")))
+						return &ec2.CreateSecurityGroupOutput{GroupId: aws.String("sg-endpoints")}, nil
+					})
+				m.AuthorizeSecurityGroupIngress(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.AuthorizeSecurityGroupIngressInput, _ ...func(*ec2.Options)) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.GroupId).To(Equal(aws.String("sg-endpoints")))
+						g.Expect(input.IpPermissions).To(HaveLen(1))
+						g.Expect(input.IpPermissions[0].FromPort).To(Equal(aws.Int32(443)))
+						g.Expect(input.IpPermissions[0].IpRanges[0].CidrIp).To(Equal(aws.String("10.0.0.0/16")))
+						return &ec2.AuthorizeSecurityGroupIngressOutput{}, nil
+					})
+				services := []string{}
+				m.CreateVpcEndpoint(context.TODO(), gomock.Any()).Times(10).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateVpcEndpointInput, _ ...func(*ec2.Options)) (*ec2.CreateVpcEndpointOutput, error) {
+						g := NewWithT(t)
+						services = append(services, aws.ToString(input.ServiceName))
+						if input.VpcEndpointType == types.VpcEndpointTypeGateway {
+							g.Expect(input.ServiceName).To(Equal(aws.String("com.amazonaws.us-east-1.s3")))
+							g.Expect(input.RouteTableIds).To(ConsistOf("rtb-private-a", "rtb-private-b", "rtb-public-a"))
+						} else {
+							g.Expect(input.VpcEndpointType).To(Equal(types.VpcEndpointTypeInterface))
+							g.Expect(input.SubnetIds).To(Equal([]string{"subnet-private-a", "subnet-private-b"}))
+							g.Expect(input.SecurityGroupIds).To(Equal([]string{"sg-endpoints"}))
+							g.Expect(input.PrivateDnsEnabled).To(Equal(aws.Bool(true)))
+						}
+						g.Expect(input.TagSpecifications[0].ResourceType).To(Equal(types.ResourceTypeVpcEndpoint))
+						if len(services) == 10 {
+							g.Expect(services).To(ContainElements("com.amazonaws.us-east-1.ecr.api", "com.amazonaws.us-east-1.ecr.dkr", "com.amazonaws.us-east-1.sts"))
+						}
+						return &ec2.CreateVpcEndpointOutput{VpcEndpoint: &types.VpcEndpoint{VpcEndpointId: aws.String("vpce-" + aws.ToString(input.ServiceName))}}, nil
+					})
+			},
+		},
+		{
+			name: "updates an existing interface endpoint and the rules of its security group",
+			vpc: infrav1.VPCSpec{VPCEndpoints: []infrav1.VPCEndpointSpec{{
+				Service:           "ecr.api",
+				Subnets:           []string{"private-b"},
+				PrivateDNSEnabled: ptr.To(false),
+				IngressRules: []infrav1.IngressRule{{
+					Protocol:   infrav1.SecurityGroupProtocolTCP,
+					FromPort:   443,
+					ToPort:     443,
+					CidrBlocks: []string{"10.1.0.0/16"},
+				}},
+			}}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpoints(context.TODO(), gomock.Eq(describeEndpointsInput), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{VpcEndpoints: []types.VpcEndpoint{{
+						VpcEndpointId:     aws.String("vpce-ecr"),
+						VpcEndpointType:   types.VpcEndpointTypeInterface,
+						ServiceName:       aws.String("com.amazonaws.us-east-1.ecr.api"),
+						State:             types.StateAvailable,
+						SubnetIds:         []string{"subnet-private-a", "subnet-private-b"},
+						Groups:            []types.SecurityGroupIdentifier{{GroupId: aws.String("sg-ecr")}},
+						PrivateDnsEnabled: aws.Bool(true),
+						Tags:              ownedTags,
+					}}}, nil)
+				m.DescribeSecurityGroups(context.TODO(), gomock.Eq(describeSecurityGroupsInput), gomock.Any()).
+					Return(&ec2.DescribeSecurityGroupsOutput{SecurityGroups: []types.SecurityGroup{{
+						GroupId:       aws.String("sg-ecr"),
+						GroupName:     aws.String("test-cluster-vpc-endpoint-ecr.api"),
+						IpPermissions: []types.IpPermission{httpsFromVPC},
+					}}}, nil)
+				m.RevokeSecurityGroupIngress(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupIngressInput{
+					GroupId:       aws.String("sg-ecr"),
+					IpPermissions: []types.IpPermission{httpsFromVPC},
+				})).Return(&ec2.RevokeSecurityGroupIngressOutput{}, nil)
+				m.AuthorizeSecurityGroupIngress(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupIngressInput{
+					GroupId: aws.String("sg-ecr"),
+					IpPermissions: []types.IpPermission{{
+						IpProtocol: aws.String("tcp"),
+						FromPort:   aws.Int32(443),
+						ToPort:     aws.Int32(443),
+						IpRanges:   []types.IpRange{{CidrIp: aws.String("10.1.0.0/16")}},
+					}},
+				})).Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil)
+				m.ModifyVpcEndpoint(context.TODO(), gomock.Eq(&ec2.ModifyVpcEndpointInput{
+					VpcEndpointId:     aws.String("vpce-ecr"),
+					RemoveSubnetIds:   []string{"subnet-private-a"},
+					PrivateDnsEnabled: aws.Bool(false),
+				})).Return(&ec2.ModifyVpcEndpointOutput{}, nil)
+			},
+		},
+		{
+			name: "deletes the endpoints and security groups no longer needed",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpoints(context.TODO(), gomock.Eq(describeEndpointsInput), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{VpcEndpoints: []types.VpcEndpoint{
+						{
+							VpcEndpointId:   aws.String("vpce-sts"),
+							VpcEndpointType: types.VpcEndpointTypeInterface,
+							ServiceName:     aws.String("com.amazonaws.us-east-1.sts"),
+							State:           types.StateAvailable,
+							Tags:            ownedTags,
+						},
+						{
+							VpcEndpointId:   aws.String("vpce-unmanaged"),
+							VpcEndpointType: types.VpcEndpointTypeInterface,
+							ServiceName:     aws.String("com.amazonaws.us-east-1.ssm"),
+							State:           types.StateAvailable,
+						},
+					}}, nil)
+				m.DescribeSecurityGroups(context.TODO(), gomock.Eq(describeSecurityGroupsInput), gomock.Any()).
+					Return(&ec2.DescribeSecurityGroupsOutput{SecurityGroups: []types.SecurityGroup{{
+						GroupId:   aws.String("sg-endpoints"),
+						GroupName: aws.String("test-cluster-vpc-endpoints"),
+					}}}, nil)
+				m.DeleteVpcEndpoints(context.TODO(), gomock.Eq(&ec2.DeleteVpcEndpointsInput{
+					VpcEndpointIds: []string{"vpce-sts"},
+				})).Return(&ec2.DeleteVpcEndpointsOutput{}, nil)
+				m.DeleteSecurityGroup(context.TODO(), gomock.Eq(&ec2.DeleteSecurityGroupInput{GroupId: aws.String("sg-endpoints")})).
+					Return(nil, &smithy.GenericAPIError{Code: awserrors.DependencyViolation, Message: "resource sg-endpoints has a dependent object"})
+			},
+		},
+		{
+			name: "fails when the subnets of an interface endpoint are in the same availability zone",
+			vpc: infrav1.VPCSpec{VPCEndpoints: []infrav1.VPCEndpointSpec{{
+				Service: "sts",
+				Subnets: []string{"private-a", "public-a"},
+			}}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpoints(context.TODO(), gomock.Eq(describeEndpointsInput), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{}, nil)
+				m.DescribeSecurityGroups(context.TODO(), gomock.Eq(describeSecurityGroupsInput), gomock.Any()).
+					Return(&ec2.DescribeSecurityGroupsOutput{SecurityGroups: []types.SecurityGroup{{
+						GroupId:       aws.String("sg-endpoints"),
+						GroupName:     aws.String("test-cluster-vpc-endpoints"),
+						IpPermissions: []types.IpPermission{httpsFromVPC},
+					}}}, nil)
+			},
+			wantErr: `are in the same availability zone "us-east-1a"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scope := vpcEndpointsTestScope(t, tc.vpc)
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err := s.reconcileVPCEndpoints()
+			if tc.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestDeleteVPCEndpoints(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	scope := vpcEndpointsTestScope(t, infrav1.VPCSpec{VPCEndpointsPreset: infrav1.VPCEndpointsPresetPrivateCluster})
+	m := ec2Mock.EXPECT()
+	m.DescribeVpcEndpoints(context.TODO(), gomock.Eq(&ec2.DescribeVpcEndpointsInput{
+		Filters: []types.Filter{
+			{Name: aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Values: []string{"owned"}},
+			{Name: aws.String("vpc-id"), Values: []string{"vpc-endpoints"}},
+		},
+	}), gomock.Any()).Return(&ec2.DescribeVpcEndpointsOutput{VpcEndpoints: []types.VpcEndpoint{
+		{VpcEndpointId: aws.String("vpce-s3")},
+		{VpcEndpointId: aws.String("vpce-sts")},
+	}}, nil)
+	m.DeleteVpcEndpoints(context.TODO(), gomock.Eq(&ec2.DeleteVpcEndpointsInput{
+		VpcEndpointIds: []string{"vpce-s3", "vpce-sts"},
+	})).Return(&ec2.DeleteVpcEndpointsOutput{}, nil)
+	m.DescribeSecurityGroups(context.TODO(), gomock.Any(), gomock.Any()).
+		Return(&ec2.DescribeSecurityGroupsOutput{SecurityGroups: []types.SecurityGroup{{
+			GroupId:   aws.String("sg-endpoints"),
+			GroupName: aws.String("test-cluster-vpc-endpoints"),
+		}}}, nil)
+	// The security group is deleted once the network interfaces of the interface endpoints are released.
+	gomock.InOrder(
+		m.DeleteSecurityGroup(context.TODO(), gomock.Eq(&ec2.DeleteSecurityGroupInput{GroupId: aws.String("sg-endpoints")})).
+			Return(nil, &smithy.GenericAPIError{Code: awserrors.DependencyViolation, Message: "resource sg-endpoints has a dependent object"}),
+		m.DeleteSecurityGroup(context.TODO(), gomock.Eq(&ec2.DeleteSecurityGroupInput{GroupId: aws.String("sg-endpoints")})).
+			Return(&ec2.DeleteSecurityGroupOutput{}, nil),
+	)
+
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+
+	g.Expect(s.deleteVPCEndpoints()).To(Succeed())
+}
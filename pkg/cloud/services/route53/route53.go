@@ -0,0 +1,333 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package route53 provides a way to manage the Route53 record of the control plane endpoint.
+package route53
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// Service holds a collection of interfaces.
+// The interfaces are broken down like this to group functions together.
+// One alternative is to have a large list of functions from the route53 client.
+type Service struct {
+	scope         scope.Route53Scope
+	Route53Client Route53API
+}
+
+// Route53API is the subset of the AWS Route53 API that is used by CAPA.
+type Route53API interface {
+	ChangeResourceRecordSets(ctx context.Context, params *route53.ChangeResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error)
+	GetHostedZone(ctx context.Context, params *route53.GetHostedZoneInput, optFns ...func(*route53.Options)) (*route53.GetHostedZoneOutput, error)
+	ListHostedZonesByName(ctx context.Context, params *route53.ListHostedZonesByNameInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByNameOutput, error)
+	ListResourceRecordSets(ctx context.Context, params *route53.ListResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error)
+}
+
+var _ Route53API = &route53.Client{}
+
+// NewService returns a new service given the api clients.
+func NewService(route53Scope scope.Route53Scope) *Service {
+	return &Service{
+		scope:         route53Scope,
+		Route53Client: scope.NewRoute53Client(route53Scope, route53Scope, route53Scope, route53Scope.InfraCluster()),
+	}
+}
+
+// ReconcileControlPlaneDNS creates or updates the Route53 record of the control plane endpoint
+// so it points to the API server load balancer.
+func (s *Service) ReconcileControlPlaneDNS(ctx context.Context) error {
+	spec := s.scope.ControlPlaneDNS()
+	if spec == nil {
+		// The record can be removed from the spec as long as the control plane endpoint isn't set.
+		return s.DeleteControlPlaneDNS(ctx)
+	}
+
+	lb := s.scope.Network().APIServerELB
+	if lb.DNSName == "" {
+		return errors.New("the API server load balancer has no DNS name yet")
+	}
+	if spec.TTL == nil && lb.CanonicalHostedZoneID == "" {
+		return errors.New("the hosted zone of the API server load balancer is unknown yet")
+	}
+
+	zoneID, err := s.getHostedZoneID(ctx, spec)
+	if err != nil {
+		return err
+	}
+	recordName := spec.GetRecordName()
+
+	status := s.scope.ControlPlaneDNSStatus()
+	if status != nil && (status.HostedZoneID != zoneID || status.RecordName != recordName) {
+		if err := s.DeleteControlPlaneDNS(ctx); err != nil {
+			return err
+		}
+		status = nil
+	}
+
+	existing, err := s.listRecordSets(ctx, zoneID, recordName)
+	if err != nil {
+		return err
+	}
+
+	desired := desiredRecordSets(spec, &lb)
+
+	var changes []types.Change
+	for i := range existing {
+		current := existing[i]
+		want, ok := desired[current.Type]
+		switch {
+		case !ok:
+			// Only records created for the load balancer are replaced, a record of the same name
+			// created outside of the cluster is left alone.
+			if status == nil && !pointsTo(&current, lb.DNSName) {
+				return errors.Errorf("record %q of type %s already exists in hosted zone %q and does not point to the API server load balancer", recordName, current.Type, zoneID)
+			}
+			changes = append(changes, types.Change{Action: types.ChangeActionDelete, ResourceRecordSet: &current})
+		case recordSetEqual(&current, &want):
+			delete(desired, current.Type)
+		default:
+			if status == nil && !pointsTo(&current, lb.DNSName) {
+				return errors.Errorf("record %q of type %s already exists in hosted zone %q and does not point to the API server load balancer", recordName, current.Type, zoneID)
+			}
+		}
+	}
+	for _, recordType := range []types.RRType{types.RRTypeCname, types.RRTypeA, types.RRTypeAaaa} {
+		if want, ok := desired[recordType]; ok {
+			changes = append(changes, types.Change{Action: types.ChangeActionUpsert, ResourceRecordSet: &want})
+		}
+	}
+
+	if len(changes) > 0 {
+		if _, err := s.Route53Client.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: aws.String(zoneID),
+			ChangeBatch: &types.ChangeBatch{
+				Comment: aws.String("Control plane endpoint of cluster " + s.scope.Name()),
+				Changes: changes,
+			},
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedUpsertControlPlaneDNS", "Failed to update record %q in hosted zone %q: %v", recordName, zoneID, err)
+			return errors.Wrapf(err, "failed to update record %q in hosted zone %q", recordName, zoneID)
+		}
+
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulUpsertControlPlaneDNS", "Updated record %q in hosted zone %q", recordName, zoneID)
+		s.scope.Info("Updated control plane DNS record", "record-name", recordName, "hosted-zone-id", zoneID)
+	}
+
+	s.scope.SetControlPlaneDNSStatus(&infrav1.ControlPlaneDNSStatus{
+		HostedZoneID: zoneID,
+		RecordName:   recordName,
+	})
+
+	return nil
+}
+
+// DeleteControlPlaneDNS deletes the Route53 record of the control plane endpoint.
+func (s *Service) DeleteControlPlaneDNS(ctx context.Context) error {
+	status := s.scope.ControlPlaneDNSStatus()
+	if status == nil {
+		return nil
+	}
+
+	existing, err := s.listRecordSets(ctx, status.HostedZoneID, status.RecordName)
+	if err != nil {
+		var notFound *types.NoSuchHostedZone
+		if !errors.As(err, &notFound) {
+			return err
+		}
+		existing = nil
+	}
+
+	if len(existing) > 0 {
+		changes := make([]types.Change, 0, len(existing))
+		for i := range existing {
+			changes = append(changes, types.Change{Action: types.ChangeActionDelete, ResourceRecordSet: &existing[i]})
+		}
+
+		if _, err := s.Route53Client.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: aws.String(status.HostedZoneID),
+			ChangeBatch:  &types.ChangeBatch{Changes: changes},
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteControlPlaneDNS", "Failed to delete record %q in hosted zone %q: %v", status.RecordName, status.HostedZoneID, err)
+			return errors.Wrapf(err, "failed to delete record %q in hosted zone %q", status.RecordName, status.HostedZoneID)
+		}
+
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteControlPlaneDNS", "Deleted record %q in hosted zone %q", status.RecordName, status.HostedZoneID)
+		s.scope.Info("Deleted control plane DNS record", "record-name", status.RecordName, "hosted-zone-id", status.HostedZoneID)
+	}
+
+	s.scope.SetControlPlaneDNSStatus(nil)
+	return nil
+}
+
+// getHostedZoneID returns the id of the hosted zone of the record, looking it up by name if needed.
+func (s *Service) getHostedZoneID(ctx context.Context, spec *infrav1.ControlPlaneDNS) (string, error) {
+	if spec.HostedZoneID != nil {
+		out, err := s.Route53Client.GetHostedZone(ctx, &route53.GetHostedZoneInput{Id: spec.HostedZoneID})
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get hosted zone %q", *spec.HostedZoneID)
+		}
+		if isPrivateZone(out.HostedZone) != spec.PrivateZone {
+			return "", errors.Errorf("hosted zone %q is not a %s hosted zone", *spec.HostedZoneID, zoneVisibility(spec.PrivateZone))
+		}
+		return trimHostedZoneID(aws.ToString(out.HostedZone.Id)), nil
+	}
+
+	zoneName := normalizeName(aws.ToString(spec.HostedZoneName))
+	out, err := s.Route53Client.ListHostedZonesByName(ctx, &route53.ListHostedZonesByNameInput{
+		DNSName: aws.String(zoneName),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to list hosted zones with name %q", zoneName)
+	}
+
+	// Hosted zones are listed in order starting from the given name, so only the first ones can match.
+	var ids []string
+	for i := range out.HostedZones {
+		zone := &out.HostedZones[i]
+		if normalizeName(aws.ToString(zone.Name)) != zoneName {
+			break
+		}
+		if isPrivateZone(zone) == spec.PrivateZone {
+			ids = append(ids, trimHostedZoneID(aws.ToString(zone.Id)))
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", errors.Errorf("no %s hosted zone found with name %q", zoneVisibility(spec.PrivateZone), zoneName)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", errors.Errorf("multiple %s hosted zones found with name %q, reference it by id", zoneVisibility(spec.PrivateZone), zoneName)
+	}
+}
+
+// listRecordSets returns the A, AAAA and CNAME record sets of the given name.
+func (s *Service) listRecordSets(ctx context.Context, zoneID, name string) ([]types.ResourceRecordSet, error) {
+	out, err := s.Route53Client.ListResourceRecordSets(ctx, &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(zoneID),
+		StartRecordName: aws.String(name),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list records %q in hosted zone %q", name, zoneID)
+	}
+
+	// Record sets are listed in order starting from the given name, so only the first ones can match.
+	var res []types.ResourceRecordSet
+	for _, rs := range out.ResourceRecordSets {
+		if normalizeName(aws.ToString(rs.Name)) != normalizeName(name) {
+			break
+		}
+		switch rs.Type {
+		case types.RRTypeA, types.RRTypeAaaa, types.RRTypeCname:
+			res = append(res, rs)
+		}
+	}
+	return res, nil
+}
+
+// desiredRecordSets returns the record sets pointing to the load balancer, by type.
+func desiredRecordSets(spec *infrav1.ControlPlaneDNS, lb *infrav1.LoadBalancer) map[types.RRType]types.ResourceRecordSet {
+	name := normalizeName(spec.GetRecordName()) + "."
+
+	if spec.TTL != nil {
+		return map[types.RRType]types.ResourceRecordSet{
+			types.RRTypeCname: {
+				Name:            aws.String(name),
+				Type:            types.RRTypeCname,
+				TTL:             spec.TTL,
+				ResourceRecords: []types.ResourceRecord{{Value: aws.String(lb.DNSName)}},
+			},
+		}
+	}
+
+	alias := &types.AliasTarget{
+		DNSName:              aws.String(lb.DNSName),
+		HostedZoneId:         aws.String(lb.CanonicalHostedZoneID),
+		EvaluateTargetHealth: false,
+	}
+	res := map[types.RRType]types.ResourceRecordSet{
+		types.RRTypeA: {Name: aws.String(name), Type: types.RRTypeA, AliasTarget: alias},
+	}
+	if lb.LoadBalancerIPAddressType == infrav1.LoadBalancerIPAddressTypeDualstack ||
+		lb.LoadBalancerIPAddressType == infrav1.LoadBalancerIPAddressTypeDualstackWithoutPublicIPv4 {
+		res[types.RRTypeAaaa] = types.ResourceRecordSet{Name: aws.String(name), Type: types.RRTypeAaaa, AliasTarget: alias}
+	}
+	return res
+}
+
+// recordSetEqual returns true if the record set points to the same target with the same TTL.
+func recordSetEqual(current, want *types.ResourceRecordSet) bool {
+	if (current.AliasTarget == nil) != (want.AliasTarget == nil) {
+		return false
+	}
+	if want.AliasTarget != nil {
+		return normalizeName(aws.ToString(current.AliasTarget.DNSName)) == normalizeName(aws.ToString(want.AliasTarget.DNSName)) &&
+			aws.ToString(current.AliasTarget.HostedZoneId) == aws.ToString(want.AliasTarget.HostedZoneId)
+	}
+	return aws.ToInt64(current.TTL) == aws.ToInt64(want.TTL) &&
+		len(current.ResourceRecords) == 1 &&
+		normalizeName(aws.ToString(current.ResourceRecords[0].Value)) == normalizeName(aws.ToString(want.ResourceRecords[0].Value))
+}
+
+// pointsTo returns true if the record set targets the given DNS name.
+func pointsTo(rs *types.ResourceRecordSet, dnsName string) bool {
+	dnsName = normalizeName(dnsName)
+	if rs.AliasTarget != nil {
+		// Alias records to classic load balancers may be prefixed with "dualstack.".
+		target := normalizeName(aws.ToString(rs.AliasTarget.DNSName))
+		return target == dnsName || target == "dualstack."+dnsName
+	}
+	for _, rr := range rs.ResourceRecords {
+		if normalizeName(aws.ToString(rr.Value)) == dnsName {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeName returns the DNS name in lower case without trailing dot, as names are case
+// insensitive and Route53 returns them fully qualified.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
+
+// trimHostedZoneID returns the id of a hosted zone without its "/hostedzone/" prefix.
+func trimHostedZoneID(id string) string {
+	return strings.TrimPrefix(id, "/hostedzone/")
+}
+
+func isPrivateZone(zone *types.HostedZone) bool {
+	return zone != nil && zone.Config != nil && zone.Config.PrivateZone
+}
+
+func zoneVisibility(private bool) string {
+	if private {
+		return "private"
+	}
+	return "public"
+}
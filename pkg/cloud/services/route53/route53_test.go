@@ -0,0 +1,308 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route53_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	route53svc "github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/route53"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/route53/mock_route53iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const (
+	testZoneID     = "Z0123456789ABCDEFGHIJ"
+	testRecordName = "api.test-cluster.example.com"
+	testLBDNSName  = "test-cluster-apiserver-0123456789.elb.us-east-1.amazonaws.com"
+	testLBZoneID   = "Z35SXDOTRQ7X7K"
+)
+
+func TestReconcileControlPlaneDNS(t *testing.T) {
+	aliasRecord := func(recordType types.RRType) types.ResourceRecordSet {
+		return types.ResourceRecordSet{
+			Name: aws.String(testRecordName + "."),
+			Type: recordType,
+			AliasTarget: &types.AliasTarget{
+				DNSName:      aws.String(testLBDNSName + "."),
+				HostedZoneId: aws.String(testLBZoneID),
+			},
+		}
+	}
+	sourceLB := infrav1.LoadBalancer{DNSName: "classic.elb.amazonaws.com", CanonicalHostedZoneID: "Z-CLASSIC"}
+	sourceAliasRecord := types.ResourceRecordSet{
+		Name: aws.String(testRecordName + "."),
+		Type: types.RRTypeA,
+		AliasTarget: &types.AliasTarget{
+			DNSName:      aws.String(sourceLB.DNSName + "."),
+			HostedZoneId: aws.String(sourceLB.CanonicalHostedZoneID),
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		spec          *infrav1.ControlPlaneDNS
+		status        *infrav1.ControlPlaneDNSStatus
+		ipAddressType infrav1.LoadBalancerIPAddressType
+		expect        func(m *mock_route53iface.MockRoute53APIMockRecorder)
+		expectErr     bool
+		expectStatus  *infrav1.ControlPlaneDNSStatus
+	}{
+		{
+			name: "creates an alias record in the hosted zone looked up by name",
+			spec: &infrav1.ControlPlaneDNS{
+				HostedZoneName: aws.String("example.com"),
+				RecordName:     testRecordName,
+			},
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListHostedZonesByName(gomock.Any(), gomock.Eq(&route53svc.ListHostedZonesByNameInput{DNSName: aws.String("example.com")})).
+					Return(&route53svc.ListHostedZonesByNameOutput{
+						HostedZones: []types.HostedZone{
+							{Id: aws.String("/hostedzone/Z-PRIVATE"), Name: aws.String("example.com."), Config: &types.HostedZoneConfig{PrivateZone: true}},
+							{Id: aws.String("/hostedzone/" + testZoneID), Name: aws.String("example.com.")},
+							{Id: aws.String("/hostedzone/Z-OTHER"), Name: aws.String("example.org.")},
+						},
+					}, nil)
+				m.ListResourceRecordSets(gomock.Any(), gomock.Any()).Return(&route53svc.ListResourceRecordSetsOutput{}, nil)
+				m.ChangeResourceRecordSets(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *route53svc.ChangeResourceRecordSetsInput, _ ...func(*route53svc.Options)) (*route53svc.ChangeResourceRecordSetsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.ToString(input.HostedZoneId)).To(Equal(testZoneID))
+						g.Expect(input.ChangeBatch.Changes).To(HaveLen(1))
+						g.Expect(input.ChangeBatch.Changes[0].Action).To(Equal(types.ChangeActionUpsert))
+						g.Expect(input.ChangeBatch.Changes[0].ResourceRecordSet.Type).To(Equal(types.RRTypeA))
+						g.Expect(aws.ToString(input.ChangeBatch.Changes[0].ResourceRecordSet.AliasTarget.HostedZoneId)).To(Equal(testLBZoneID))
+						return &route53svc.ChangeResourceRecordSetsOutput{}, nil
+					})
+			},
+			expectStatus: &infrav1.ControlPlaneDNSStatus{HostedZoneID: testZoneID, RecordName: testRecordName},
+		},
+		{
+			name: "creates A and AAAA alias records for a dualstack load balancer",
+			spec: &infrav1.ControlPlaneDNS{
+				HostedZoneID: aws.String(testZoneID),
+				RecordName:   testRecordName,
+			},
+			ipAddressType: infrav1.LoadBalancerIPAddressTypeDualstack,
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.GetHostedZone(gomock.Any(), gomock.Any()).
+					Return(&route53svc.GetHostedZoneOutput{HostedZone: &types.HostedZone{Id: aws.String("/hostedzone/" + testZoneID)}}, nil)
+				m.ListResourceRecordSets(gomock.Any(), gomock.Any()).Return(&route53svc.ListResourceRecordSetsOutput{}, nil)
+				m.ChangeResourceRecordSets(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *route53svc.ChangeResourceRecordSetsInput, _ ...func(*route53svc.Options)) (*route53svc.ChangeResourceRecordSetsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.ChangeBatch.Changes).To(HaveLen(2))
+						g.Expect(input.ChangeBatch.Changes[0].ResourceRecordSet.Type).To(Equal(types.RRTypeA))
+						g.Expect(input.ChangeBatch.Changes[1].ResourceRecordSet.Type).To(Equal(types.RRTypeAaaa))
+						return &route53svc.ChangeResourceRecordSetsOutput{}, nil
+					})
+			},
+			expectStatus: &infrav1.ControlPlaneDNSStatus{HostedZoneID: testZoneID, RecordName: testRecordName},
+		},
+		{
+			name: "does not change an up to date record",
+			spec: &infrav1.ControlPlaneDNS{
+				HostedZoneID: aws.String(testZoneID),
+				RecordName:   testRecordName,
+			},
+			status: &infrav1.ControlPlaneDNSStatus{HostedZoneID: testZoneID, RecordName: testRecordName},
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.GetHostedZone(gomock.Any(), gomock.Any()).
+					Return(&route53svc.GetHostedZoneOutput{HostedZone: &types.HostedZone{Id: aws.String("/hostedzone/" + testZoneID)}}, nil)
+				m.ListResourceRecordSets(gomock.Any(), gomock.Any()).Return(&route53svc.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []types.ResourceRecordSet{aliasRecord(types.RRTypeA)},
+				}, nil)
+			},
+			expectStatus: &infrav1.ControlPlaneDNSStatus{HostedZoneID: testZoneID, RecordName: testRecordName},
+		},
+		{
+			name: "replaces the alias record with a CNAME record when a TTL is set",
+			spec: &infrav1.ControlPlaneDNS{
+				HostedZoneID: aws.String(testZoneID),
+				RecordName:   testRecordName,
+				TTL:          aws.Int64(60),
+			},
+			status: &infrav1.ControlPlaneDNSStatus{HostedZoneID: testZoneID, RecordName: testRecordName},
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.GetHostedZone(gomock.Any(), gomock.Any()).
+					Return(&route53svc.GetHostedZoneOutput{HostedZone: &types.HostedZone{Id: aws.String("/hostedzone/" + testZoneID)}}, nil)
+				m.ListResourceRecordSets(gomock.Any(), gomock.Any()).Return(&route53svc.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []types.ResourceRecordSet{aliasRecord(types.RRTypeA)},
+				}, nil)
+				m.ChangeResourceRecordSets(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *route53svc.ChangeResourceRecordSetsInput, _ ...func(*route53svc.Options)) (*route53svc.ChangeResourceRecordSetsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.ChangeBatch.Changes).To(HaveLen(2))
+						g.Expect(input.ChangeBatch.Changes[0].Action).To(Equal(types.ChangeActionDelete))
+						g.Expect(input.ChangeBatch.Changes[0].ResourceRecordSet.Type).To(Equal(types.RRTypeA))
+						g.Expect(input.ChangeBatch.Changes[1].Action).To(Equal(types.ChangeActionUpsert))
+						g.Expect(input.ChangeBatch.Changes[1].ResourceRecordSet.Type).To(Equal(types.RRTypeCname))
+						g.Expect(aws.ToInt64(input.ChangeBatch.Changes[1].ResourceRecordSet.TTL)).To(BeEquivalentTo(60))
+						return &route53svc.ChangeResourceRecordSetsOutput{}, nil
+					})
+			},
+			expectStatus: &infrav1.ControlPlaneDNSStatus{HostedZoneID: testZoneID, RecordName: testRecordName},
+		},
+		{
+			name: "does not take over a record pointing elsewhere",
+			spec: &infrav1.ControlPlaneDNS{
+				HostedZoneID: aws.String(testZoneID),
+				RecordName:   testRecordName,
+			},
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.GetHostedZone(gomock.Any(), gomock.Any()).
+					Return(&route53svc.GetHostedZoneOutput{HostedZone: &types.HostedZone{Id: aws.String("/hostedzone/" + testZoneID)}}, nil)
+				m.ListResourceRecordSets(gomock.Any(), gomock.Any()).Return(&route53svc.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []types.ResourceRecordSet{
+						{
+							Name:            aws.String(testRecordName + "."),
+							Type:            types.RRTypeA,
+							TTL:             aws.Int64(300),
+							ResourceRecords: []types.ResourceRecord{{Value: aws.String("192.0.2.10")}},
+						},
+					},
+				}, nil)
+			},
+			expectErr: true,
+		},
+		{
+			name: "rejects a public hosted zone when a private one is expected",
+			spec: &infrav1.ControlPlaneDNS{
+				HostedZoneID: aws.String(testZoneID),
+				RecordName:   testRecordName,
+				PrivateZone:  true,
+			},
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.GetHostedZone(gomock.Any(), gomock.Any()).
+					Return(&route53svc.GetHostedZoneOutput{HostedZone: &types.HostedZone{Id: aws.String("/hostedzone/" + testZoneID)}}, nil)
+			},
+			expectErr: true,
+		},
+		{
+			name: "moves the record to a new load balancer",
+			spec: &infrav1.ControlPlaneDNS{
+				HostedZoneID: aws.String(testZoneID),
+				RecordName:   testRecordName,
+			},
+			status: &infrav1.ControlPlaneDNSStatus{HostedZoneID: testZoneID, RecordName: testRecordName},
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.GetHostedZone(gomock.Any(), gomock.Any()).
+					Return(&route53svc.GetHostedZoneOutput{HostedZone: &types.HostedZone{Id: aws.String("/hostedzone/" + testZoneID)}}, nil)
+				m.ListResourceRecordSets(gomock.Any(), gomock.Any()).Return(&route53svc.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []types.ResourceRecordSet{sourceAliasRecord},
+				}, nil)
+				m.ChangeResourceRecordSets(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *route53svc.ChangeResourceRecordSetsInput, _ ...func(*route53svc.Options)) (*route53svc.ChangeResourceRecordSetsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.ChangeBatch.Changes).To(HaveLen(1))
+						g.Expect(input.ChangeBatch.Changes[0].Action).To(Equal(types.ChangeActionUpsert))
+						g.Expect(aws.ToString(input.ChangeBatch.Changes[0].ResourceRecordSet.AliasTarget.DNSName)).To(HavePrefix(testLBDNSName))
+						return &route53svc.ChangeResourceRecordSetsOutput{}, nil
+					})
+			},
+			expectStatus: &infrav1.ControlPlaneDNSStatus{HostedZoneID: testZoneID, RecordName: testRecordName},
+		},
+		{
+			name:   "deletes the record once removed from the spec",
+			status: &infrav1.ControlPlaneDNSStatus{HostedZoneID: testZoneID, RecordName: testRecordName},
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(gomock.Any(), gomock.Any()).Return(&route53svc.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []types.ResourceRecordSet{
+						aliasRecord(types.RRTypeA),
+						{Name: aws.String("zz." + testRecordName + "."), Type: types.RRTypeA},
+					},
+				}, nil)
+				m.ChangeResourceRecordSets(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *route53svc.ChangeResourceRecordSetsInput, _ ...func(*route53svc.Options)) (*route53svc.ChangeResourceRecordSetsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.ChangeBatch.Changes).To(HaveLen(1))
+						g.Expect(input.ChangeBatch.Changes[0].Action).To(Equal(types.ChangeActionDelete))
+						return &route53svc.ChangeResourceRecordSetsOutput{}, nil
+					})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			route53Mock := mock_route53iface.NewMockRoute53API(mockCtrl)
+
+			svc, clusterScope := testService(t, tc.spec, tc.status, tc.ipAddressType)
+			svc.Route53Client = route53Mock
+			if tc.expect != nil {
+				tc.expect(route53Mock.EXPECT())
+			}
+
+			err := svc.ReconcileControlPlaneDNS(context.TODO())
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clusterScope.ControlPlaneDNSStatus()).To(Equal(tc.expectStatus))
+		})
+	}
+}
+
+func testService(t *testing.T, spec *infrav1.ControlPlaneDNS, status *infrav1.ControlPlaneDNSStatus, ipAddressType infrav1.LoadBalancerIPAddressType) (*route53.Service, *scope.ClusterScope) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				Region:          "us-east-1",
+				ControlPlaneDNS: spec,
+			},
+			Status: infrav1.AWSClusterStatus{
+				ControlPlaneDNS: status,
+				Network: infrav1.NetworkStatus{
+					APIServerELB: infrav1.LoadBalancer{
+						DNSName:                   testLBDNSName,
+						CanonicalHostedZoneID:     testLBZoneID,
+						LoadBalancerIPAddressType: ipAddressType,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	return route53.NewService(clusterScope), clusterScope
+}
@@ -0,0 +1,379 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// reconcileSecurityGroupEgressRules creates or revokes the egress rules of the security group to match
+// the egress rules of its role.
+func (s *Service) reconcileSecurityGroupEgressRules(role infrav1.SecurityGroupRole, sg infrav1.SecurityGroup) error {
+	specRules, managed, err := s.getSecurityGroupEgressRules(role)
+	if err != nil {
+		return err
+	}
+	if !managed {
+		return nil
+	}
+
+	current := sg.EgressRules
+	// Duplicate rules with multiple destinations so that we are comparing similar sets.
+	want := expandEgressRules(specRules)
+
+	restricted := s.scope.SecurityGroupEgressMode() == infrav1.SecurityGroupEgressModeRestricted
+	if !restricted && len(current) > 0 && !slices.ContainsFunc(current, isAllowAllIPv4EgressRule) {
+		// AWS adds the rule allowing all outbound IPv4 traffic to new security groups, so a group
+		// with egress rules but without that one had its egress restricted before: restore it.
+		want = append(want, allowAllIPv4EgressRule())
+	}
+
+	var toRevoke infrav1.EgressRules
+	for _, rule := range current.Difference(want) {
+		// The rules allowing all outbound traffic are only revoked when the egress is restricted.
+		if !restricted && isAllowAllEgressRule(rule) {
+			continue
+		}
+		toRevoke = append(toRevoke, rule)
+	}
+	if len(toRevoke) > 0 {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if err := s.revokeSecurityGroupEgressRules(sg.ID, toRevoke); err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.GroupNotFound); err != nil {
+			return errors.Wrapf(err, "failed to revoke security group egress rules for %q", sg.ID)
+		}
+
+		s.scope.Debug("Revoked egress rules from security group", "revoked-egress-rules", toRevoke, "security-group-id", sg.ID)
+	}
+
+	toAuthorize := want.Difference(current)
+	if len(toAuthorize) > 0 {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if err := s.authorizeSecurityGroupEgressRules(sg.ID, toAuthorize); err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.GroupNotFound); err != nil {
+			return err
+		}
+
+		s.scope.Debug("Authorized egress rules in security group", "authorized-egress-rules", toAuthorize, "security-group-id", sg.ID)
+	}
+
+	return nil
+}
+
+// getSecurityGroupEgressRules returns the egress rules of the security group role, and whether
+// the egress rules of this role are managed at all.
+func (s *Service) getSecurityGroupEgressRules(role infrav1.SecurityGroupRole) (infrav1.EgressRules, bool, error) {
+	restricted := s.scope.SecurityGroupEgressMode() == infrav1.SecurityGroupEgressModeRestricted
+
+	var rules, additionalRules infrav1.EgressRules
+	switch role {
+	case infrav1.SecurityGroupControlPlane:
+		if restricted {
+			rules = append(rules, s.getEgressRuleToAllowVPCTraffic())
+		}
+		additionalRules = s.scope.AdditionalControlPlaneEgressRules()
+	case infrav1.SecurityGroupNode:
+		if restricted {
+			rules = append(rules, s.getEgressRuleToAllowVPCTraffic())
+		}
+		additionalRules = s.scope.AdditionalNodeEgressRules()
+	case infrav1.SecurityGroupAPIServerLB:
+		if restricted {
+			rules = append(rules, s.getEgressRulesToAllowControlPlaneLBTargets()...)
+		}
+		for _, lb := range s.scope.ControlPlaneLoadBalancers() {
+			if lb != nil {
+				additionalRules = append(additionalRules, lb.EgressRules...)
+			}
+		}
+	case infrav1.SecurityGroupIngressLB:
+		if restricted {
+			rules = append(rules, s.getEgressRulesToAllowIngressLBTargets()...)
+		}
+	default:
+		return nil, false, nil
+	}
+
+	processedRules, err := s.processEgressRulesSGs(additionalRules)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return append(rules, processedRules...), true, nil
+}
+
+// getEgressRuleToAllowVPCTraffic returns an egress rule allowing all traffic within the VPC.
+func (s *Service) getEgressRuleToAllowVPCTraffic() infrav1.EgressRule {
+	rule := infrav1.EgressRule{
+		Description: "VPC traffic",
+		Protocol:    infrav1.SecurityGroupProtocolAll,
+		FromPort:    -1,
+		ToPort:      -1,
+	}
+
+	vpc := s.scope.VPC()
+	if vpc.CidrBlock != "" {
+		rule.CidrBlocks = append(rule.CidrBlocks, vpc.CidrBlock)
+	}
+	for _, cidrBlock := range vpc.SecondaryCidrBlocks {
+		rule.CidrBlocks = append(rule.CidrBlocks, cidrBlock.IPv4CidrBlock)
+	}
+	if vpc.IsIPv6Enabled() && vpc.IPv6.CidrBlock != "" {
+		rule.IPv6CidrBlocks = []string{vpc.IPv6.CidrBlock}
+	}
+
+	return rule
+}
+
+// getEgressRulesToAllowControlPlaneLBTargets returns the egress rules allowing the control plane
+// load balancers to reach, and health check, the control plane instances.
+func (s *Service) getEgressRulesToAllowControlPlaneLBTargets() infrav1.EgressRules {
+	ports := sets.New(int64(s.scope.APIServerPort()))
+	for _, lb := range s.scope.ControlPlaneLoadBalancers() {
+		if lb == nil {
+			continue
+		}
+		for _, ln := range lb.AdditionalListeners {
+			ports.Insert(ln.Port)
+		}
+	}
+
+	rules := make(infrav1.EgressRules, 0, ports.Len())
+	for _, port := range sets.List(ports) {
+		rules = append(rules, infrav1.EgressRule{
+			Description:                 "Control plane instances",
+			Protocol:                    infrav1.SecurityGroupProtocolTCP,
+			FromPort:                    port,
+			ToPort:                      port,
+			DestinationSecurityGroupIDs: []string{s.scope.SecurityGroups()[infrav1.SecurityGroupControlPlane].ID},
+		})
+	}
+
+	return rules
+}
+
+// getEgressRulesToAllowIngressLBTargets returns the egress rules allowing the ingress load balancer
+// to reach, and health check, the control plane instances.
+func (s *Service) getEgressRulesToAllowIngressLBTargets() infrav1.EgressRules {
+	ports := ingressLBTargetPorts(s.scope.IngressLoadBalancer())
+	rules := make(infrav1.EgressRules, 0, len(ports))
+	for _, port := range ports {
+		rules = append(rules, infrav1.EgressRule{
+			Description:                 "Control plane instances",
+			Protocol:                    infrav1.SecurityGroupProtocolTCP,
+			FromPort:                    port,
+			ToPort:                      port,
+			DestinationSecurityGroupIDs: []string{s.scope.SecurityGroups()[infrav1.SecurityGroupControlPlane].ID},
+		})
+	}
+	return rules
+}
+
+// processEgressRulesSGs translates the destination security group roles of the rules into security group IDs.
+func (s *Service) processEgressRulesSGs(egressRules infrav1.EgressRules) (infrav1.EgressRules, error) {
+	output := make(infrav1.EgressRules, 0, len(egressRules))
+
+	for _, rule := range egressRules {
+		if len(rule.DestinationSecurityGroupRoles) == 0 {
+			output = append(output, rule)
+			continue
+		}
+
+		securityGroupIDs := sets.New(rule.DestinationSecurityGroupIDs...)
+		for _, role := range rule.DestinationSecurityGroupRoles {
+			sg, ok := s.scope.SecurityGroups()[role]
+			if !ok {
+				return nil, errors.Errorf("security group with role %q of egress rule %q not found", role, rule.Description)
+			}
+			securityGroupIDs.Insert(sg.ID)
+		}
+		rule.DestinationSecurityGroupIDs = sets.List(securityGroupIDs)
+		rule.DestinationSecurityGroupRoles = nil
+
+		output = append(output, rule)
+	}
+
+	return output, nil
+}
+
+// expandEgressRules expand the given egress rules so that it's compatible with the list generated by
+// egressRulesFromSDKType.
+// We assume that processEgressRulesSGs has been already called on the input, so the DestinationSecurityGroupRoles
+// have been translated into Security Group IDs.
+func expandEgressRules(rules infrav1.EgressRules) infrav1.EgressRules {
+	res := make(infrav1.EgressRules, 0, len(rules))
+	for _, rule := range rules {
+		base := infrav1.EgressRule{
+			Description: rule.Description,
+			Protocol:    rule.Protocol,
+			FromPort:    rule.FromPort,
+			ToPort:      rule.ToPort,
+		}
+
+		for _, dst := range rule.CidrBlocks {
+			rcopy := base
+			rcopy.CidrBlocks = []string{dst}
+			res = append(res, rcopy)
+		}
+
+		for _, dst := range rule.IPv6CidrBlocks {
+			rcopy := base
+			rcopy.IPv6CidrBlocks = []string{dst}
+			res = append(res, rcopy)
+		}
+
+		for _, dst := range rule.PrefixListIDs {
+			rcopy := base
+			rcopy.PrefixListIDs = []string{dst}
+			res = append(res, rcopy)
+		}
+
+		for _, dst := range rule.DestinationSecurityGroupIDs {
+			rcopy := base
+			rcopy.DestinationSecurityGroupIDs = []string{dst}
+			res = append(res, rcopy)
+		}
+	}
+	return res
+}
+
+func allowAllIPv4EgressRule() infrav1.EgressRule {
+	return infrav1.EgressRule{
+		Protocol:   infrav1.SecurityGroupProtocolAll,
+		FromPort:   -1,
+		ToPort:     -1,
+		CidrBlocks: []string{services.AnyIPv4CidrBlock},
+	}
+}
+
+func isAllowAllIPv4EgressRule(rule infrav1.EgressRule) bool {
+	return rule.Protocol == infrav1.SecurityGroupProtocolAll &&
+		slices.Equal(rule.CidrBlocks, []string{services.AnyIPv4CidrBlock}) &&
+		len(rule.IPv6CidrBlocks) == 0 && len(rule.PrefixListIDs) == 0 && len(rule.DestinationSecurityGroupIDs) == 0
+}
+
+func isAllowAllEgressRule(rule infrav1.EgressRule) bool {
+	if isAllowAllIPv4EgressRule(rule) {
+		return true
+	}
+	return rule.Protocol == infrav1.SecurityGroupProtocolAll &&
+		slices.Equal(rule.IPv6CidrBlocks, []string{services.AnyIPv6CidrBlock}) &&
+		len(rule.CidrBlocks) == 0 && len(rule.PrefixListIDs) == 0 && len(rule.DestinationSecurityGroupIDs) == 0
+}
+
+func (s *Service) authorizeSecurityGroupEgressRules(id string, rules infrav1.EgressRules) error {
+	input := &ec2.AuthorizeSecurityGroupEgressInput{GroupId: aws.String(id)}
+	for i := range rules {
+		rule := rules[i]
+		input.IpPermissions = append(input.IpPermissions, *egressRuleToSDKType(s.scope, &rule))
+	}
+	if _, err := s.EC2Client.AuthorizeSecurityGroupEgress(context.TODO(), input); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAuthorizeSecurityGroupEgressRules", "Failed to authorize security group egress rules %v for SecurityGroup %q: %v", rules, id, err)
+		return errors.Wrapf(err, "failed to authorize security group %q egress rules: %v", id, rules)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAuthorizeSecurityGroupEgressRules", "Authorized security group egress rules %v for SecurityGroup %q", rules, id)
+	return nil
+}
+
+func (s *Service) revokeSecurityGroupEgressRules(id string, rules infrav1.EgressRules) error {
+	input := &ec2.RevokeSecurityGroupEgressInput{GroupId: aws.String(id)}
+	for i := range rules {
+		rule := rules[i]
+		input.IpPermissions = append(input.IpPermissions, *egressRuleToSDKType(s.scope, &rule))
+	}
+
+	if _, err := s.EC2Client.RevokeSecurityGroupEgress(context.TODO(), input); err != nil && !awserrors.IsPermissionNotFoundError(errors.Cause(err)) {
+		record.Warnf(s.scope.InfraCluster(), "FailedRevokeSecurityGroupEgressRules", "Failed to revoke security group egress rules %v for SecurityGroup %q: %v", rules, id, err)
+		return errors.Wrapf(err, "failed to revoke security group %q egress rules: %v", id, rules)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulRevokeSecurityGroupEgressRules", "Revoked security group egress rules %v for SecurityGroup %q", rules, id)
+	return nil
+}
+
+func egressRuleToSDKType(scope scope.SGScope, e *infrav1.EgressRule) *types.IpPermission {
+	// The destinations of an egress permission share their representation with the sources of an ingress one.
+	res := ingressRuleToSDKType(scope, &infrav1.IngressRule{
+		Description:            e.Description,
+		Protocol:               e.Protocol,
+		FromPort:               e.FromPort,
+		ToPort:                 e.ToPort,
+		CidrBlocks:             e.CidrBlocks,
+		IPv6CidrBlocks:         e.IPv6CidrBlocks,
+		SourceSecurityGroupIDs: e.DestinationSecurityGroupIDs,
+	})
+	if res == nil {
+		return nil
+	}
+
+	for _, id := range e.PrefixListIDs {
+		prefixList := types.PrefixListId{
+			PrefixListId: aws.String(id),
+		}
+
+		if e.Description != "" {
+			prefixList.Description = aws.String(e.Description)
+		}
+
+		res.PrefixListIds = append(res.PrefixListIds, prefixList)
+	}
+
+	return res
+}
+
+func egressRulesFromSDKType(v types.IpPermission) (res infrav1.EgressRules) {
+	for _, rule := range ingressRulesFromSDKType(v) {
+		var prefixListIDs []string
+		for _, prefixList := range rule.SourcePrefixLists {
+			prefixListIDs = append(prefixListIDs, aws.ToString(prefixList.ID))
+		}
+
+		res = append(res, infrav1.EgressRule{
+			Description:                 rule.Description,
+			Protocol:                    rule.Protocol,
+			FromPort:                    rule.FromPort,
+			ToPort:                      rule.ToPort,
+			CidrBlocks:                  rule.CidrBlocks,
+			IPv6CidrBlocks:              rule.IPv6CidrBlocks,
+			PrefixListIDs:               prefixListIDs,
+			DestinationSecurityGroupIDs: rule.SourceSecurityGroupIDs,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,326 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileSecurityGroupEgressRules(t *testing.T) {
+	allowAllIPv4 := infrav1.EgressRule{
+		Protocol:   infrav1.SecurityGroupProtocolAll,
+		CidrBlocks: []string{services.AnyIPv4CidrBlock},
+	}
+	allowAllIPv6 := infrav1.EgressRule{
+		Protocol:       infrav1.SecurityGroupProtocolAll,
+		IPv6CidrBlocks: []string{services.AnyIPv6CidrBlock},
+	}
+	vpcTraffic := infrav1.EgressRule{
+		Description: "VPC traffic",
+		Protocol:    infrav1.SecurityGroupProtocolAll,
+		CidrBlocks:  []string{"10.0.0.0/16"},
+	}
+
+	testCases := []struct {
+		name        string
+		role        infrav1.SecurityGroupRole
+		networkSpec infrav1.NetworkSpec
+		lb          *infrav1.AWSLoadBalancerSpec
+		current     infrav1.EgressRules
+		expect      func(m *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name:    "allow all mode without additional rules leaves the default egress rule alone",
+			role:    infrav1.SecurityGroupNode,
+			current: infrav1.EgressRules{allowAllIPv4, allowAllIPv6},
+		},
+		{
+			name: "allow all mode authorizes the additional rules and revokes the stale ones",
+			role: infrav1.SecurityGroupControlPlane,
+			networkSpec: infrav1.NetworkSpec{
+				AdditionalControlPlaneEgressRules: []infrav1.EgressRule{
+					{
+						Description:   "S3",
+						Protocol:      infrav1.SecurityGroupProtocolTCP,
+						FromPort:      443,
+						ToPort:        443,
+						PrefixListIDs: []string{"pl-s3"},
+					},
+					{
+						Description:                   "Nodes",
+						Protocol:                      infrav1.SecurityGroupProtocolAll,
+						DestinationSecurityGroupRoles: []infrav1.SecurityGroupRole{infrav1.SecurityGroupNode},
+					},
+				},
+			},
+			current: infrav1.EgressRules{
+				allowAllIPv4,
+				{
+					Description: "stale",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    80,
+					ToPort:      80,
+					CidrBlocks:  []string{"192.168.0.0/16"},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.RevokeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId: aws.String("sg-control"),
+					IpPermissions: []types.IpPermission{
+						{
+							IpProtocol: aws.String("tcp"),
+							FromPort:   aws.Int32(80),
+							ToPort:     aws.Int32(80),
+							IpRanges:   []types.IpRange{{CidrIp: aws.String("192.168.0.0/16"), Description: aws.String("stale")}},
+						},
+					},
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+				m.AuthorizeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId: aws.String("sg-control"),
+					IpPermissions: []types.IpPermission{
+						{
+							IpProtocol:    aws.String("tcp"),
+							FromPort:      aws.Int32(443),
+							ToPort:        aws.Int32(443),
+							PrefixListIds: []types.PrefixListId{{PrefixListId: aws.String("pl-s3"), Description: aws.String("S3")}},
+						},
+						{
+							IpProtocol:       aws.String("-1"),
+							UserIdGroupPairs: []types.UserIdGroupPair{{GroupId: aws.String("sg-node"), Description: aws.String("Nodes")}},
+						},
+					},
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+			},
+		},
+		{
+			name: "restricted mode revokes the default egress rules and allows the VPC traffic",
+			role: infrav1.SecurityGroupNode,
+			networkSpec: infrav1.NetworkSpec{
+				SecurityGroupEgressMode: infrav1.SecurityGroupEgressModeRestricted,
+			},
+			current: infrav1.EgressRules{allowAllIPv4, allowAllIPv6},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.RevokeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId: aws.String("sg-node"),
+					IpPermissions: []types.IpPermission{
+						{
+							IpProtocol: aws.String("-1"),
+							IpRanges:   []types.IpRange{{CidrIp: aws.String(services.AnyIPv4CidrBlock)}},
+						},
+						{
+							IpProtocol: aws.String("-1"),
+							Ipv6Ranges: []types.Ipv6Range{{CidrIpv6: aws.String(services.AnyIPv6CidrBlock)}},
+						},
+					},
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+				m.AuthorizeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId: aws.String("sg-node"),
+					IpPermissions: []types.IpPermission{
+						{
+							IpProtocol: aws.String("-1"),
+							IpRanges:   []types.IpRange{{CidrIp: aws.String("10.0.0.0/16"), Description: aws.String("VPC traffic")}},
+						},
+					},
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+			},
+		},
+		{
+			name: "restricted mode allows the control plane load balancer to reach the control plane instances",
+			role: infrav1.SecurityGroupAPIServerLB,
+			networkSpec: infrav1.NetworkSpec{
+				SecurityGroupEgressMode: infrav1.SecurityGroupEgressModeRestricted,
+			},
+			lb: &infrav1.AWSLoadBalancerSpec{
+				LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+				AdditionalListeners: []infrav1.AdditionalListenerSpec{
+					{Port: 2379, Protocol: infrav1.ELBProtocolTCP},
+				},
+			},
+			current: infrav1.EgressRules{allowAllIPv4},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.RevokeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId: aws.String("sg-apiserver-lb"),
+					IpPermissions: []types.IpPermission{
+						{
+							IpProtocol: aws.String("-1"),
+							IpRanges:   []types.IpRange{{CidrIp: aws.String(services.AnyIPv4CidrBlock)}},
+						},
+					},
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+				m.AuthorizeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId: aws.String("sg-apiserver-lb"),
+					IpPermissions: []types.IpPermission{
+						{
+							IpProtocol:       aws.String("tcp"),
+							FromPort:         aws.Int32(2379),
+							ToPort:           aws.Int32(2379),
+							UserIdGroupPairs: []types.UserIdGroupPair{{GroupId: aws.String("sg-control"), Description: aws.String("Control plane instances")}},
+						},
+						{
+							IpProtocol:       aws.String("tcp"),
+							FromPort:         aws.Int32(6443),
+							ToPort:           aws.Int32(6443),
+							UserIdGroupPairs: []types.UserIdGroupPair{{GroupId: aws.String("sg-control"), Description: aws.String("Control plane instances")}},
+						},
+					},
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+			},
+		},
+		{
+			name:    "allow all mode restores the default egress rule of a restricted security group",
+			role:    infrav1.SecurityGroupNode,
+			current: infrav1.EgressRules{vpcTraffic},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.RevokeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId: aws.String("sg-node"),
+					IpPermissions: []types.IpPermission{
+						{
+							IpProtocol: aws.String("-1"),
+							IpRanges:   []types.IpRange{{CidrIp: aws.String("10.0.0.0/16"), Description: aws.String("VPC traffic")}},
+						},
+					},
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+				m.AuthorizeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId: aws.String("sg-node"),
+					IpPermissions: []types.IpPermission{
+						{
+							IpProtocol: aws.String("-1"),
+							IpRanges:   []types.IpRange{{CidrIp: aws.String(services.AnyIPv4CidrBlock)}},
+						},
+					},
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+			},
+		},
+		{
+			name: "egress rules of the bastion security group are not managed",
+			role: infrav1.SecurityGroupBastion,
+			networkSpec: infrav1.NetworkSpec{
+				SecurityGroupEgressMode: infrav1.SecurityGroupEgressModeRestricted,
+			},
+			current: infrav1.EgressRules{allowAllIPv4},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			networkSpec := tc.networkSpec
+			networkSpec.VPC = infrav1.VPCSpec{
+				ID:        "vpc-securitygroups",
+				CidrBlock: "10.0.0.0/16",
+			}
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec:              networkSpec,
+					ControlPlaneLoadBalancer: tc.lb,
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {ID: "sg-control"},
+							infrav1.SecurityGroupNode:         {ID: "sg-node"},
+							infrav1.SecurityGroupAPIServerLB:  {ID: "sg-apiserver-lb"},
+							infrav1.SecurityGroupBastion:      {ID: "sg-bastion"},
+						},
+					},
+				},
+			}
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: awsCluster,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(cs, testSecurityGroupRoles)
+			s.EC2Client = ec2Mock
+
+			sg := cs.SecurityGroups()[tc.role]
+			sg.EgressRules = tc.current
+			g.Expect(s.reconcileSecurityGroupEgressRules(tc.role, sg)).To(Succeed())
+		})
+	}
+}
+
+func TestEgressRulesFromSDKType(t *testing.T) {
+	g := NewWithT(t)
+
+	rules := egressRulesFromSDKType(types.IpPermission{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int32(443),
+		ToPort:     aws.Int32(443),
+		IpRanges:   []types.IpRange{{CidrIp: aws.String("10.0.0.0/16"), Description: aws.String("VPC")}},
+		PrefixListIds: []types.PrefixListId{
+			{PrefixListId: aws.String("pl-s3"), Description: aws.String("S3")},
+		},
+		UserIdGroupPairs: []types.UserIdGroupPair{{GroupId: aws.String("sg-node")}},
+	})
+
+	g.Expect(rules).To(Equal(infrav1.EgressRules{
+		{
+			Description: "VPC",
+			Protocol:    infrav1.SecurityGroupProtocolTCP,
+			FromPort:    443,
+			ToPort:      443,
+			CidrBlocks:  []string{"10.0.0.0/16"},
+		},
+		{
+			Protocol:                    infrav1.SecurityGroupProtocolTCP,
+			FromPort:                    443,
+			ToPort:                      443,
+			DestinationSecurityGroupIDs: []string{"sg-node"},
+		},
+		{
+			Description:   "S3",
+			Protocol:      infrav1.SecurityGroupProtocolTCP,
+			FromPort:      443,
+			ToPort:        443,
+			PrefixListIDs: []string{"pl-s3"},
+		},
+	}))
+}
@@ -0,0 +1,335 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// errCodeIncorrectState is returned by EC2 when a managed prefix list is modified while
+// a previous modification is still in progress.
+const errCodeIncorrectState = "IncorrectState"
+
+// reconcileManagedPrefixLists creates or updates the managed prefix lists of the cluster, and records
+// their ids in the network status. The owned prefix lists no longer in the spec are returned, they can
+// only be deleted once no security group rule references them anymore.
+func (s *Service) reconcileManagedPrefixLists() ([]types.ManagedPrefixList, error) {
+	specs := s.scope.ManagedPrefixLists()
+	if len(specs) == 0 && len(s.scope.Network().ManagedPrefixLists) == 0 {
+		return nil, nil
+	}
+
+	existing, err := s.describeClusterOwnedPrefixLists()
+	if err != nil {
+		return nil, err
+	}
+
+	status := make(map[string]string, len(specs))
+	for i := range specs {
+		spec := &specs[i]
+		name := s.getPrefixListName(spec.Name)
+
+		current, ok := existing[name]
+		if !ok {
+			id, err := s.createManagedPrefixList(spec)
+			if err != nil {
+				return nil, err
+			}
+			status[spec.Name] = id
+			continue
+		}
+		delete(existing, name)
+
+		if err := s.updateManagedPrefixList(spec, current); err != nil {
+			return nil, err
+		}
+		status[spec.Name] = aws.ToString(current.PrefixListId)
+	}
+	s.scope.Network().ManagedPrefixLists = status
+
+	stale := make([]types.ManagedPrefixList, 0, len(existing))
+	for _, pl := range existing {
+		stale = append(stale, pl)
+	}
+	return stale, nil
+}
+
+// deleteManagedPrefixLists deletes the given owned managed prefix lists.
+func (s *Service) deleteManagedPrefixLists(lists []types.ManagedPrefixList) error {
+	var errs []error
+	for _, pl := range lists {
+		if pl.State == types.PrefixListStateDeleteInProgress || pl.State == types.PrefixListStateDeleteComplete {
+			continue
+		}
+
+		if _, err := s.EC2Client.DeleteManagedPrefixList(context.TODO(), &ec2.DeleteManagedPrefixListInput{
+			PrefixListId: pl.PrefixListId,
+		}); err != nil && !awserrors.IsNotFound(err) {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteManagedPrefixList", "Failed to delete managed prefix list %q: %v", aws.ToString(pl.PrefixListId), err)
+			errs = append(errs, errors.Wrapf(err, "failed to delete managed prefix list %q", aws.ToString(pl.PrefixListId)))
+			continue
+		}
+
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteManagedPrefixList", "Deleted managed prefix list %q", aws.ToString(pl.PrefixListId))
+		s.scope.Info("Deleted managed prefix list", "prefix-list-id", aws.ToString(pl.PrefixListId), "name", aws.ToString(pl.PrefixListName))
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// deleteClusterOwnedPrefixLists deletes all the managed prefix lists owned by the cluster.
+func (s *Service) deleteClusterOwnedPrefixLists() error {
+	if len(s.scope.ManagedPrefixLists()) == 0 && len(s.scope.Network().ManagedPrefixLists) == 0 {
+		return nil
+	}
+
+	existing, err := s.describeClusterOwnedPrefixLists()
+	if err != nil {
+		return err
+	}
+
+	lists := make([]types.ManagedPrefixList, 0, len(existing))
+	for _, pl := range existing {
+		lists = append(lists, pl)
+	}
+	if err := s.deleteManagedPrefixLists(lists); err != nil {
+		return err
+	}
+
+	s.scope.Network().ManagedPrefixLists = nil
+	return nil
+}
+
+// describeClusterOwnedPrefixLists returns the managed prefix lists owned by the cluster, by name.
+// DescribeManagedPrefixLists doesn't support filtering on tags, so the lists are filtered here.
+func (s *Service) describeClusterOwnedPrefixLists() (map[string]types.ManagedPrefixList, error) {
+	res := map[string]types.ManagedPrefixList{}
+
+	paginator := ec2.NewDescribeManagedPrefixListsPaginator(s.EC2Client, &ec2.DescribeManagedPrefixListsInput{})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe managed prefix lists")
+		}
+
+		for _, pl := range out.PrefixLists {
+			if converters.TagsToMap(pl.Tags).HasOwned(s.scope.Name()) {
+				res[aws.ToString(pl.PrefixListName)] = pl
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (s *Service) createManagedPrefixList(spec *infrav1.ManagedPrefixListSpec) (string, error) {
+	name := s.getPrefixListName(spec.Name)
+
+	entries := make([]types.AddPrefixListEntry, 0, len(spec.CidrBlocks))
+	for _, cidr := range spec.CidrBlocks {
+		entries = append(entries, types.AddPrefixListEntry{Cidr: aws.String(cidr)})
+	}
+
+	out, err := s.EC2Client.CreateManagedPrefixList(context.TODO(), &ec2.CreateManagedPrefixListInput{
+		PrefixListName:    aws.String(name),
+		AddressFamily:     aws.String(string(prefixListAddressFamily(spec))),
+		MaxEntries:        aws.Int32(spec.GetMaxEntries()),
+		Entries:           entries,
+		TagSpecifications: []types.TagSpecification{tags.BuildParamsToTagSpecification(types.ResourceTypePrefixList, s.getPrefixListTagParams(name))},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateManagedPrefixList", "Failed to create managed prefix list %q: %v", name, err)
+		return "", errors.Wrapf(err, "failed to create managed prefix list %q", name)
+	}
+
+	id := aws.ToString(out.PrefixList.PrefixListId)
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateManagedPrefixList", "Created managed prefix list %q with name %q", id, name)
+	s.scope.Info("Created managed prefix list", "prefix-list-id", id, "name", name)
+
+	return id, nil
+}
+
+// updateManagedPrefixList updates the entries and the size of the managed prefix list to match the spec.
+// A prefix list can't be resized and have its entries modified at once, so it is grown before its entries
+// are modified, and shrunk once its entries are up to date.
+func (s *Service) updateManagedPrefixList(spec *infrav1.ManagedPrefixListSpec, current types.ManagedPrefixList) error {
+	id := aws.ToString(current.PrefixListId)
+
+	if aws.ToString(current.AddressFamily) != string(prefixListAddressFamily(spec)) {
+		return errors.Errorf("cannot change the address family of managed prefix list %q from %s to %s", id, aws.ToString(current.AddressFamily), prefixListAddressFamily(spec))
+	}
+
+	entries, err := s.getManagedPrefixListEntries(id)
+	if err != nil {
+		return err
+	}
+	want := sets.New(spec.CidrBlocks...)
+	toAdd := sets.List(want.Difference(entries))
+	toRemove := sets.List(entries.Difference(want))
+
+	maxEntries := spec.GetMaxEntries()
+	if len(toAdd) == 0 && len(toRemove) == 0 {
+		if aws.ToInt32(current.MaxEntries) != maxEntries {
+			return s.modifyManagedPrefixList(id, func(input *ec2.ModifyManagedPrefixListInput) {
+				input.MaxEntries = aws.Int32(maxEntries)
+			})
+		}
+		return nil
+	}
+
+	if aws.ToInt32(current.MaxEntries) < maxEntries {
+		if err := s.modifyManagedPrefixList(id, func(input *ec2.ModifyManagedPrefixListInput) {
+			input.MaxEntries = aws.Int32(maxEntries)
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := s.modifyManagedPrefixList(id, func(input *ec2.ModifyManagedPrefixListInput) {
+		for _, cidr := range toAdd {
+			input.AddEntries = append(input.AddEntries, types.AddPrefixListEntry{Cidr: aws.String(cidr)})
+		}
+		for _, cidr := range toRemove {
+			input.RemoveEntries = append(input.RemoveEntries, types.RemovePrefixListEntry{Cidr: aws.String(cidr)})
+		}
+	}); err != nil {
+		return err
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulModifyManagedPrefixList", "Modified entries of managed prefix list %q", id)
+	s.scope.Info("Modified managed prefix list entries", "prefix-list-id", id, "added", toAdd, "removed", toRemove)
+	return nil
+}
+
+// modifyManagedPrefixList modifies the managed prefix list at its current version, waiting for any
+// modification still in progress to complete.
+func (s *Service) modifyManagedPrefixList(id string, mutate func(*ec2.ModifyManagedPrefixListInput)) error {
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		out, err := s.EC2Client.DescribeManagedPrefixLists(context.TODO(), &ec2.DescribeManagedPrefixListsInput{
+			PrefixListIds: []string{id},
+		})
+		if err != nil {
+			return false, err
+		}
+		if len(out.PrefixLists) == 0 {
+			return false, errors.Errorf("managed prefix list %q not found", id)
+		}
+
+		input := &ec2.ModifyManagedPrefixListInput{
+			PrefixListId:   aws.String(id),
+			CurrentVersion: out.PrefixLists[0].Version,
+		}
+		mutate(input)
+		if _, err := s.EC2Client.ModifyManagedPrefixList(context.TODO(), input); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, errCodeIncorrectState); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedModifyManagedPrefixList", "Failed to modify managed prefix list %q: %v", id, err)
+		return errors.Wrapf(err, "failed to modify managed prefix list %q", id)
+	}
+	return nil
+}
+
+func (s *Service) getManagedPrefixListEntries(id string) (sets.Set[string], error) {
+	entries := sets.New[string]()
+
+	paginator := ec2.NewGetManagedPrefixListEntriesPaginator(s.EC2Client, &ec2.GetManagedPrefixListEntriesInput{
+		PrefixListId: aws.String(id),
+	})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get entries of managed prefix list %q", id)
+		}
+		for _, entry := range out.Entries {
+			entries.Insert(aws.ToString(entry.Cidr))
+		}
+	}
+
+	return entries, nil
+}
+
+// resolvePrefixLists resolves the prefix list references into references by id.
+// Names are looked up in the managed prefix lists of the cluster first, then in the account.
+func (s *Service) resolvePrefixLists(refs []infrav1.PrefixListReference) ([]infrav1.PrefixListReference, error) {
+	res := make([]infrav1.PrefixListReference, 0, len(refs))
+	for _, ref := range refs {
+		if ref.ID != nil {
+			res = append(res, infrav1.PrefixListReference{ID: ref.ID})
+			continue
+		}
+
+		name := aws.ToString(ref.Name)
+		if id, ok := s.scope.Network().ManagedPrefixLists[name]; ok {
+			res = append(res, infrav1.PrefixListReference{ID: aws.String(id)})
+			continue
+		}
+
+		out, err := s.EC2Client.DescribeManagedPrefixLists(context.TODO(), &ec2.DescribeManagedPrefixListsInput{
+			Filters: []types.Filter{filter.EC2.PrefixListName(name)},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe managed prefix list with name %q", name)
+		}
+		switch len(out.PrefixLists) {
+		case 0:
+			return nil, errors.Errorf("no managed prefix list found with name %q", name)
+		case 1:
+			res = append(res, infrav1.PrefixListReference{ID: out.PrefixLists[0].PrefixListId})
+		default:
+			return nil, errors.Errorf("multiple managed prefix lists found with name %q, reference it by id", name)
+		}
+	}
+	return res, nil
+}
+
+func (s *Service) getPrefixListName(name string) string {
+	return fmt.Sprintf("%s-%s", s.scope.Name(), name)
+}
+
+func (s *Service) getPrefixListTagParams(name string) infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+func prefixListAddressFamily(spec *infrav1.ManagedPrefixListSpec) infrav1.PrefixListAddressFamily {
+	if spec.AddressFamily == "" {
+		return infrav1.PrefixListAddressFamilyIPv4
+	}
+	return spec.AddressFamily
+}
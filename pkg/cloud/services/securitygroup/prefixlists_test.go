@@ -0,0 +1,311 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileManagedPrefixLists(t *testing.T) {
+	ownedTags := []types.Tag{
+		{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+	}
+	vpnList := infrav1.ManagedPrefixListSpec{
+		Name:       "vpn",
+		CidrBlocks: []string{"10.10.0.0/16", "10.20.0.0/16"},
+	}
+
+	testCases := []struct {
+		name         string
+		specs        []infrav1.ManagedPrefixListSpec
+		status       map[string]string
+		expect       func(m *mocks.MockEC2APIMockRecorder)
+		expectStatus map[string]string
+		expectStale  []string
+	}{
+		{
+			name: "does nothing without managed prefix lists",
+		},
+		{
+			name:  "creates a missing prefix list",
+			specs: []infrav1.ManagedPrefixListSpec{vpnList},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.Eq(&ec2.DescribeManagedPrefixListsInput{}), gomock.Any()).
+					Return(&ec2.DescribeManagedPrefixListsOutput{
+						PrefixLists: []types.ManagedPrefixList{
+							{PrefixListId: aws.String("pl-aws"), PrefixListName: aws.String("com.amazonaws.us-east-1.s3")},
+						},
+					}, nil)
+				m.CreateManagedPrefixList(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateManagedPrefixListInput, _ ...func(*ec2.Options)) (*ec2.CreateManagedPrefixListOutput, error) {
+						if aws.ToString(input.PrefixListName) != "test-cluster-vpn" || aws.ToString(input.AddressFamily) != "IPv4" ||
+							aws.ToInt32(input.MaxEntries) != 2 || len(input.Entries) != 2 {
+							t.Fatalf("unexpected input: %+v", input)
+						}
+						return &ec2.CreateManagedPrefixListOutput{
+							PrefixList: &types.ManagedPrefixList{PrefixListId: aws.String("pl-vpn")},
+						}, nil
+					})
+			},
+			expectStatus: map[string]string{"vpn": "pl-vpn"},
+		},
+		{
+			name:   "grows the prefix list before updating its entries",
+			specs:  []infrav1.ManagedPrefixListSpec{vpnList},
+			status: map[string]string{"vpn": "pl-vpn"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.Eq(&ec2.DescribeManagedPrefixListsInput{}), gomock.Any()).
+					Return(&ec2.DescribeManagedPrefixListsOutput{
+						PrefixLists: []types.ManagedPrefixList{
+							{
+								PrefixListId:   aws.String("pl-vpn"),
+								PrefixListName: aws.String("test-cluster-vpn"),
+								AddressFamily:  aws.String("IPv4"),
+								MaxEntries:     aws.Int32(1),
+								Tags:           ownedTags,
+							},
+						},
+					}, nil)
+				m.GetManagedPrefixListEntries(context.TODO(), gomock.Eq(&ec2.GetManagedPrefixListEntriesInput{
+					PrefixListId: aws.String("pl-vpn"),
+				}), gomock.Any()).Return(&ec2.GetManagedPrefixListEntriesOutput{
+					Entries: []types.PrefixListEntry{{Cidr: aws.String("10.30.0.0/16")}},
+				}, nil)
+				gomock.InOrder(
+					m.DescribeManagedPrefixLists(context.TODO(), gomock.Eq(&ec2.DescribeManagedPrefixListsInput{
+						PrefixListIds: []string{"pl-vpn"},
+					})).Return(&ec2.DescribeManagedPrefixListsOutput{
+						PrefixLists: []types.ManagedPrefixList{{PrefixListId: aws.String("pl-vpn"), Version: aws.Int64(1)}},
+					}, nil),
+					m.ModifyManagedPrefixList(context.TODO(), gomock.Eq(&ec2.ModifyManagedPrefixListInput{
+						PrefixListId:   aws.String("pl-vpn"),
+						CurrentVersion: aws.Int64(1),
+						MaxEntries:     aws.Int32(2),
+					})).Return(&ec2.ModifyManagedPrefixListOutput{}, nil),
+					m.DescribeManagedPrefixLists(context.TODO(), gomock.Eq(&ec2.DescribeManagedPrefixListsInput{
+						PrefixListIds: []string{"pl-vpn"},
+					})).Return(&ec2.DescribeManagedPrefixListsOutput{
+						PrefixLists: []types.ManagedPrefixList{{PrefixListId: aws.String("pl-vpn"), Version: aws.Int64(2)}},
+					}, nil),
+					m.ModifyManagedPrefixList(context.TODO(), gomock.Eq(&ec2.ModifyManagedPrefixListInput{
+						PrefixListId:   aws.String("pl-vpn"),
+						CurrentVersion: aws.Int64(2),
+						AddEntries: []types.AddPrefixListEntry{
+							{Cidr: aws.String("10.10.0.0/16")},
+							{Cidr: aws.String("10.20.0.0/16")},
+						},
+						RemoveEntries: []types.RemovePrefixListEntry{{Cidr: aws.String("10.30.0.0/16")}},
+					})).Return(&ec2.ModifyManagedPrefixListOutput{}, nil),
+				)
+			},
+			expectStatus: map[string]string{"vpn": "pl-vpn"},
+		},
+		{
+			name:   "returns the prefix lists removed from the spec",
+			status: map[string]string{"vpn": "pl-vpn"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.Eq(&ec2.DescribeManagedPrefixListsInput{}), gomock.Any()).
+					Return(&ec2.DescribeManagedPrefixListsOutput{
+						PrefixLists: []types.ManagedPrefixList{
+							{
+								PrefixListId:   aws.String("pl-vpn"),
+								PrefixListName: aws.String("test-cluster-vpn"),
+								Tags:           ownedTags,
+							},
+						},
+					}, nil)
+			},
+			expectStatus: map[string]string{},
+			expectStale:  []string{"pl-vpn"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			cs := newPrefixListsTestScope(g, tc.specs, tc.status)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(cs, testSecurityGroupRoles)
+			s.EC2Client = ec2Mock
+
+			stale, err := s.reconcileManagedPrefixLists()
+			g.Expect(err).NotTo(HaveOccurred())
+
+			staleIDs := []string{}
+			for _, pl := range stale {
+				staleIDs = append(staleIDs, aws.ToString(pl.PrefixListId))
+			}
+			g.Expect(staleIDs).To(ConsistOf(tc.expectStale))
+			if tc.expectStatus != nil {
+				g.Expect(cs.Network().ManagedPrefixLists).To(Equal(tc.expectStatus))
+			}
+		})
+	}
+}
+
+func TestResolvePrefixLists(t *testing.T) {
+	testCases := []struct {
+		name        string
+		refs        []infrav1.PrefixListReference
+		expect      func(m *mocks.MockEC2APIMockRecorder)
+		expectIDs   []string
+		expectError bool
+	}{
+		{
+			name:      "keeps references by id",
+			refs:      []infrav1.PrefixListReference{{ID: aws.String("pl-123")}},
+			expectIDs: []string{"pl-123"},
+		},
+		{
+			name:      "resolves the managed prefix lists of the cluster by name",
+			refs:      []infrav1.PrefixListReference{{Name: aws.String("vpn")}},
+			expectIDs: []string{"pl-vpn"},
+		},
+		{
+			name: "looks up the other names in the account",
+			refs: []infrav1.PrefixListReference{{Name: aws.String("corporate")}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.Eq(&ec2.DescribeManagedPrefixListsInput{
+					Filters: []types.Filter{{Name: aws.String("prefix-list-name"), Values: []string{"corporate"}}},
+				})).Return(&ec2.DescribeManagedPrefixListsOutput{
+					PrefixLists: []types.ManagedPrefixList{{PrefixListId: aws.String("pl-corporate")}},
+				}, nil)
+			},
+			expectIDs: []string{"pl-corporate"},
+		},
+		{
+			name: "fails when the name is ambiguous",
+			refs: []infrav1.PrefixListReference{{Name: aws.String("corporate")}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.Any()).Return(&ec2.DescribeManagedPrefixListsOutput{
+					PrefixLists: []types.ManagedPrefixList{{PrefixListId: aws.String("pl-1")}, {PrefixListId: aws.String("pl-2")}},
+				}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "fails when the name is not found",
+			refs: []infrav1.PrefixListReference{{Name: aws.String("corporate")}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.Any()).Return(&ec2.DescribeManagedPrefixListsOutput{}, nil)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			cs := newPrefixListsTestScope(g, nil, map[string]string{"vpn": "pl-vpn"})
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(cs, testSecurityGroupRoles)
+			s.EC2Client = ec2Mock
+
+			refs, err := s.resolvePrefixLists(tc.refs)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+
+			ids := []string{}
+			for _, ref := range refs {
+				g.Expect(ref.Name).To(BeNil())
+				ids = append(ids, aws.ToString(ref.ID))
+			}
+			g.Expect(ids).To(Equal(tc.expectIDs))
+		})
+	}
+}
+
+func TestIngressRulesFromSDKTypeWithPrefixLists(t *testing.T) {
+	g := NewWithT(t)
+
+	rule := infrav1.IngressRule{
+		Description:       "VPN",
+		Protocol:          infrav1.SecurityGroupProtocolTCP,
+		FromPort:          22,
+		ToPort:            22,
+		SourcePrefixLists: []infrav1.PrefixListReference{{ID: aws.String("pl-vpn")}},
+	}
+	permission := ingressRuleToSDKType(nil, &rule)
+	g.Expect(permission.PrefixListIds).To(Equal([]types.PrefixListId{
+		{PrefixListId: aws.String("pl-vpn"), Description: aws.String("VPN")},
+	}))
+
+	rules := ingressRulesFromSDKType(*permission)
+	g.Expect(rules).To(HaveLen(1))
+	g.Expect(rules[0].Equals(&rule)).To(BeTrue())
+}
+
+func newPrefixListsTestScope(g *WithT, specs []infrav1.ManagedPrefixListSpec, status map[string]string) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: infrav1.AWSClusterSpec{
+				NetworkSpec: infrav1.NetworkSpec{
+					VPC:                infrav1.VPCSpec{ID: "vpc-securitygroups"},
+					ManagedPrefixLists: specs,
+				},
+			},
+			Status: infrav1.AWSClusterStatus{
+				Network: infrav1.NetworkStatus{
+					ManagedPrefixLists: status,
+				},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	return cs
+}
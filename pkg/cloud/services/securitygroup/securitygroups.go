@@ -19,6 +19,7 @@ package securitygroup
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -71,6 +72,12 @@ func (s *Service) ReconcileSecurityGroups() error {
 		return err
 	}
 
+	// Managed prefix lists are reconciled first, as the security group rules can reference them.
+	stalePrefixLists, err := s.reconcileManagedPrefixLists()
+	if err != nil {
+		return err
+	}
+
 	// Security group overrides are mapped by Role rather than their security group name
 	// They are copied into the main 'sgs' list by their group name later
 	var securityGroupOverrides map[infrav1.SecurityGroupRole]types.SecurityGroup
@@ -118,6 +125,8 @@ func (s *Service) ReconcileSecurityGroups() error {
 			s.scope.SecurityGroups()[role] = infrav1.SecurityGroup{
 				ID:   *sg.GroupId,
 				Name: *sg.GroupName,
+				// AWS adds a rule allowing all outbound IPv4 traffic to new security groups.
+				EgressRules: infrav1.EgressRules{allowAllIPv4EgressRule()},
 			}
 			continue
 		}
@@ -146,7 +155,7 @@ func (s *Service) ReconcileSecurityGroups() error {
 	}
 
 	// Second iteration creates or updates all permissions on the security group to match
-	// the specified ingress rules.
+	// the specified ingress and egress rules.
 	for role := range s.scope.SecurityGroups() {
 		sg := s.scope.SecurityGroups()[role]
 		s.scope.Debug("second pass security group reconciliation", "group-id", sg.ID, "name", sg.Name, "role", role)
@@ -196,6 +205,15 @@ func (s *Service) ReconcileSecurityGroups() error {
 
 			s.scope.Debug("Authorized ingress rules in security group", "authorized-ingress-rules", toAuthorize, "security-group-id", sg.ID)
 		}
+
+		if err := s.reconcileSecurityGroupEgressRules(role, sg); err != nil {
+			return err
+		}
+	}
+
+	// The managed prefix lists removed from the spec are no longer referenced by the security group rules.
+	if err := s.deleteManagedPrefixLists(stalePrefixLists); err != nil {
+		return err
 	}
 	v1beta1conditions.MarkTrue(s.scope.InfraCluster(), infrav1.ClusterSecurityGroupsReadyCondition)
 	return nil
@@ -216,7 +234,7 @@ func expandIngressRules(rules infrav1.IngressRules) infrav1.IngressRules {
 		}
 
 		// Nothing to expand
-		if len(rule.CidrBlocks) == 0 && len(rule.IPv6CidrBlocks) == 0 && len(rule.SourceSecurityGroupIDs) == 0 {
+		if len(rule.CidrBlocks) == 0 && len(rule.IPv6CidrBlocks) == 0 && len(rule.SourceSecurityGroupIDs) == 0 && len(rule.SourcePrefixLists) == 0 {
 			res = append(res, base)
 			continue
 		}
@@ -238,6 +256,12 @@ func expandIngressRules(rules infrav1.IngressRules) infrav1.IngressRules {
 			rcopy.SourceSecurityGroupIDs = []string{src}
 			res = append(res, rcopy)
 		}
+
+		for _, src := range rule.SourcePrefixLists {
+			rcopy := base
+			rcopy.SourcePrefixLists = []infrav1.PrefixListReference{src}
+			res = append(res, rcopy)
+		}
 	}
 	return res
 }
@@ -301,6 +325,9 @@ func (s *Service) ec2SecurityGroupToSecurityGroup(ec2SecurityGroup types.Securit
 	for _, ec2rule := range ec2SecurityGroup.IpPermissions {
 		sg.IngressRules = append(sg.IngressRules, ingressRulesFromSDKType(ec2rule)...)
 	}
+	for _, ec2rule := range ec2SecurityGroup.IpPermissionsEgress {
+		sg.EgressRules = append(sg.EgressRules, egressRulesFromSDKType(ec2rule)...)
+	}
 	return sg
 }
 
@@ -319,7 +346,7 @@ func (s *Service) DeleteSecurityGroups() error {
 
 	// Security groups already deleted, exit early
 	if len(clusterGroups) == 0 {
-		return nil
+		return s.deleteClusterOwnedPrefixLists()
 	}
 
 	v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.ClusterSecurityGroupsReadyCondition, clusterv1beta1.DeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
@@ -329,8 +356,12 @@ func (s *Service) DeleteSecurityGroups() error {
 
 	for i := range clusterGroups {
 		sg := clusterGroups[i]
+		// The security groups of the VPC endpoints are deleted by the network service, along with the endpoints using them.
+		if sg.Tags[infrav1.NameAWSClusterAPIRole] == infrav1.VPCEndpointRoleTagValue {
+			continue
+		}
 		current := sg.IngressRules
-		if err := s.revokeAllSecurityGroupIngressRules(sg.ID); awserrors.IsIgnorableSecurityGroupError(err) != nil { //nolint:gocritic
+		if err := s.revokeAllSecurityGroupRules(sg.ID); awserrors.IsIgnorableSecurityGroupError(err) != nil { //nolint:gocritic
 			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.ClusterSecurityGroupsReadyCondition, "DeletingFailed", clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
 			return err
 		}
@@ -342,6 +373,11 @@ func (s *Service) DeleteSecurityGroups() error {
 		}
 	}
 
+	if err == nil {
+		// The managed prefix lists can only be deleted once the security group rules referencing them are gone.
+		err = s.deleteClusterOwnedPrefixLists()
+	}
+
 	if err != nil {
 		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.ClusterSecurityGroupsReadyCondition, "DeletingFailed", clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
 		return err
@@ -447,7 +483,7 @@ func (s *Service) revokeIngressAndEgressRulesFromVPCDefaultSecurityGroup() error
 		return errors.Wrapf(err, "failed to revoke ingress rules from vpc default security group %q in VPC %q", defaultSecurityGroupID, s.scope.VPC().ID)
 	}
 
-	egressRules := infrav1.IngressRules{
+	egressRules := infrav1.EgressRules{
 		{
 			Protocol:   infrav1.SecurityGroupProtocolAll,
 			FromPort:   -1,
@@ -526,23 +562,9 @@ func (s *Service) revokeSecurityGroupIngressRules(id string, rules infrav1.Ingre
 	return nil
 }
 
-func (s *Service) revokeSecurityGroupEgressRules(id string, rules infrav1.IngressRules) error {
-	input := &ec2.RevokeSecurityGroupEgressInput{GroupId: aws.String(id)}
-	for i := range rules {
-		rule := rules[i]
-		input.IpPermissions = append(input.IpPermissions, *ingressRuleToSDKType(s.scope, &rule))
-	}
-
-	if _, err := s.EC2Client.RevokeSecurityGroupEgress(context.TODO(), input); err != nil && !awserrors.IsPermissionNotFoundError(errors.Cause(err)) {
-		record.Warnf(s.scope.InfraCluster(), "FailedRevokeSecurityGroupEgressRules", "Failed to revoke security group egress rules %v for SecurityGroup %q: %v", rules, id, err)
-		return errors.Wrapf(err, "failed to revoke security group %q egress rules: %v", id, rules)
-	}
-
-	record.Eventf(s.scope.InfraCluster(), "SuccessfulRevokeSecurityGroupEgressRules", "Revoked security group egress rules %v for SecurityGroup %q", rules, id)
-	return nil
-}
-
-func (s *Service) revokeAllSecurityGroupIngressRules(id string) error {
+// revokeAllSecurityGroupRules revokes all the ingress rules of the security group, along with the egress
+// rules referencing other security groups, as those would prevent deleting the referenced groups.
+func (s *Service) revokeAllSecurityGroupRules(id string) error {
 	describeInput := &ec2.DescribeSecurityGroupsInput{GroupIds: []string{id}}
 
 	securityGroups, err := s.EC2Client.DescribeSecurityGroups(context.TODO(), describeInput)
@@ -562,6 +584,24 @@ func (s *Service) revokeAllSecurityGroupIngressRules(id string) error {
 			}
 			record.Eventf(s.scope.InfraCluster(), "SuccessfulRevokeSecurityGroupIngressRules", "Revoked all security group ingress rules for SecurityGroup %q", *sg.GroupId)
 		}
+
+		var egressPermissions []types.IpPermission
+		for _, permission := range sg.IpPermissionsEgress {
+			if len(permission.UserIdGroupPairs) > 0 {
+				egressPermissions = append(egressPermissions, permission)
+			}
+		}
+		if len(egressPermissions) > 0 {
+			revokeInput := &ec2.RevokeSecurityGroupEgressInput{
+				GroupId:       aws.String(id),
+				IpPermissions: egressPermissions,
+			}
+			if _, err := s.EC2Client.RevokeSecurityGroupEgress(context.TODO(), revokeInput); err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedRevokeSecurityGroupEgressRules", "Failed to revoke security group egress rules for SecurityGroup %q: %v", *sg.GroupId, err)
+				return err
+			}
+			record.Eventf(s.scope.InfraCluster(), "SuccessfulRevokeSecurityGroupEgressRules", "Revoked security group egress rules referencing security groups for SecurityGroup %q", *sg.GroupId)
+		}
 	}
 
 	return nil
@@ -596,19 +636,28 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 	}
 	switch role {
 	case infrav1.SecurityGroupBastion:
+		// Bastion hosts accessed through Session Manager only need outbound connectivity.
+		if s.scope.Bastion().IsSSM() {
+			return infrav1.IngressRules{}, nil
+		}
 		ipv4CidrBlocks := s.scope.Bastion().AllowedCIDRBlocks.IPv4CidrBlocks()
 		var ipv6CidrBlocks []string
 		if s.scope.VPC().IsIPv6Enabled() {
 			ipv6CidrBlocks = s.scope.Bastion().AllowedCIDRBlocks.IPv6CidrBlocks()
 		}
+		prefixLists, err := s.resolvePrefixLists(s.scope.Bastion().AllowedPrefixLists)
+		if err != nil {
+			return nil, err
+		}
 		return infrav1.IngressRules{
 			{
-				Description:    "SSH",
-				Protocol:       infrav1.SecurityGroupProtocolTCP,
-				FromPort:       22,
-				ToPort:         22,
-				CidrBlocks:     ipv4CidrBlocks,
-				IPv6CidrBlocks: ipv6CidrBlocks,
+				Description:       "SSH",
+				Protocol:          infrav1.SecurityGroupProtocolTCP,
+				FromPort:          22,
+				ToPort:            22,
+				CidrBlocks:        ipv4CidrBlocks,
+				IPv6CidrBlocks:    ipv6CidrBlocks,
+				SourcePrefixLists: prefixLists,
 			},
 		}, nil
 	case infrav1.SecurityGroupControlPlane:
@@ -643,6 +692,16 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 			rules = append(rules, s.defaultSSHIngressRule(s.scope.SecurityGroups()[infrav1.SecurityGroupBastion].ID))
 		}
 
+		for _, port := range ingressLBTargetPorts(s.scope.IngressLoadBalancer()) {
+			rules = append(rules, infrav1.IngressRule{
+				Description:            fmt.Sprintf("Ingress load balancer traffic on port %d", port),
+				Protocol:               infrav1.SecurityGroupProtocolTCP,
+				FromPort:               port,
+				ToPort:                 port,
+				SourceSecurityGroupIDs: []string{s.scope.SecurityGroups()[infrav1.SecurityGroupIngressLB].ID},
+			})
+		}
+
 		additionalIngressRules, err := s.processIngressRulesSGs(s.scope.AdditionalControlPlaneIngressRules())
 		if err != nil {
 			return nil, err
@@ -701,6 +760,13 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 		return append(cniRules, rules...), nil
 	case infrav1.SecurityGroupEKSNodeAdditional:
 		ingressRules := s.scope.AdditionalControlPlaneIngressRules()
+		for i := range ingressRules {
+			prefixLists, err := s.resolvePrefixLists(ingressRules[i].SourcePrefixLists)
+			if err != nil {
+				return nil, err
+			}
+			ingressRules[i].SourcePrefixLists = prefixLists
+		}
 		if s.scope.Bastion().Enabled {
 			ingressRules = append(ingressRules, s.defaultSSHIngressRule(s.scope.SecurityGroups()[infrav1.SecurityGroupBastion].ID))
 		}
@@ -713,6 +779,8 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 		}
 		rulesToApply := customIngressRules.Difference(kubeletRules)
 		return append(kubeletRules, rulesToApply...), nil
+	case infrav1.SecurityGroupIngressLB:
+		return s.getIngressLBIngressRules()
 	case infrav1.SecurityGroupLB:
 		rules := infrav1.IngressRules{}
 		allowedNLBTraffic := false
@@ -878,6 +946,23 @@ func ingressRuleToSDKType(scope scope.SGScope, i *infrav1.IngressRule) (res *typ
 		res.UserIdGroupPairs = append(res.UserIdGroupPairs, userIDGroupPair)
 	}
 
+	for _, prefixList := range i.SourcePrefixLists {
+		// Prefix lists are resolved into ids before the rules are authorized.
+		if prefixList.ID == nil {
+			continue
+		}
+
+		prefixListID := types.PrefixListId{
+			PrefixListId: prefixList.ID,
+		}
+
+		if i.Description != "" {
+			prefixListID.Description = aws.String(i.Description)
+		}
+
+		res.PrefixListIds = append(res.PrefixListIds, prefixListID)
+	}
+
 	return res
 }
 
@@ -916,6 +1001,20 @@ func ingressRulesFromSDKType(v types.IpPermission) (res infrav1.IngressRules) {
 		res = append(res, rule)
 	}
 
+	for _, prefixList := range v.PrefixListIds {
+		rule := ingressRuleFromSDKProtocol(v)
+		if prefixList.PrefixListId == nil {
+			continue
+		}
+
+		if prefixList.Description != nil && *prefixList.Description != "" {
+			rule.Description = *prefixList.Description
+		}
+
+		rule.SourcePrefixLists = []infrav1.PrefixListReference{{ID: prefixList.PrefixListId}}
+		res = append(res, rule)
+	}
+
 	return res
 }
 
@@ -990,7 +1089,82 @@ func (s *Service) getControlPlaneLBIngressRules() infrav1.IngressRules {
 	}
 
 	// If no custom ingress rules have been defined we allow all traffic so that the MC can access the WC API
-	return s.getIngressRuleToAllowAnyIPInTheAPIServer()
+	ingressRules = s.getIngressRuleToAllowAnyIPInTheAPIServer()
+	for _, lb := range s.scope.ControlPlaneLoadBalancers() {
+		if lb == nil || lb.TLSListener == nil {
+			continue
+		}
+		ingressRules = append(ingressRules, infrav1.IngressRule{
+			Description: "Kubernetes API TLS listener",
+			Protocol:    infrav1.SecurityGroupProtocolTCP,
+			FromPort:    lb.TLSListener.Port,
+			ToPort:      lb.TLSListener.Port,
+			CidrBlocks:  []string{services.AnyIPv4CidrBlock},
+		})
+		if s.scope.VPC().IsIPv6Enabled() {
+			ingressRules = append(ingressRules, infrav1.IngressRule{
+				Description:    "Kubernetes API TLS listener IPv6",
+				Protocol:       infrav1.SecurityGroupProtocolTCP,
+				FromPort:       lb.TLSListener.Port,
+				ToPort:         lb.TLSListener.Port,
+				IPv6CidrBlocks: []string{services.AnyIPv6CidrBlock},
+			})
+		}
+	}
+	return ingressRules
+}
+
+// getIngressLBIngressRules returns the ingress rules of the ingress load balancer.
+// The ports of the listeners are allowed from anywhere when no rules are defined.
+func (s *Service) getIngressLBIngressRules() (infrav1.IngressRules, error) {
+	lbSpec := s.scope.IngressLoadBalancer()
+	if lbSpec == nil {
+		return infrav1.IngressRules{}, nil
+	}
+	if len(lbSpec.IngressRules) > 0 {
+		return s.processIngressRulesSGs(lbSpec.IngressRules)
+	}
+
+	rules := infrav1.IngressRules{}
+	for _, ln := range lbSpec.Listeners {
+		rules = append(rules, infrav1.IngressRule{
+			Description: fmt.Sprintf("Ingress load balancer listener on port %d", ln.Port),
+			Protocol:    infrav1.SecurityGroupProtocolTCP,
+			FromPort:    ln.Port,
+			ToPort:      ln.Port,
+			CidrBlocks:  []string{services.AnyIPv4CidrBlock},
+		})
+		if s.scope.VPC().IsIPv6Enabled() {
+			rules = append(rules, infrav1.IngressRule{
+				Description:    fmt.Sprintf("Ingress load balancer listener on port %d IPv6", ln.Port),
+				Protocol:       infrav1.SecurityGroupProtocolTCP,
+				FromPort:       ln.Port,
+				ToPort:         ln.Port,
+				IPv6CidrBlocks: []string{services.AnyIPv6CidrBlock},
+			})
+		}
+	}
+	return rules, nil
+}
+
+// ingressLBTargetPorts returns the ports of the control plane instances the ingress load balancer
+// forwards requests to and health checks.
+func ingressLBTargetPorts(lbSpec *infrav1.IngressLoadBalancerSpec) []int64 {
+	if lbSpec == nil {
+		return nil
+	}
+	ports := sets.New[int64]()
+	for _, ln := range lbSpec.Listeners {
+		for _, rule := range ln.Rules {
+			ports.Insert(rule.TargetGroup.Port)
+			if hc := rule.TargetGroup.HealthCheck; hc != nil && hc.Port != nil {
+				if port, err := strconv.ParseInt(*hc.Port, 10, 64); err == nil {
+					ports.Insert(port)
+				}
+			}
+		}
+	}
+	return sets.List(ports)
 }
 
 func (s *Service) getIngressRuleToAllowAnyIPInTheAPIServer() infrav1.IngressRules {
@@ -1056,7 +1230,15 @@ func (s *Service) processIngressRulesSGs(ingressRules []infrav1.IngressRule) (in
 			return nil, errors.New("NAT Gateway IPs are not available yet")
 		}
 
-		if len(rule.CidrBlocks) != 0 || len(rule.IPv6CidrBlocks) != 0 { // don't set source security group if cidr blocks are set
+		if len(rule.SourcePrefixLists) != 0 {
+			prefixLists, err := s.resolvePrefixLists(rule.SourcePrefixLists)
+			if err != nil {
+				return nil, err
+			}
+			rule.SourcePrefixLists = prefixLists
+		}
+
+		if len(rule.CidrBlocks) != 0 || len(rule.IPv6CidrBlocks) != 0 || len(rule.SourcePrefixLists) != 0 { // don't set source security group if cidr blocks or prefix lists are set
 			output = append(output, rule)
 			continue
 		}
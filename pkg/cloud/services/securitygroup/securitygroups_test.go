@@ -1919,6 +1919,113 @@ func TestControlPlaneLoadBalancerIngressRules(t *testing.T) {
 	}
 }
 
+func TestIngressLoadBalancerIngressRules(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	listeners := []infrav1.IngressListenerSpec{
+		{
+			Port:           443,
+			CertificateARN: "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+			Rules: []infrav1.IngressListenerRule{
+				{
+					Priority:    1,
+					HostHeaders: []string{"webhooks.example.com"},
+					TargetGroup: infrav1.IngressTargetGroupSpec{Port: 9443, Protocol: infrav1.ELBProtocolHTTPS},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		ingressLoadBalancer  *infrav1.IngressLoadBalancerSpec
+		expectedIngressRules infrav1.IngressRules
+	}{
+		{
+			name:                "when no ingress rules are passed, the listener ports are allowed from anywhere",
+			ingressLoadBalancer: &infrav1.IngressLoadBalancerSpec{Listeners: listeners},
+			expectedIngressRules: infrav1.IngressRules{
+				{
+					Description: "Ingress load balancer listener on port 443",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    443,
+					ToPort:      443,
+					CidrBlocks:  []string{services.AnyIPv4CidrBlock},
+				},
+			},
+		},
+		{
+			name: "defined ingress rules replace the default",
+			ingressLoadBalancer: &infrav1.IngressLoadBalancerSpec{
+				Listeners: listeners,
+				IngressRules: []infrav1.IngressRule{
+					{
+						Description: "Corporate network",
+						Protocol:    infrav1.SecurityGroupProtocolTCP,
+						FromPort:    443,
+						ToPort:      443,
+						CidrBlocks:  []string{"192.168.0.0/16"},
+					},
+				},
+			},
+			expectedIngressRules: infrav1.IngressRules{
+				{
+					Description: "Corporate network",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    443,
+					ToPort:      443,
+					CidrBlocks:  []string{"192.168.0.0/16"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						IngressLoadBalancer: tc.ingressLoadBalancer,
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{CidrBlock: "10.0.0.0/16"},
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+								infrav1.SecurityGroupControlPlane: {ID: "sg-cp"},
+								infrav1.SecurityGroupIngressLB:    {ID: "sg-ingress"},
+							},
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(cs, testSecurityGroupRoles)
+			rules, err := s.getSecurityGroupIngressRules(infrav1.SecurityGroupIngressLB)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(rules).To(Equal(tc.expectedIngressRules))
+
+			rules, err = s.getSecurityGroupIngressRules(infrav1.SecurityGroupControlPlane)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(rules).To(ContainElement(infrav1.IngressRule{
+				Description:            "Ingress load balancer traffic on port 9443",
+				Protocol:               infrav1.SecurityGroupProtocolTCP,
+				FromPort:               9443,
+				ToPort:                 9443,
+				SourceSecurityGroupIDs: []string{"sg-ingress"},
+			}))
+		})
+	}
+}
+
 func TestDeleteSecurityGroups(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -0,0 +1,213 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+	"sigs.k8s.io/yaml"
+)
+
+// maxSchemaRefDepth limits how deeply references are inlined so that recursive
+// schemas can be validated.
+const maxSchemaRefDepth = 16
+
+// exclusiveBounds maps the exclusive bound keywords to the bound they modify in draft 4.
+var exclusiveBounds = map[string]string{
+	"exclusiveMinimum": "minimum",
+	"exclusiveMaximum": "maximum",
+}
+
+// configurationSchemas caches the configuration schema of addon versions by addon name
+// and version. The schema of an addon version never changes so entries are not evicted.
+var configurationSchemas sync.Map
+
+// ConfigurationError is returned when the configuration of an addon doesn't match the
+// configuration schema of the addon version.
+type ConfigurationError struct {
+	Name    string
+	Version string
+	Errors  []string
+}
+
+func (e *ConfigurationError) Error() string {
+	return fmt.Sprintf("configuration of eks addon %s is not valid for version %s: %s", e.Name, e.Version, strings.Join(e.Errors, "; "))
+}
+
+// validateConfiguration validates the configuration of the desired addon against the
+// configuration schema of the addon version, returning a ConfigurationError if it is
+// not valid.
+func (a *plan) validateConfiguration(ctx context.Context, desired *EKSAddon) error {
+	if desired.Configuration == nil {
+		return nil
+	}
+
+	schema, err := a.configurationSchema(ctx, aws.ToString(desired.Name), aws.ToString(desired.Version))
+	if err != nil {
+		return err
+	}
+	if schema == nil {
+		return nil
+	}
+
+	errs, err := validateAgainstSchema(schema, *desired.Configuration)
+	if err != nil {
+		return &ConfigurationError{Name: aws.ToString(desired.Name), Version: aws.ToString(desired.Version), Errors: []string{err.Error()}}
+	}
+	if len(errs) > 0 {
+		return &ConfigurationError{Name: aws.ToString(desired.Name), Version: aws.ToString(desired.Version), Errors: errs}
+	}
+
+	return nil
+}
+
+// configurationSchema returns the configuration schema of an addon version, or nil if the
+// addon version has no schema or its schema can't be used for validation.
+func (a *plan) configurationSchema(ctx context.Context, name, version string) (*spec.Schema, error) {
+	key := name + "/" + version
+	if cached, ok := configurationSchemas.Load(key); ok {
+		return cached.(*spec.Schema), nil
+	}
+
+	output, err := a.eksClient.DescribeAddonConfiguration(ctx, &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(name),
+		AddonVersion: aws.String(version),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describing configuration schema of eks addon %s version %s: %w", name, version, err)
+	}
+
+	// The configuration is left to EKS to validate if the schema can't be parsed
+	schema, _ := parseConfigurationSchema(aws.ToString(output.ConfigurationSchema))
+	configurationSchemas.Store(key, schema)
+
+	return schema, nil
+}
+
+// parseConfigurationSchema parses the JSON schema of an addon configuration. The schema
+// validator doesn't resolve references and only supports draft 4 keywords, so references
+// to the definitions of the schema are inlined and newer keywords are converted.
+func parseConfigurationSchema(raw string) (*spec.Schema, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &document); err != nil {
+		return nil, fmt.Errorf("parsing configuration schema: %w", err)
+	}
+
+	definitions, _ := document["definitions"].(map[string]interface{})
+	if defs, ok := document["$defs"].(map[string]interface{}); ok {
+		definitions = defs
+	}
+	normalized, err := json.Marshal(normalizeSchema(document, definitions, 0))
+	if err != nil {
+		return nil, fmt.Errorf("normalizing configuration schema: %w", err)
+	}
+
+	schema := &spec.Schema{}
+	if err := json.Unmarshal(normalized, schema); err != nil {
+		return nil, fmt.Errorf("parsing configuration schema: %w", err)
+	}
+
+	return schema, nil
+}
+
+// normalizeSchema inlines the references to the definitions of a schema and converts the
+// keywords of newer drafts to their draft 4 equivalent.
+func normalizeSchema(node interface{}, definitions map[string]interface{}, depth int) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if ref, ok := n["$ref"].(string); ok {
+			name := ref[strings.LastIndex(ref, "/")+1:]
+			definition, found := definitions[name]
+			if !found || depth >= maxSchemaRefDepth || !strings.HasPrefix(ref, "#/") {
+				// Accept any value where a reference can't be resolved
+				return map[string]interface{}{}
+			}
+			return normalizeSchema(definition, definitions, depth+1)
+		}
+
+		normalized := map[string]interface{}{}
+		for key, value := range n {
+			switch key {
+			case "definitions", "$defs", "$schema", "$id":
+				continue
+			case "default", "enum", "examples":
+				normalized[key] = value
+			case "properties", "patternProperties":
+				properties := map[string]interface{}{}
+				if schemas, ok := value.(map[string]interface{}); ok {
+					for name, schema := range schemas {
+						properties[name] = normalizeSchema(schema, definitions, depth)
+					}
+				}
+				normalized[key] = properties
+			case "const":
+				normalized["enum"] = []interface{}{value}
+			case "exclusiveMinimum", "exclusiveMaximum":
+				// Draft 6 and later use the bound itself rather than a boolean modifier
+				if bound, ok := value.(float64); ok {
+					normalized[key] = true
+					normalized[exclusiveBounds[key]] = bound
+					continue
+				}
+				normalized[key] = value
+			default:
+				normalized[key] = normalizeSchema(value, definitions, depth)
+			}
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, 0, len(n))
+		for _, item := range n {
+			normalized = append(normalized, normalizeSchema(item, definitions, depth))
+		}
+		return normalized
+	default:
+		return node
+	}
+}
+
+// validateAgainstSchema validates a JSON or YAML addon configuration against a schema,
+// returning the validation errors sorted by path.
+func validateAgainstSchema(schema *spec.Schema, configuration string) ([]string, error) {
+	var data interface{}
+	if err := yaml.Unmarshal([]byte(configuration), &data); err != nil {
+		return nil, fmt.Errorf("parsing configuration: %w", err)
+	}
+
+	result := validate.NewSchemaValidator(schema, nil, "configuration", strfmt.Default).Validate(data)
+	errs := []string{}
+	for _, err := range result.Errors {
+		errs = append(errs, strings.Replace(err.Error(), " in body ", " ", 1))
+	}
+	sort.Strings(errs)
+
+	return errs, nil
+}
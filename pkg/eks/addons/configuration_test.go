@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+const testConfigurationSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "additionalProperties": false,
+  "properties": {
+    "env": {
+      "$ref": "#/definitions/Env"
+    },
+    "replicaCount": {
+      "type": "integer",
+      "exclusiveMinimum": 0
+    },
+    "mode": {
+      "const": "standard"
+    },
+    "resources": {
+      "$ref": "#/definitions/Resources"
+    }
+  },
+  "definitions": {
+    "Env": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "WARM_IP_TARGET": {
+          "type": "string"
+        },
+        "const": {
+          "type": "string"
+        }
+      }
+    },
+    "Resources": {
+      "type": "object",
+      "properties": {
+        "nested": {
+          "$ref": "#/definitions/Resources"
+        }
+      }
+    }
+  }
+}`
+
+func TestValidateAgainstSchema(t *testing.T) {
+	tests := []struct {
+		name           string
+		configuration  string
+		expectedErrors []string
+		expectError    bool
+	}{
+		{
+			name:           "valid json configuration",
+			configuration:  `{"env":{"WARM_IP_TARGET":"5","const":"x"},"replicaCount":2,"mode":"standard"}`,
+			expectedErrors: []string{},
+		},
+		{
+			name:           "valid yaml configuration",
+			configuration:  "env:\n  WARM_IP_TARGET: \"5\"\nresources:\n  nested:\n    nested: {}\n",
+			expectedErrors: []string{},
+		},
+		{
+			name:          "invalid configuration",
+			configuration: "env:\n  WARM_IP_TARGET: 5\n  UNKNOWN: x\nreplicaCount: 0\nmode: other\n",
+			expectedErrors: []string{
+				"configuration.env.UNKNOWN is a forbidden property",
+				`configuration.env.WARM_IP_TARGET must be of type string: "number"`,
+				"configuration.mode should be one of [standard]",
+				"configuration.replicaCount should be greater than 0",
+			},
+		},
+		{
+			name:          "configuration that can't be parsed",
+			configuration: "env: [",
+			expectError:   true,
+		},
+	}
+
+	schema, err := parseConfigurationSchema(testConfigurationSchema)
+	NewWithT(t).Expect(err).NotTo(HaveOccurred())
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			errs, err := validateAgainstSchema(schema, tc.configuration)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(errs).To(Equal(tc.expectedErrors))
+		})
+	}
+}
+
+func TestParseConfigurationSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	schema, err := parseConfigurationSchema("")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(schema).To(BeNil())
+
+	_, err = parseConfigurationSchema("{")
+	g.Expect(err).To(HaveOccurred())
+}
@@ -21,20 +21,28 @@ import (
 	"context"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/planner"
+	capicache "sigs.k8s.io/cluster-api/util/cache"
 )
 
-// NewPlan creates a new Plan to manage EKS addons.
-func NewPlan(clusterName string, desiredAddons, installedAddons []*EKSAddon, client eks.Client, maxWait time.Duration) planner.Plan {
+// NewPlan creates a new Plan to manage EKS addons. The version selectors of the desired
+// addons are resolved for the given Kubernetes version when the plan is created, and the
+// version of the desired addons is replaced with the resolved version. The configuration
+// of addons that are created or updated is validated against the configuration schema
+// of the addon version, and a ConfigurationError is returned if it is not valid.
+func NewPlan(clusterName, kubernetesVersion string, desiredAddons, installedAddons []*EKSAddon, client eks.Client, maxWait time.Duration) planner.Plan {
 	return &plan{
 		installedAddons:           installedAddons,
 		desiredAddons:             desiredAddons,
 		eksClient:                 client,
 		clusterName:               clusterName,
+		kubernetesVersion:         kubernetesVersion,
 		maxWaitActiveUpdateDelete: maxWait,
+		versionsCache:             compatibleVersionsCache,
 	}
 }
 
@@ -44,19 +52,32 @@ type plan struct {
 	desiredAddons             []*EKSAddon
 	eksClient                 eks.Client
 	clusterName               string
+	kubernetesVersion         string
 	maxWaitActiveUpdateDelete time.Duration
+	// versionsCache caches the compatible versions of addons (nil disables caching).
+	versionsCache capicache.Cache[compatibleVersionsEntry]
 }
 
 // Create will create the plan (i.e. list of procedures) for managing EKS addons.
-func (a *plan) Create(_ context.Context) ([]planner.Procedure, error) {
+func (a *plan) Create(ctx context.Context) ([]planner.Procedure, error) {
 	procedures := []planner.Procedure{}
 
 	// Handle create and update
 	for i := range a.desiredAddons {
 		desired := a.desiredAddons[i]
 		installed := a.getInstalled(*desired.Name)
+
+		resolvedVersion, err := a.resolveVersion(ctx, desired, installed)
+		if err != nil {
+			return nil, err
+		}
+		desired.Version = aws.String(resolvedVersion)
+
 		if installed == nil {
 			// Need to add the addon
+			if err := a.validateConfiguration(ctx, desired); err != nil {
+				return nil, err
+			}
 			procedures = append(procedures,
 				&CreateAddonProcedure{plan: a, name: *desired.Name},
 				&WaitAddonActiveProcedure{plan: a, name: *desired.Name, includeDegraded: true},
@@ -69,6 +90,9 @@ func (a *plan) Create(_ context.Context) ([]planner.Procedure, error) {
 			}
 			// Check if we also need to update the addon
 			if !desired.IsEqual(installed, false) {
+				if err := a.validateConfiguration(ctx, desired); err != nil {
+					return nil, err
+				}
 				procedures = append(procedures,
 					&UpdateAddonProcedure{plan: a, name: *installed.Name},
 					&WaitAddonActiveProcedure{plan: a, name: *desired.Name, includeDegraded: true},
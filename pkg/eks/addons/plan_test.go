@@ -33,6 +33,7 @@ import (
 
 func TestEKSAddonPlan(t *testing.T) {
 	clusterName := "default.cluster"
+	kubernetesVersion := "1.31"
 	addonARN := "aws://someaddonarn"
 	addon1Name := "addon1"
 	addon1version := "1.0.0"
@@ -44,6 +45,21 @@ func TestEKSAddonPlan(t *testing.T) {
 	addonPreserve := false
 	created := time.Now()
 	maxActiveUpdateDeleteWait := 30 * time.Minute
+	configurationSchema := `{"type":"object","properties":{"replicaCount":{"type":"integer"}}}`
+	addonVersions := &eks.DescribeAddonVersionsOutput{
+		Addons: []ekstypes.AddonInfo{
+			{
+				AddonName: aws.String(addon1Name),
+				AddonVersions: []ekstypes.AddonVersionInfo{
+					createAddonVersionInfo("v1.18.5-eksbuild.1", kubernetesVersion, true),
+					createAddonVersionInfo("v1.18.6-eksbuild.2", kubernetesVersion, false),
+					createAddonVersionInfo("v1.19.2-eksbuild.9", kubernetesVersion, false),
+					createAddonVersionInfo("v1.19.2-eksbuild.10", kubernetesVersion, false),
+					createAddonVersionInfo("v1.20.0-eksbuild.1", "1.32", false),
+				},
+			},
+		},
+	}
 
 	testCases := []struct {
 		name              string
@@ -242,6 +258,78 @@ func TestEKSAddonPlan(t *testing.T) {
 			expectCreateError: false,
 			expectDoError:     false,
 		},
+		{
+			name: "1 installed and 1 desired - pod identity associations update",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					UpdateAddon(gomock.Eq(context.TODO()), gomock.Eq(&eks.UpdateAddonInput{
+						AddonName:        aws.String(addon1Name),
+						AddonVersion:     aws.String(addon1version),
+						ClusterName:      aws.String(clusterName),
+						ResolveConflicts: ekstypes.ResolveConflictsOverwrite,
+						PodIdentityAssociations: []ekstypes.AddonPodIdentityAssociations{
+							{ServiceAccount: aws.String("addon-sa"), RoleArn: aws.String("arn:aws:iam::123456789012:role/addon")},
+						},
+					})).
+					Return(&eks.UpdateAddonOutput{}, nil)
+
+				out := &eks.DescribeAddonOutput{
+					Addon: &ekstypes.Addon{
+						Status: ekstypes.AddonStatusActive,
+					},
+				}
+				m.DescribeAddon(gomock.Eq(context.TODO()), gomock.Eq(&eks.DescribeAddonInput{
+					AddonName:   aws.String(addon1Name),
+					ClusterName: aws.String(clusterName),
+				})).Return(out, nil)
+			},
+			desiredAddons: []*EKSAddon{
+				createDesiredAddonWithPodIdentity(addon1Name, addon1version, "addon-sa", "arn:aws:iam::123456789012:role/addon"),
+			},
+			installedAddons: []*EKSAddon{
+				createInstalledAddon(addon1Name, addon1version, addonARN, addonStatusActive, addonPreserve),
+			},
+			expectCreateError: false,
+			expectDoError:     false,
+		},
+		{
+			name: "1 installed and 1 desired - pod identity associations removed",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					UpdateAddon(gomock.Eq(context.TODO()), gomock.Eq(&eks.UpdateAddonInput{
+						AddonName:               aws.String(addon1Name),
+						AddonVersion:            aws.String(addon1version),
+						ClusterName:             aws.String(clusterName),
+						ResolveConflicts:        ekstypes.ResolveConflictsOverwrite,
+						PodIdentityAssociations: []ekstypes.AddonPodIdentityAssociations{},
+					})).
+					Return(&eks.UpdateAddonOutput{}, nil)
+
+				out := &eks.DescribeAddonOutput{
+					Addon: &ekstypes.Addon{
+						Status: ekstypes.AddonStatusActive,
+					},
+				}
+				m.DescribeAddon(gomock.Eq(context.TODO()), gomock.Eq(&eks.DescribeAddonInput{
+					AddonName:   aws.String(addon1Name),
+					ClusterName: aws.String(clusterName),
+				})).Return(out, nil)
+			},
+			desiredAddons: []*EKSAddon{
+				createDesiredAddon(addon1Name, addon1version),
+			},
+			installedAddons: []*EKSAddon{
+				func() *EKSAddon {
+					installed := createInstalledAddon(addon1Name, addon1version, addonARN, addonStatusActive, addonPreserve)
+					installed.PodIdentityAssociations = []PodIdentityAssociation{
+						{ServiceAccount: "addon-sa", RoleARN: "arn:aws:iam::123456789012:role/addon"},
+					}
+					return installed
+				}(),
+			},
+			expectCreateError: false,
+			expectDoError:     false,
+		},
 		{
 			name: "1 installed and 1 desired - version upgrade in progress",
 			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
@@ -327,6 +415,188 @@ func TestEKSAddonPlan(t *testing.T) {
 			expectCreateError: false,
 			expectDoError:     false,
 		},
+		{
+			name: "no installed and 1 desired - latest version",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonVersions(gomock.Eq(context.TODO()), gomock.Eq(&eks.DescribeAddonVersionsInput{
+					AddonName:         aws.String(addon1Name),
+					KubernetesVersion: aws.String(kubernetesVersion),
+				})).Return(addonVersions, nil)
+				m.
+					CreateAddon(gomock.Eq(context.TODO()), gomock.Eq(&eks.CreateAddonInput{
+						AddonName:        aws.String(addon1Name),
+						AddonVersion:     aws.String("v1.19.2-eksbuild.10"),
+						ClusterName:      aws.String(clusterName),
+						ResolveConflicts: ekstypes.ResolveConflictsOverwrite,
+						Tags:             createTags(),
+					})).
+					Return(&eks.CreateAddonOutput{
+						Addon: &ekstypes.Addon{
+							AddonArn:     aws.String(addonARN),
+							AddonName:    aws.String(addon1Name),
+							AddonVersion: aws.String("v1.19.2-eksbuild.10"),
+							ClusterName:  aws.String(clusterName),
+							Status:       ekstypes.AddonStatusCreating,
+						},
+					}, nil)
+
+				out := &eks.DescribeAddonOutput{
+					Addon: &ekstypes.Addon{
+						Status: ekstypes.AddonStatusActive,
+					},
+				}
+				m.DescribeAddon(gomock.Eq(context.TODO()), gomock.Any()).Return(out, nil)
+			},
+			desiredAddons: []*EKSAddon{
+				createDesiredAddon(addon1Name, "latest"),
+			},
+			expectCreateError: false,
+			expectDoError:     false,
+		},
+		{
+			name: "no installed and 1 desired - no compatible version",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonVersions(gomock.Eq(context.TODO()), gomock.Any()).Return(&eks.DescribeAddonVersionsOutput{}, nil)
+			},
+			desiredAddons: []*EKSAddon{
+				createDesiredAddon(addon1Name, "default"),
+			},
+			expectCreateError: true,
+		},
+		{
+			name: "1 installed and 1 desired - installed version matches selector",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonVersions(gomock.Eq(context.TODO()), gomock.Any()).Return(addonVersions, nil)
+			},
+			desiredAddons: []*EKSAddon{
+				createDesiredAddon(addon1Name, "default"),
+			},
+			installedAddons: []*EKSAddon{
+				createInstalledAddon(addon1Name, "v1.18.5-eksbuild.1", addonARN, addonStatusActive, false),
+			},
+			expectCreateError: false,
+			expectDoError:     false,
+		},
+		{
+			name: "1 installed and 1 desired - auto update to selected version",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonVersions(gomock.Eq(context.TODO()), gomock.Any()).Return(addonVersions, nil)
+				m.
+					UpdateAddon(gomock.Eq(context.TODO()), gomock.Eq(&eks.UpdateAddonInput{
+						AddonName:        aws.String(addon1Name),
+						AddonVersion:     aws.String("v1.18.6-eksbuild.2"),
+						ClusterName:      aws.String(clusterName),
+						ResolveConflicts: ekstypes.ResolveConflictsOverwrite,
+					})).
+					Return(&eks.UpdateAddonOutput{}, nil)
+
+				out := &eks.DescribeAddonOutput{
+					Addon: &ekstypes.Addon{
+						Status: ekstypes.AddonStatusActive,
+					},
+				}
+				m.DescribeAddon(gomock.Eq(context.TODO()), gomock.Any()).Return(out, nil)
+			},
+			desiredAddons: []*EKSAddon{
+				func() *EKSAddon {
+					desired := createDesiredAddon(addon1Name, "1.18.x")
+					desired.AutoUpdate = true
+					return desired
+				}(),
+			},
+			installedAddons: []*EKSAddon{
+				createInstalledAddon(addon1Name, "v1.18.5-eksbuild.1", addonARN, addonStatusActive, false),
+			},
+			expectCreateError: false,
+			expectDoError:     false,
+		},
+		{
+			name: "1 installed and 1 desired - installed version no longer matches selector",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonVersions(gomock.Eq(context.TODO()), gomock.Any()).Return(addonVersions, nil)
+				m.
+					UpdateAddon(gomock.Eq(context.TODO()), gomock.Eq(&eks.UpdateAddonInput{
+						AddonName:        aws.String(addon1Name),
+						AddonVersion:     aws.String("v1.19.2-eksbuild.10"),
+						ClusterName:      aws.String(clusterName),
+						ResolveConflicts: ekstypes.ResolveConflictsOverwrite,
+					})).
+					Return(&eks.UpdateAddonOutput{}, nil)
+
+				out := &eks.DescribeAddonOutput{
+					Addon: &ekstypes.Addon{
+						Status: ekstypes.AddonStatusActive,
+					},
+				}
+				m.DescribeAddon(gomock.Eq(context.TODO()), gomock.Any()).Return(out, nil)
+			},
+			desiredAddons: []*EKSAddon{
+				createDesiredAddon(addon1Name, ">=1.19.0"),
+			},
+			installedAddons: []*EKSAddon{
+				createInstalledAddon(addon1Name, "v1.18.5-eksbuild.1", addonARN, addonStatusActive, false),
+			},
+			expectCreateError: false,
+			expectDoError:     false,
+		},
+		{
+			name: "no installed and 1 desired - invalid configuration",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonConfiguration(gomock.Eq(context.TODO()), gomock.Eq(&eks.DescribeAddonConfigurationInput{
+					AddonName:    aws.String(addon1Name),
+					AddonVersion: aws.String("1.0.1"),
+				})).Return(&eks.DescribeAddonConfigurationOutput{
+					ConfigurationSchema: aws.String(configurationSchema),
+				}, nil)
+			},
+			desiredAddons: []*EKSAddon{
+				func() *EKSAddon {
+					desired := createDesiredAddon(addon1Name, "1.0.1")
+					desired.Configuration = aws.String(`{"replicaCount":"two"}`)
+					return desired
+				}(),
+			},
+			expectCreateError: true,
+		},
+		{
+			name: "1 installed and 1 desired - configuration update",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonConfiguration(gomock.Eq(context.TODO()), gomock.Eq(&eks.DescribeAddonConfigurationInput{
+					AddonName:    aws.String(addon1Name),
+					AddonVersion: aws.String("1.0.2"),
+				})).Return(&eks.DescribeAddonConfigurationOutput{
+					ConfigurationSchema: aws.String(configurationSchema),
+				}, nil)
+				m.
+					UpdateAddon(gomock.Eq(context.TODO()), gomock.Eq(&eks.UpdateAddonInput{
+						AddonName:           aws.String(addon1Name),
+						AddonVersion:        aws.String("1.0.2"),
+						ClusterName:         aws.String(clusterName),
+						ConfigurationValues: aws.String(`{"replicaCount":2}`),
+						ResolveConflicts:    ekstypes.ResolveConflictsOverwrite,
+					})).
+					Return(&eks.UpdateAddonOutput{}, nil)
+
+				out := &eks.DescribeAddonOutput{
+					Addon: &ekstypes.Addon{
+						Status: ekstypes.AddonStatusActive,
+					},
+				}
+				m.DescribeAddon(gomock.Eq(context.TODO()), gomock.Any()).Return(out, nil)
+			},
+			desiredAddons: []*EKSAddon{
+				func() *EKSAddon {
+					desired := createDesiredAddon(addon1Name, "1.0.2")
+					desired.Configuration = aws.String(`{"replicaCount":2}`)
+					return desired
+				}(),
+			},
+			installedAddons: []*EKSAddon{
+				createInstalledAddon(addon1Name, "1.0.2", addonARN, addonStatusActive, false),
+			},
+			expectCreateError: false,
+			expectDoError:     false,
+		},
 		{
 			name: "1 installed and 0 desired - delete addon",
 			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
@@ -419,7 +689,8 @@ func TestEKSAddonPlan(t *testing.T) {
 
 			ctx := context.TODO()
 
-			planner := NewPlan(clusterName, tc.desiredAddons, tc.installedAddons, eksMock, maxActiveUpdateDeleteWait)
+			planner := NewPlan(clusterName, kubernetesVersion, tc.desiredAddons, tc.installedAddons, eksMock, maxActiveUpdateDeleteWait)
+			planner.(*plan).versionsCache = nil
 			procedures, err := planner.Create(ctx)
 			if tc.expectCreateError {
 				g.Expect(err).To(HaveOccurred())
@@ -476,6 +747,15 @@ func createDesiredAddonExtraTag(name, version string) *EKSAddon {
 	}
 }
 
+func createDesiredAddonWithPodIdentity(name, version, serviceAccount, roleARN string) *EKSAddon {
+	desired := createDesiredAddon(name, version)
+	desired.PodIdentityAssociations = []PodIdentityAssociation{
+		{ServiceAccount: serviceAccount, RoleARN: roleARN},
+	}
+
+	return desired
+}
+
 func createInstalledAddon(name, version, arn, status string, preserve bool) *EKSAddon {
 	desired := createDesiredAddon(name, version)
 	desired.ARN = &arn
@@ -484,3 +764,15 @@ func createInstalledAddon(name, version, arn, status string, preserve bool) *EKS
 
 	return desired
 }
+
+func createAddonVersionInfo(version, kubernetesVersion string, defaultVersion bool) ekstypes.AddonVersionInfo {
+	return ekstypes.AddonVersionInfo{
+		AddonVersion: aws.String(version),
+		Compatibilities: []ekstypes.Compatibility{
+			{
+				ClusterVersion: aws.String(kubernetesVersion),
+				DefaultVersion: defaultVersion,
+			},
+		},
+	}
+}
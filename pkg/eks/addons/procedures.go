@@ -80,12 +80,19 @@ func (p *UpdateAddonProcedure) Do(ctx context.Context) error {
 	}
 
 	input := &eks.UpdateAddonInput{
-		AddonName:             desired.Name,
-		AddonVersion:          desired.Version,
-		ClusterName:           &p.plan.clusterName,
-		ConfigurationValues:   desired.Configuration,
-		ResolveConflicts:      converters.AddonConflictResolutionToSDK(desired.ResolveConflict),
-		ServiceAccountRoleArn: desired.ServiceAccountRoleARN,
+		AddonName:               desired.Name,
+		AddonVersion:            desired.Version,
+		ClusterName:             &p.plan.clusterName,
+		ConfigurationValues:     desired.Configuration,
+		ResolveConflicts:        converters.AddonConflictResolutionToSDK(desired.ResolveConflict),
+		ServiceAccountRoleArn:   desired.ServiceAccountRoleARN,
+		PodIdentityAssociations: podIdentityAssociationsToSDK(desired.PodIdentityAssociations),
+	}
+
+	// EKS leaves the pod identity associations unchanged when none are passed, an empty list
+	// is required to delete the ones owned by the addon.
+	if installed := p.plan.getInstalled(p.name); input.PodIdentityAssociations == nil && installed != nil && len(installed.PodIdentityAssociations) > 0 {
+		input.PodIdentityAssociations = []ekstypes.AddonPodIdentityAssociations{}
 	}
 
 	if _, err := p.plan.eksClient.UpdateAddon(ctx, input); err != nil {
@@ -149,13 +156,14 @@ func (p *CreateAddonProcedure) Do(ctx context.Context) error {
 	}
 
 	input := &eks.CreateAddonInput{
-		AddonName:             desired.Name,
-		AddonVersion:          desired.Version,
-		ClusterName:           &p.plan.clusterName,
-		ConfigurationValues:   desired.Configuration,
-		ServiceAccountRoleArn: desired.ServiceAccountRoleARN,
-		ResolveConflicts:      converters.AddonConflictResolutionToSDK(desired.ResolveConflict),
-		Tags:                  desired.Tags,
+		AddonName:               desired.Name,
+		AddonVersion:            desired.Version,
+		ClusterName:             &p.plan.clusterName,
+		ConfigurationValues:     desired.Configuration,
+		ServiceAccountRoleArn:   desired.ServiceAccountRoleARN,
+		PodIdentityAssociations: podIdentityAssociationsToSDK(desired.PodIdentityAssociations),
+		ResolveConflicts:        converters.AddonConflictResolutionToSDK(desired.ResolveConflict),
+		Tags:                    desired.Tags,
 	}
 
 	output, err := p.plan.eksClient.CreateAddon(ctx, input)
@@ -243,3 +251,19 @@ func (p *WaitAddonDeleteProcedure) Do(ctx context.Context) error {
 func (p *WaitAddonDeleteProcedure) Name() string {
 	return "addon_wait_delete"
 }
+
+func podIdentityAssociationsToSDK(associations []PodIdentityAssociation) []ekstypes.AddonPodIdentityAssociations {
+	if len(associations) == 0 {
+		return nil
+	}
+
+	sdkAssociations := make([]ekstypes.AddonPodIdentityAssociations, 0, len(associations))
+	for _, association := range associations {
+		sdkAssociations = append(sdkAssociations, ekstypes.AddonPodIdentityAssociations{
+			ServiceAccount: aws.String(association.ServiceAccount),
+			RoleArn:        aws.String(association.RoleARN),
+		})
+	}
+
+	return sdkAssociations
+}
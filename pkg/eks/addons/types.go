@@ -18,21 +18,33 @@ package addons
 
 import (
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 )
 
 // EKSAddon represents an EKS addon.
 type EKSAddon struct {
-	Name                  *string
-	Version               *string
-	ServiceAccountRoleARN *string
-	Configuration         *string
-	Tags                  infrav1.Tags
-	ResolveConflict       *string
-	Preserve              bool
-	ARN                   *string
-	Status                *string
+	Name                    *string
+	Version                 *string
+	ServiceAccountRoleARN   *string
+	PodIdentityAssociations []PodIdentityAssociation
+	Configuration           *string
+	Tags                    infrav1.Tags
+	ResolveConflict         *string
+	Preserve                bool
+	ARN                     *string
+	Status                  *string
+	// AutoUpdate indicates that an installed addon whose version is a selector is
+	// updated to the version the selector resolves to, even if the installed version
+	// still matches the selector.
+	AutoUpdate bool
+}
+
+// PodIdentityAssociation represents an EKS Pod Identity association of an addon service account.
+type PodIdentityAssociation struct {
+	ServiceAccount string
+	RoleARN        string
 }
 
 // IsEqual determines if 2 EKSAddon are equal.
@@ -50,6 +62,11 @@ func (e *EKSAddon) IsEqual(other *EKSAddon, includeTags bool) bool {
 	if !cmp.Equal(e.Configuration, other.Configuration) {
 		return false
 	}
+	if !cmp.Equal(e.PodIdentityAssociations, other.PodIdentityAssociations, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b PodIdentityAssociation) bool {
+		return a.ServiceAccount < b.ServiceAccount
+	})) {
+		return false
+	}
 
 	if includeTags {
 		diffTags := e.Tags.Difference(other.Tags)
@@ -0,0 +1,203 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/blang/semver"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	capicache "sigs.k8s.io/cluster-api/util/cache"
+)
+
+// compatibleVersionsTTL is how long the versions of an addon that are compatible with a Kubernetes
+// version are cached, so that the version selectors of addons are not resolved against the EKS API on
+// every reconcile of every cluster.
+const compatibleVersionsTTL = time.Hour
+
+// compatibleVersions are the versions of an addon that are compatible with a Kubernetes version.
+type compatibleVersions struct {
+	versions       []string
+	defaultVersion string
+}
+
+// compatibleVersionsEntry caches the compatible versions of an addon for a Kubernetes version.
+type compatibleVersionsEntry struct {
+	addonName         string
+	kubernetesVersion string
+	compatible        *compatibleVersions
+}
+
+// Key returns the cache key of a compatibleVersionsEntry.
+func (e compatibleVersionsEntry) Key() string {
+	return compatibleVersionsKey(e.addonName, e.kubernetesVersion)
+}
+
+func compatibleVersionsKey(addonName, kubernetesVersion string) string {
+	return addonName + "/" + kubernetesVersion
+}
+
+// compatibleVersionsCache is shared by the plans of all the clusters.
+var compatibleVersionsCache = capicache.New[compatibleVersionsEntry](compatibleVersionsTTL)
+
+// resolveVersion returns the version of the desired addon to install. Exact versions are
+// returned as is, selectors are resolved against the versions of the addon that are
+// compatible with the Kubernetes version of the cluster. An installed version that matches
+// the selector is kept unless the addon is auto updated.
+func (a *plan) resolveVersion(ctx context.Context, desired, installed *EKSAddon) (string, error) {
+	selector := aws.ToString(desired.Version)
+	if !ekscontrolplanev1.IsAddonVersionSelector(selector) {
+		return selector, nil
+	}
+	if selector == ekscontrolplanev1.AddonVersionAuto {
+		selector = ekscontrolplanev1.AddonVersionDefault
+	}
+
+	if a.kubernetesVersion == "" {
+		return "", fmt.Errorf("resolving version %q of eks addon %s: Kubernetes version of the cluster is not known yet", selector, aws.ToString(desired.Name))
+	}
+
+	compatible, err := a.describeCompatibleVersions(ctx, aws.ToString(desired.Name))
+	if err != nil {
+		return "", fmt.Errorf("resolving version %q of eks addon %s: %w", selector, aws.ToString(desired.Name), err)
+	}
+
+	candidates, err := filterVersions(compatible.versions, selector)
+	if err != nil {
+		return "", fmt.Errorf("resolving version %q of eks addon %s: %w", selector, aws.ToString(desired.Name), err)
+	}
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("no version of eks addon %s matching %q is compatible with Kubernetes %s", aws.ToString(desired.Name), selector, a.kubernetesVersion)
+	}
+
+	if installed != nil && !desired.AutoUpdate {
+		for _, candidate := range candidates {
+			if candidate == aws.ToString(installed.Version) {
+				return candidate, nil
+			}
+		}
+	}
+
+	if selector == ekscontrolplanev1.AddonVersionDefault && compatible.defaultVersion != "" {
+		return compatible.defaultVersion, nil
+	}
+
+	return newestVersion(candidates), nil
+}
+
+// describeCompatibleVersions returns the versions of an addon that are compatible with the
+// Kubernetes version of the cluster.
+func (a *plan) describeCompatibleVersions(ctx context.Context, addonName string) (*compatibleVersions, error) {
+	if a.versionsCache != nil {
+		if entry, ok := a.versionsCache.Has(compatibleVersionsKey(addonName, a.kubernetesVersion)); ok {
+			return entry.compatible, nil
+		}
+	}
+
+	input := &eks.DescribeAddonVersionsInput{
+		AddonName:         aws.String(addonName),
+		KubernetesVersion: aws.String(a.kubernetesVersion),
+	}
+
+	compatible := &compatibleVersions{}
+	for {
+		output, err := a.eksClient.DescribeAddonVersions(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("describing eks addon versions: %w", err)
+		}
+
+		for _, addon := range output.Addons {
+			for _, addonVersion := range addon.AddonVersions {
+				for _, compatibility := range addonVersion.Compatibilities {
+					if aws.ToString(compatibility.ClusterVersion) != a.kubernetesVersion {
+						continue
+					}
+					compatible.versions = append(compatible.versions, aws.ToString(addonVersion.AddonVersion))
+					if compatibility.DefaultVersion {
+						compatible.defaultVersion = aws.ToString(addonVersion.AddonVersion)
+					}
+				}
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	if a.versionsCache != nil {
+		a.versionsCache.Add(compatibleVersionsEntry{
+			addonName:         addonName,
+			kubernetesVersion: a.kubernetesVersion,
+			compatible:        compatible,
+		})
+	}
+
+	return compatible, nil
+}
+
+// filterVersions returns the versions that match a version selector. Semver ranges are
+// matched against the major, minor and patch version only so that the build metadata of
+// EKS addon versions, such as -eksbuild.1, does not exclude them from a range.
+func filterVersions(versions []string, selector string) ([]string, error) {
+	if selector == ekscontrolplanev1.AddonVersionDefault || selector == ekscontrolplanev1.AddonVersionLatest {
+		return versions, nil
+	}
+
+	versionRange, err := semver.ParseRange(selector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing version range: %w", err)
+	}
+
+	filtered := []string{}
+	for _, v := range versions {
+		parsed, err := semver.ParseTolerant(v)
+		if err != nil {
+			continue
+		}
+		if versionRange(semver.Version{Major: parsed.Major, Minor: parsed.Minor, Patch: parsed.Patch}) {
+			filtered = append(filtered, v)
+		}
+	}
+
+	return filtered, nil
+}
+
+// newestVersion returns the newest of the given addon versions, comparing the EKS build
+// of versions that share the same major, minor and patch version.
+func newestVersion(versions []string) string {
+	var newest string
+	var newestParsed semver.Version
+	for _, v := range versions {
+		parsed, err := semver.ParseTolerant(v)
+		if err != nil {
+			continue
+		}
+		if newest == "" || parsed.GT(newestParsed) {
+			newest = v
+			newestParsed = parsed
+		}
+	}
+
+	return newest
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	capicache "sigs.k8s.io/cluster-api/util/cache"
+)
+
+func TestResolveVersion(t *testing.T) {
+	addonVersions := &eks.DescribeAddonVersionsOutput{
+		Addons: []ekstypes.AddonInfo{
+			{
+				AddonName: aws.String("vpc-cni"),
+				AddonVersions: []ekstypes.AddonVersionInfo{
+					createAddonVersionInfo("v1.19.2-eksbuild.1", "1.31", false),
+					createAddonVersionInfo("v1.18.5-eksbuild.1", "1.31", true),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		kubernetesVersion string
+		version           string
+		autoUpdate        bool
+		installedVersion  string
+		expect            func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectedVersion   string
+		expectError       bool
+	}{
+		{
+			name:              "explicit version is kept",
+			kubernetesVersion: "1.31",
+			version:           "v1.17.0-eksbuild.1",
+			expect:            func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectedVersion:   "v1.17.0-eksbuild.1",
+		},
+		{
+			name:              "auto resolves to the default version",
+			kubernetesVersion: "1.31",
+			version:           ekscontrolplanev1.AddonVersionAuto,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonVersions(gomock.Any(), &eks.DescribeAddonVersionsInput{
+					AddonName:         aws.String("vpc-cni"),
+					KubernetesVersion: aws.String("1.31"),
+				}).Return(addonVersions, nil)
+			},
+			expectedVersion: "v1.18.5-eksbuild.1",
+		},
+		{
+			name:              "auto resolves to the newest version without a default",
+			kubernetesVersion: "1.31",
+			version:           ekscontrolplanev1.AddonVersionAuto,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonVersions(gomock.Any(), gomock.Any()).Return(&eks.DescribeAddonVersionsOutput{
+					Addons: []ekstypes.AddonInfo{
+						{
+							AddonName: aws.String("vpc-cni"),
+							AddonVersions: []ekstypes.AddonVersionInfo{
+								createAddonVersionInfo("v1.18.5-eksbuild.1", "1.31", false),
+								createAddonVersionInfo("v1.19.2-eksbuild.1", "1.31", false),
+							},
+						},
+					},
+				}, nil)
+			},
+			expectedVersion: "v1.19.2-eksbuild.1",
+		},
+		{
+			name:              "auto keeps the installed version until the addon is updated",
+			kubernetesVersion: "1.31",
+			version:           ekscontrolplanev1.AddonVersionAuto,
+			installedVersion:  "v1.19.2-eksbuild.1",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonVersions(gomock.Any(), gomock.Any()).Return(addonVersions, nil)
+			},
+			expectedVersion: "v1.19.2-eksbuild.1",
+		},
+		{
+			name:              "auto resolves to the default version after a cluster upgrade",
+			kubernetesVersion: "1.31",
+			version:           ekscontrolplanev1.AddonVersionAuto,
+			autoUpdate:        true,
+			installedVersion:  "v1.19.2-eksbuild.1",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonVersions(gomock.Any(), gomock.Any()).Return(addonVersions, nil)
+			},
+			expectedVersion: "v1.18.5-eksbuild.1",
+		},
+		{
+			name:              "no compatible version",
+			kubernetesVersion: "1.31",
+			version:           ekscontrolplanev1.AddonVersionAuto,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonVersions(gomock.Any(), gomock.Any()).Return(&eks.DescribeAddonVersionsOutput{}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name:        "unknown cluster version",
+			version:     ekscontrolplanev1.AddonVersionAuto,
+			expect:      func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			tc.expect(eksMock.EXPECT())
+
+			a := &plan{
+				eksClient:         eksMock,
+				kubernetesVersion: tc.kubernetesVersion,
+			}
+
+			desired := &EKSAddon{
+				Name:       aws.String("vpc-cni"),
+				Version:    aws.String(tc.version),
+				AutoUpdate: tc.autoUpdate,
+			}
+			var installed *EKSAddon
+			if tc.installedVersion != "" {
+				installed = &EKSAddon{
+					Name:    aws.String("vpc-cni"),
+					Version: aws.String(tc.installedVersion),
+				}
+			}
+
+			resolved, err := a.resolveVersion(context.TODO(), desired, installed)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(resolved).To(Equal(tc.expectedVersion))
+		})
+	}
+}
+
+func TestDescribeCompatibleVersionsCache(t *testing.T) {
+	g := NewWithT(t)
+
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+
+	eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+	// The versions are described once per addon and Kubernetes version.
+	eksMock.EXPECT().DescribeAddonVersions(gomock.Any(), &eks.DescribeAddonVersionsInput{
+		AddonName:         aws.String("vpc-cni"),
+		KubernetesVersion: aws.String("1.31"),
+	}).Return(&eks.DescribeAddonVersionsOutput{
+		Addons: []ekstypes.AddonInfo{
+			{
+				AddonName: aws.String("vpc-cni"),
+				AddonVersions: []ekstypes.AddonVersionInfo{
+					createAddonVersionInfo("v1.18.5-eksbuild.1", "1.31", true),
+				},
+			},
+		},
+	}, nil).Times(1)
+	eksMock.EXPECT().DescribeAddonVersions(gomock.Any(), &eks.DescribeAddonVersionsInput{
+		AddonName:         aws.String("vpc-cni"),
+		KubernetesVersion: aws.String("1.32"),
+	}).Return(&eks.DescribeAddonVersionsOutput{}, nil).Times(1)
+
+	versionsCache := capicache.New[compatibleVersionsEntry](time.Hour)
+	for range 2 {
+		a := &plan{eksClient: eksMock, kubernetesVersion: "1.31", versionsCache: versionsCache}
+		compatible, err := a.describeCompatibleVersions(context.TODO(), "vpc-cni")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(compatible.versions).To(ConsistOf("v1.18.5-eksbuild.1"))
+		g.Expect(compatible.defaultVersion).To(Equal("v1.18.5-eksbuild.1"))
+	}
+
+	a := &plan{eksClient: eksMock, kubernetesVersion: "1.32", versionsCache: versionsCache}
+	compatible, err := a.describeCompatibleVersions(context.TODO(), "vpc-cni")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(compatible.versions).To(BeEmpty())
+}
+
+func TestFilterVersions(t *testing.T) {
+	versions := []string{"v1.18.5-eksbuild.1", "v1.18.6-eksbuild.2", "v1.19.2-eksbuild.1", "v2.0.0-eksbuild.1"}
+
+	tests := []struct {
+		name        string
+		selector    string
+		expected    []string
+		expectError bool
+	}{
+		{
+			name:     "latest",
+			selector: "latest",
+			expected: versions,
+		},
+		{
+			name:     "wildcard",
+			selector: "1.18.x",
+			expected: []string{"v1.18.5-eksbuild.1", "v1.18.6-eksbuild.2"},
+		},
+		{
+			name:     "range includes builds of the upper bound",
+			selector: ">=1.18.6 <=1.19.2",
+			expected: []string{"v1.18.6-eksbuild.2", "v1.19.2-eksbuild.1"},
+		},
+		{
+			name:     "no match",
+			selector: ">3.0.0",
+			expected: []string{},
+		},
+		{
+			name:        "invalid range",
+			selector:    ">=a.b",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			filtered, err := filterVersions(versions, tc.selector)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(filtered).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestNewestVersion(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(newestVersion([]string{"v1.18.5-eksbuild.9", "v1.18.5-eksbuild.10", "v1.18.4-eksbuild.11"})).To(Equal("v1.18.5-eksbuild.10"))
+	g.Expect(newestVersion([]string{})).To(BeEmpty())
+}
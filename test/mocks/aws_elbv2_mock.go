@@ -112,6 +112,26 @@ func (mr *MockELBV2APIMockRecorder) CreateLoadBalancer(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancer", reflect.TypeOf((*MockELBV2API)(nil).CreateLoadBalancer), varargs...)
 }
 
+// CreateRule mocks base method.
+func (m *MockELBV2API) CreateRule(arg0 context.Context, arg1 *elasticloadbalancingv2.CreateRuleInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.CreateRuleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateRule", varargs...)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.CreateRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRule indicates an expected call of CreateRule.
+func (mr *MockELBV2APIMockRecorder) CreateRule(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRule", reflect.TypeOf((*MockELBV2API)(nil).CreateRule), varargs...)
+}
+
 // CreateTargetGroup mocks base method.
 func (m *MockELBV2API) CreateTargetGroup(arg0 context.Context, arg1 *elasticloadbalancingv2.CreateTargetGroupInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.CreateTargetGroupOutput, error) {
 	m.ctrl.T.Helper()
@@ -172,6 +192,26 @@ func (mr *MockELBV2APIMockRecorder) DeleteLoadBalancer(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancer", reflect.TypeOf((*MockELBV2API)(nil).DeleteLoadBalancer), varargs...)
 }
 
+// DeleteRule mocks base method.
+func (m *MockELBV2API) DeleteRule(arg0 context.Context, arg1 *elasticloadbalancingv2.DeleteRuleInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DeleteRuleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRule", varargs...)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.DeleteRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockELBV2APIMockRecorder) DeleteRule(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockELBV2API)(nil).DeleteRule), varargs...)
+}
+
 // DeleteTargetGroup mocks base method.
 func (m *MockELBV2API) DeleteTargetGroup(arg0 context.Context, arg1 *elasticloadbalancingv2.DeleteTargetGroupInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DeleteTargetGroupOutput, error) {
 	m.ctrl.T.Helper()
@@ -286,6 +326,26 @@ func (mr *MockELBV2APIMockRecorder) DescribeLoadBalancersPages(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoadBalancersPages", reflect.TypeOf((*MockELBV2API)(nil).DescribeLoadBalancersPages), arg0, arg1, arg2)
 }
 
+// DescribeRules mocks base method.
+func (m *MockELBV2API) DescribeRules(arg0 context.Context, arg1 *elasticloadbalancingv2.DescribeRulesInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeRulesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeRules", varargs...)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.DescribeRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRules indicates an expected call of DescribeRules.
+func (mr *MockELBV2APIMockRecorder) DescribeRules(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRules", reflect.TypeOf((*MockELBV2API)(nil).DescribeRules), varargs...)
+}
+
 // DescribeTags mocks base method.
 func (m *MockELBV2API) DescribeTags(arg0 context.Context, arg1 *elasticloadbalancingv2.DescribeTagsInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTagsOutput, error) {
 	m.ctrl.T.Helper()
@@ -306,6 +366,26 @@ func (mr *MockELBV2APIMockRecorder) DescribeTags(arg0, arg1 interface{}, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTags", reflect.TypeOf((*MockELBV2API)(nil).DescribeTags), varargs...)
 }
 
+// DescribeTargetGroupAttributes mocks base method.
+func (m *MockELBV2API) DescribeTargetGroupAttributes(arg0 context.Context, arg1 *elasticloadbalancingv2.DescribeTargetGroupAttributesInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTargetGroupAttributesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTargetGroupAttributes", varargs...)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.DescribeTargetGroupAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTargetGroupAttributes indicates an expected call of DescribeTargetGroupAttributes.
+func (mr *MockELBV2APIMockRecorder) DescribeTargetGroupAttributes(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTargetGroupAttributes", reflect.TypeOf((*MockELBV2API)(nil).DescribeTargetGroupAttributes), varargs...)
+}
+
 // DescribeTargetGroups mocks base method.
 func (m *MockELBV2API) DescribeTargetGroups(arg0 context.Context, arg1 *elasticloadbalancingv2.DescribeTargetGroupsInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTargetGroupsOutput, error) {
 	m.ctrl.T.Helper()
@@ -386,6 +466,26 @@ func (mr *MockELBV2APIMockRecorder) ModifyLoadBalancerAttributes(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyLoadBalancerAttributes", reflect.TypeOf((*MockELBV2API)(nil).ModifyLoadBalancerAttributes), varargs...)
 }
 
+// ModifyRule mocks base method.
+func (m *MockELBV2API) ModifyRule(arg0 context.Context, arg1 *elasticloadbalancingv2.ModifyRuleInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.ModifyRuleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ModifyRule", varargs...)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.ModifyRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyRule indicates an expected call of ModifyRule.
+func (mr *MockELBV2APIMockRecorder) ModifyRule(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyRule", reflect.TypeOf((*MockELBV2API)(nil).ModifyRule), varargs...)
+}
+
 // ModifyTargetGroupAttributes mocks base method.
 func (m *MockELBV2API) ModifyTargetGroupAttributes(arg0 context.Context, arg1 *elasticloadbalancingv2.ModifyTargetGroupAttributesInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.ModifyTargetGroupAttributesOutput, error) {
 	m.ctrl.T.Helper()